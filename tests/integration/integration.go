@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica"
+	icaTypes "github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq"
+	icqTypes "github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/warp"
 	warpTypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 
@@ -175,6 +179,18 @@ func (suite *KeeperTestSuite) genesisImportExport() {
 	genWarpState := warpModule.ExportGenesis(suite.Ctx(), suite.App().AppCodec())
 	suite.deleteStore(suite.getStoreByKeyName(warpTypes.ModuleName))
 	warpModule.InitGenesis(suite.Ctx(), suite.App().AppCodec(), genWarpState)
+
+	// Reimport Hyperlane ICA state
+	icaModule := ica.NewAppModule(suite.App().AppCodec(), suite.App().IcaKeeper)
+	genIcaState := icaModule.ExportGenesis(suite.Ctx(), suite.App().AppCodec())
+	suite.deleteStore(suite.getStoreByKeyName(icaTypes.ModuleName))
+	icaModule.InitGenesis(suite.Ctx(), suite.App().AppCodec(), genIcaState)
+
+	// Reimport Hyperlane ICQ state
+	icqModule := icq.NewAppModule(suite.App().AppCodec(), suite.App().IcqKeeper)
+	genIcqState := icqModule.ExportGenesis(suite.Ctx(), suite.App().AppCodec())
+	suite.deleteStore(suite.getStoreByKeyName(icqTypes.ModuleName))
+	icqModule.InitGenesis(suite.Ctx(), suite.App().AppCodec(), genIcqState)
 }
 
 func (suite *KeeperTestSuite) deleteStore(store store.KVStore) {
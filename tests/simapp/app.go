@@ -13,6 +13,8 @@ import (
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 
 	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	icaTypes "github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+	icqTypes "github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
 	warpTypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -25,8 +27,12 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	coremodulev1 "github.com/bcp-innovations/hyperlane-cosmos/api/core/module/v1"
+	icamodulev1 "github.com/bcp-innovations/hyperlane-cosmos/api/ica/module/v1"
+	icqmodulev1 "github.com/bcp-innovations/hyperlane-cosmos/api/icq/module/v1"
 	warpmodulev1 "github.com/bcp-innovations/hyperlane-cosmos/api/warp/module/v1"
 	hyperlanekeeper "github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
+	icakeeper "github.com/bcp-innovations/hyperlane-cosmos/x/ica/keeper"
+	icqkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/icq/keeper"
 	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -47,6 +53,8 @@ import (
 
 	_ "cosmossdk.io/api/cosmos/tx/config/v1"               // import for side-effects
 	_ "github.com/bcp-innovations/hyperlane-cosmos/x/core" // import for side-effects
+	_ "github.com/bcp-innovations/hyperlane-cosmos/x/ica"  // import for side-effects
+	_ "github.com/bcp-innovations/hyperlane-cosmos/x/icq"  // import for side-effects
 	_ "github.com/bcp-innovations/hyperlane-cosmos/x/warp" // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/auth"                // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"      // import for side-effects
@@ -97,6 +105,8 @@ type App struct {
 	// Hyperlane
 	HyperlaneKeeper *hyperlanekeeper.Keeper
 	WarpKeeper      warpkeeper.Keeper
+	IcaKeeper       icakeeper.Keeper
+	IcqKeeper       icqkeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -114,6 +124,14 @@ func DefaultHyperlaneModuleConfigs(enabledTokens []int32) []*appv1alpha1.ModuleC
 			Name:   coreTypes.ModuleName,
 			Config: appconfig.WrapAny(&coremodulev1.Module{}),
 		},
+		{
+			Name:   icaTypes.ModuleName,
+			Config: appconfig.WrapAny(&icamodulev1.Module{}),
+		},
+		{
+			Name:   icqTypes.ModuleName,
+			Config: appconfig.WrapAny(&icqmodulev1.Module{}),
+		},
 	}
 }
 
@@ -192,6 +210,8 @@ func NewMiniAppWithCustomConfig(
 
 		&app.HyperlaneKeeper,
 		&app.WarpKeeper,
+		&app.IcaKeeper,
+		&app.IcqKeeper,
 	); err != nil {
 		return nil, err
 	}
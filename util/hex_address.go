@@ -84,6 +84,18 @@ func DecodeHexAddress(s string) (HexAddress, error) {
 	return HexAddress(b), nil
 }
 
+// ParseFromCosmosAcc left-pads a cosmos account address to a HexAddress.
+// This is the canonical encoding for cosmos accounts in Hyperlane messages.
+func ParseFromCosmosAcc(cosmosAcc []byte) (HexAddress, error) {
+	if len(cosmosAcc) > HEX_ADDRESS_LENGTH {
+		return HexAddress{}, fmt.Errorf("account address too long: %d bytes", len(cosmosAcc))
+	}
+
+	var address HexAddress
+	copy(address[HEX_ADDRESS_LENGTH-len(cosmosAcc):], cosmosAcc)
+	return address, nil
+}
+
 func CreateMockHexAddress(identifier string, id int64) HexAddress {
 	idBytes := make([]byte, id_length)
 	binary.BigEndian.PutUint64(idBytes, uint64(id))
@@ -1,6 +1,7 @@
 package util_test
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -16,6 +17,8 @@ TEST CASES - hex_address.go
 * Decode (invalid) Hex Address (to short)
 * Decode (invalid) Hex Address (invalid hex)
 * Address generation
+* ParseFromCosmosAcc (valid) 20 byte account
+* ParseFromCosmosAcc (invalid) too long account
 * Proto marshalling
 * Proto marshalling 2
 * Proto marshalling 2 (wrong length)
@@ -90,6 +93,29 @@ var _ = Describe("hex_address.go", Ordered, func() {
 		Expect(address.GetType()).To(Equal(uint32(1)))
 	})
 
+	It("ParseFromCosmosAcc (valid) 20 byte account", func() {
+		// Arrange
+		account := bytes.Repeat([]byte{0xab}, 20)
+
+		// Act
+		address, err := util.ParseFromCosmosAcc(account)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(address.String()).To(Equal("0x000000000000000000000000abababababababababababababababababababab"))
+	})
+
+	It("ParseFromCosmosAcc (invalid) too long account", func() {
+		// Arrange
+		account := bytes.Repeat([]byte{0xab}, 33)
+
+		// Act
+		_, err := util.ParseFromCosmosAcc(account)
+
+		// Assert
+		Expect(err.Error()).To(Equal("account address too long: 33 bytes"))
+	})
+
 	It("Proto marshalling", func() {
 		// Arrange
 		identifier := make([]byte, 20)
@@ -40,6 +40,14 @@ func GetTxCmd() *cobra.Command {
 		CmdSetRoutingIsmDomain(),
 		CmdRemoveRoutingIsmDomain(),
 		CmdUpdateRoutingIsmOwner(),
+		CmdCreateAggregationIsm(),
+		CmdSetAggregationIsmModules(),
+		CmdUpdateAggregationIsmOwner(),
+		CmdCreateWeightedMessageIdMultisigIsm(),
+		CmdCreateWeightedMerkleRootMultisigIsm(),
+		CmdSetMultisigIsmValidators(),
+		CmdSetMultisigIsmThreshold(),
+		CmdUpdateMultisigIsmOwner(),
 	)
 
 	return txCmd
@@ -333,3 +341,374 @@ func CmdUpdateRoutingIsmOwner() *cobra.Command {
 
 	return cmd
 }
+
+func parseHexAddresses(raw string) ([]util.HexAddress, error) {
+	var addresses []util.HexAddress
+	for _, item := range strings.Split(raw, ",") {
+		address, err := util.DecodeHexAddress(item)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func CmdCreateAggregationIsm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-aggregation [modules] [threshold]",
+		Short: "Create a Hyperlane Aggregation ISM",
+		Long:  "Create a Hyperlane Aggregation ISM. Modules are provided as a comma-separated list of ISM ids.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			modules, err := parseHexAddresses(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateAggregationIsm{
+				Creator:   clientCtx.GetFromAddress().String(),
+				Modules:   modules,
+				Threshold: uint32(threshold),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetAggregationIsmModules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-aggregation-ism-modules [aggregation-ism-id] [modules] [threshold]",
+		Short: "Sets the modules and threshold of an aggregation ISM",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			aggregationIsmId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			modules, err := parseHexAddresses(args[1])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetAggregationIsmModules{
+				IsmId:     aggregationIsmId,
+				Modules:   modules,
+				Threshold: uint32(threshold),
+				Owner:     clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateAggregationIsmOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-aggregation-ism-owner [aggregation-ism-id]",
+		Short: "Update the owner of an aggregation ISM",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			yes, err := cmd.Flags().GetBool("yes")
+			if err != nil {
+				return err
+			}
+
+			if renounceOwnership && !yes {
+				fmt.Print("Are you sure you want to renounce ownership? This action is irreversible. (yes/no): ")
+				var response string
+
+				_, err := fmt.Scanln(&response)
+				if err != nil {
+					return err
+				}
+
+				if strings.ToLower(response) != "yes" {
+					return fmt.Errorf("canceled transaction")
+				}
+			}
+			return nil
+		}, RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			aggregationIsmId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateAggregationIsmOwner{
+				IsmId:             aggregationIsmId,
+				NewOwner:          newOwner,
+				Owner:             clientCtx.GetFromAddress().String(),
+				RenounceOwnership: renounceOwnership,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&newOwner, "new-owner", "", "new owner")
+	cmd.Flags().BoolVar(&renounceOwnership, "renounce-ownership", false, "renounce ownership")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseWeightedValidators parses a comma separated list of `address:weight` pairs.
+func parseWeightedValidators(raw string) ([]types.WeightedValidator, error) {
+	var validators []types.WeightedValidator
+	for _, item := range strings.Split(raw, ",") {
+		address, rawWeight, found := strings.Cut(item, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid weighted validator %s, expected format address:weight", item)
+		}
+
+		weight, err := strconv.ParseUint(rawWeight, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, types.WeightedValidator{
+			Address: address,
+			Weight:  weight,
+		})
+	}
+	return validators, nil
+}
+
+func CmdCreateWeightedMessageIdMultisigIsm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-weighted-message-id-multisig [validators] [threshold-weight]",
+		Short: "Create a Hyperlane Weighted MessageId Multisig ISM",
+		Long:  "Create a Hyperlane Weighted MessageId Multisig ISM. Validators are provided as comma separated address:weight pairs.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			validators, err := parseWeightedValidators(args[0])
+			if err != nil {
+				return err
+			}
+
+			thresholdWeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateWeightedMessageIdMultisigIsm{
+				Creator:         clientCtx.GetFromAddress().String(),
+				Validators:      validators,
+				ThresholdWeight: thresholdWeight,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCreateWeightedMerkleRootMultisigIsm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-weighted-merkle-root-multisig [validators] [threshold-weight]",
+		Short: "Create a Hyperlane Weighted MerkleRoot Multisig ISM",
+		Long:  "Create a Hyperlane Weighted MerkleRoot Multisig ISM. Validators are provided as comma separated address:weight pairs.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			validators, err := parseWeightedValidators(args[0])
+			if err != nil {
+				return err
+			}
+
+			thresholdWeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateWeightedMerkleRootMultisigIsm{
+				Creator:         clientCtx.GetFromAddress().String(),
+				Validators:      validators,
+				ThresholdWeight: thresholdWeight,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetMultisigIsmValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-multisig-ism-validators [multisig-ism-id] [validators] [threshold]",
+		Short: "Replace the validators and threshold of a multisig ISM",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			multisigIsmId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			validators := strings.Split(args[1], ",")
+			threshold, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetMultisigIsmValidators{
+				IsmId:      multisigIsmId,
+				Owner:      clientCtx.GetFromAddress().String(),
+				Validators: validators,
+				Threshold:  uint32(threshold),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetMultisigIsmThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-multisig-ism-threshold [multisig-ism-id] [threshold]",
+		Short: "Change the threshold of a multisig ISM",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			multisigIsmId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetMultisigIsmThreshold{
+				IsmId:     multisigIsmId,
+				Owner:     clientCtx.GetFromAddress().String(),
+				Threshold: uint32(threshold),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateMultisigIsmOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-multisig-ism-owner [multisig-ism-id]",
+		Short: "Update the owner of a multisig ISM",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			yes, err := cmd.Flags().GetBool("yes")
+			if err != nil {
+				return err
+			}
+
+			if renounceOwnership && !yes {
+				fmt.Print("Are you sure you want to renounce ownership? This action is irreversible. (yes/no): ")
+				var response string
+
+				_, err := fmt.Scanln(&response)
+				if err != nil {
+					return err
+				}
+
+				if strings.ToLower(response) != "yes" {
+					return fmt.Errorf("canceled transaction")
+				}
+			}
+			return nil
+		}, RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			multisigIsmId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateMultisigIsmOwner{
+				IsmId:             multisigIsmId,
+				NewOwner:          newOwner,
+				Owner:             clientCtx.GetFromAddress().String(),
+				RenounceOwnership: renounceOwnership,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&newOwner, "new-owner", "", "new owner")
+	cmd.Flags().BoolVar(&renounceOwnership, "renounce-ownership", false, "renounce ownership")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
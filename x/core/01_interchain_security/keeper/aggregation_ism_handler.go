@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+)
+
+// AggregationISMHandler
+// The AggregationISM is a special ISM that requires m-of-n child ISMs to verify a message.
+// It enables combining different security models, e.g. a multisig together with a light client.
+type AggregationISMHandler struct {
+	keeper *Keeper // The ism keeper
+}
+
+// Verify implements HyperlaneInterchainSecurityModule
+// Decodes the aggregation metadata and delegates the verify call to every child ISM for which
+// metadata was provided, until the threshold is met.
+func (m *AggregationISMHandler) Verify(ctx context.Context, ismId util.HexAddress, rawMetadata []byte, message util.HyperlaneMessage) (bool, error) {
+	ism, err := m.keeper.isms.Get(ctx, ismId.GetInternalId())
+	if err != nil {
+		return false, err
+	}
+
+	// check if the ism is an aggregation ism
+	aggregationIsm, ok := ism.(*types.AggregationISM)
+	if !ok {
+		return false, errors.Wrapf(types.ErrInvalidISMType, "ISM %s is not an aggregation ISM", ismId.String())
+	}
+
+	metadata := types.NewAggregationIsmMetadata(rawMetadata)
+
+	threshold := aggregationIsm.Threshold
+	for i := 0; threshold > 0 && i < len(aggregationIsm.Modules); i++ {
+		hasMetadata, err := metadata.HasMetadata(i)
+		if err != nil {
+			return false, err
+		}
+		if !hasMetadata {
+			continue
+		}
+
+		subMetadata, err := metadata.MetadataAt(i)
+		if err != nil {
+			return false, err
+		}
+
+		// call the top level Verify method on the core module
+		// this method will then recursively invoke the Verify method on all the sub ISMs
+		verified, err := m.keeper.coreKeeper.Verify(ctx, aggregationIsm.Modules[i], subMetadata, message)
+		if err != nil {
+			return false, err
+		}
+
+		// Every provided metadata must be valid, this matches the behaviour of the EVM implementation.
+		if !verified {
+			return false, nil
+		}
+
+		threshold--
+	}
+
+	return threshold == 0, nil
+}
+
+func (m *AggregationISMHandler) Exists(ctx context.Context, ismId util.HexAddress) (bool, error) {
+	return m.keeper.isms.Has(ctx, ismId.GetInternalId())
+}
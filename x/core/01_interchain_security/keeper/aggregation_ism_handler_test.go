@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	"github.com/cosmos/gogoproto/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - aggregation_ism_handler.go
+
+* Verify (valid) 1-of-1
+* Verify (valid) 2-of-3 with metadata for two modules
+* Verify (valid) stops after threshold is met
+* Verify (valid) nested AggregationISM
+* Verify (invalid) threshold not met
+* Verify (invalid) empty metadata
+* Verify (invalid) invalid metadata range
+* Verify (invalid) failing child ISM
+
+*/
+
+var _ = Describe("aggregation_ism_handler.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var creator i.TestValidatorAddress
+	var mockIsm *i.MockIsm
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		creator = i.GenerateTestValidatorAddress("Creator")
+		err := s.MintBaseCoins(creator.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		mockIsm = i.CreateMockIsm(s.App().HyperlaneKeeper.IsmRouter())
+	})
+
+	registerMockIsms := func(count int) []util.HexAddress {
+		isms := make([]util.HexAddress, count)
+		for k := 0; k < count; k++ {
+			ismId, err := mockIsm.RegisterIsm(s.Ctx())
+			Expect(err).To(BeNil())
+			isms[k] = ismId
+		}
+		return isms
+	}
+
+	It("Verify (valid) 1-of-1", func() {
+		// Arrange
+		modules := registerMockIsms(1)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 1)
+
+		metadata := types.EncodeAggregationIsmMetadata([][]byte{{0x01}})
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(result).To(BeTrue())
+		Expect(mockIsm.CallCount()).To(Equal(1))
+	})
+
+	It("Verify (valid) 2-of-3 with metadata for two modules", func() {
+		// Arrange
+		modules := registerMockIsms(3)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 2)
+
+		metadata := types.EncodeAggregationIsmMetadata([][]byte{{0x01}, nil, {0x02, 0x03}})
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(result).To(BeTrue())
+		Expect(mockIsm.CallCount()).To(Equal(2))
+	})
+
+	It("Verify (valid) stops after threshold is met", func() {
+		// Arrange
+		modules := registerMockIsms(3)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 1)
+
+		metadata := types.EncodeAggregationIsmMetadata([][]byte{{0x01}, {0x02}, {0x03}})
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(result).To(BeTrue())
+		Expect(mockIsm.CallCount()).To(Equal(1))
+	})
+
+	It("Verify (valid) nested AggregationISM", func() {
+		// Arrange
+		modules := registerMockIsms(2)
+		innerAggregationIsm := createAggregationIsm(s, creator.Address, modules, 2)
+		outerAggregationIsm := createAggregationIsm(s, creator.Address, []util.HexAddress{innerAggregationIsm}, 1)
+
+		innerMetadata := types.EncodeAggregationIsmMetadata([][]byte{{0x01}, {0x02}})
+		metadata := types.EncodeAggregationIsmMetadata([][]byte{innerMetadata})
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), outerAggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(result).To(BeTrue())
+		Expect(mockIsm.CallCount()).To(Equal(2))
+	})
+
+	It("Verify (invalid) threshold not met", func() {
+		// Arrange
+		modules := registerMockIsms(3)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 2)
+
+		metadata := types.EncodeAggregationIsmMetadata([][]byte{nil, {0x01}, nil})
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(result).To(BeFalse())
+		Expect(mockIsm.CallCount()).To(Equal(1))
+	})
+
+	It("Verify (invalid) empty metadata", func() {
+		// Arrange
+		modules := registerMockIsms(2)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 1)
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, []byte{}, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid metadata length: missing range for module 0"))
+		Expect(result).To(BeFalse())
+		Expect(mockIsm.CallCount()).To(Equal(0))
+	})
+
+	It("Verify (invalid) invalid metadata range", func() {
+		// Arrange
+		modules := registerMockIsms(1)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 1)
+
+		// start = 8, end = 100, but metadata is only 8 bytes long
+		metadata := []byte{0, 0, 0, 8, 0, 0, 0, 100}
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid metadata range for module 0: [8:100]"))
+		Expect(result).To(BeFalse())
+	})
+
+	It("Verify (invalid) failing child ISM", func() {
+		// Arrange
+		res, err := s.RunTx(&types.MsgCreateMessageIdMultisigIsm{
+			Creator: creator.Address,
+			Validators: []string{
+				"0xa05b6a0aa112b61a7aa16c19cac27d970692995e",
+				"0xb05b6a0aa112b61a7aa16c19cac27d970692995e",
+			},
+			Threshold: 2,
+		})
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateMessageIdMultisigIsmResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		modules := append(registerMockIsms(1), response.Id)
+		aggregationIsm := createAggregationIsm(s, creator.Address, modules, 2)
+
+		metadata := types.EncodeAggregationIsmMetadata([][]byte{{0x01}, {0x02}})
+
+		// Act
+		result, err := s.App().HyperlaneKeeper.Verify(s.Ctx(), aggregationIsm, metadata, util.HyperlaneMessage{})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("invalid metadata length: got %v, expected at least %v bytes", 1, 68)))
+		Expect(result).To(BeFalse())
+	})
+})
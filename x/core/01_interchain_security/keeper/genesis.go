@@ -28,8 +28,14 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 			item = &types.MessageIdMultisigISM{}
 		case "/hyperlane.core.interchain_security.v1.MerkleRootMultisigISM":
 			item = &types.MerkleRootMultisigISM{}
+		case "/hyperlane.core.interchain_security.v1.WeightedMessageIdMultisigISM":
+			item = &types.WeightedMessageIdMultisigISM{}
+		case "/hyperlane.core.interchain_security.v1.WeightedMerkleRootMultisigISM":
+			item = &types.WeightedMerkleRootMultisigISM{}
 		case "/hyperlane.core.interchain_security.v1.RoutingISM":
 			item = &types.RoutingISM{}
+		case "/hyperlane.core.interchain_security.v1.AggregationISM":
+			item = &types.AggregationISM{}
 		default:
 			panic(fmt.Sprintf("unsupported type %s", rawIsm.TypeUrl))
 		}
@@ -59,9 +59,14 @@ func (k *Keeper) SetCoreKeeper(coreKeeper types.CoreKeeper) {
 	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_UNUSED, k)
 	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_MERKLE_ROOT_MULTISIG, k)
 	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_MESSAGE_ID_MULTISIG, k)
+	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_WEIGHTED_MERKLE_ROOT_MULTISIG, k)
+	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_WEIGHTED_MESSAGE_ID_MULTISIG, k)
 
 	// routing ism
 	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_ROUTING, &RoutingISMHandler{keeper: k})
+
+	// aggregation ism
+	router.RegisterModule(types.INTERCHAIN_SECURITY_MODULE_TYPE_AGGREGATION, &AggregationISMHandler{keeper: k})
 }
 
 // Verify checks if the metadata has signed the message correctly.
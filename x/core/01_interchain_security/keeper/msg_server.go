@@ -144,6 +144,183 @@ func (m msgServer) SetRoutingIsmDomain(ctx context.Context, req *types.MsgSetRou
 	return &types.MsgSetRoutingIsmDomainResponse{}, nil
 }
 
+// CreateAggregationIsm creates a new Aggregation ISM after validating the threshold
+// and that all modules reference existing ISMs.
+func (m msgServer) CreateAggregationIsm(ctx context.Context, req *types.MsgCreateAggregationIsm) (*types.MsgCreateAggregationIsmResponse, error) {
+	ismId, err := m.k.coreKeeper.IsmRouter().GetNextSequence(ctx, types.INTERCHAIN_SECURITY_MODULE_TYPE_AGGREGATION)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	newIsm := types.AggregationISM{
+		Id:        ismId,
+		Owner:     req.Creator,
+		Modules:   req.Modules,
+		Threshold: req.Threshold,
+	}
+
+	if err = m.validateAggregationIsm(ctx, &newIsm); err != nil {
+		return nil, err
+	}
+
+	if err = m.k.isms.Set(ctx, ismId.GetInternalId(), &newIsm); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	return &types.MsgCreateAggregationIsmResponse{Id: ismId}, nil
+}
+
+// SetAggregationIsmModules replaces the modules and the threshold of an Aggregation ISM.
+func (m msgServer) SetAggregationIsmModules(ctx context.Context, req *types.MsgSetAggregationIsmModules) (*types.MsgSetAggregationIsmModulesResponse, error) {
+	// get aggregation ism
+	aggregationISM, err := m.getAggregationIsm(ctx, req.IsmId, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregationISM.Modules = req.Modules
+	aggregationISM.Threshold = req.Threshold
+
+	if err = m.validateAggregationIsm(ctx, aggregationISM); err != nil {
+		return nil, err
+	}
+
+	// write to kv store
+	if err = m.k.isms.Set(ctx, aggregationISM.Id.GetInternalId(), aggregationISM); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	return &types.MsgSetAggregationIsmModulesResponse{}, nil
+}
+
+// UpdateAggregationIsmOwner updates or renounces the owner of an Aggregation ISM.
+func (m msgServer) UpdateAggregationIsmOwner(ctx context.Context, req *types.MsgUpdateAggregationIsmOwner) (*types.MsgUpdateAggregationIsmOwnerResponse, error) {
+	// get aggregation ism
+	aggregationISM, err := m.getAggregationIsm(ctx, req.IsmId, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NewOwner != "" {
+		_, err = sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			return nil, errors.Wrap(types.ErrInvalidOwner, "invalid new owner")
+		}
+	}
+	aggregationISM.Owner = req.NewOwner
+
+	// only renounce if new owner is empty
+	if req.RenounceOwnership && req.NewOwner != "" {
+		return nil, errors.Wrap(types.ErrInvalidOwner, "cannot set new owner and renounce ownership at the same time")
+	}
+
+	// don't allow new owner to be empty if not renouncing ownership
+	if !req.RenounceOwnership && req.NewOwner == "" {
+		return nil, errors.Wrap(types.ErrInvalidOwner, "cannot set owner to empty address without renouncing ownership")
+	}
+
+	// write to kv store
+	if err = m.k.isms.Set(ctx, aggregationISM.Id.GetInternalId(), aggregationISM); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	return &types.MsgUpdateAggregationIsmOwnerResponse{}, nil
+}
+
+// SetMultisigIsmValidators replaces the validator set and the threshold of a multisig ISM.
+// Both are updated at once, as changing the validators may invalidate the current threshold.
+func (m msgServer) SetMultisigIsmValidators(ctx context.Context, req *types.MsgSetMultisigIsmValidators) (*types.MsgSetMultisigIsmValidatorsResponse, error) {
+	multisigIsm, err := m.getMultisigIsm(ctx, req.IsmId, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigIsm.SetValidators(req.Validators)
+	multisigIsm.SetThreshold(req.Threshold)
+
+	if err = types.ValidateNewMultisig(multisigIsm); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidMultisigConfiguration, err.Error())
+	}
+
+	if err = m.k.isms.Set(ctx, req.IsmId.GetInternalId(), multisigIsm); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSetMultisigIsmValidators{
+		IsmId:      req.IsmId.String(),
+		Owner:      req.Owner,
+		Validators: req.Validators,
+		Threshold:  req.Threshold,
+	})
+
+	return &types.MsgSetMultisigIsmValidatorsResponse{}, nil
+}
+
+// SetMultisigIsmThreshold changes the threshold of a multisig ISM.
+func (m msgServer) SetMultisigIsmThreshold(ctx context.Context, req *types.MsgSetMultisigIsmThreshold) (*types.MsgSetMultisigIsmThresholdResponse, error) {
+	multisigIsm, err := m.getMultisigIsm(ctx, req.IsmId, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigIsm.SetThreshold(req.Threshold)
+
+	if err = types.ValidateNewMultisig(multisigIsm); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidMultisigConfiguration, err.Error())
+	}
+
+	if err = m.k.isms.Set(ctx, req.IsmId.GetInternalId(), multisigIsm); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSetMultisigIsmThreshold{
+		IsmId:     req.IsmId.String(),
+		Owner:     req.Owner,
+		Threshold: req.Threshold,
+	})
+
+	return &types.MsgSetMultisigIsmThresholdResponse{}, nil
+}
+
+// UpdateMultisigIsmOwner updates or renounces the owner of a multisig ISM.
+func (m msgServer) UpdateMultisigIsmOwner(ctx context.Context, req *types.MsgUpdateMultisigIsmOwner) (*types.MsgUpdateMultisigIsmOwnerResponse, error) {
+	multisigIsm, err := m.getMultisigIsm(ctx, req.IsmId, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NewOwner != "" {
+		_, err = sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			return nil, errors.Wrap(types.ErrInvalidOwner, "invalid new owner")
+		}
+	}
+	multisigIsm.SetOwner(req.NewOwner)
+
+	// only renounce if new owner is empty
+	if req.RenounceOwnership && req.NewOwner != "" {
+		return nil, errors.Wrap(types.ErrInvalidOwner, "cannot set new owner and renounce ownership at the same time")
+	}
+
+	// don't allow new owner to be empty if not renouncing ownership
+	if !req.RenounceOwnership && req.NewOwner == "" {
+		return nil, errors.Wrap(types.ErrInvalidOwner, "cannot set owner to empty address without renouncing ownership")
+	}
+
+	if err = m.k.isms.Set(ctx, req.IsmId.GetInternalId(), multisigIsm); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUpdateMultisigIsmOwner{
+		IsmId:             req.IsmId.String(),
+		Owner:             req.Owner,
+		NewOwner:          req.NewOwner,
+		RenounceOwnership: req.RenounceOwnership,
+	})
+
+	return &types.MsgUpdateMultisigIsmOwnerResponse{}, nil
+}
+
 // AnnounceValidator lets a validator store a string in the state, which is queryable.
 // The string should contain the storage location for the proofs (e.g. an S3 bucket)
 // The Relayer uses this information to fetch the signatures for messages.
@@ -279,6 +456,54 @@ func (m msgServer) CreateMerkleRootMultisigIsm(ctx context.Context, req *types.M
 	return &types.MsgCreateMerkleRootMultisigIsmResponse{Id: ismId}, nil
 }
 
+func (m msgServer) CreateWeightedMessageIdMultisigIsm(ctx context.Context, req *types.MsgCreateWeightedMessageIdMultisigIsm) (*types.MsgCreateWeightedMessageIdMultisigIsmResponse, error) {
+	ismId, err := m.k.coreKeeper.IsmRouter().GetNextSequence(ctx, types.INTERCHAIN_SECURITY_MODULE_TYPE_WEIGHTED_MESSAGE_ID_MULTISIG)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	newIsm := types.WeightedMessageIdMultisigISM{
+		Id:              ismId,
+		Owner:           req.Creator,
+		Validators:      req.Validators,
+		ThresholdWeight: req.ThresholdWeight,
+	}
+
+	if err = newIsm.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidMultisigConfiguration, err.Error())
+	}
+
+	if err = m.k.isms.Set(ctx, ismId.GetInternalId(), &newIsm); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	return &types.MsgCreateWeightedMessageIdMultisigIsmResponse{Id: ismId}, nil
+}
+
+func (m msgServer) CreateWeightedMerkleRootMultisigIsm(ctx context.Context, req *types.MsgCreateWeightedMerkleRootMultisigIsm) (*types.MsgCreateWeightedMerkleRootMultisigIsmResponse, error) {
+	ismId, err := m.k.coreKeeper.IsmRouter().GetNextSequence(ctx, types.INTERCHAIN_SECURITY_MODULE_TYPE_WEIGHTED_MERKLE_ROOT_MULTISIG)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	newIsm := types.WeightedMerkleRootMultisigISM{
+		Id:              ismId,
+		Owner:           req.Creator,
+		Validators:      req.Validators,
+		ThresholdWeight: req.ThresholdWeight,
+	}
+
+	if err = newIsm.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidMultisigConfiguration, err.Error())
+	}
+
+	if err = m.k.isms.Set(ctx, ismId.GetInternalId(), &newIsm); err != nil {
+		return nil, errors.Wrap(types.ErrUnexpectedError, err.Error())
+	}
+
+	return &types.MsgCreateWeightedMerkleRootMultisigIsmResponse{Id: ismId}, nil
+}
+
 func (m msgServer) CreateNoopIsm(ctx context.Context, ism *types.MsgCreateNoopIsm) (*types.MsgCreateNoopIsmResponse, error) {
 	ismId, err := m.k.coreKeeper.IsmRouter().GetNextSequence(ctx, types.INTERCHAIN_SECURITY_MODULE_TYPE_UNUSED)
 	if err != nil {
@@ -323,3 +548,66 @@ func (m msgServer) getRoutingIsm(ctx context.Context, ismId util.HexAddress, own
 
 	return routingISM, nil
 }
+
+func (m msgServer) getAggregationIsm(ctx context.Context, ismId util.HexAddress, owner string) (*types.AggregationISM, error) {
+	// check if the ism exists
+	ism, err := m.k.isms.Get(ctx, ismId.GetInternalId())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrUnkownIsmId, "ISM %s not found", ismId.String())
+	}
+	// check if the ism is an aggregation ism
+	if ism.ModuleType() != types.INTERCHAIN_SECURITY_MODULE_TYPE_AGGREGATION {
+		return nil, errors.Wrapf(types.ErrInvalidISMType, "ISM %s is not an aggregation ISM", ismId.String())
+	}
+
+	// this should never happen
+	aggregationISM, ok := ism.(*types.AggregationISM)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidISMType, "ISM %s is not an aggregation ISM", ismId.String())
+	}
+
+	// check if the tx sender is the owner of the ism
+	if aggregationISM.Owner != owner {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "owner %s is not the owner of the ism %s", owner, aggregationISM.Id.String())
+	}
+
+	return aggregationISM, nil
+}
+
+// validateAggregationIsm checks the aggregation configuration and ensures that
+// every child ISM exists.
+func (m msgServer) validateAggregationIsm(ctx context.Context, ism *types.AggregationISM) error {
+	if err := ism.Validate(); err != nil {
+		return errors.Wrap(types.ErrInvalidAggregationConfig, err.Error())
+	}
+
+	for _, module := range ism.Modules {
+		exists, err := m.k.coreKeeper.IsmExists(ctx, module)
+		if err != nil || !exists {
+			return errors.Wrapf(types.ErrUnkownIsmId, "ISM %s not found", module.String())
+		}
+	}
+
+	return nil
+}
+
+func (m msgServer) getMultisigIsm(ctx context.Context, ismId util.HexAddress, owner string) (types.MutableMultisigISM, error) {
+	// check if the ism exists
+	ism, err := m.k.isms.Get(ctx, ismId.GetInternalId())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrUnkownIsmId, "ISM %s not found", ismId.String())
+	}
+
+	// only the MessageId and MerkleRoot multisig ISMs are mutable
+	multisigIsm, ok := ism.(types.MutableMultisigISM)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidISMType, "ISM %s is not a multisig ISM", ismId.String())
+	}
+
+	// check if the tx sender is the owner of the ism
+	if multisigIsm.GetOwner() != owner {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "owner %s is not the owner of the ism %s", owner, ismId.String())
+	}
+
+	return multisigIsm, nil
+}
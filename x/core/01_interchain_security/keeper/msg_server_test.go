@@ -66,6 +66,31 @@ TEST CASES - msg_server.go
 * UpdateRoutingIsmOwner (invalid) incorrectly formatted owner address
 * UpdateRoutingIsmOwner (valid) new owner
 * UpdateRoutingIsmOwner (valid) renounce ownership
+* Create (invalid) Aggregation ISM with non-existing child ISM
+* Create (invalid) Aggregation ISM with zero threshold
+* Create (invalid) Aggregation ISM with threshold greater than modules
+* Create (invalid) Aggregation ISM with duplicate modules
+* Create (valid) Aggregation ISM
+* SetAggregationIsmModules (invalid) with non owner
+* SetAggregationIsmModules (invalid) on non aggregation ism
+* SetAggregationIsmModules (valid)
+* UpdateAggregationIsmOwner (invalid) with non owner
+* UpdateAggregationIsmOwner (valid) new owner
+* UpdateAggregationIsmOwner (valid) renounce ownership
+* Create (invalid) WeightedMessageIdMultisig ISM with threshold weight above total weight
+* Create (valid) WeightedMessageIdMultisig ISM
+* Create (invalid) WeightedMerkleRootMultisig ISM with zero validator weight
+* Create (valid) WeightedMerkleRootMultisig ISM
+* SetMultisigIsmValidators (invalid) with non owner
+* SetMultisigIsmValidators (invalid) on non multisig ism
+* SetMultisigIsmValidators (invalid) with unsorted validators
+* SetMultisigIsmValidators (valid)
+* SetMultisigIsmThreshold (invalid) with too high threshold
+* SetMultisigIsmThreshold (valid)
+* UpdateMultisigIsmOwner (invalid) with non owner
+* UpdateMultisigIsmOwner (invalid) with both renounce and new owner
+* UpdateMultisigIsmOwner (valid) new owner
+* UpdateMultisigIsmOwner (valid) renounce ownership
 */
 
 var _ = Describe("msg_server.go", Ordered, func() {
@@ -1255,6 +1280,489 @@ var _ = Describe("msg_server.go", Ordered, func() {
 		Expect(ism.Owner).To(Equal(""))
 		Expect(ism.Id.String()).To(Equal(response.Id.String()))
 	})
+
+	It("Create (invalid) Aggregation ISM with non-existing child ISM", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+		nonExistingIsmId := util.CreateMockHexAddress("ism", 0)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateAggregationIsm{
+			Creator:   creator.Address,
+			Modules:   []util.HexAddress{noopIsmId, nonExistingIsmId},
+			Threshold: 1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("ISM %s not found: unknown ism id", nonExistingIsmId.String())))
+	})
+
+	It("Create (invalid) Aggregation ISM with zero threshold", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateAggregationIsm{
+			Creator:   creator.Address,
+			Modules:   []util.HexAddress{noopIsmId},
+			Threshold: 0,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("threshold must be greater than zero: invalid aggregation configuration"))
+	})
+
+	It("Create (invalid) Aggregation ISM with threshold greater than modules", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateAggregationIsm{
+			Creator:   creator.Address,
+			Modules:   []util.HexAddress{noopIsmId},
+			Threshold: 2,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("modules less than threshold: invalid aggregation configuration"))
+	})
+
+	It("Create (invalid) Aggregation ISM with duplicate modules", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateAggregationIsm{
+			Creator:   creator.Address,
+			Modules:   []util.HexAddress{noopIsmId, noopIsmId},
+			Threshold: 1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("duplicate module: %s: invalid aggregation configuration", noopIsmId.String())))
+	})
+
+	It("Create (valid) Aggregation ISM", func() {
+		// Arrange
+		modules := []util.HexAddress{createNoopIsm(s, creator.Address), createNoopIsm(s, creator.Address)}
+
+		// Act
+		res, err := s.RunTx(&types.MsgCreateAggregationIsm{
+			Creator:   creator.Address,
+			Modules:   modules,
+			Threshold: 2,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateAggregationIsmResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		var ism types.AggregationISM
+		typeUrl := queryISM(&ism, s, response.Id.String())
+		Expect(typeUrl).To(Equal("/hyperlane.core.interchain_security.v1.AggregationISM"))
+		Expect(ism.Owner).To(Equal(creator.Address))
+		Expect(ism.Id.String()).To(Equal(response.Id.String()))
+		Expect(ism.Modules).To(Equal(modules))
+		Expect(ism.Threshold).To(Equal(uint32(2)))
+	})
+
+	It("SetAggregationIsmModules (invalid) with non owner", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+		aggregationIsmId := createAggregationIsm(s, creator.Address, []util.HexAddress{noopIsmId}, 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetAggregationIsmModules{
+			Owner:     nonOwner.Address,
+			IsmId:     aggregationIsmId,
+			Modules:   []util.HexAddress{noopIsmId},
+			Threshold: 1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(errors.Wrapf(types.ErrUnauthorized, "owner %s is not the owner of the ism %s", nonOwner.Address, aggregationIsmId).Error()))
+	})
+
+	It("SetAggregationIsmModules (invalid) on non aggregation ism", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetAggregationIsmModules{
+			Owner:     creator.Address,
+			IsmId:     noopIsmId,
+			Modules:   []util.HexAddress{noopIsmId},
+			Threshold: 1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("ISM %s is not an aggregation ISM: invalid ism type", noopIsmId.String())))
+	})
+
+	It("SetAggregationIsmModules (valid)", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+		aggregationIsmId := createAggregationIsm(s, creator.Address, []util.HexAddress{noopIsmId}, 1)
+		modules := []util.HexAddress{noopIsmId, createNoopIsm(s, creator.Address), createNoopIsm(s, creator.Address)}
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetAggregationIsmModules{
+			Owner:     creator.Address,
+			IsmId:     aggregationIsmId,
+			Modules:   modules,
+			Threshold: 2,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var ism types.AggregationISM
+		typeUrl := queryISM(&ism, s, aggregationIsmId.String())
+		Expect(typeUrl).To(Equal("/hyperlane.core.interchain_security.v1.AggregationISM"))
+		Expect(ism.Modules).To(Equal(modules))
+		Expect(ism.Threshold).To(Equal(uint32(2)))
+	})
+
+	It("UpdateAggregationIsmOwner (invalid) with non owner", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+		aggregationIsmId := createAggregationIsm(s, creator.Address, []util.HexAddress{noopIsmId}, 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateAggregationIsmOwner{
+			Owner:    nonOwner.Address,
+			IsmId:    aggregationIsmId,
+			NewOwner: nonOwner.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(errors.Wrapf(types.ErrUnauthorized, "owner %s is not the owner of the ism %s", nonOwner.Address, aggregationIsmId).Error()))
+	})
+
+	It("UpdateAggregationIsmOwner (valid) new owner", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+		aggregationIsmId := createAggregationIsm(s, creator.Address, []util.HexAddress{noopIsmId}, 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateAggregationIsmOwner{
+			Owner:    creator.Address,
+			IsmId:    aggregationIsmId,
+			NewOwner: nonOwner.Address,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var ism types.AggregationISM
+		queryISM(&ism, s, aggregationIsmId.String())
+		Expect(ism.Owner).To(Equal(nonOwner.Address))
+	})
+
+	It("UpdateAggregationIsmOwner (valid) renounce ownership", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+		aggregationIsmId := createAggregationIsm(s, creator.Address, []util.HexAddress{noopIsmId}, 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateAggregationIsmOwner{
+			Owner:             creator.Address,
+			IsmId:             aggregationIsmId,
+			RenounceOwnership: true,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var ism types.AggregationISM
+		queryISM(&ism, s, aggregationIsmId.String())
+		Expect(ism.Owner).To(Equal(""))
+	})
+
+	It("Create (invalid) WeightedMessageIdMultisig ISM with threshold weight above total weight", func() {
+		// Arrange
+		validators := []types.WeightedValidator{
+			{Address: "0xa05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 10},
+			{Address: "0xb05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 20},
+		}
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateWeightedMessageIdMultisigIsm{
+			Creator:         creator.Address,
+			Validators:      validators,
+			ThresholdWeight: 31,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("total validator weight less than threshold weight: invalid multisig configuration"))
+	})
+
+	It("Create (valid) WeightedMessageIdMultisig ISM", func() {
+		// Arrange
+		validators := []types.WeightedValidator{
+			{Address: "0xa05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 10},
+			{Address: "0xb05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 20},
+		}
+
+		// Act
+		res, err := s.RunTx(&types.MsgCreateWeightedMessageIdMultisigIsm{
+			Creator:         creator.Address,
+			Validators:      validators,
+			ThresholdWeight: 20,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateWeightedMessageIdMultisigIsmResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		var ism types.WeightedMessageIdMultisigISM
+		typeUrl := queryISM(&ism, s, response.Id.String())
+		Expect(typeUrl).To(Equal("/hyperlane.core.interchain_security.v1.WeightedMessageIdMultisigISM"))
+		Expect(ism.Owner).To(Equal(creator.Address))
+		Expect(ism.Id.String()).To(Equal(response.Id.String()))
+		Expect(ism.Validators).To(Equal(validators))
+		Expect(ism.ThresholdWeight).To(Equal(uint64(20)))
+	})
+
+	It("Create (invalid) WeightedMerkleRootMultisig ISM with zero validator weight", func() {
+		// Arrange
+		validators := []types.WeightedValidator{
+			{Address: "0xa05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 10},
+			{Address: "0xb05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 0},
+		}
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateWeightedMerkleRootMultisigIsm{
+			Creator:         creator.Address,
+			Validators:      validators,
+			ThresholdWeight: 10,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("validator weight must be greater than zero: %s: invalid multisig configuration", validators[1].Address)))
+	})
+
+	It("Create (valid) WeightedMerkleRootMultisig ISM", func() {
+		// Arrange
+		validators := []types.WeightedValidator{
+			{Address: "0xa05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 10},
+			{Address: "0xb05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 20},
+			{Address: "0xd05b6a0aa112b61a7aa16c19cac27d970692995e", Weight: 30},
+		}
+
+		// Act
+		res, err := s.RunTx(&types.MsgCreateWeightedMerkleRootMultisigIsm{
+			Creator:         creator.Address,
+			Validators:      validators,
+			ThresholdWeight: 40,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateWeightedMerkleRootMultisigIsmResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		var ism types.WeightedMerkleRootMultisigISM
+		typeUrl := queryISM(&ism, s, response.Id.String())
+		Expect(typeUrl).To(Equal("/hyperlane.core.interchain_security.v1.WeightedMerkleRootMultisigISM"))
+		Expect(ism.Owner).To(Equal(creator.Address))
+		Expect(ism.Id.String()).To(Equal(response.Id.String()))
+		Expect(ism.Validators).To(Equal(validators))
+		Expect(ism.ThresholdWeight).To(Equal(uint64(40)))
+	})
+
+	It("SetMultisigIsmValidators (invalid) with non owner", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetMultisigIsmValidators{
+			IsmId:      ismId,
+			Owner:      nonOwner.Address,
+			Validators: []string{"0xa05b6a0aa112b61a7aa16c19cac27d970692995e"},
+			Threshold:  1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(errors.Wrapf(types.ErrUnauthorized, "owner %s is not the owner of the ism %s", nonOwner.Address, ismId).Error()))
+	})
+
+	It("SetMultisigIsmValidators (invalid) on non multisig ism", func() {
+		// Arrange
+		noopIsmId := createNoopIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetMultisigIsmValidators{
+			IsmId:      noopIsmId,
+			Owner:      creator.Address,
+			Validators: []string{"0xa05b6a0aa112b61a7aa16c19cac27d970692995e"},
+			Threshold:  1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("ISM %s is not a multisig ISM: invalid ism type", noopIsmId.String())))
+	})
+
+	It("SetMultisigIsmValidators (invalid) with unsorted validators", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetMultisigIsmValidators{
+			IsmId: ismId,
+			Owner: creator.Address,
+			Validators: []string{
+				"0xb05b6a0aa112b61a7aa16c19cac27d970692995e",
+				"0xa05b6a0aa112b61a7aa16c19cac27d970692995e",
+			},
+			Threshold: 1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("validator addresses are not sorted correctly in ascending order: invalid multisig configuration"))
+	})
+
+	It("SetMultisigIsmValidators (valid)", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+		validators := []string{
+			"0xc05b6a0aa112b61a7aa16c19cac27d970692995e",
+			"0xe05b6a0aa112b61a7aa16c19cac27d970692995e",
+		}
+
+		// Act
+		res, err := s.RunTx(&types.MsgSetMultisigIsmValidators{
+			IsmId:      ismId,
+			Owner:      creator.Address,
+			Validators: validators,
+			Threshold:  1,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Events[len(res.Events)-1].Type).To(Equal("hyperlane.core.interchain_security.v1.EventSetMultisigIsmValidators"))
+
+		var ism types.MessageIdMultisigISM
+		typeUrl := queryISM(&ism, s, ismId.String())
+		Expect(typeUrl).To(Equal("/hyperlane.core.interchain_security.v1.MessageIdMultisigISM"))
+		Expect(ism.Validators).To(Equal(validators))
+		Expect(ism.Threshold).To(Equal(uint32(1)))
+	})
+
+	It("SetMultisigIsmThreshold (invalid) with too high threshold", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetMultisigIsmThreshold{
+			IsmId:     ismId,
+			Owner:     creator.Address,
+			Threshold: 4,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("validator addresses less than threshold: invalid multisig configuration"))
+	})
+
+	It("SetMultisigIsmThreshold (valid)", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		res, err := s.RunTx(&types.MsgSetMultisigIsmThreshold{
+			IsmId:     ismId,
+			Owner:     creator.Address,
+			Threshold: 3,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Events[len(res.Events)-1].Type).To(Equal("hyperlane.core.interchain_security.v1.EventSetMultisigIsmThreshold"))
+
+		var ism types.MessageIdMultisigISM
+		queryISM(&ism, s, ismId.String())
+		Expect(ism.Threshold).To(Equal(uint32(3)))
+		Expect(ism.Validators).To(HaveLen(3))
+	})
+
+	It("UpdateMultisigIsmOwner (invalid) with non owner", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateMultisigIsmOwner{
+			IsmId:    ismId,
+			Owner:    nonOwner.Address,
+			NewOwner: nonOwner.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(errors.Wrapf(types.ErrUnauthorized, "owner %s is not the owner of the ism %s", nonOwner.Address, ismId).Error()))
+	})
+
+	It("UpdateMultisigIsmOwner (invalid) with both renounce and new owner", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateMultisigIsmOwner{
+			IsmId:             ismId,
+			Owner:             creator.Address,
+			NewOwner:          nonOwner.Address,
+			RenounceOwnership: true,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("cannot set new owner and renounce ownership at the same time: invalid owner"))
+	})
+
+	It("UpdateMultisigIsmOwner (valid) new owner", func() {
+		// Arrange
+		ismId := createMessageIdMultisigIsm(s, creator.Address)
+
+		// Act
+		res, err := s.RunTx(&types.MsgUpdateMultisigIsmOwner{
+			IsmId:    ismId,
+			Owner:    creator.Address,
+			NewOwner: nonOwner.Address,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Events[len(res.Events)-1].Type).To(Equal("hyperlane.core.interchain_security.v1.EventUpdateMultisigIsmOwner"))
+
+		var ism types.MessageIdMultisigISM
+		queryISM(&ism, s, ismId.String())
+		Expect(ism.Owner).To(Equal(nonOwner.Address))
+	})
+
+	It("UpdateMultisigIsmOwner (valid) renounce ownership", func() {
+		// Arrange
+		ismId := createValidMerkleRootMultisigIsm(s, creator.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateMultisigIsmOwner{
+			IsmId:             ismId,
+			Owner:             creator.Address,
+			RenounceOwnership: true,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var ism types.MerkleRootMultisigISM
+		typeUrl := queryISM(&ism, s, ismId.String())
+		Expect(typeUrl).To(Equal("/hyperlane.core.interchain_security.v1.MerkleRootMultisigISM"))
+		Expect(ism.Owner).To(Equal(""))
+	})
 })
 
 func createValidMailbox(s *i.KeeperTestSuite, creator string, ism string) (util.HexAddress, util.HexAddress, util.HexAddress) {
@@ -1383,3 +1891,55 @@ func validateAnnouncement(s *i.KeeperTestSuite, mailboxId, validatorAddress stri
 		Expect(latestAnnouncedStorageLocation.StorageLocation).To(Equal(storageLocations[len(storageLocations)-1]))
 	}
 }
+
+func createAggregationIsm(s *i.KeeperTestSuite, creator string, modules []util.HexAddress, threshold uint32) util.HexAddress {
+	res, err := s.RunTx(&types.MsgCreateAggregationIsm{
+		Creator:   creator,
+		Modules:   modules,
+		Threshold: threshold,
+	})
+	Expect(err).To(BeNil())
+
+	var response types.MsgCreateAggregationIsmResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	return response.Id
+}
+
+func createMessageIdMultisigIsm(s *i.KeeperTestSuite, creator string) util.HexAddress {
+	res, err := s.RunTx(&types.MsgCreateMessageIdMultisigIsm{
+		Creator: creator,
+		Validators: []string{
+			"0xa05b6a0aa112b61a7aa16c19cac27d970692995e",
+			"0xb05b6a0aa112b61a7aa16c19cac27d970692995e",
+			"0xd05b6a0aa112b61a7aa16c19cac27d970692995e",
+		},
+		Threshold: 2,
+	})
+	Expect(err).To(BeNil())
+
+	var response types.MsgCreateMessageIdMultisigIsmResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	return response.Id
+}
+
+func createValidMerkleRootMultisigIsm(s *i.KeeperTestSuite, creator string) util.HexAddress {
+	res, err := s.RunTx(&types.MsgCreateMerkleRootMultisigIsm{
+		Creator: creator,
+		Validators: []string{
+			"0xa05b6a0aa112b61a7aa16c19cac27d970692995e",
+			"0xb05b6a0aa112b61a7aa16c19cac27d970692995e",
+		},
+		Threshold: 2,
+	})
+	Expect(err).To(BeNil())
+
+	var response types.MsgCreateMerkleRootMultisigIsmResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	return response.Id
+}
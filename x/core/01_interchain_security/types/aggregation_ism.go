@@ -0,0 +1,131 @@
+package types
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+)
+
+var _ HyperlaneInterchainSecurityModule = &AggregationISM{}
+
+// GetId implements HyperlaneInterchainSecurityModule.
+func (m *AggregationISM) GetId() (util.HexAddress, error) {
+	return m.Id, nil
+}
+
+// ModuleType implements HyperlaneInterchainSecurityModule.
+func (m *AggregationISM) ModuleType() uint8 {
+	return INTERCHAIN_SECURITY_MODULE_TYPE_AGGREGATION
+}
+
+// Verify implements HyperlaneInterchainSecurityModule, but should not be called on AggregationISM.
+func (m *AggregationISM) Verify(_ context.Context, _ []byte, _ util.HyperlaneMessage) (bool, error) {
+	// This method will never be called in the aggregation ISM struct
+	// Aggregation happens on the Handler level in `aggregation_ism_handler.go`
+	return false, errors.Wrapf(ErrUnexpectedError, "Verify should not be called on AggregationISM")
+}
+
+// Validate ensures the Aggregation ISM configuration is valid.
+// It does not check whether the child ISMs exist, as this requires state access.
+func (m *AggregationISM) Validate() error {
+	if m.Threshold == 0 {
+		return fmt.Errorf("threshold must be greater than zero")
+	}
+
+	if len(m.Modules) < int(m.Threshold) {
+		return fmt.Errorf("modules less than threshold")
+	}
+
+	seen := make(map[util.HexAddress]struct{}, len(m.Modules))
+	for _, module := range m.Modules {
+		if _, ok := seen[module]; ok {
+			return fmt.Errorf("duplicate module: %s", module.String())
+		}
+		seen[module] = struct{}{}
+	}
+
+	return nil
+}
+
+type AggregationIsmMetadata struct {
+	metadata []byte
+}
+
+// NewAggregationIsmMetadata wraps the raw metadata of an Aggregation ISM.
+func NewAggregationIsmMetadata(metadata []byte) AggregationIsmMetadata {
+	/*
+	 * Format of metadata:
+	 * [????:????] Metadata start/end uint32 ranges, packed as uint64
+	 * [????:????] ISM metadata, packed encoding
+	 *
+	 * The range for the i-th module is stored at [i*8 : i*8+8]. The first four bytes
+	 * are the start offset, the last four bytes the end offset of the sub-metadata.
+	 * A start offset of zero indicates that no metadata was provided for that module.
+	 */
+	return AggregationIsmMetadata{metadata: metadata}
+}
+
+func (m AggregationIsmMetadata) metadataRange(index int) (start, end uint32, err error) {
+	rangeSize := 4
+	offset := index * rangeSize * 2
+
+	if len(m.metadata) < offset+rangeSize*2 {
+		return 0, 0, fmt.Errorf("invalid metadata length: missing range for module %d", index)
+	}
+
+	start = binary.BigEndian.Uint32(m.metadata[offset : offset+rangeSize])
+	end = binary.BigEndian.Uint32(m.metadata[offset+rangeSize : offset+rangeSize*2])
+
+	return start, end, nil
+}
+
+// HasMetadata returns true if the metadata contains a sub-metadata for the module at the given index.
+func (m AggregationIsmMetadata) HasMetadata(index int) (bool, error) {
+	start, _, err := m.metadataRange(index)
+	if err != nil {
+		return false, err
+	}
+	return start > 0, nil
+}
+
+// MetadataAt returns the sub-metadata for the module at the given index.
+func (m AggregationIsmMetadata) MetadataAt(index int) ([]byte, error) {
+	start, end, err := m.metadataRange(index)
+	if err != nil {
+		return nil, err
+	}
+
+	if start > end || int(end) > len(m.metadata) {
+		return nil, fmt.Errorf("invalid metadata range for module %d: [%d:%d]", index, start, end)
+	}
+
+	return m.metadata[start:end], nil
+}
+
+// EncodeAggregationIsmMetadata packs the sub-metadata of the child ISMs into the
+// aggregation metadata format. A nil entry indicates that no metadata is provided
+// for the module at that index.
+func EncodeAggregationIsmMetadata(subMetadata [][]byte) []byte {
+	rangesLength := len(subMetadata) * 8
+
+	ranges := make([]byte, rangesLength)
+	var body []byte
+
+	for i, metadata := range subMetadata {
+		if metadata == nil {
+			continue
+		}
+		start := uint32(rangesLength + len(body))
+		end := start + uint32(len(metadata))
+
+		binary.BigEndian.PutUint32(ranges[i*8:], start)
+		binary.BigEndian.PutUint32(ranges[i*8+4:], end)
+
+		body = append(body, metadata...)
+	}
+
+	return append(ranges, body...)
+}
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - aggregation_ism.go
+
+* Validate (invalid) zero threshold
+* Validate (invalid) too high threshold
+* Validate (invalid) duplicated modules
+* Validate (valid)
+* Metadata (valid) encode and decode
+* Metadata (invalid) missing range
+
+*/
+
+var _ = Describe("aggregation_ism.go", Ordered, func() {
+	moduleA := util.CreateMockHexAddress("ism", 1)
+	moduleB := util.CreateMockHexAddress("ism", 2)
+
+	It("Validate (invalid) zero threshold", func() {
+		// Arrange
+		aggregationIsm := types.AggregationISM{
+			Modules:   []util.HexAddress{moduleA},
+			Threshold: 0,
+		}
+
+		// Act
+		err := aggregationIsm.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("threshold must be greater than zero"))
+	})
+
+	It("Validate (invalid) too high threshold", func() {
+		// Arrange
+		aggregationIsm := types.AggregationISM{
+			Modules:   []util.HexAddress{moduleA, moduleB},
+			Threshold: 3,
+		}
+
+		// Act
+		err := aggregationIsm.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("modules less than threshold"))
+	})
+
+	It("Validate (invalid) duplicated modules", func() {
+		// Arrange
+		aggregationIsm := types.AggregationISM{
+			Modules:   []util.HexAddress{moduleA, moduleA},
+			Threshold: 1,
+		}
+
+		// Act
+		err := aggregationIsm.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("duplicate module: " + moduleA.String()))
+	})
+
+	It("Validate (valid)", func() {
+		// Arrange
+		aggregationIsm := types.AggregationISM{
+			Modules:   []util.HexAddress{moduleA, moduleB},
+			Threshold: 2,
+		}
+
+		// Act
+		err := aggregationIsm.Validate()
+
+		// Assert
+		Expect(err).To(BeNil())
+	})
+
+	It("Metadata (valid) encode and decode", func() {
+		// Arrange
+		raw := types.EncodeAggregationIsmMetadata([][]byte{{0x01, 0x02}, nil, {0x03}})
+
+		// Act
+		metadata := types.NewAggregationIsmMetadata(raw)
+
+		// Assert
+		hasMetadata, err := metadata.HasMetadata(0)
+		Expect(err).To(BeNil())
+		Expect(hasMetadata).To(BeTrue())
+		subMetadata, err := metadata.MetadataAt(0)
+		Expect(err).To(BeNil())
+		Expect(subMetadata).To(Equal([]byte{0x01, 0x02}))
+
+		hasMetadata, err = metadata.HasMetadata(1)
+		Expect(err).To(BeNil())
+		Expect(hasMetadata).To(BeFalse())
+
+		hasMetadata, err = metadata.HasMetadata(2)
+		Expect(err).To(BeNil())
+		Expect(hasMetadata).To(BeTrue())
+		subMetadata, err = metadata.MetadataAt(2)
+		Expect(err).To(BeNil())
+		Expect(subMetadata).To(Equal([]byte{0x03}))
+	})
+
+	It("Metadata (invalid) missing range", func() {
+		// Arrange
+		raw := types.EncodeAggregationIsmMetadata([][]byte{{0x01}})
+
+		// Act
+		metadata := types.NewAggregationIsmMetadata(raw)
+
+		// Assert
+		_, err := metadata.HasMetadata(1)
+		Expect(err.Error()).To(Equal("invalid metadata length: missing range for module 1"))
+	})
+})
@@ -14,6 +14,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateNoopIsm{},
 		&MsgAnnounceValidator{},
 		&MsgCreateRoutingIsm{},
+		&MsgCreateAggregationIsm{},
+		&MsgSetAggregationIsmModules{},
+		&MsgUpdateAggregationIsmOwner{},
+		&MsgCreateWeightedMessageIdMultisigIsm{},
+		&MsgCreateWeightedMerkleRootMultisigIsm{},
+		&MsgSetMultisigIsmValidators{},
+		&MsgSetMultisigIsmThreshold{},
+		&MsgUpdateMultisigIsmOwner{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 
@@ -24,5 +32,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MessageIdMultisigISM{},
 		&MerkleRootMultisigISM{},
 		&RoutingISM{},
+		&AggregationISM{},
+		&WeightedMessageIdMultisigISM{},
+		&WeightedMerkleRootMultisigISM{},
 	)
 }
@@ -10,7 +10,10 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
-var _ HyperlaneInterchainSecurityModule = &MerkleRootMultisigISM{}
+var (
+	_ HyperlaneInterchainSecurityModule = &MerkleRootMultisigISM{}
+	_ MutableMultisigISM                = &MerkleRootMultisigISM{}
+)
 
 func (m *MerkleRootMultisigISM) GetId() (util.HexAddress, error) {
 	return m.Id, nil
@@ -47,6 +50,22 @@ func (m *MerkleRootMultisigISM) Validate() error {
 	return ValidateNewMultisig(m)
 }
 
+func (m *MerkleRootMultisigISM) GetOwner() string {
+	return m.Owner
+}
+
+func (m *MerkleRootMultisigISM) SetOwner(owner string) {
+	m.Owner = owner
+}
+
+func (m *MerkleRootMultisigISM) SetValidators(validators []string) {
+	m.Validators = validators
+}
+
+func (m *MerkleRootMultisigISM) SetThreshold(threshold uint32) {
+	m.Threshold = threshold
+}
+
 type MerkleRootMultisigMetadata struct {
 	MerkleTreeHook  [32]byte
 	MessageIndex    uint32
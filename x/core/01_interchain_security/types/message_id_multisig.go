@@ -9,7 +9,10 @@ import (
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 )
 
-var _ HyperlaneInterchainSecurityModule = &MessageIdMultisigISM{}
+var (
+	_ HyperlaneInterchainSecurityModule = &MessageIdMultisigISM{}
+	_ MutableMultisigISM                = &MessageIdMultisigISM{}
+)
 
 func (m *MessageIdMultisigISM) GetId() (util.HexAddress, error) {
 	return m.Id, nil
@@ -42,6 +45,22 @@ func (m *MessageIdMultisigISM) Validate() error {
 	return ValidateNewMultisig(m)
 }
 
+func (m *MessageIdMultisigISM) GetOwner() string {
+	return m.Owner
+}
+
+func (m *MessageIdMultisigISM) SetOwner(owner string) {
+	m.Owner = owner
+}
+
+func (m *MessageIdMultisigISM) SetValidators(validators []string) {
+	m.Validators = validators
+}
+
+func (m *MessageIdMultisigISM) SetThreshold(threshold uint32) {
+	m.Threshold = threshold
+}
+
 type MessageIdMultisigMetadata struct {
 	MerkleTreeHook [32]byte
 	MerkleRoot     [32]byte
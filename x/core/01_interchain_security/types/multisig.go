@@ -15,6 +15,17 @@ type MultisigISM interface {
 	GetThreshold() uint32
 }
 
+// MutableMultisigISM is a multisig ISM whose configuration can be updated by its owner.
+type MutableMultisigISM interface {
+	HyperlaneInterchainSecurityModule
+	MultisigISM
+
+	GetOwner() string
+	SetOwner(owner string)
+	SetValidators(validators []string)
+	SetThreshold(threshold uint32)
+}
+
 // VerifyMultisig checks if a message digest is signed by a sufficient number of validators.
 // It recovers public keys from signatures and ensures the threshold is met before returning success.
 func VerifyMultisig(validators []string, threshold uint32, signatures [][]byte, digest [32]byte) (bool, error) {
@@ -64,6 +75,12 @@ func ValidateNewMultisig(m MultisigISM) error {
 		return fmt.Errorf("validator addresses less than threshold")
 	}
 
+	return validateValidatorAddresses(validators)
+}
+
+// validateValidatorAddresses ensures that the given validator addresses are sorted,
+// unique and valid 20 byte ethereum addresses.
+func validateValidatorAddresses(validators []string) error {
 	// Ensure that validators are sorted in ascending order.
 	if !slices.IsSorted(validators) {
 		return fmt.Errorf("validator addresses are not sorted correctly in ascending order")
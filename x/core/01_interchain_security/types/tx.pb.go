@@ -820,1303 +820,5013 @@ func (m *MsgUpdateRoutingIsmOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateRoutingIsmOwnerResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateMessageIdMultisigIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMessageIdMultisigIsm")
-	proto.RegisterType((*MsgCreateMessageIdMultisigIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMessageIdMultisigIsmResponse")
-	proto.RegisterType((*MsgCreateMerkleRootMultisigIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMerkleRootMultisigIsm")
-	proto.RegisterType((*MsgCreateMerkleRootMultisigIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMerkleRootMultisigIsmResponse")
-	proto.RegisterType((*MsgCreateNoopIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateNoopIsm")
-	proto.RegisterType((*MsgCreateNoopIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateNoopIsmResponse")
-	proto.RegisterType((*MsgAnnounceValidator)(nil), "hyperlane.core.interchain_security.v1.MsgAnnounceValidator")
-	proto.RegisterType((*MsgAnnounceValidatorResponse)(nil), "hyperlane.core.interchain_security.v1.MsgAnnounceValidatorResponse")
-	proto.RegisterType((*MsgCreateRoutingIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateRoutingIsm")
-	proto.RegisterType((*MsgCreateRoutingIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateRoutingIsmResponse")
-	proto.RegisterType((*MsgSetRoutingIsmDomain)(nil), "hyperlane.core.interchain_security.v1.MsgSetRoutingIsmDomain")
-	proto.RegisterType((*MsgSetRoutingIsmDomainResponse)(nil), "hyperlane.core.interchain_security.v1.MsgSetRoutingIsmDomainResponse")
-	proto.RegisterType((*MsgRemoveRoutingIsmDomain)(nil), "hyperlane.core.interchain_security.v1.MsgRemoveRoutingIsmDomain")
-	proto.RegisterType((*MsgRemoveRoutingIsmDomainResponse)(nil), "hyperlane.core.interchain_security.v1.MsgRemoveRoutingIsmDomainResponse")
-	proto.RegisterType((*MsgUpdateRoutingIsmOwner)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateRoutingIsmOwner")
-	proto.RegisterType((*MsgUpdateRoutingIsmOwnerResponse)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateRoutingIsmOwnerResponse")
+// MsgCreateAggregationIsm ...
+type MsgCreateAggregationIsm struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// modules ...
+	Modules []github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,rep,name=modules,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"modules"`
+	// threshold ...
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("hyperlane/core/interchain_security/v1/tx.proto", fileDescriptor_4ee100bdd8d27ecb)
+func (m *MsgCreateAggregationIsm) Reset()         { *m = MsgCreateAggregationIsm{} }
+func (m *MsgCreateAggregationIsm) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAggregationIsm) ProtoMessage()    {}
+func (*MsgCreateAggregationIsm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{16}
 }
-
-var fileDescriptor_4ee100bdd8d27ecb = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0x75, 0xa8, 0x1f, 0x8a, 0x48, 0xb6, 0x21, 0xb8, 0xdb, 0x76, 0xeb, 0x2e, 0x4a,
-	0x15, 0x02, 0xd9, 0xc5, 0x81, 0x82, 0xe4, 0x80, 0xa0, 0x4e, 0x23, 0x12, 0x54, 0x13, 0x69, 0x23,
-	0x38, 0x54, 0x48, 0xd6, 0x7a, 0x77, 0xb4, 0x1e, 0xe1, 0x9d, 0xb1, 0x76, 0xd6, 0x4e, 0x22, 0x81,
-	0x84, 0xb8, 0xc1, 0x09, 0x21, 0x21, 0x0e, 0x3d, 0x21, 0x71, 0xe0, 0x04, 0x91, 0xa8, 0xf8, 0x1b,
-	0x7a, 0xac, 0x38, 0x21, 0x84, 0x2a, 0x94, 0x1c, 0xf2, 0x6f, 0xa0, 0xfd, 0xe9, 0x1f, 0x19, 0xbb,
-	0x76, 0x12, 0xf7, 0x62, 0x79, 0xde, 0xcc, 0xfb, 0xde, 0xf7, 0xbe, 0xf7, 0xf6, 0xed, 0x2c, 0x68,
-	0xf5, 0x83, 0x26, 0xf6, 0x1a, 0x26, 0xc5, 0xba, 0xc5, 0x3c, 0xac, 0x13, 0xea, 0x63, 0xcf, 0xaa,
-	0x9b, 0x84, 0x56, 0x39, 0xb6, 0x5a, 0x1e, 0xf1, 0x0f, 0xf4, 0x76, 0x51, 0xf7, 0xf7, 0xb5, 0xa6,
-	0xc7, 0x7c, 0x26, 0x2d, 0xa5, 0xe7, 0xb5, 0xe0, 0xbc, 0x26, 0x38, 0xaf, 0xb5, 0x8b, 0xf2, 0x55,
-	0x8b, 0x71, 0x97, 0xf1, 0x6a, 0xe8, 0xa4, 0x47, 0x8b, 0x08, 0x41, 0x7e, 0x25, 0x5a, 0xe9, 0x2e,
-	0x77, 0x02, 0x64, 0x97, 0x3b, 0xf1, 0xc6, 0xbc, 0xe9, 0x12, 0xca, 0xf4, 0xf0, 0x37, 0x36, 0x15,
-	0x47, 0x64, 0x77, 0xd0, 0xc4, 0x09, 0xfc, 0x82, 0xc3, 0x1c, 0x16, 0x85, 0x0d, 0xfe, 0x45, 0x56,
-	0xf5, 0x11, 0x82, 0x1b, 0x15, 0xee, 0x6c, 0x78, 0xd8, 0xf4, 0x71, 0x05, 0x73, 0x6e, 0x3a, 0x78,
-	0xdb, 0xae, 0xb4, 0x1a, 0x3e, 0xe1, 0xc4, 0xd9, 0xe6, 0xae, 0x94, 0x87, 0x17, 0xac, 0x60, 0x97,
-	0x79, 0x79, 0x54, 0x40, 0xcb, 0x39, 0x23, 0x59, 0x4a, 0x0a, 0x40, 0xdb, 0x6c, 0x10, 0x3b, 0x58,
-	0xf0, 0x7c, 0xa6, 0x30, 0xbd, 0x9c, 0x33, 0xba, 0x2c, 0xd2, 0x75, 0xc8, 0xf9, 0x75, 0x0f, 0xf3,
-	0x3a, 0x6b, 0xd8, 0xf9, 0xe9, 0x02, 0x5a, 0x9e, 0x35, 0x3a, 0x86, 0xd2, 0xfa, 0x37, 0x27, 0x87,
-	0x2b, 0x09, 0xd6, 0x77, 0x27, 0x87, 0x2b, 0x2b, 0x9d, 0x9c, 0xda, 0x45, 0x7d, 0x28, 0x29, 0xf5,
-	0x4b, 0x58, 0x1a, 0x7a, 0xc0, 0xc0, 0xbc, 0xc9, 0x28, 0xc7, 0xd2, 0x2e, 0x64, 0x88, 0x1d, 0x11,
-	0x2f, 0x6f, 0x3c, 0x7e, 0x7a, 0x73, 0xea, 0x9f, 0xa7, 0x37, 0xd7, 0x1d, 0xe2, 0xd7, 0x5b, 0x35,
-	0xcd, 0x62, 0xae, 0x5e, 0xb3, 0x9a, 0xab, 0x84, 0x52, 0xd6, 0x36, 0x7d, 0xc2, 0x28, 0xd7, 0x53,
-	0x0e, 0xab, 0x71, 0x35, 0x5a, 0x3e, 0x69, 0x68, 0x5b, 0x78, 0xff, 0xae, 0x6d, 0x7b, 0x98, 0x73,
-	0x23, 0x43, 0x6c, 0xf5, 0x4f, 0x04, 0x4a, 0x57, 0x78, 0xef, 0x8b, 0x06, 0x36, 0x18, 0xf3, 0x9f,
-	0x87, 0x6a, 0xef, 0xf5, 0xab, 0xf6, 0xfa, 0x20, 0xd5, 0x04, 0xac, 0xd4, 0xaf, 0xe0, 0xf6, 0xf0,
-	0x13, 0x93, 0xd5, 0xed, 0x73, 0x98, 0x4b, 0xc3, 0x7f, 0xc2, 0x58, 0x73, 0xa8, 0x50, 0x25, 0xad,
-	0x3f, 0xd5, 0x1b, 0xe2, 0x54, 0x63, 0x24, 0x95, 0x41, 0xbe, 0xdf, 0x36, 0xd9, 0x74, 0x7e, 0xcf,
-	0xc0, 0x42, 0x85, 0x3b, 0x77, 0x29, 0x65, 0x2d, 0x6a, 0xe1, 0xcf, 0x92, 0x1a, 0x06, 0x25, 0x4c,
-	0x0b, 0x1a, 0x67, 0xd5, 0x31, 0x48, 0xaf, 0xc1, 0x1c, 0xf7, 0x99, 0x67, 0x3a, 0xb8, 0xda, 0x60,
-	0x56, 0x18, 0x30, 0x9f, 0x09, 0x0f, 0xbd, 0x14, 0xdb, 0xef, 0xc7, 0xe6, 0x00, 0x88, 0x13, 0x87,
-	0x9a, 0x7e, 0xcb, 0xc3, 0x61, 0x2f, 0xe4, 0x8c, 0x8e, 0x41, 0xaa, 0x01, 0xb8, 0x26, 0x69, 0xd4,
-	0xd8, 0x7e, 0x95, 0xd8, 0xf9, 0x4b, 0x17, 0x97, 0x5c, 0x2e, 0x86, 0xdd, 0xb6, 0xbb, 0xcb, 0x93,
-	0xed, 0x2d, 0xcf, 0x5a, 0x7f, 0x79, 0x6e, 0xf5, 0x97, 0xe7, 0x94, 0x30, 0xaa, 0x02, 0xd7, 0x45,
-	0xf6, 0xa4, 0x4c, 0xea, 0x6f, 0x08, 0xae, 0xa4, 0x35, 0x34, 0x58, 0xcb, 0x27, 0xf4, 0x19, 0x4f,
-	0xd3, 0xc7, 0x30, 0xe3, 0xb1, 0x96, 0x8f, 0xa3, 0x27, 0xe9, 0xc5, 0xb5, 0x37, 0xb4, 0x91, 0xe6,
-	0xb0, 0x16, 0x80, 0xe3, 0xf2, 0xa5, 0x40, 0x2d, 0x23, 0x46, 0x28, 0x15, 0xfb, 0x33, 0x2a, 0x88,
-	0x1b, 0xae, 0x43, 0x4c, 0xf5, 0xe0, 0x9a, 0xc0, 0x3c, 0xd9, 0xb6, 0x7b, 0x98, 0x81, 0xc5, 0x0a,
-	0x77, 0x76, 0xb1, 0xdf, 0x89, 0x78, 0x8f, 0xb9, 0x26, 0xa1, 0xd2, 0x03, 0x98, 0x21, 0xdc, 0xad,
-	0x5e, 0x6c, 0xcc, 0x2c, 0xe1, 0xee, 0xb6, 0x2d, 0x6d, 0x41, 0x36, 0xd4, 0x29, 0xec, 0xd5, 0xb3,
-	0x09, 0x1d, 0x01, 0x48, 0x0b, 0x90, 0x65, 0x7b, 0x14, 0x7b, 0x71, 0x47, 0x47, 0x8b, 0xd2, 0x66,
-	0xa0, 0x7e, 0xf4, 0x3f, 0xd0, 0xfe, 0x9d, 0x41, 0xda, 0x8b, 0x53, 0x4f, 0x2b, 0x52, 0x08, 0x47,
-	0xb3, 0xe0, 0x44, 0xda, 0x64, 0xff, 0x22, 0xb8, 0x5a, 0xe1, 0x8e, 0x81, 0x5d, 0xd6, 0xc6, 0xcf,
-	0x55, 0xc2, 0x45, 0x98, 0xb1, 0xc3, 0x28, 0xa1, 0x86, 0xb3, 0x46, 0xbc, 0x1a, 0x20, 0xc8, 0x9d,
-	0x5e, 0x41, 0x6e, 0xf7, 0x0b, 0x22, 0x4e, 0x40, 0x7d, 0x15, 0x6e, 0x0d, 0xdc, 0x4c, 0x35, 0xf8,
-	0x35, 0x13, 0x0e, 0xcb, 0x4f, 0x9b, 0x76, 0x4f, 0xe3, 0xee, 0x04, 0xc1, 0x26, 0x2a, 0x41, 0x9a,
-	0x6a, 0xa6, 0x2b, 0x55, 0xe9, 0x0e, 0xe4, 0x28, 0xde, 0xab, 0x76, 0x89, 0x50, 0xce, 0xff, 0xf5,
-	0x68, 0x75, 0x21, 0xbe, 0x1f, 0xc5, 0x18, 0xbb, 0xbe, 0x47, 0xa8, 0x63, 0x5c, 0xa6, 0x78, 0x2f,
-	0x22, 0xba, 0x0a, 0x92, 0x87, 0xa3, 0x59, 0x12, 0xf9, 0xf2, 0x3a, 0x69, 0x86, 0x83, 0xf0, 0xb2,
-	0x31, 0x9f, 0xec, 0xec, 0x24, 0x1b, 0xa5, 0xb7, 0x7b, 0x05, 0x5d, 0xea, 0x17, 0x54, 0xa8, 0x86,
-	0xaa, 0x42, 0x61, 0xd0, 0x5e, 0x22, 0xe7, 0xda, 0x4f, 0x00, 0xd3, 0x15, 0xee, 0x48, 0x87, 0x08,
-	0xe4, 0x21, 0x57, 0xa9, 0x7b, 0x23, 0x3e, 0x33, 0x43, 0xaf, 0x36, 0xf2, 0xfd, 0x8b, 0x40, 0x49,
-	0x47, 0xd4, 0x1f, 0x08, 0xae, 0x0d, 0xbb, 0xc8, 0x6c, 0x8e, 0x1f, 0x4d, 0x00, 0x23, 0x57, 0x2e,
-	0x04, 0x26, 0x65, 0xfd, 0x2d, 0x82, 0xd9, 0xde, 0x7b, 0xc4, 0xbb, 0xe3, 0x06, 0x88, 0x1d, 0xe5,
-	0x0f, 0xce, 0xe8, 0x98, 0x72, 0xf9, 0x01, 0xc1, 0xdc, 0xa9, 0x37, 0x56, 0x69, 0x5c, 0xd4, 0x8e,
-	0xaf, 0x5c, 0x3e, 0xbb, 0x6f, 0x4a, 0xea, 0x21, 0x82, 0x2b, 0xa2, 0x37, 0xc4, 0xfb, 0xa3, 0x63,
-	0x0b, 0xdc, 0xe5, 0xcd, 0x73, 0xb9, 0xa7, 0xec, 0x7e, 0x41, 0xb0, 0x38, 0x60, 0xfe, 0x7e, 0x38,
-	0x7a, 0x04, 0x31, 0x82, 0xbc, 0x75, 0x5e, 0x84, 0x94, 0xe6, 0xcf, 0x08, 0x5e, 0x16, 0x8f, 0xc8,
-	0x31, 0x9a, 0x46, 0x08, 0x20, 0x7f, 0x74, 0x4e, 0x80, 0x94, 0xe3, 0x8f, 0x08, 0xe6, 0x4f, 0xdf,
-	0x40, 0xd7, 0x47, 0x87, 0x3f, 0xe5, 0x2c, 0x6f, 0x9c, 0xc3, 0x39, 0xe1, 0x25, 0x67, 0xbf, 0x3e,
-	0x39, 0x5c, 0x41, 0x65, 0xf2, 0xf8, 0x48, 0x41, 0x4f, 0x8e, 0x14, 0xf4, 0xdf, 0x91, 0x82, 0xbe,
-	0x3f, 0x56, 0xa6, 0x9e, 0x1c, 0x2b, 0x53, 0x7f, 0x1f, 0x2b, 0x53, 0x0f, 0x76, 0xc6, 0x79, 0x9b,
-	0xec, 0x47, 0x9f, 0xb9, 0x6f, 0x16, 0xab, 0xa2, 0x2f, 0xdd, 0xf0, 0x33, 0xb7, 0x36, 0x13, 0x7e,
-	0xd1, 0xbe, 0xf5, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x17, 0x95, 0xd6, 0x16, 0xba, 0x0f, 0x00,
-	0x00,
+func (m *MsgCreateAggregationIsm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateMessageIdMultisigIsm ...
-	CreateMessageIdMultisigIsm(ctx context.Context, in *MsgCreateMessageIdMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMessageIdMultisigIsmResponse, error)
-	// CreateMerkleRootMultisigIsm ...
-	CreateMerkleRootMultisigIsm(ctx context.Context, in *MsgCreateMerkleRootMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMerkleRootMultisigIsmResponse, error)
-	// CreateNoopIsm ...
-	CreateNoopIsm(ctx context.Context, in *MsgCreateNoopIsm, opts ...grpc.CallOption) (*MsgCreateNoopIsmResponse, error)
-	// CreateRoutingIsm ...
-	CreateRoutingIsm(ctx context.Context, in *MsgCreateRoutingIsm, opts ...grpc.CallOption) (*MsgCreateRoutingIsmResponse, error)
-	// SetRoutingIsmDomain ...
-	SetRoutingIsmDomain(ctx context.Context, in *MsgSetRoutingIsmDomain, opts ...grpc.CallOption) (*MsgSetRoutingIsmDomainResponse, error)
-	// RemoveRoutingIsmDomain ...
-	RemoveRoutingIsmDomain(ctx context.Context, in *MsgRemoveRoutingIsmDomain, opts ...grpc.CallOption) (*MsgRemoveRoutingIsmDomainResponse, error)
-	// UpdateRoutingIsmOwner ...
-	UpdateRoutingIsmOwner(ctx context.Context, in *MsgUpdateRoutingIsmOwner, opts ...grpc.CallOption) (*MsgUpdateRoutingIsmOwnerResponse, error)
-	// AnnounceValidator ...
-	AnnounceValidator(ctx context.Context, in *MsgAnnounceValidator, opts ...grpc.CallOption) (*MsgAnnounceValidatorResponse, error)
+func (m *MsgCreateAggregationIsm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAggregationIsm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateAggregationIsm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAggregationIsm.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateAggregationIsm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAggregationIsm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAggregationIsm.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateMessageIdMultisigIsm(ctx context.Context, in *MsgCreateMessageIdMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMessageIdMultisigIsmResponse, error) {
-	out := new(MsgCreateMessageIdMultisigIsmResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateMessageIdMultisigIsm", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateAggregationIsm proto.InternalMessageInfo
+
+func (m *MsgCreateAggregationIsm) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateMerkleRootMultisigIsm(ctx context.Context, in *MsgCreateMerkleRootMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMerkleRootMultisigIsmResponse, error) {
-	out := new(MsgCreateMerkleRootMultisigIsmResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateMerkleRootMultisigIsm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateAggregationIsm) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateNoopIsm(ctx context.Context, in *MsgCreateNoopIsm, opts ...grpc.CallOption) (*MsgCreateNoopIsmResponse, error) {
-	out := new(MsgCreateNoopIsmResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateNoopIsm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgCreateAggregationIsmResponse ...
+type MsgCreateAggregationIsmResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 }
 
-func (c *msgClient) CreateRoutingIsm(ctx context.Context, in *MsgCreateRoutingIsm, opts ...grpc.CallOption) (*MsgCreateRoutingIsmResponse, error) {
-	out := new(MsgCreateRoutingIsmResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateRoutingIsm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateAggregationIsmResponse) Reset()         { *m = MsgCreateAggregationIsmResponse{} }
+func (m *MsgCreateAggregationIsmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAggregationIsmResponse) ProtoMessage()    {}
+func (*MsgCreateAggregationIsmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{17}
+}
+func (m *MsgCreateAggregationIsmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAggregationIsmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAggregationIsmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateAggregationIsmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAggregationIsmResponse.Merge(m, src)
+}
+func (m *MsgCreateAggregationIsmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAggregationIsmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAggregationIsmResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetRoutingIsmDomain(ctx context.Context, in *MsgSetRoutingIsmDomain, opts ...grpc.CallOption) (*MsgSetRoutingIsmDomainResponse, error) {
-	out := new(MsgSetRoutingIsmDomainResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/SetRoutingIsmDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCreateAggregationIsmResponse proto.InternalMessageInfo
+
+// MsgSetAggregationIsmModules ...
+type MsgSetAggregationIsmModules struct {
+	// ism_id ...
+	IsmId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id"`
+	// modules ...
+	Modules []github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,rep,name=modules,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"modules"`
+	// threshold ...
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// owner ...
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (c *msgClient) RemoveRoutingIsmDomain(ctx context.Context, in *MsgRemoveRoutingIsmDomain, opts ...grpc.CallOption) (*MsgRemoveRoutingIsmDomainResponse, error) {
-	out := new(MsgRemoveRoutingIsmDomainResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/RemoveRoutingIsmDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAggregationIsmModules) Reset()         { *m = MsgSetAggregationIsmModules{} }
+func (m *MsgSetAggregationIsmModules) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAggregationIsmModules) ProtoMessage()    {}
+func (*MsgSetAggregationIsmModules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{18}
+}
+func (m *MsgSetAggregationIsmModules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAggregationIsmModules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAggregationIsmModules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAggregationIsmModules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAggregationIsmModules.Merge(m, src)
+}
+func (m *MsgSetAggregationIsmModules) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAggregationIsmModules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAggregationIsmModules.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateRoutingIsmOwner(ctx context.Context, in *MsgUpdateRoutingIsmOwner, opts ...grpc.CallOption) (*MsgUpdateRoutingIsmOwnerResponse, error) {
-	out := new(MsgUpdateRoutingIsmOwnerResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/UpdateRoutingIsmOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAggregationIsmModules proto.InternalMessageInfo
+
+func (m *MsgSetAggregationIsmModules) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AnnounceValidator(ctx context.Context, in *MsgAnnounceValidator, opts ...grpc.CallOption) (*MsgAnnounceValidatorResponse, error) {
-	out := new(MsgAnnounceValidatorResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/AnnounceValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAggregationIsmModules) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateMessageIdMultisigIsm ...
-	CreateMessageIdMultisigIsm(context.Context, *MsgCreateMessageIdMultisigIsm) (*MsgCreateMessageIdMultisigIsmResponse, error)
-	// CreateMerkleRootMultisigIsm ...
-	CreateMerkleRootMultisigIsm(context.Context, *MsgCreateMerkleRootMultisigIsm) (*MsgCreateMerkleRootMultisigIsmResponse, error)
-	// CreateNoopIsm ...
-	CreateNoopIsm(context.Context, *MsgCreateNoopIsm) (*MsgCreateNoopIsmResponse, error)
-	// CreateRoutingIsm ...
-	CreateRoutingIsm(context.Context, *MsgCreateRoutingIsm) (*MsgCreateRoutingIsmResponse, error)
-	// SetRoutingIsmDomain ...
-	SetRoutingIsmDomain(context.Context, *MsgSetRoutingIsmDomain) (*MsgSetRoutingIsmDomainResponse, error)
-	// RemoveRoutingIsmDomain ...
-	RemoveRoutingIsmDomain(context.Context, *MsgRemoveRoutingIsmDomain) (*MsgRemoveRoutingIsmDomainResponse, error)
-	// UpdateRoutingIsmOwner ...
-	UpdateRoutingIsmOwner(context.Context, *MsgUpdateRoutingIsmOwner) (*MsgUpdateRoutingIsmOwnerResponse, error)
-	// AnnounceValidator ...
-	AnnounceValidator(context.Context, *MsgAnnounceValidator) (*MsgAnnounceValidatorResponse, error)
+// MsgSetAggregationIsmModulesResponse ...
+type MsgSetAggregationIsmModulesResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetAggregationIsmModulesResponse) Reset()         { *m = MsgSetAggregationIsmModulesResponse{} }
+func (m *MsgSetAggregationIsmModulesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAggregationIsmModulesResponse) ProtoMessage()    {}
+func (*MsgSetAggregationIsmModulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{19}
 }
-
-func (*UnimplementedMsgServer) CreateMessageIdMultisigIsm(ctx context.Context, req *MsgCreateMessageIdMultisigIsm) (*MsgCreateMessageIdMultisigIsmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMessageIdMultisigIsm not implemented")
+func (m *MsgSetAggregationIsmModulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateMerkleRootMultisigIsm(ctx context.Context, req *MsgCreateMerkleRootMultisigIsm) (*MsgCreateMerkleRootMultisigIsmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleRootMultisigIsm not implemented")
+func (m *MsgSetAggregationIsmModulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAggregationIsmModulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateNoopIsm(ctx context.Context, req *MsgCreateNoopIsm) (*MsgCreateNoopIsmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNoopIsm not implemented")
+func (m *MsgSetAggregationIsmModulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAggregationIsmModulesResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateRoutingIsm(ctx context.Context, req *MsgCreateRoutingIsm) (*MsgCreateRoutingIsmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRoutingIsm not implemented")
+func (m *MsgSetAggregationIsmModulesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetRoutingIsmDomain(ctx context.Context, req *MsgSetRoutingIsmDomain) (*MsgSetRoutingIsmDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRoutingIsmDomain not implemented")
+func (m *MsgSetAggregationIsmModulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAggregationIsmModulesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RemoveRoutingIsmDomain(ctx context.Context, req *MsgRemoveRoutingIsmDomain) (*MsgRemoveRoutingIsmDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoutingIsmDomain not implemented")
+
+var xxx_messageInfo_MsgSetAggregationIsmModulesResponse proto.InternalMessageInfo
+
+// MsgUpdateAggregationIsmOwner ...
+type MsgUpdateAggregationIsmOwner struct {
+	// ism_id ...
+	IsmId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// new owner
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// renounce_ownership
+	RenounceOwnership bool `protobuf:"varint,4,opt,name=renounce_ownership,json=renounceOwnership,proto3" json:"renounce_ownership,omitempty"`
 }
-func (*UnimplementedMsgServer) UpdateRoutingIsmOwner(ctx context.Context, req *MsgUpdateRoutingIsmOwner) (*MsgUpdateRoutingIsmOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoutingIsmOwner not implemented")
+
+func (m *MsgUpdateAggregationIsmOwner) Reset()         { *m = MsgUpdateAggregationIsmOwner{} }
+func (m *MsgUpdateAggregationIsmOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAggregationIsmOwner) ProtoMessage()    {}
+func (*MsgUpdateAggregationIsmOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{20}
 }
-func (*UnimplementedMsgServer) AnnounceValidator(ctx context.Context, req *MsgAnnounceValidator) (*MsgAnnounceValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnounceValidator not implemented")
+func (m *MsgUpdateAggregationIsmOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateAggregationIsmOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAggregationIsmOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAggregationIsmOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAggregationIsmOwner.Merge(m, src)
+}
+func (m *MsgUpdateAggregationIsmOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAggregationIsmOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAggregationIsmOwner.DiscardUnknown(m)
 }
 
-func _Msg_CreateMessageIdMultisigIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMessageIdMultisigIsm)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateAggregationIsmOwner proto.InternalMessageInfo
+
+func (m *MsgUpdateAggregationIsmOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMessageIdMultisigIsm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateMessageIdMultisigIsm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMessageIdMultisigIsm(ctx, req.(*MsgCreateMessageIdMultisigIsm))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateMerkleRootMultisigIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMerkleRootMultisigIsm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMerkleRootMultisigIsm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateMerkleRootMultisigIsm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMerkleRootMultisigIsm(ctx, req.(*MsgCreateMerkleRootMultisigIsm))
+func (m *MsgUpdateAggregationIsmOwner) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateNoopIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateNoopIsm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateNoopIsm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateNoopIsm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateNoopIsm(ctx, req.(*MsgCreateNoopIsm))
+func (m *MsgUpdateAggregationIsmOwner) GetRenounceOwnership() bool {
+	if m != nil {
+		return m.RenounceOwnership
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_CreateRoutingIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRoutingIsm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRoutingIsm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateRoutingIsm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRoutingIsm(ctx, req.(*MsgCreateRoutingIsm))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateAggregationIsmOwnerResponse ...
+type MsgUpdateAggregationIsmOwnerResponse struct {
 }
 
-func _Msg_SetRoutingIsmDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetRoutingIsmDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetRoutingIsmDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/SetRoutingIsmDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetRoutingIsmDomain(ctx, req.(*MsgSetRoutingIsmDomain))
+func (m *MsgUpdateAggregationIsmOwnerResponse) Reset()         { *m = MsgUpdateAggregationIsmOwnerResponse{} }
+func (m *MsgUpdateAggregationIsmOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAggregationIsmOwnerResponse) ProtoMessage()    {}
+func (*MsgUpdateAggregationIsmOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{21}
+}
+func (m *MsgUpdateAggregationIsmOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAggregationIsmOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAggregationIsmOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateAggregationIsmOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAggregationIsmOwnerResponse.Merge(m, src)
+}
+func (m *MsgUpdateAggregationIsmOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAggregationIsmOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAggregationIsmOwnerResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveRoutingIsmDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveRoutingIsmDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveRoutingIsmDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/RemoveRoutingIsmDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveRoutingIsmDomain(ctx, req.(*MsgRemoveRoutingIsmDomain))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateAggregationIsmOwnerResponse proto.InternalMessageInfo
+
+// MsgCreateWeightedMessageIdMultisigIsm ...
+type MsgCreateWeightedMessageIdMultisigIsm struct {
+	// creator is the message sender.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// validators must be sorted in ascending order by address.
+	Validators []WeightedValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// threshold_weight ...
+	ThresholdWeight uint64 `protobuf:"varint,3,opt,name=threshold_weight,json=thresholdWeight,proto3" json:"threshold_weight,omitempty"`
 }
 
-func _Msg_UpdateRoutingIsmOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRoutingIsmOwner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRoutingIsmOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/UpdateRoutingIsmOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRoutingIsmOwner(ctx, req.(*MsgUpdateRoutingIsmOwner))
+func (m *MsgCreateWeightedMessageIdMultisigIsm) Reset()         { *m = MsgCreateWeightedMessageIdMultisigIsm{} }
+func (m *MsgCreateWeightedMessageIdMultisigIsm) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateWeightedMessageIdMultisigIsm) ProtoMessage()    {}
+func (*MsgCreateWeightedMessageIdMultisigIsm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{22}
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsm.Merge(m, src)
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsm.DiscardUnknown(m)
 }
 
-func _Msg_AnnounceValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAnnounceValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AnnounceValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/AnnounceValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AnnounceValidator(ctx, req.(*MsgAnnounceValidator))
+var xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsm proto.InternalMessageInfo
+
+func (m *MsgCreateWeightedMessageIdMultisigIsm) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hyperlane.core.interchain_security.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMessageIdMultisigIsm",
-			Handler:    _Msg_CreateMessageIdMultisigIsm_Handler,
-		},
-		{
-			MethodName: "CreateMerkleRootMultisigIsm",
-			Handler:    _Msg_CreateMerkleRootMultisigIsm_Handler,
-		},
-		{
-			MethodName: "CreateNoopIsm",
-			Handler:    _Msg_CreateNoopIsm_Handler,
-		},
-		{
-			MethodName: "CreateRoutingIsm",
-			Handler:    _Msg_CreateRoutingIsm_Handler,
-		},
-		{
-			MethodName: "SetRoutingIsmDomain",
-			Handler:    _Msg_SetRoutingIsmDomain_Handler,
-		},
-		{
-			MethodName: "RemoveRoutingIsmDomain",
-			Handler:    _Msg_RemoveRoutingIsmDomain_Handler,
-		},
-		{
-			MethodName: "UpdateRoutingIsmOwner",
-			Handler:    _Msg_UpdateRoutingIsmOwner_Handler,
-		},
-		{
-			MethodName: "AnnounceValidator",
-			Handler:    _Msg_AnnounceValidator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hyperlane/core/interchain_security/v1/tx.proto",
+func (m *MsgCreateWeightedMessageIdMultisigIsm) GetValidators() []WeightedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *MsgCreateMessageIdMultisigIsm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateWeightedMessageIdMultisigIsm) GetThresholdWeight() uint64 {
+	if m != nil {
+		return m.ThresholdWeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateMessageIdMultisigIsm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateWeightedMessageIdMultisigIsmResponse ...
+type MsgCreateWeightedMessageIdMultisigIsmResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 }
 
-func (m *MsgCreateMessageIdMultisigIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Validators[iNdEx])
-			copy(dAtA[i:], m.Validators[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) Reset() {
+	*m = MsgCreateWeightedMessageIdMultisigIsmResponse{}
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCreateWeightedMessageIdMultisigIsmResponse) ProtoMessage() {}
+func (*MsgCreateWeightedMessageIdMultisigIsmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{23}
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateMessageIdMultisigIsmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsmResponse.Merge(m, src)
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateMessageIdMultisigIsmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateWeightedMessageIdMultisigIsmResponse proto.InternalMessageInfo
+
+// MsgCreateWeightedMerkleRootMultisigIsm ...
+type MsgCreateWeightedMerkleRootMultisigIsm struct {
+	// creator is the message sender.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// validators must be sorted in ascending order by address.
+	Validators []WeightedValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// threshold_weight ...
+	ThresholdWeight uint64 `protobuf:"varint,3,opt,name=threshold_weight,json=thresholdWeight,proto3" json:"threshold_weight,omitempty"`
 }
 
-func (m *MsgCreateMessageIdMultisigIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) Reset() {
+	*m = MsgCreateWeightedMerkleRootMultisigIsm{}
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateWeightedMerkleRootMultisigIsm) ProtoMessage()    {}
+func (*MsgCreateWeightedMerkleRootMultisigIsm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{24}
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateMerkleRootMultisigIsm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsm.Merge(m, src)
 }
-
-func (m *MsgCreateMerkleRootMultisigIsm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsm.DiscardUnknown(m)
 }
 
-func (m *MsgCreateMerkleRootMultisigIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Threshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Validators[iNdEx])
-			copy(dAtA[i:], m.Validators[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+var xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsm proto.InternalMessageInfo
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) GetValidators() []WeightedValidator {
+	if m != nil {
+		return m.Validators
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateMerkleRootMultisigIsmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) GetThresholdWeight() uint64 {
+	if m != nil {
+		return m.ThresholdWeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateMerkleRootMultisigIsmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateWeightedMerkleRootMultisigIsmResponse ...
+type MsgCreateWeightedMerkleRootMultisigIsmResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 }
 
-func (m *MsgCreateMerkleRootMultisigIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) Reset() {
+	*m = MsgCreateWeightedMerkleRootMultisigIsmResponse{}
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCreateWeightedMerkleRootMultisigIsmResponse) ProtoMessage() {}
+func (*MsgCreateWeightedMerkleRootMultisigIsmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{25}
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsmResponse.Merge(m, src)
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateNoopIsm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateWeightedMerkleRootMultisigIsmResponse proto.InternalMessageInfo
+
+// MsgSetMultisigIsmValidators replaces the validator set and the threshold of
+// a MessageIdMultisigISM or MerkleRootMultisigISM.
+type MsgSetMultisigIsmValidators struct {
+	// ism_id ...
+	IsmId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validators
+	// these are 20 byte long ethereum style addresses
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	// threshold ...
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *MsgSetMultisigIsmValidators) Reset()         { *m = MsgSetMultisigIsmValidators{} }
+func (m *MsgSetMultisigIsmValidators) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMultisigIsmValidators) ProtoMessage()    {}
+func (*MsgSetMultisigIsmValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{26}
+}
+func (m *MsgSetMultisigIsmValidators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMultisigIsmValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMultisigIsmValidators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetMultisigIsmValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMultisigIsmValidators.Merge(m, src)
+}
+func (m *MsgSetMultisigIsmValidators) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMultisigIsmValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMultisigIsmValidators.DiscardUnknown(m)
 }
 
-func (m *MsgCreateNoopIsm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMultisigIsmValidators proto.InternalMessageInfo
+
+func (m *MsgSetMultisigIsmValidators) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgCreateNoopIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetMultisigIsmValidators) GetValidators() []string {
+	if m != nil {
+		return m.Validators
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateNoopIsmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMultisigIsmValidators) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateNoopIsmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetMultisigIsmValidatorsResponse ...
+type MsgSetMultisigIsmValidatorsResponse struct {
 }
 
-func (m *MsgCreateNoopIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetMultisigIsmValidatorsResponse) Reset()         { *m = MsgSetMultisigIsmValidatorsResponse{} }
+func (m *MsgSetMultisigIsmValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMultisigIsmValidatorsResponse) ProtoMessage()    {}
+func (*MsgSetMultisigIsmValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{27}
+}
+func (m *MsgSetMultisigIsmValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMultisigIsmValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMultisigIsmValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAnnounceValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetMultisigIsmValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMultisigIsmValidatorsResponse.Merge(m, src)
+}
+func (m *MsgSetMultisigIsmValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMultisigIsmValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMultisigIsmValidatorsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAnnounceValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMultisigIsmValidatorsResponse proto.InternalMessageInfo
+
+// MsgSetMultisigIsmThreshold changes the threshold of a MessageIdMultisigISM
+// or MerkleRootMultisigISM.
+type MsgSetMultisigIsmThreshold struct {
+	// ism_id ...
+	IsmId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// threshold ...
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *MsgAnnounceValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.MailboxId.Size()
-		i -= size
-		if _, err := m.MailboxId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetMultisigIsmThreshold) Reset()         { *m = MsgSetMultisigIsmThreshold{} }
+func (m *MsgSetMultisigIsmThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMultisigIsmThreshold) ProtoMessage()    {}
+func (*MsgSetMultisigIsmThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{28}
+}
+func (m *MsgSetMultisigIsmThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMultisigIsmThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMultisigIsmThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StorageLocation) > 0 {
-		i -= len(m.StorageLocation)
-		copy(dAtA[i:], m.StorageLocation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageLocation)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetMultisigIsmThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMultisigIsmThreshold.Merge(m, src)
+}
+func (m *MsgSetMultisigIsmThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMultisigIsmThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMultisigIsmThreshold.DiscardUnknown(m)
 }
 
-func (m *MsgAnnounceValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetMultisigIsmThreshold proto.InternalMessageInfo
+
+func (m *MsgSetMultisigIsmThreshold) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAnnounceValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetMultisigIsmThreshold) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
 }
 
-func (m *MsgAnnounceValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetMultisigIsmThresholdResponse ...
+type MsgSetMultisigIsmThresholdResponse struct {
 }
 
-func (m *MsgCreateRoutingIsm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetMultisigIsmThresholdResponse) Reset()         { *m = MsgSetMultisigIsmThresholdResponse{} }
+func (m *MsgSetMultisigIsmThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMultisigIsmThresholdResponse) ProtoMessage()    {}
+func (*MsgSetMultisigIsmThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{29}
+}
+func (m *MsgSetMultisigIsmThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMultisigIsmThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMultisigIsmThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetMultisigIsmThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMultisigIsmThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetMultisigIsmThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMultisigIsmThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMultisigIsmThresholdResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRoutingIsm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetMultisigIsmThresholdResponse proto.InternalMessageInfo
+
+// MsgUpdateMultisigIsmOwner ...
+type MsgUpdateMultisigIsmOwner struct {
+	// ism_id ...
+	IsmId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// new owner
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// renounce_ownership
+	RenounceOwnership bool `protobuf:"varint,4,opt,name=renounce_ownership,json=renounceOwnership,proto3" json:"renounce_ownership,omitempty"`
 }
 
-func (m *MsgCreateRoutingIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateMultisigIsmOwner) Reset()         { *m = MsgUpdateMultisigIsmOwner{} }
+func (m *MsgUpdateMultisigIsmOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMultisigIsmOwner) ProtoMessage()    {}
+func (*MsgUpdateMultisigIsmOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{30}
+}
+func (m *MsgUpdateMultisigIsmOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMultisigIsmOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMultisigIsmOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateRoutingIsmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateMultisigIsmOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMultisigIsmOwner.Merge(m, src)
+}
+func (m *MsgUpdateMultisigIsmOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMultisigIsmOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMultisigIsmOwner.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRoutingIsmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateMultisigIsmOwner proto.InternalMessageInfo
+
+func (m *MsgUpdateMultisigIsmOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *MsgCreateRoutingIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateMultisigIsmOwner) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetRoutingIsmDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateMultisigIsmOwner) GetRenounceOwnership() bool {
+	if m != nil {
+		return m.RenounceOwnership
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgSetRoutingIsmDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateMultisigIsmOwnerResponse ...
+type MsgUpdateMultisigIsmOwnerResponse struct {
 }
 
-func (m *MsgSetRoutingIsmDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateMultisigIsmOwnerResponse) Reset()         { *m = MsgUpdateMultisigIsmOwnerResponse{} }
+func (m *MsgUpdateMultisigIsmOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMultisigIsmOwnerResponse) ProtoMessage()    {}
+func (*MsgUpdateMultisigIsmOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ee100bdd8d27ecb, []int{31}
+}
+func (m *MsgUpdateMultisigIsmOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMultisigIsmOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMultisigIsmOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.IsmId.Size()
-		i -= size
-		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetRoutingIsmDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateMultisigIsmOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMultisigIsmOwnerResponse.Merge(m, src)
 }
-
-func (m *MsgSetRoutingIsmDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateMultisigIsmOwnerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSetRoutingIsmDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateMultisigIsmOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMultisigIsmOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveRoutingIsmDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateMultisigIsmOwnerResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateMessageIdMultisigIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMessageIdMultisigIsm")
+	proto.RegisterType((*MsgCreateMessageIdMultisigIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMessageIdMultisigIsmResponse")
+	proto.RegisterType((*MsgCreateMerkleRootMultisigIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMerkleRootMultisigIsm")
+	proto.RegisterType((*MsgCreateMerkleRootMultisigIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateMerkleRootMultisigIsmResponse")
+	proto.RegisterType((*MsgCreateNoopIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateNoopIsm")
+	proto.RegisterType((*MsgCreateNoopIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateNoopIsmResponse")
+	proto.RegisterType((*MsgAnnounceValidator)(nil), "hyperlane.core.interchain_security.v1.MsgAnnounceValidator")
+	proto.RegisterType((*MsgAnnounceValidatorResponse)(nil), "hyperlane.core.interchain_security.v1.MsgAnnounceValidatorResponse")
+	proto.RegisterType((*MsgCreateRoutingIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateRoutingIsm")
+	proto.RegisterType((*MsgCreateRoutingIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateRoutingIsmResponse")
+	proto.RegisterType((*MsgSetRoutingIsmDomain)(nil), "hyperlane.core.interchain_security.v1.MsgSetRoutingIsmDomain")
+	proto.RegisterType((*MsgSetRoutingIsmDomainResponse)(nil), "hyperlane.core.interchain_security.v1.MsgSetRoutingIsmDomainResponse")
+	proto.RegisterType((*MsgRemoveRoutingIsmDomain)(nil), "hyperlane.core.interchain_security.v1.MsgRemoveRoutingIsmDomain")
+	proto.RegisterType((*MsgRemoveRoutingIsmDomainResponse)(nil), "hyperlane.core.interchain_security.v1.MsgRemoveRoutingIsmDomainResponse")
+	proto.RegisterType((*MsgUpdateRoutingIsmOwner)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateRoutingIsmOwner")
+	proto.RegisterType((*MsgUpdateRoutingIsmOwnerResponse)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateRoutingIsmOwnerResponse")
+	proto.RegisterType((*MsgCreateAggregationIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateAggregationIsm")
+	proto.RegisterType((*MsgCreateAggregationIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateAggregationIsmResponse")
+	proto.RegisterType((*MsgSetAggregationIsmModules)(nil), "hyperlane.core.interchain_security.v1.MsgSetAggregationIsmModules")
+	proto.RegisterType((*MsgSetAggregationIsmModulesResponse)(nil), "hyperlane.core.interchain_security.v1.MsgSetAggregationIsmModulesResponse")
+	proto.RegisterType((*MsgUpdateAggregationIsmOwner)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateAggregationIsmOwner")
+	proto.RegisterType((*MsgUpdateAggregationIsmOwnerResponse)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateAggregationIsmOwnerResponse")
+	proto.RegisterType((*MsgCreateWeightedMessageIdMultisigIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateWeightedMessageIdMultisigIsm")
+	proto.RegisterType((*MsgCreateWeightedMessageIdMultisigIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateWeightedMessageIdMultisigIsmResponse")
+	proto.RegisterType((*MsgCreateWeightedMerkleRootMultisigIsm)(nil), "hyperlane.core.interchain_security.v1.MsgCreateWeightedMerkleRootMultisigIsm")
+	proto.RegisterType((*MsgCreateWeightedMerkleRootMultisigIsmResponse)(nil), "hyperlane.core.interchain_security.v1.MsgCreateWeightedMerkleRootMultisigIsmResponse")
+	proto.RegisterType((*MsgSetMultisigIsmValidators)(nil), "hyperlane.core.interchain_security.v1.MsgSetMultisigIsmValidators")
+	proto.RegisterType((*MsgSetMultisigIsmValidatorsResponse)(nil), "hyperlane.core.interchain_security.v1.MsgSetMultisigIsmValidatorsResponse")
+	proto.RegisterType((*MsgSetMultisigIsmThreshold)(nil), "hyperlane.core.interchain_security.v1.MsgSetMultisigIsmThreshold")
+	proto.RegisterType((*MsgSetMultisigIsmThresholdResponse)(nil), "hyperlane.core.interchain_security.v1.MsgSetMultisigIsmThresholdResponse")
+	proto.RegisterType((*MsgUpdateMultisigIsmOwner)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateMultisigIsmOwner")
+	proto.RegisterType((*MsgUpdateMultisigIsmOwnerResponse)(nil), "hyperlane.core.interchain_security.v1.MsgUpdateMultisigIsmOwnerResponse")
+}
+
+func init() {
+	proto.RegisterFile("hyperlane/core/interchain_security/v1/tx.proto", fileDescriptor_4ee100bdd8d27ecb)
+}
+
+var fileDescriptor_4ee100bdd8d27ecb = []byte{
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6b, 0xdb, 0x56,
+	0x14, 0xcf, 0x55, 0x3e, 0xda, 0x9c, 0x52, 0x9a, 0xaa, 0x69, 0xeb, 0xa8, 0xad, 0x9b, 0xaa, 0x4d,
+	0x96, 0x66, 0x8b, 0xbd, 0xa4, 0xeb, 0x07, 0xee, 0x3e, 0x9a, 0xa4, 0xdd, 0x92, 0xae, 0x5e, 0x41,
+	0x69, 0x3b, 0x28, 0xdb, 0x8c, 0x63, 0x5d, 0x64, 0x31, 0x5b, 0xd7, 0xe8, 0xca, 0x4e, 0x0a, 0x1b,
+	0x8c, 0xb1, 0x0d, 0xb6, 0xa7, 0x7d, 0xb0, 0xa7, 0xc2, 0x60, 0x30, 0xd8, 0x28, 0xfb, 0x08, 0xac,
+	0xec, 0x3f, 0x18, 0x14, 0xf6, 0x52, 0xf6, 0x34, 0xc6, 0x28, 0xa3, 0x19, 0xe4, 0xdf, 0x18, 0x96,
+	0xe4, 0x6b, 0x4b, 0xb9, 0x52, 0xa4, 0xd8, 0xce, 0x46, 0x5f, 0x8c, 0x75, 0xef, 0x3d, 0xbf, 0x73,
+	0xce, 0xef, 0x1c, 0x9d, 0x7b, 0xcf, 0x15, 0xa4, 0x8a, 0x77, 0x2a, 0xd8, 0x2c, 0xe5, 0x0d, 0x9c,
+	0x2e, 0x10, 0x13, 0xa7, 0x75, 0xc3, 0xc2, 0x66, 0xa1, 0x98, 0xd7, 0x8d, 0x1c, 0xc5, 0x85, 0xaa,
+	0xa9, 0x5b, 0x77, 0xd2, 0xb5, 0xe9, 0xb4, 0xb5, 0x9a, 0xaa, 0x98, 0xc4, 0x22, 0xe2, 0x18, 0x5b,
+	0x9f, 0xaa, 0xaf, 0x4f, 0x71, 0xd6, 0xa7, 0x6a, 0xd3, 0xd2, 0x48, 0x81, 0xd0, 0x32, 0xa1, 0x39,
+	0x5b, 0x28, 0xed, 0x3c, 0x38, 0x08, 0xd2, 0x61, 0xe7, 0x29, 0x5d, 0xa6, 0x5a, 0x1d, 0xb9, 0x4c,
+	0x35, 0x77, 0x62, 0x7f, 0xbe, 0xac, 0x1b, 0x24, 0x6d, 0xff, 0xba, 0x43, 0xd3, 0x11, 0xad, 0xbb,
+	0x53, 0xc1, 0x0d, 0xf8, 0x61, 0x8d, 0x68, 0xc4, 0x51, 0x5b, 0xff, 0xe7, 0x8c, 0xca, 0xf7, 0x11,
+	0x1c, 0xcb, 0x52, 0x6d, 0xde, 0xc4, 0x79, 0x0b, 0x67, 0x31, 0xa5, 0x79, 0x0d, 0x2f, 0xaa, 0xd9,
+	0x6a, 0xc9, 0xd2, 0xa9, 0xae, 0x2d, 0xd2, 0xb2, 0x98, 0x80, 0x5d, 0x85, 0xfa, 0x2c, 0x31, 0x13,
+	0x68, 0x14, 0x4d, 0x0c, 0x2a, 0x8d, 0x47, 0x31, 0x09, 0x50, 0xcb, 0x97, 0x74, 0xb5, 0xfe, 0x40,
+	0x13, 0xc2, 0x68, 0xef, 0xc4, 0xa0, 0xd2, 0x32, 0x22, 0x1e, 0x85, 0x41, 0xab, 0x68, 0x62, 0x5a,
+	0x24, 0x25, 0x35, 0xd1, 0x3b, 0x8a, 0x26, 0xf6, 0x2a, 0xcd, 0x81, 0xcc, 0xc5, 0xf7, 0x37, 0xd6,
+	0x26, 0x1b, 0x58, 0x9f, 0x6c, 0xac, 0x4d, 0x4e, 0x36, 0x7d, 0xaa, 0x4d, 0xa7, 0x43, 0x8d, 0x92,
+	0xdf, 0x81, 0xb1, 0xd0, 0x05, 0x0a, 0xa6, 0x15, 0x62, 0x50, 0x2c, 0x2e, 0x81, 0xa0, 0xab, 0x8e,
+	0xe1, 0x73, 0xf3, 0x0f, 0x1e, 0x1d, 0xef, 0xf9, 0xf3, 0xd1, 0xf1, 0x8b, 0x9a, 0x6e, 0x15, 0xab,
+	0xcb, 0xa9, 0x02, 0x29, 0xa7, 0x97, 0x0b, 0x95, 0x29, 0xdd, 0x30, 0x48, 0x2d, 0x6f, 0xe9, 0xc4,
+	0xa0, 0x69, 0x66, 0xc3, 0x94, 0x1b, 0x8d, 0xaa, 0xa5, 0x97, 0x52, 0x0b, 0x78, 0x75, 0x56, 0x55,
+	0x4d, 0x4c, 0xa9, 0x22, 0xe8, 0xaa, 0xfc, 0x0b, 0x82, 0x64, 0x8b, 0x7a, 0xf3, 0xed, 0x12, 0x56,
+	0x08, 0xb1, 0x76, 0x82, 0xb5, 0xe7, 0xfd, 0xac, 0x3d, 0x1d, 0xc4, 0x1a, 0xc7, 0x2a, 0xf9, 0x5d,
+	0x18, 0x0f, 0x5f, 0xd1, 0x5d, 0xde, 0xde, 0x80, 0x21, 0xa6, 0xfe, 0x35, 0x42, 0x2a, 0xa1, 0x44,
+	0x65, 0x52, 0x7e, 0x57, 0x8f, 0xf1, 0x5d, 0x75, 0x91, 0x64, 0x02, 0x09, 0xff, 0x58, 0x77, 0xdd,
+	0xf9, 0x49, 0x80, 0xe1, 0x2c, 0xd5, 0x66, 0x0d, 0x83, 0x54, 0x8d, 0x02, 0xbe, 0xd5, 0x88, 0x61,
+	0x3d, 0x84, 0x2c, 0xa0, 0xae, 0x57, 0xcd, 0x01, 0xf1, 0x34, 0x0c, 0x51, 0x8b, 0x98, 0x79, 0x0d,
+	0xe7, 0x4a, 0xa4, 0x60, 0x2b, 0x4c, 0x08, 0xf6, 0xa2, 0x7d, 0xee, 0xf8, 0x35, 0x77, 0xb8, 0x0e,
+	0x44, 0x75, 0xcd, 0xc8, 0x5b, 0x55, 0x13, 0xdb, 0xb9, 0x30, 0xa8, 0x34, 0x07, 0xc4, 0x65, 0x80,
+	0x72, 0x5e, 0x2f, 0x2d, 0x93, 0xd5, 0x9c, 0xae, 0x26, 0xfa, 0x3a, 0xe7, 0xdc, 0xa0, 0x0b, 0xbb,
+	0xa8, 0xb6, 0x86, 0xa7, 0xdf, 0x1b, 0x9e, 0x19, 0x7f, 0x78, 0x4e, 0xf8, 0xc3, 0xb3, 0x89, 0x18,
+	0x39, 0x09, 0x47, 0x79, 0xe3, 0x8d, 0x30, 0xc9, 0x3f, 0x22, 0x38, 0xc0, 0x62, 0xa8, 0x90, 0xaa,
+	0xa5, 0x1b, 0x5b, 0xbc, 0x4d, 0x57, 0x61, 0xc0, 0x24, 0x55, 0x0b, 0x3b, 0x6f, 0xd2, 0x9e, 0x99,
+	0x67, 0x52, 0x91, 0xea, 0x70, 0xaa, 0x0e, 0x8e, 0xe7, 0xfa, 0xea, 0x6c, 0x29, 0x2e, 0x42, 0x66,
+	0xda, 0xef, 0xd1, 0x28, 0x3f, 0xe1, 0x9a, 0x86, 0xc9, 0x26, 0x1c, 0xe1, 0x0c, 0x77, 0x37, 0xed,
+	0xee, 0x0a, 0x70, 0x28, 0x4b, 0xb5, 0x25, 0x6c, 0x35, 0x35, 0x5e, 0x26, 0xe5, 0xbc, 0x6e, 0x88,
+	0xb7, 0x61, 0x40, 0xa7, 0xe5, 0x5c, 0x67, 0x75, 0xf6, 0xeb, 0xb4, 0xbc, 0xa8, 0x8a, 0x0b, 0xd0,
+	0x6f, 0xf3, 0x64, 0xe7, 0xea, 0xf6, 0x88, 0x76, 0x00, 0xc4, 0x61, 0xe8, 0x27, 0x2b, 0x06, 0x36,
+	0xdd, 0x8c, 0x76, 0x1e, 0x32, 0x57, 0xea, 0xec, 0x3b, 0xff, 0xeb, 0xdc, 0x9f, 0x0b, 0xe2, 0x9e,
+	0xef, 0x3a, 0x8b, 0xc8, 0x28, 0x24, 0xf9, 0x2b, 0x58, 0x92, 0xfd, 0x85, 0x60, 0x24, 0x4b, 0x35,
+	0x05, 0x97, 0x49, 0x0d, 0xef, 0x28, 0x85, 0x87, 0x60, 0x40, 0xb5, 0xb5, 0xd8, 0x1c, 0xee, 0x55,
+	0xdc, 0xa7, 0x00, 0x42, 0xce, 0x7a, 0x09, 0x19, 0xf7, 0x13, 0xc2, 0x77, 0x40, 0x3e, 0x09, 0x27,
+	0x02, 0x27, 0x19, 0x07, 0xdf, 0x09, 0x76, 0xb1, 0xbc, 0x59, 0x51, 0x3d, 0x89, 0x7b, 0xbd, 0xae,
+	0xac, 0xab, 0x14, 0x30, 0x57, 0x85, 0x16, 0x57, 0xc5, 0xb3, 0x30, 0x68, 0xe0, 0x95, 0x5c, 0x0b,
+	0x09, 0x73, 0x89, 0xdf, 0xef, 0x4f, 0x0d, 0x3b, 0x58, 0x29, 0x17, 0x63, 0xc9, 0x32, 0x75, 0x43,
+	0x53, 0x76, 0x1b, 0x78, 0xc5, 0x31, 0x74, 0x0a, 0x44, 0x13, 0x3b, 0xb5, 0xc4, 0x91, 0xa5, 0x45,
+	0xbd, 0x62, 0x17, 0xc2, 0xdd, 0xca, 0xfe, 0xc6, 0xcc, 0xf5, 0xc6, 0x44, 0xe6, 0x39, 0x2f, 0xa1,
+	0x63, 0x7e, 0x42, 0xb9, 0x6c, 0xc8, 0x32, 0x8c, 0x06, 0xcd, 0x31, 0x3a, 0x37, 0x10, 0x1c, 0x66,
+	0x29, 0x3a, 0xab, 0x69, 0x26, 0xd6, 0x6c, 0x5e, 0xc2, 0x6b, 0xd7, 0x9b, 0xb0, 0xab, 0x4c, 0xd4,
+	0x6a, 0xc9, 0x2d, 0x5e, 0x1d, 0x22, 0xba, 0x81, 0xb9, 0xc5, 0x41, 0xe2, 0xac, 0xbf, 0xd8, 0x9d,
+	0xe2, 0xbf, 0x70, 0x5e, 0x6f, 0xe4, 0x1a, 0x1c, 0x0f, 0x98, 0xea, 0x6e, 0xd1, 0xfb, 0x55, 0xb0,
+	0x2b, 0xed, 0x12, 0xb6, 0xbc, 0x5a, 0xb3, 0xae, 0xb3, 0xdd, 0xcc, 0xd9, 0xff, 0x32, 0x4e, 0xcd,
+	0x17, 0xa6, 0xaf, 0xb5, 0x36, 0x9c, 0xf7, 0xa6, 0xf2, 0x84, 0x3f, 0x76, 0x41, 0x3c, 0xc9, 0x63,
+	0x70, 0x32, 0x64, 0x9a, 0x25, 0xf4, 0x9a, 0x00, 0x47, 0x59, 0xd6, 0x7b, 0x97, 0x3e, 0xd9, 0x35,
+	0xe2, 0x82, 0x97, 0xd8, 0xd3, 0xfc, 0x1a, 0xc1, 0x61, 0x44, 0x1e, 0x87, 0x53, 0x61, 0xf3, 0x8c,
+	0xda, 0xcf, 0x84, 0x96, 0xde, 0xe5, 0x75, 0xac, 0x6b, 0x45, 0x0b, 0xab, 0x31, 0x3b, 0xaf, 0xb7,
+	0x36, 0xf5, 0x10, 0x7b, 0x66, 0x2e, 0x44, 0xdc, 0x90, 0x1b, 0x2a, 0xd9, 0xe9, 0xcb, 0xdd, 0x9c,
+	0x5b, 0x7b, 0x90, 0xd3, 0x30, 0xc4, 0x32, 0x30, 0xb7, 0x62, 0x0b, 0xd8, 0x94, 0xf7, 0x29, 0xfb,
+	0xd8, 0xb8, 0x83, 0x93, 0x99, 0xf7, 0xd7, 0x91, 0x19, 0x7e, 0x1d, 0x09, 0xf3, 0x54, 0xfe, 0x00,
+	0xc1, 0x54, 0xa4, 0x95, 0xdd, 0x2d, 0x32, 0x5f, 0x08, 0x30, 0xce, 0x31, 0x23, 0x5e, 0x7f, 0xf7,
+	0x3f, 0x8a, 0xcd, 0x65, 0x7f, 0x6c, 0xce, 0x6c, 0x15, 0x1b, 0x5e, 0xd3, 0xf8, 0x21, 0x82, 0x54,
+	0xb4, 0xa5, 0xdd, 0x8d, 0xce, 0x47, 0x6c, 0x0b, 0x68, 0x51, 0x79, 0xab, 0x49, 0xcc, 0xce, 0x97,
+	0x24, 0x6f, 0x2b, 0xdf, 0x1b, 0xde, 0xca, 0xf7, 0xf9, 0x77, 0xe0, 0x08, 0x35, 0x9c, 0xeb, 0x68,
+	0xb3, 0x86, 0x73, 0xa7, 0x59, 0xa1, 0xf9, 0x07, 0x81, 0xb4, 0x69, 0xdd, 0x0d, 0xb6, 0xb1, 0xec,
+	0x3c, 0x5d, 0xe1, 0x07, 0x92, 0x73, 0x5e, 0x3a, 0x9e, 0x0a, 0xa7, 0x83, 0xf9, 0x21, 0x9f, 0x02,
+	0x39, 0x78, 0x96, 0x91, 0x71, 0x4f, 0x80, 0x11, 0x56, 0x9e, 0x5b, 0x56, 0x3e, 0xd9, 0xbb, 0xd9,
+	0x56, 0x2d, 0x04, 0x9f, 0x0e, 0xb7, 0x85, 0xe0, 0x4f, 0x36, 0x18, 0x9d, 0xf9, 0xfe, 0x20, 0xf4,
+	0x66, 0xa9, 0x26, 0xae, 0x21, 0x90, 0x42, 0xae, 0x0f, 0x2f, 0x47, 0x2c, 0x7d, 0xa1, 0xd7, 0x79,
+	0xd2, 0xb5, 0x4e, 0xa0, 0xb0, 0xf2, 0xf4, 0x33, 0x82, 0x23, 0x61, 0x97, 0x77, 0x57, 0xe2, 0x6b,
+	0xe3, 0xc0, 0x48, 0xd9, 0x8e, 0xc0, 0x30, 0xab, 0x3f, 0x46, 0xb0, 0xd7, 0x7b, 0x77, 0x76, 0x3e,
+	0xae, 0x02, 0x57, 0x50, 0x7a, 0x69, 0x9b, 0x82, 0xcc, 0x96, 0xcf, 0x11, 0x0c, 0x6d, 0xba, 0xa5,
+	0xc9, 0xc4, 0x45, 0x6d, 0xca, 0x4a, 0x73, 0xdb, 0x97, 0x65, 0x46, 0xdd, 0x45, 0x70, 0x80, 0x77,
+	0x2b, 0xf2, 0x42, 0x74, 0x6c, 0x8e, 0xb8, 0x74, 0xa5, 0x2d, 0x71, 0x66, 0xdd, 0x37, 0x08, 0x0e,
+	0x05, 0xdc, 0x39, 0x5c, 0x8a, 0xae, 0x81, 0x8f, 0x20, 0x2d, 0xb4, 0x8b, 0xc0, 0xcc, 0xfc, 0x1a,
+	0xc1, 0x41, 0xfe, 0xb5, 0x40, 0x8c, 0xa4, 0xe1, 0x02, 0x48, 0xaf, 0xb4, 0x09, 0xc0, 0x6c, 0xfc,
+	0x12, 0xc1, 0xfe, 0xcd, 0xb7, 0xae, 0x17, 0xa3, 0xc3, 0x6f, 0x12, 0x96, 0xe6, 0xdb, 0x10, 0x66,
+	0x76, 0x7d, 0x85, 0x60, 0x98, 0x7b, 0x07, 0xf0, 0x62, 0xdc, 0xec, 0xf6, 0xca, 0x4b, 0x2f, 0xb7,
+	0x27, 0xcf, 0x0c, 0xbc, 0x87, 0x20, 0x11, 0xd8, 0x42, 0xcf, 0xc5, 0xca, 0x73, 0x2e, 0x86, 0x74,
+	0xb5, 0x7d, 0x0c, 0x66, 0xec, 0x0f, 0x08, 0x46, 0x82, 0x1b, 0xd0, 0xf9, 0xb8, 0xc9, 0xc4, 0x01,
+	0x91, 0x5e, 0xed, 0x00, 0x08, 0xb3, 0xf7, 0x01, 0x02, 0x39, 0x42, 0x57, 0x17, 0x7b, 0x2b, 0x0b,
+	0x43, 0x93, 0x6e, 0x74, 0x12, 0x8d, 0xb9, 0xf2, 0x1b, 0x82, 0x93, 0x51, 0xba, 0xa0, 0xec, 0xf6,
+	0xb5, 0xf3, 0x36, 0xcc, 0x9b, 0x1d, 0x85, 0xf3, 0x67, 0x3d, 0xbf, 0x6b, 0x88, 0x97, 0xf5, 0x5c,
+	0x0c, 0xe9, 0x6a, 0xfb, 0x18, 0xcc, 0xd8, 0x6f, 0x11, 0x1c, 0x0e, 0x3a, 0xb2, 0xcf, 0x6e, 0x57,
+	0x0f, 0x83, 0x90, 0x16, 0xdb, 0x86, 0xf0, 0x6c, 0x68, 0x01, 0xe7, 0xe9, 0x4b, 0x71, 0xdf, 0x2b,
+	0x3f, 0x82, 0xb4, 0xd0, 0x2e, 0x42, 0xc3, 0x4c, 0xa9, 0xff, 0xbd, 0x8d, 0xb5, 0x49, 0x34, 0xa7,
+	0x3f, 0x78, 0x9c, 0x44, 0x0f, 0x1f, 0x27, 0xd1, 0xdf, 0x8f, 0x93, 0xe8, 0xd3, 0xf5, 0x64, 0xcf,
+	0xc3, 0xf5, 0x64, 0xcf, 0x1f, 0xeb, 0xc9, 0x9e, 0xdb, 0xd7, 0xe3, 0x1c, 0xf2, 0x57, 0x9d, 0xef,
+	0xed, 0xcf, 0x4e, 0xe7, 0x78, 0x9f, 0xdc, 0xed, 0xef, 0xed, 0xcb, 0x03, 0xf6, 0xa7, 0xf5, 0x33,
+	0xff, 0x0e, 0x00, 0xcb, 0xab, 0x7f, 0xa6, 0x43, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateMessageIdMultisigIsm ...
+	CreateMessageIdMultisigIsm(ctx context.Context, in *MsgCreateMessageIdMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMessageIdMultisigIsmResponse, error)
+	// CreateMerkleRootMultisigIsm ...
+	CreateMerkleRootMultisigIsm(ctx context.Context, in *MsgCreateMerkleRootMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMerkleRootMultisigIsmResponse, error)
+	// CreateNoopIsm ...
+	CreateNoopIsm(ctx context.Context, in *MsgCreateNoopIsm, opts ...grpc.CallOption) (*MsgCreateNoopIsmResponse, error)
+	// CreateRoutingIsm ...
+	CreateRoutingIsm(ctx context.Context, in *MsgCreateRoutingIsm, opts ...grpc.CallOption) (*MsgCreateRoutingIsmResponse, error)
+	// SetRoutingIsmDomain ...
+	SetRoutingIsmDomain(ctx context.Context, in *MsgSetRoutingIsmDomain, opts ...grpc.CallOption) (*MsgSetRoutingIsmDomainResponse, error)
+	// RemoveRoutingIsmDomain ...
+	RemoveRoutingIsmDomain(ctx context.Context, in *MsgRemoveRoutingIsmDomain, opts ...grpc.CallOption) (*MsgRemoveRoutingIsmDomainResponse, error)
+	// UpdateRoutingIsmOwner ...
+	UpdateRoutingIsmOwner(ctx context.Context, in *MsgUpdateRoutingIsmOwner, opts ...grpc.CallOption) (*MsgUpdateRoutingIsmOwnerResponse, error)
+	// AnnounceValidator ...
+	AnnounceValidator(ctx context.Context, in *MsgAnnounceValidator, opts ...grpc.CallOption) (*MsgAnnounceValidatorResponse, error)
+	// CreateAggregationIsm ...
+	CreateAggregationIsm(ctx context.Context, in *MsgCreateAggregationIsm, opts ...grpc.CallOption) (*MsgCreateAggregationIsmResponse, error)
+	// SetAggregationIsmModules ...
+	SetAggregationIsmModules(ctx context.Context, in *MsgSetAggregationIsmModules, opts ...grpc.CallOption) (*MsgSetAggregationIsmModulesResponse, error)
+	// UpdateAggregationIsmOwner ...
+	UpdateAggregationIsmOwner(ctx context.Context, in *MsgUpdateAggregationIsmOwner, opts ...grpc.CallOption) (*MsgUpdateAggregationIsmOwnerResponse, error)
+	// CreateWeightedMessageIdMultisigIsm ...
+	CreateWeightedMessageIdMultisigIsm(ctx context.Context, in *MsgCreateWeightedMessageIdMultisigIsm, opts ...grpc.CallOption) (*MsgCreateWeightedMessageIdMultisigIsmResponse, error)
+	// CreateWeightedMerkleRootMultisigIsm ...
+	CreateWeightedMerkleRootMultisigIsm(ctx context.Context, in *MsgCreateWeightedMerkleRootMultisigIsm, opts ...grpc.CallOption) (*MsgCreateWeightedMerkleRootMultisigIsmResponse, error)
+	// SetMultisigIsmValidators ...
+	SetMultisigIsmValidators(ctx context.Context, in *MsgSetMultisigIsmValidators, opts ...grpc.CallOption) (*MsgSetMultisigIsmValidatorsResponse, error)
+	// SetMultisigIsmThreshold ...
+	SetMultisigIsmThreshold(ctx context.Context, in *MsgSetMultisigIsmThreshold, opts ...grpc.CallOption) (*MsgSetMultisigIsmThresholdResponse, error)
+	// UpdateMultisigIsmOwner ...
+	UpdateMultisigIsmOwner(ctx context.Context, in *MsgUpdateMultisigIsmOwner, opts ...grpc.CallOption) (*MsgUpdateMultisigIsmOwnerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateMessageIdMultisigIsm(ctx context.Context, in *MsgCreateMessageIdMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMessageIdMultisigIsmResponse, error) {
+	out := new(MsgCreateMessageIdMultisigIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateMessageIdMultisigIsm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveRoutingIsmDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateMerkleRootMultisigIsm(ctx context.Context, in *MsgCreateMerkleRootMultisigIsm, opts ...grpc.CallOption) (*MsgCreateMerkleRootMultisigIsmResponse, error) {
+	out := new(MsgCreateMerkleRootMultisigIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateMerkleRootMultisigIsm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveRoutingIsmDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Domain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Domain))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.IsmId.Size()
-		i -= size
-		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) CreateNoopIsm(ctx context.Context, in *MsgCreateNoopIsm, opts ...grpc.CallOption) (*MsgCreateNoopIsmResponse, error) {
+	out := new(MsgCreateNoopIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateNoopIsm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveRoutingIsmDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateRoutingIsm(ctx context.Context, in *MsgCreateRoutingIsm, opts ...grpc.CallOption) (*MsgCreateRoutingIsmResponse, error) {
+	out := new(MsgCreateRoutingIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateRoutingIsm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveRoutingIsmDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetRoutingIsmDomain(ctx context.Context, in *MsgSetRoutingIsmDomain, opts ...grpc.CallOption) (*MsgSetRoutingIsmDomainResponse, error) {
+	out := new(MsgSetRoutingIsmDomainResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/SetRoutingIsmDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveRoutingIsmDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RemoveRoutingIsmDomain(ctx context.Context, in *MsgRemoveRoutingIsmDomain, opts ...grpc.CallOption) (*MsgRemoveRoutingIsmDomainResponse, error) {
+	out := new(MsgRemoveRoutingIsmDomainResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/RemoveRoutingIsmDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRoutingIsmOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRoutingIsmOwner(ctx context.Context, in *MsgUpdateRoutingIsmOwner, opts ...grpc.CallOption) (*MsgUpdateRoutingIsmOwnerResponse, error) {
+	out := new(MsgUpdateRoutingIsmOwnerResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/UpdateRoutingIsmOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRoutingIsmOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AnnounceValidator(ctx context.Context, in *MsgAnnounceValidator, opts ...grpc.CallOption) (*MsgAnnounceValidatorResponse, error) {
+	out := new(MsgAnnounceValidatorResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/AnnounceValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRoutingIsmOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RenounceOwnership {
-		i--
-		if m.RenounceOwnership {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) CreateAggregationIsm(ctx context.Context, in *MsgCreateAggregationIsm, opts ...grpc.CallOption) (*MsgCreateAggregationIsmResponse, error) {
+	out := new(MsgCreateAggregationIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateAggregationIsm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) SetAggregationIsmModules(ctx context.Context, in *MsgSetAggregationIsmModules, opts ...grpc.CallOption) (*MsgSetAggregationIsmModulesResponse, error) {
+	out := new(MsgSetAggregationIsmModulesResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/SetAggregationIsmModules", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size := m.IsmId.Size()
-		i -= size
-		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAggregationIsmOwner(ctx context.Context, in *MsgUpdateAggregationIsmOwner, opts ...grpc.CallOption) (*MsgUpdateAggregationIsmOwnerResponse, error) {
+	out := new(MsgUpdateAggregationIsmOwnerResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/UpdateAggregationIsmOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateRoutingIsmOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateWeightedMessageIdMultisigIsm(ctx context.Context, in *MsgCreateWeightedMessageIdMultisigIsm, opts ...grpc.CallOption) (*MsgCreateWeightedMessageIdMultisigIsmResponse, error) {
+	out := new(MsgCreateWeightedMessageIdMultisigIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateWeightedMessageIdMultisigIsm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRoutingIsmOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateWeightedMerkleRootMultisigIsm(ctx context.Context, in *MsgCreateWeightedMerkleRootMultisigIsm, opts ...grpc.CallOption) (*MsgCreateWeightedMerkleRootMultisigIsmResponse, error) {
+	out := new(MsgCreateWeightedMerkleRootMultisigIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/CreateWeightedMerkleRootMultisigIsm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRoutingIsmOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetMultisigIsmValidators(ctx context.Context, in *MsgSetMultisigIsmValidators, opts ...grpc.CallOption) (*MsgSetMultisigIsmValidatorsResponse, error) {
+	out := new(MsgSetMultisigIsmValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/SetMultisigIsmValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) SetMultisigIsmThreshold(ctx context.Context, in *MsgSetMultisigIsmThreshold, opts ...grpc.CallOption) (*MsgSetMultisigIsmThresholdResponse, error) {
+	out := new(MsgSetMultisigIsmThresholdResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/SetMultisigIsmThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateMessageIdMultisigIsm) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) UpdateMultisigIsmOwner(ctx context.Context, in *MsgUpdateMultisigIsmOwner, opts ...grpc.CallOption) (*MsgUpdateMultisigIsmOwnerResponse, error) {
+	out := new(MsgUpdateMultisigIsmOwnerResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.interchain_security.v1.Msg/UpdateMultisigIsmOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateMessageIdMultisigIsmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateMessageIdMultisigIsm ...
+	CreateMessageIdMultisigIsm(context.Context, *MsgCreateMessageIdMultisigIsm) (*MsgCreateMessageIdMultisigIsmResponse, error)
+	// CreateMerkleRootMultisigIsm ...
+	CreateMerkleRootMultisigIsm(context.Context, *MsgCreateMerkleRootMultisigIsm) (*MsgCreateMerkleRootMultisigIsmResponse, error)
+	// CreateNoopIsm ...
+	CreateNoopIsm(context.Context, *MsgCreateNoopIsm) (*MsgCreateNoopIsmResponse, error)
+	// CreateRoutingIsm ...
+	CreateRoutingIsm(context.Context, *MsgCreateRoutingIsm) (*MsgCreateRoutingIsmResponse, error)
+	// SetRoutingIsmDomain ...
+	SetRoutingIsmDomain(context.Context, *MsgSetRoutingIsmDomain) (*MsgSetRoutingIsmDomainResponse, error)
+	// RemoveRoutingIsmDomain ...
+	RemoveRoutingIsmDomain(context.Context, *MsgRemoveRoutingIsmDomain) (*MsgRemoveRoutingIsmDomainResponse, error)
+	// UpdateRoutingIsmOwner ...
+	UpdateRoutingIsmOwner(context.Context, *MsgUpdateRoutingIsmOwner) (*MsgUpdateRoutingIsmOwnerResponse, error)
+	// AnnounceValidator ...
+	AnnounceValidator(context.Context, *MsgAnnounceValidator) (*MsgAnnounceValidatorResponse, error)
+	// CreateAggregationIsm ...
+	CreateAggregationIsm(context.Context, *MsgCreateAggregationIsm) (*MsgCreateAggregationIsmResponse, error)
+	// SetAggregationIsmModules ...
+	SetAggregationIsmModules(context.Context, *MsgSetAggregationIsmModules) (*MsgSetAggregationIsmModulesResponse, error)
+	// UpdateAggregationIsmOwner ...
+	UpdateAggregationIsmOwner(context.Context, *MsgUpdateAggregationIsmOwner) (*MsgUpdateAggregationIsmOwnerResponse, error)
+	// CreateWeightedMessageIdMultisigIsm ...
+	CreateWeightedMessageIdMultisigIsm(context.Context, *MsgCreateWeightedMessageIdMultisigIsm) (*MsgCreateWeightedMessageIdMultisigIsmResponse, error)
+	// CreateWeightedMerkleRootMultisigIsm ...
+	CreateWeightedMerkleRootMultisigIsm(context.Context, *MsgCreateWeightedMerkleRootMultisigIsm) (*MsgCreateWeightedMerkleRootMultisigIsmResponse, error)
+	// SetMultisigIsmValidators ...
+	SetMultisigIsmValidators(context.Context, *MsgSetMultisigIsmValidators) (*MsgSetMultisigIsmValidatorsResponse, error)
+	// SetMultisigIsmThreshold ...
+	SetMultisigIsmThreshold(context.Context, *MsgSetMultisigIsmThreshold) (*MsgSetMultisigIsmThresholdResponse, error)
+	// UpdateMultisigIsmOwner ...
+	UpdateMultisigIsmOwner(context.Context, *MsgUpdateMultisigIsmOwner) (*MsgUpdateMultisigIsmOwnerResponse, error)
 }
 
-func (m *MsgCreateMerkleRootMultisigIsm) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateMessageIdMultisigIsm(ctx context.Context, req *MsgCreateMessageIdMultisigIsm) (*MsgCreateMessageIdMultisigIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMessageIdMultisigIsm not implemented")
+}
+func (*UnimplementedMsgServer) CreateMerkleRootMultisigIsm(ctx context.Context, req *MsgCreateMerkleRootMultisigIsm) (*MsgCreateMerkleRootMultisigIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleRootMultisigIsm not implemented")
+}
+func (*UnimplementedMsgServer) CreateNoopIsm(ctx context.Context, req *MsgCreateNoopIsm) (*MsgCreateNoopIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoopIsm not implemented")
+}
+func (*UnimplementedMsgServer) CreateRoutingIsm(ctx context.Context, req *MsgCreateRoutingIsm) (*MsgCreateRoutingIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoutingIsm not implemented")
+}
+func (*UnimplementedMsgServer) SetRoutingIsmDomain(ctx context.Context, req *MsgSetRoutingIsmDomain) (*MsgSetRoutingIsmDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoutingIsmDomain not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRoutingIsmDomain(ctx context.Context, req *MsgRemoveRoutingIsmDomain) (*MsgRemoveRoutingIsmDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoutingIsmDomain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRoutingIsmOwner(ctx context.Context, req *MsgUpdateRoutingIsmOwner) (*MsgUpdateRoutingIsmOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoutingIsmOwner not implemented")
+}
+func (*UnimplementedMsgServer) AnnounceValidator(ctx context.Context, req *MsgAnnounceValidator) (*MsgAnnounceValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceValidator not implemented")
+}
+func (*UnimplementedMsgServer) CreateAggregationIsm(ctx context.Context, req *MsgCreateAggregationIsm) (*MsgCreateAggregationIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAggregationIsm not implemented")
+}
+func (*UnimplementedMsgServer) SetAggregationIsmModules(ctx context.Context, req *MsgSetAggregationIsmModules) (*MsgSetAggregationIsmModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAggregationIsmModules not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAggregationIsmOwner(ctx context.Context, req *MsgUpdateAggregationIsmOwner) (*MsgUpdateAggregationIsmOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAggregationIsmOwner not implemented")
+}
+func (*UnimplementedMsgServer) CreateWeightedMessageIdMultisigIsm(ctx context.Context, req *MsgCreateWeightedMessageIdMultisigIsm) (*MsgCreateWeightedMessageIdMultisigIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWeightedMessageIdMultisigIsm not implemented")
+}
+func (*UnimplementedMsgServer) CreateWeightedMerkleRootMultisigIsm(ctx context.Context, req *MsgCreateWeightedMerkleRootMultisigIsm) (*MsgCreateWeightedMerkleRootMultisigIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWeightedMerkleRootMultisigIsm not implemented")
+}
+func (*UnimplementedMsgServer) SetMultisigIsmValidators(ctx context.Context, req *MsgSetMultisigIsmValidators) (*MsgSetMultisigIsmValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMultisigIsmValidators not implemented")
+}
+func (*UnimplementedMsgServer) SetMultisigIsmThreshold(ctx context.Context, req *MsgSetMultisigIsmThreshold) (*MsgSetMultisigIsmThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMultisigIsmThreshold not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMultisigIsmOwner(ctx context.Context, req *MsgUpdateMultisigIsmOwner) (*MsgUpdateMultisigIsmOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMultisigIsmOwner not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateMessageIdMultisigIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMessageIdMultisigIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMessageIdMultisigIsm(ctx, in)
 	}
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateMessageIdMultisigIsm",
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTx(uint64(m.Threshold))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMessageIdMultisigIsm(ctx, req.(*MsgCreateMessageIdMultisigIsm))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateMerkleRootMultisigIsmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateMerkleRootMultisigIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMerkleRootMultisigIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgCreateNoopIsm) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMerkleRootMultisigIsm(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateMerkleRootMultisigIsm",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMerkleRootMultisigIsm(ctx, req.(*MsgCreateMerkleRootMultisigIsm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNoopIsmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateNoopIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNoopIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNoopIsm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateNoopIsm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNoopIsm(ctx, req.(*MsgCreateNoopIsm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAnnounceValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CreateRoutingIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRoutingIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.StorageLocation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRoutingIsm(ctx, in)
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateRoutingIsm",
 	}
-	l = m.MailboxId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRoutingIsm(ctx, req.(*MsgCreateRoutingIsm))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAnnounceValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetRoutingIsmDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRoutingIsmDomain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetRoutingIsmDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/SetRoutingIsmDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRoutingIsmDomain(ctx, req.(*MsgSetRoutingIsmDomain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRoutingIsm) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveRoutingIsmDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRoutingIsmDomain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRoutingIsmDomain(ctx, in)
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/RemoveRoutingIsmDomain",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRoutingIsmDomain(ctx, req.(*MsgRemoveRoutingIsmDomain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRoutingIsmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRoutingIsmOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRoutingIsmOwner)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRoutingIsmOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/UpdateRoutingIsmOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRoutingIsmOwner(ctx, req.(*MsgUpdateRoutingIsmOwner))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRoutingIsmDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AnnounceValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnounceValidator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IsmId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Route.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AnnounceValidator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/AnnounceValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnounceValidator(ctx, req.(*MsgAnnounceValidator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRoutingIsmDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateAggregationIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAggregationIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAggregationIsm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateAggregationIsm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAggregationIsm(ctx, req.(*MsgCreateAggregationIsm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveRoutingIsmDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAggregationIsmModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAggregationIsmModules)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IsmId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Domain != 0 {
-		n += 1 + sovTx(uint64(m.Domain))
+	if interceptor == nil {
+		return srv.(MsgServer).SetAggregationIsmModules(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/SetAggregationIsmModules",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAggregationIsmModules(ctx, req.(*MsgSetAggregationIsmModules))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveRoutingIsmDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAggregationIsmOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAggregationIsmOwner)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAggregationIsmOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/UpdateAggregationIsmOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAggregationIsmOwner(ctx, req.(*MsgUpdateAggregationIsmOwner))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRoutingIsmOwner) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateWeightedMessageIdMultisigIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateWeightedMessageIdMultisigIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IsmId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateWeightedMessageIdMultisigIsm(ctx, in)
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateWeightedMessageIdMultisigIsm",
 	}
-	if m.RenounceOwnership {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateWeightedMessageIdMultisigIsm(ctx, req.(*MsgCreateWeightedMessageIdMultisigIsm))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateRoutingIsmOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateWeightedMerkleRootMultisigIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateWeightedMerkleRootMultisigIsm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateWeightedMerkleRootMultisigIsm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/CreateWeightedMerkleRootMultisigIsm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateWeightedMerkleRootMultisigIsm(ctx, req.(*MsgCreateWeightedMerkleRootMultisigIsm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_SetMultisigIsmValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMultisigIsmValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMultisigIsmValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/SetMultisigIsmValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMultisigIsmValidators(ctx, req.(*MsgSetMultisigIsmValidators))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_SetMultisigIsmThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMultisigIsmThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMultisigIsmThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/SetMultisigIsmThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMultisigIsmThreshold(ctx, req.(*MsgSetMultisigIsmThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMultisigIsmOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMultisigIsmOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMultisigIsmOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.interchain_security.v1.Msg/UpdateMultisigIsmOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMultisigIsmOwner(ctx, req.(*MsgUpdateMultisigIsmOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperlane.core.interchain_security.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMessageIdMultisigIsm",
+			Handler:    _Msg_CreateMessageIdMultisigIsm_Handler,
+		},
+		{
+			MethodName: "CreateMerkleRootMultisigIsm",
+			Handler:    _Msg_CreateMerkleRootMultisigIsm_Handler,
+		},
+		{
+			MethodName: "CreateNoopIsm",
+			Handler:    _Msg_CreateNoopIsm_Handler,
+		},
+		{
+			MethodName: "CreateRoutingIsm",
+			Handler:    _Msg_CreateRoutingIsm_Handler,
+		},
+		{
+			MethodName: "SetRoutingIsmDomain",
+			Handler:    _Msg_SetRoutingIsmDomain_Handler,
+		},
+		{
+			MethodName: "RemoveRoutingIsmDomain",
+			Handler:    _Msg_RemoveRoutingIsmDomain_Handler,
+		},
+		{
+			MethodName: "UpdateRoutingIsmOwner",
+			Handler:    _Msg_UpdateRoutingIsmOwner_Handler,
+		},
+		{
+			MethodName: "AnnounceValidator",
+			Handler:    _Msg_AnnounceValidator_Handler,
+		},
+		{
+			MethodName: "CreateAggregationIsm",
+			Handler:    _Msg_CreateAggregationIsm_Handler,
+		},
+		{
+			MethodName: "SetAggregationIsmModules",
+			Handler:    _Msg_SetAggregationIsmModules_Handler,
+		},
+		{
+			MethodName: "UpdateAggregationIsmOwner",
+			Handler:    _Msg_UpdateAggregationIsmOwner_Handler,
+		},
+		{
+			MethodName: "CreateWeightedMessageIdMultisigIsm",
+			Handler:    _Msg_CreateWeightedMessageIdMultisigIsm_Handler,
+		},
+		{
+			MethodName: "CreateWeightedMerkleRootMultisigIsm",
+			Handler:    _Msg_CreateWeightedMerkleRootMultisigIsm_Handler,
+		},
+		{
+			MethodName: "SetMultisigIsmValidators",
+			Handler:    _Msg_SetMultisigIsmValidators_Handler,
+		},
+		{
+			MethodName: "SetMultisigIsmThreshold",
+			Handler:    _Msg_SetMultisigIsmThreshold_Handler,
+		},
+		{
+			MethodName: "UpdateMultisigIsmOwner",
+			Handler:    _Msg_UpdateMultisigIsmOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyperlane/core/interchain_security/v1/tx.proto",
+}
+
+func (m *MsgCreateMessageIdMultisigIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMessageIdMultisigIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMessageIdMultisigIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMessageIdMultisigIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMessageIdMultisigIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMessageIdMultisigIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleRootMultisigIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleRootMultisigIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleRootMultisigIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleRootMultisigIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleRootMultisigIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleRootMultisigIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNoopIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNoopIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNoopIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNoopIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNoopIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNoopIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnounceValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnounceValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnounceValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MailboxId.Size()
+		i -= size
+		if _, err := m.MailboxId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StorageLocation) > 0 {
+		i -= len(m.StorageLocation)
+		copy(dAtA[i:], m.StorageLocation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageLocation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnounceValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnounceValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnounceValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRoutingIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRoutingIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRoutingIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRoutingIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRoutingIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRoutingIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRoutingIsmDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoutingIsmDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoutingIsmDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRoutingIsmDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoutingIsmDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoutingIsmDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRoutingIsmDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRoutingIsmDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRoutingIsmDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Domain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Domain))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRoutingIsmDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRoutingIsmDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRoutingIsmDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRoutingIsmOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRoutingIsmOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoutingIsmOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenounceOwnership {
+		i--
+		if m.RenounceOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRoutingIsmOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRoutingIsmOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoutingIsmOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAggregationIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAggregationIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAggregationIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Modules[iNdEx].Size()
+				i -= size
+				if _, err := m.Modules[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAggregationIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAggregationIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAggregationIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAggregationIsmModules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAggregationIsmModules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAggregationIsmModules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Modules[iNdEx].Size()
+				i -= size
+				if _, err := m.Modules[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAggregationIsmModulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAggregationIsmModulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAggregationIsmModulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAggregationIsmOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAggregationIsmOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAggregationIsmOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenounceOwnership {
+		i--
+		if m.RenounceOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAggregationIsmOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAggregationIsmOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAggregationIsmOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdWeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ThresholdWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdWeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ThresholdWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMultisigIsmValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMultisigIsmValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMultisigIsmValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMultisigIsmValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMultisigIsmValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMultisigIsmValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMultisigIsmThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMultisigIsmThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMultisigIsmThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMultisigIsmThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMultisigIsmThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMultisigIsmThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMultisigIsmOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMultisigIsmOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMultisigIsmOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenounceOwnership {
+		i--
+		if m.RenounceOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMultisigIsmOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMultisigIsmOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMultisigIsmOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateMessageIdMultisigIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgCreateMessageIdMultisigIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMerkleRootMultisigIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgCreateMerkleRootMultisigIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateNoopIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateNoopIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAnnounceValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StorageLocation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MailboxId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAnnounceValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRoutingIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateRoutingIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRoutingIsmDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Route.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRoutingIsmDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRoutingIsmDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Domain != 0 {
+		n += 1 + sovTx(uint64(m.Domain))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRoutingIsmDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRoutingIsmOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateRoutingIsmOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAggregationIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgCreateAggregationIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAggregationIsmModules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAggregationIsmModulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAggregationIsmOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateAggregationIsmOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ThresholdWeight != 0 {
+		n += 1 + sovTx(uint64(m.ThresholdWeight))
+	}
+	return n
+}
+
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ThresholdWeight != 0 {
+		n += 1 + sovTx(uint64(m.ThresholdWeight))
+	}
+	return n
+}
+
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMultisigIsmValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetMultisigIsmValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMultisigIsmThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetMultisigIsmThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMultisigIsmOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IsmId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateMultisigIsmOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMessageIdMultisigIsmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleRootMultisigIsmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNoopIsm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNoopIsm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNoopIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNoopIsmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNoopIsmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNoopIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnounceValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnounceValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnounceValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnounceValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnounceValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRoutingIsm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRoutingIsm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRoutingIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRoutingIsmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRoutingIsmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRoutingIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoutingIsmDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoutingIsmDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoutingIsmDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoutingIsmDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRoutingIsmDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRoutingIsmOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenounceOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRoutingIsmOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAggregationIsm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2139,10 +5849,10 @@ func (m *MsgCreateMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAggregationIsm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAggregationIsm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2179,7 +5889,7 @@ func (m *MsgCreateMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2207,7 +5917,11 @@ func (m *MsgCreateMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.Modules = append(m.Modules, v)
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -2249,7 +5963,7 @@ func (m *MsgCreateMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMessageIdMultisigIsmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAggregationIsmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,10 +5986,10 @@ func (m *MsgCreateMessageIdMultisigIsmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAggregationIsmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMessageIdMultisigIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAggregationIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2333,7 +6047,7 @@ func (m *MsgCreateMessageIdMultisigIsmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAggregationIsmModules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,15 +6070,15 @@ func (m *MsgCreateMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAggregationIsmModules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAggregationIsmModules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2392,11 +6106,13 @@ func (m *MsgCreateMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2424,7 +6140,11 @@ func (m *MsgCreateMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.Modules = append(m.Modules, v)
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -2445,59 +6165,9 @@ func (m *MsgCreateMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateMerkleRootMultisigIsmResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsmResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMerkleRootMultisigIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,9 +6195,7 @@ func (m *MsgCreateMerkleRootMultisigIsmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2550,7 +6218,7 @@ func (m *MsgCreateMerkleRootMultisigIsmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNoopIsm) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAggregationIsmModulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2573,44 +6241,12 @@ func (m *MsgCreateNoopIsm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNoopIsm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAggregationIsmModulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNoopIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAggregationIsmModulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2632,7 +6268,7 @@ func (m *MsgCreateNoopIsm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNoopIsmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAggregationIsmOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2655,15 +6291,15 @@ func (m *MsgCreateNoopIsmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNoopIsmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAggregationIsmOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNoopIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAggregationIsmOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2691,63 +6327,45 @@ func (m *MsgCreateNoopIsmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnnounceValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnnounceValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2775,13 +6393,13 @@ func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageLocation", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2791,27 +6409,115 @@ func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.RenounceOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAggregationIsmOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAggregationIsmOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAggregationIsmOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageLocation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateWeightedMessageIdMultisigIsm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateWeightedMessageIdMultisigIsm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateWeightedMessageIdMultisigIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2839,13 +6545,13 @@ func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2855,31 +6561,31 @@ func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, WeightedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdWeight", wireType)
 			}
-			var stringLen uint64
+			m.ThresholdWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2889,24 +6595,11 @@ func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ThresholdWeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2928,7 +6621,7 @@ func (m *MsgAnnounceValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAnnounceValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateWeightedMessageIdMultisigIsmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2951,12 +6644,46 @@ func (m *MsgAnnounceValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnnounceValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateWeightedMessageIdMultisigIsmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnnounceValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateWeightedMessageIdMultisigIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2978,7 +6705,7 @@ func (m *MsgAnnounceValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRoutingIsm) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateWeightedMerkleRootMultisigIsm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3001,10 +6728,10 @@ func (m *MsgCreateRoutingIsm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRoutingIsm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateWeightedMerkleRootMultisigIsm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRoutingIsm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateWeightedMerkleRootMultisigIsm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3041,7 +6768,7 @@ func (m *MsgCreateRoutingIsm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3068,11 +6795,30 @@ func (m *MsgCreateRoutingIsm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, WeightedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdWeight", wireType)
+			}
+			m.ThresholdWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3094,7 +6840,7 @@ func (m *MsgCreateRoutingIsm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRoutingIsmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateWeightedMerkleRootMultisigIsmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3117,10 +6863,10 @@ func (m *MsgCreateRoutingIsmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRoutingIsmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateWeightedMerkleRootMultisigIsmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRoutingIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateWeightedMerkleRootMultisigIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3178,7 +6924,7 @@ func (m *MsgCreateRoutingIsmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMultisigIsmValidators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3201,10 +6947,10 @@ func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRoutingIsmDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMultisigIsmValidators: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRoutingIsmDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMultisigIsmValidators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3243,9 +6989,9 @@ func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3255,28 +7001,27 @@ func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3304,8 +7049,27 @@ func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3327,7 +7091,7 @@ func (m *MsgSetRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMultisigIsmValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3350,10 +7114,10 @@ func (m *MsgSetRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRoutingIsmDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMultisigIsmValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRoutingIsmDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMultisigIsmValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3377,7 +7141,7 @@ func (m *MsgSetRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRoutingIsmDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMultisigIsmThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3400,10 +7164,10 @@ func (m *MsgRemoveRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMultisigIsmThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMultisigIsmThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3441,25 +7205,6 @@ func (m *MsgRemoveRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-			}
-			m.Domain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Domain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -3491,6 +7236,25 @@ func (m *MsgRemoveRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3512,7 +7276,7 @@ func (m *MsgRemoveRoutingIsmDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMultisigIsmThresholdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3535,10 +7299,10 @@ func (m *MsgRemoveRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMultisigIsmThresholdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRoutingIsmDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMultisigIsmThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3562,7 +7326,7 @@ func (m *MsgRemoveRoutingIsmDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRoutingIsmOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMultisigIsmOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3585,10 +7349,10 @@ func (m *MsgUpdateRoutingIsmOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMultisigIsmOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMultisigIsmOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3730,7 +7494,7 @@ func (m *MsgUpdateRoutingIsmOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRoutingIsmOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMultisigIsmOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3753,10 +7517,10 @@ func (m *MsgUpdateRoutingIsmOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMultisigIsmOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRoutingIsmOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMultisigIsmOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
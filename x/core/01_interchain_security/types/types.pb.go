@@ -246,12 +246,211 @@ func (m *NoopISM) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoopISM proto.InternalMessageInfo
 
+// AggregationISM ...
+type AggregationISM struct {
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// modules are the child ISMs which are aggregated by this ISM.
+	// The order is relevant, as the metadata ranges are matched by index.
+	Modules []github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,3,rep,name=modules,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"modules"`
+	// threshold is the number of child ISMs which must verify a message.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *AggregationISM) Reset()         { *m = AggregationISM{} }
+func (m *AggregationISM) String() string { return proto.CompactTextString(m) }
+func (*AggregationISM) ProtoMessage()    {}
+func (*AggregationISM) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9ae28ed3623cedf, []int{5}
+}
+func (m *AggregationISM) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregationISM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregationISM.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregationISM) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationISM.Merge(m, src)
+}
+func (m *AggregationISM) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregationISM) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationISM.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregationISM proto.InternalMessageInfo
+
+// WeightedValidator is a multisig validator with an associated voting weight.
+type WeightedValidator struct {
+	// address is a 20 byte long ethereum style address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the voting weight of the validator.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedValidator) Reset()         { *m = WeightedValidator{} }
+func (m *WeightedValidator) String() string { return proto.CompactTextString(m) }
+func (*WeightedValidator) ProtoMessage()    {}
+func (*WeightedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9ae28ed3623cedf, []int{6}
+}
+func (m *WeightedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedValidator.Merge(m, src)
+}
+func (m *WeightedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedValidator proto.InternalMessageInfo
+
+func (m *WeightedValidator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WeightedValidator) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// WeightedMessageIdMultisigISM is a MessageIdMultisigISM in which every
+// validator contributes its weight instead of a single vote.
+type WeightedMessageIdMultisigISM struct {
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validators must be sorted in ascending order by address.
+	Validators []WeightedValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+	// threshold_weight is the minimum accumulated weight of the signing
+	// validators required to verify a message.
+	ThresholdWeight uint64 `protobuf:"varint,4,opt,name=threshold_weight,json=thresholdWeight,proto3" json:"threshold_weight,omitempty"`
+}
+
+func (m *WeightedMessageIdMultisigISM) Reset()         { *m = WeightedMessageIdMultisigISM{} }
+func (m *WeightedMessageIdMultisigISM) String() string { return proto.CompactTextString(m) }
+func (*WeightedMessageIdMultisigISM) ProtoMessage()    {}
+func (*WeightedMessageIdMultisigISM) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9ae28ed3623cedf, []int{7}
+}
+func (m *WeightedMessageIdMultisigISM) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMessageIdMultisigISM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMessageIdMultisigISM.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMessageIdMultisigISM) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMessageIdMultisigISM.Merge(m, src)
+}
+func (m *WeightedMessageIdMultisigISM) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMessageIdMultisigISM) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMessageIdMultisigISM.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMessageIdMultisigISM proto.InternalMessageInfo
+
+// WeightedMerkleRootMultisigISM is a MerkleRootMultisigISM in which every
+// validator contributes its weight instead of a single vote.
+type WeightedMerkleRootMultisigISM struct {
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validators must be sorted in ascending order by address.
+	Validators []WeightedValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+	// threshold_weight is the minimum accumulated weight of the signing
+	// validators required to verify a message.
+	ThresholdWeight uint64 `protobuf:"varint,4,opt,name=threshold_weight,json=thresholdWeight,proto3" json:"threshold_weight,omitempty"`
+}
+
+func (m *WeightedMerkleRootMultisigISM) Reset()         { *m = WeightedMerkleRootMultisigISM{} }
+func (m *WeightedMerkleRootMultisigISM) String() string { return proto.CompactTextString(m) }
+func (*WeightedMerkleRootMultisigISM) ProtoMessage()    {}
+func (*WeightedMerkleRootMultisigISM) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9ae28ed3623cedf, []int{8}
+}
+func (m *WeightedMerkleRootMultisigISM) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMerkleRootMultisigISM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMerkleRootMultisigISM.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMerkleRootMultisigISM) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMerkleRootMultisigISM.Merge(m, src)
+}
+func (m *WeightedMerkleRootMultisigISM) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMerkleRootMultisigISM) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMerkleRootMultisigISM.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMerkleRootMultisigISM proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Route)(nil), "hyperlane.core.interchain_security.v1.Route")
 	proto.RegisterType((*RoutingISM)(nil), "hyperlane.core.interchain_security.v1.RoutingISM")
 	proto.RegisterType((*MessageIdMultisigISM)(nil), "hyperlane.core.interchain_security.v1.MessageIdMultisigISM")
 	proto.RegisterType((*MerkleRootMultisigISM)(nil), "hyperlane.core.interchain_security.v1.MerkleRootMultisigISM")
 	proto.RegisterType((*NoopISM)(nil), "hyperlane.core.interchain_security.v1.NoopISM")
+	proto.RegisterType((*AggregationISM)(nil), "hyperlane.core.interchain_security.v1.AggregationISM")
+	proto.RegisterType((*WeightedValidator)(nil), "hyperlane.core.interchain_security.v1.WeightedValidator")
+	proto.RegisterType((*WeightedMessageIdMultisigISM)(nil), "hyperlane.core.interchain_security.v1.WeightedMessageIdMultisigISM")
+	proto.RegisterType((*WeightedMerkleRootMultisigISM)(nil), "hyperlane.core.interchain_security.v1.WeightedMerkleRootMultisigISM")
 }
 
 func init() {
@@ -259,37 +458,45 @@ func init() {
 }
 
 var fileDescriptor_b9ae28ed3623cedf = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x93, 0xb4, 0x5b, 0xe9, 0x88, 0x97, 0x50, 0x25, 0x2e, 0x92, 0x96, 0x82, 0xd0, 0x83,
-	0x9d, 0x58, 0xbd, 0xad, 0x27, 0xeb, 0xc1, 0xad, 0x10, 0x17, 0x52, 0xbc, 0x78, 0x29, 0x69, 0x66,
-	0x48, 0x5e, 0x4c, 0xe7, 0x0d, 0x33, 0x93, 0xba, 0x05, 0x3f, 0x80, 0x47, 0x3f, 0x82, 0x67, 0x05,
-	0x4f, 0xfb, 0x21, 0x16, 0x4f, 0x8b, 0x27, 0xf1, 0xb0, 0x4a, 0xfb, 0x45, 0x24, 0x7f, 0xac, 0x1e,
-	0x16, 0x59, 0xa1, 0x87, 0x3d, 0x78, 0xcb, 0xbc, 0xf3, 0xbe, 0xcf, 0x3c, 0xef, 0x8f, 0xf0, 0x90,
-	0x51, 0xb2, 0xca, 0xb8, 0x4c, 0x43, 0xc1, 0xbd, 0x08, 0x25, 0xf7, 0x40, 0x68, 0x2e, 0xa3, 0x24,
-	0x04, 0x31, 0x53, 0x3c, 0xca, 0x25, 0xe8, 0x95, 0xb7, 0x1c, 0x79, 0x7a, 0x95, 0x71, 0x45, 0x33,
-	0x89, 0x1a, 0xed, 0xbb, 0xdb, 0x11, 0x5a, 0x8c, 0xd0, 0x0b, 0x46, 0xe8, 0x72, 0xb4, 0x7f, 0x3b,
-	0x42, 0xb5, 0x40, 0x35, 0x2b, 0x87, 0xbc, 0xea, 0x50, 0x29, 0xec, 0x77, 0x62, 0x8c, 0xb1, 0xaa,
-	0x17, 0x5f, 0x55, 0xb5, 0xff, 0x86, 0xec, 0x05, 0x98, 0x6b, 0x6e, 0xbf, 0x20, 0x0d, 0x50, 0x0b,
-	0xc7, 0xec, 0x99, 0x83, 0xf6, 0xf8, 0xc9, 0xe9, 0x79, 0xd7, 0xf8, 0x76, 0xde, 0x7d, 0x14, 0x83,
-	0x4e, 0xf2, 0x39, 0x8d, 0x70, 0xe1, 0xcd, 0xa3, 0x6c, 0x08, 0x42, 0xe0, 0x32, 0xd4, 0x80, 0x42,
-	0x79, 0x5b, 0x43, 0xc3, 0xea, 0x19, 0x2f, 0xd7, 0x90, 0xd2, 0x43, 0x7e, 0xfc, 0x98, 0x31, 0xc9,
-	0x95, 0x0a, 0x0a, 0x3d, 0xfb, 0x16, 0x69, 0x31, 0x5c, 0x84, 0x20, 0x1c, 0xab, 0x67, 0x0e, 0x6e,
-	0x04, 0xf5, 0xe9, 0xa0, 0xf9, 0xf6, 0x7d, 0xd7, 0xe8, 0x7f, 0xb2, 0x08, 0x29, 0x9e, 0x07, 0x11,
-	0x4f, 0xa6, 0xbe, 0x3d, 0x25, 0x16, 0xb0, 0x5d, 0x5a, 0xb0, 0x80, 0xd9, 0x94, 0xec, 0xe1, 0x6b,
-	0xc1, 0x65, 0x69, 0xa0, 0x3d, 0x76, 0xbe, 0x9c, 0x0c, 0x3b, 0x35, 0x98, 0xba, 0x6d, 0xaa, 0x25,
-	0x88, 0x38, 0xa8, 0xda, 0xec, 0x67, 0xa4, 0x25, 0x0b, 0x22, 0xca, 0x69, 0xf4, 0x1a, 0x83, 0xeb,
-	0x0f, 0xee, 0xd1, 0x4b, 0xa1, 0xa7, 0x25, 0xc6, 0x71, 0xb3, 0xb0, 0x1d, 0xd4, 0x0a, 0x07, 0x47,
-	0xc5, 0x96, 0x9f, 0x4f, 0x86, 0x4f, 0x2f, 0x27, 0x71, 0xf8, 0xab, 0x6b, 0xb2, 0xbd, 0x9f, 0xd6,
-	0xd7, 0x3e, 0xb2, 0x3c, 0xe5, 0xfd, 0x0f, 0x16, 0xe9, 0xf8, 0x5c, 0xa9, 0x30, 0xe6, 0x13, 0xe6,
-	0xe7, 0xa9, 0x06, 0x05, 0x57, 0x07, 0x9d, 0x4b, 0xc8, 0x32, 0x4c, 0x81, 0x85, 0x1a, 0x65, 0x85,
-	0xaf, 0x1d, 0xfc, 0x51, 0xb1, 0xef, 0x90, 0xb6, 0x4e, 0x24, 0x57, 0x09, 0xa6, 0xcc, 0x69, 0x96,
-	0xff, 0xc3, 0xef, 0xc2, 0xee, 0x61, 0x7d, 0xb4, 0xc8, 0x4d, 0x9f, 0xcb, 0x57, 0x29, 0x0f, 0x10,
-	0xf5, 0x7f, 0x5a, 0x7f, 0xa7, 0xf5, 0xdd, 0x24, 0xd7, 0x9e, 0x23, 0x66, 0x57, 0x85, 0xcf, 0xce,
-	0x37, 0x1c, 0xc3, 0xe9, 0xda, 0x35, 0xcf, 0xd6, 0xae, 0xf9, 0x63, 0xed, 0x9a, 0xef, 0x36, 0xae,
-	0x71, 0xb6, 0x71, 0x8d, 0xaf, 0x1b, 0xd7, 0x78, 0x79, 0xf4, 0x2f, 0xbb, 0x1d, 0x57, 0xa1, 0x7d,
-	0x7f, 0x34, 0xbb, 0x28, 0xb7, 0xcb, 0xd0, 0x9e, 0xb7, 0xca, 0x74, 0x7d, 0xf8, 0x33, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x6b, 0x86, 0xc5, 0xea, 0x05, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0xb4, 0xdb, 0xd2, 0xb7, 0xf8, 0x2b, 0x54, 0x89, 0xcb, 0x9a, 0x96, 0x82, 0x50,
+	0xc1, 0x26, 0x76, 0xbd, 0xc8, 0x7a, 0xda, 0x8a, 0xb8, 0x15, 0xea, 0x42, 0x8a, 0x0a, 0x82, 0x96,
+	0x34, 0x19, 0x92, 0xc1, 0x34, 0x53, 0x66, 0x26, 0xdd, 0x2d, 0x78, 0xf0, 0xe8, 0xd1, 0x3f, 0xc1,
+	0xb3, 0x82, 0xa7, 0xfd, 0x23, 0x16, 0x4f, 0x8b, 0x27, 0xf1, 0xb0, 0x4a, 0xfb, 0x87, 0x28, 0x99,
+	0x49, 0xbb, 0x0b, 0xbb, 0x48, 0x85, 0x22, 0x45, 0xf6, 0xd6, 0xf7, 0xf2, 0xde, 0xcb, 0x37, 0x9f,
+	0xf7, 0x3a, 0xf3, 0xa0, 0x11, 0x8c, 0x06, 0x88, 0x86, 0x4e, 0x84, 0x2c, 0x97, 0x50, 0x64, 0xe1,
+	0x88, 0x23, 0xea, 0x06, 0x0e, 0x8e, 0xba, 0x0c, 0xb9, 0x31, 0xc5, 0x7c, 0x64, 0x0d, 0x1b, 0x16,
+	0x1f, 0x0d, 0x10, 0x33, 0x07, 0x94, 0x70, 0xa2, 0xdd, 0x9c, 0xa5, 0x98, 0x49, 0x8a, 0x79, 0x46,
+	0x8a, 0x39, 0x6c, 0xac, 0x5d, 0x77, 0x09, 0xeb, 0x13, 0xd6, 0x15, 0x49, 0x96, 0x34, 0x64, 0x85,
+	0xb5, 0x92, 0x4f, 0x7c, 0x22, 0xfd, 0xc9, 0x2f, 0xe9, 0xad, 0xbe, 0x81, 0x15, 0x9b, 0xc4, 0x1c,
+	0x69, 0x4f, 0x21, 0x8b, 0x59, 0x5f, 0x57, 0x2a, 0x4a, 0xad, 0xd8, 0x7c, 0x70, 0x70, 0x54, 0xce,
+	0x7c, 0x3f, 0x2a, 0xdf, 0xf7, 0x31, 0x0f, 0xe2, 0x9e, 0xe9, 0x92, 0xbe, 0xd5, 0x73, 0x07, 0x75,
+	0x1c, 0x45, 0x64, 0xe8, 0x70, 0x4c, 0x22, 0x66, 0xcd, 0x04, 0xd5, 0xe5, 0x6b, 0xac, 0x98, 0xe3,
+	0xd0, 0xdc, 0x46, 0x7b, 0x5b, 0x9e, 0x47, 0x11, 0x63, 0x76, 0x52, 0x4f, 0xbb, 0x06, 0x79, 0x8f,
+	0xf4, 0x1d, 0x1c, 0xe9, 0x6a, 0x45, 0xa9, 0x5d, 0xb0, 0x53, 0x6b, 0x33, 0xf7, 0xee, 0x43, 0x39,
+	0x53, 0xfd, 0xac, 0x02, 0x24, 0xaf, 0xc7, 0x91, 0xdf, 0xea, 0xb4, 0xb5, 0x0e, 0xa8, 0xd8, 0x5b,
+	0xa4, 0x04, 0x15, 0x7b, 0x9a, 0x09, 0x2b, 0x64, 0x37, 0x42, 0x54, 0x08, 0x28, 0x36, 0xf5, 0xaf,
+	0xfb, 0xf5, 0x52, 0x0a, 0x26, 0x0d, 0xeb, 0x70, 0x8a, 0x23, 0xdf, 0x96, 0x61, 0xda, 0x63, 0xc8,
+	0xd3, 0x84, 0x08, 0xd3, 0xb3, 0x95, 0x6c, 0x6d, 0x75, 0xe3, 0xb6, 0x39, 0x17, 0x7a, 0x53, 0x60,
+	0x6c, 0xe6, 0x12, 0xd9, 0x76, 0x5a, 0x61, 0x73, 0x27, 0xf9, 0xca, 0x2f, 0xfb, 0xf5, 0x47, 0xf3,
+	0x95, 0xd8, 0x9e, 0x46, 0xb5, 0x66, 0xcf, 0x3b, 0xe9, 0xe3, 0x36, 0xf1, 0xe2, 0x10, 0x55, 0x3f,
+	0xaa, 0x50, 0x6a, 0x23, 0xc6, 0x1c, 0x1f, 0xb5, 0xbc, 0x76, 0x1c, 0x72, 0xcc, 0xf0, 0xf2, 0xa0,
+	0x33, 0x00, 0x86, 0x4e, 0x88, 0x3d, 0x87, 0x13, 0x2a, 0xf1, 0x15, 0xed, 0x13, 0x1e, 0x6d, 0x1d,
+	0x8a, 0x3c, 0xa0, 0x88, 0x05, 0x24, 0xf4, 0xf4, 0x9c, 0x98, 0x87, 0x63, 0xc7, 0xe2, 0x61, 0x7d,
+	0x52, 0xe1, 0x6a, 0x1b, 0xd1, 0xd7, 0x21, 0xb2, 0x09, 0xe1, 0xe7, 0xb4, 0xfe, 0x4c, 0xeb, 0x87,
+	0x02, 0x85, 0x27, 0x84, 0x0c, 0x96, 0x85, 0xcf, 0xe2, 0xbf, 0x70, 0xa2, 0xc2, 0xc5, 0x2d, 0xdf,
+	0xa7, 0xc8, 0x17, 0xb2, 0x97, 0x66, 0x10, 0x5e, 0x42, 0xa1, 0x2f, 0x14, 0xa6, 0x53, 0xb0, 0x18,
+	0x25, 0xd3, 0x9a, 0xff, 0x7a, 0x8e, 0x1e, 0xc2, 0x95, 0xe7, 0x08, 0xfb, 0x01, 0x47, 0xde, 0xb3,
+	0xe9, 0x30, 0x6b, 0x3a, 0x14, 0x1c, 0xa9, 0x4b, 0xc2, 0xb6, 0xa7, 0x66, 0x72, 0x41, 0xec, 0x8a,
+	0x70, 0x41, 0x2b, 0x67, 0xa7, 0x56, 0xf5, 0x97, 0x0a, 0xeb, 0xd3, 0x3a, 0xcb, 0x7b, 0xe2, 0xbd,
+	0x3a, 0xf5, 0x1f, 0x5e, 0xdd, 0xb8, 0x37, 0xe7, 0x85, 0x71, 0x8a, 0x52, 0x7a, 0x79, 0x9c, 0x3c,
+	0x03, 0x6e, 0xc1, 0xe5, 0x59, 0xab, 0xba, 0x29, 0xa7, 0x9c, 0xe0, 0x74, 0x69, 0xe6, 0x97, 0x75,
+	0x16, 0xdf, 0xc8, 0xb7, 0x59, 0xb8, 0x71, 0xdc, 0x81, 0xa5, 0x3d, 0x46, 0xff, 0xe3, 0x16, 0x34,
+	0xf1, 0xc1, 0xd8, 0x50, 0x0e, 0xc7, 0x86, 0xf2, 0x73, 0x6c, 0x28, 0xef, 0x27, 0x46, 0xe6, 0x70,
+	0x62, 0x64, 0xbe, 0x4d, 0x8c, 0xcc, 0x8b, 0x9d, 0xbf, 0xc1, 0xbc, 0x27, 0xd7, 0xcc, 0x3b, 0x8d,
+	0xee, 0x59, 0x9b, 0xa6, 0x58, 0x33, 0x7b, 0x79, 0xb1, 0x0f, 0xde, 0xfd, 0x3d, 0x00, 0x06, 0x55,
+	0xe9, 0xe3, 0x9c, 0x0a, 0x00, 0x00,
 }
 
 func (m *Route) Marshal() (dAtA []byte, err error) {
@@ -532,122 +739,842 @@ func (m *NoopISM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AggregationISM) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Route) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Ism.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Domain != 0 {
-		n += 1 + sovTypes(uint64(m.Domain))
-	}
-	return n
+
+func (m *AggregationISM) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RoutingISM) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregationISM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Threshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Modules[iNdEx].Size()
+				i -= size
+				if _, err := m.Modules[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
-}
-
-func (m *MessageIdMultisigISM) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTypes(uint64(m.Threshold))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MerkleRootMultisigISM) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WeightedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.Weight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Threshold != 0 {
-		n += 1 + sovTypes(uint64(m.Threshold))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NoopISM) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *WeightedMessageIdMultisigISM) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *WeightedMessageIdMultisigISM) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *WeightedMessageIdMultisigISM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdWeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ThresholdWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedMerkleRootMultisigISM) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMerkleRootMultisigISM) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMerkleRootMultisigISM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdWeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ThresholdWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Ism.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Domain != 0 {
+		n += 1 + sovTypes(uint64(m.Domain))
+	}
+	return n
+}
+
+func (m *RoutingISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageIdMultisigISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MerkleRootMultisigISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *NoopISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregationISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *WeightedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTypes(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *WeightedMessageIdMultisigISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ThresholdWeight != 0 {
+		n += 1 + sovTypes(uint64(m.ThresholdWeight))
+	}
+	return n
+}
+
+func (m *WeightedMerkleRootMultisigISM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ThresholdWeight != 0 {
+		n += 1 + sovTypes(uint64(m.ThresholdWeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ism", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ism.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoutingISM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoutingISM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoutingISM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Route) Unmarshal(dAtA []byte) error {
+func (m *MessageIdMultisigISM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageIdMultisigISM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageIdMultisigISM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -657,28 +1584,94 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleRootMultisigISM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleRootMultisigISM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Route: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -706,15 +1699,13 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ism.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.Domain = 0
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -724,7 +1715,7 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Domain |= uint32(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -750,7 +1741,7 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoutingISM) Unmarshal(dAtA []byte) error {
+func (m *NoopISM) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -773,10 +1764,10 @@ func (m *RoutingISM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoutingISM: wiretype end group for non-group")
+			return fmt.Errorf("proto: NoopISM: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoutingISM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NoopISM: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -845,40 +1836,6 @@ func (m *RoutingISM) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Routes = append(m.Routes, Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -900,7 +1857,7 @@ func (m *RoutingISM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageIdMultisigISM) Unmarshal(dAtA []byte) error {
+func (m *AggregationISM) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -923,10 +1880,10 @@ func (m *MessageIdMultisigISM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageIdMultisigISM: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregationISM: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageIdMultisigISM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregationISM: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -997,7 +1954,7 @@ func (m *MessageIdMultisigISM) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1025,7 +1982,11 @@ func (m *MessageIdMultisigISM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.Modules = append(m.Modules, v)
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
@@ -1067,7 +2028,7 @@ func (m *MessageIdMultisigISM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
+func (m *WeightedValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1090,10 +2051,111 @@ func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MerkleRootMultisigISM: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MerkleRootMultisigISM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedMessageIdMultisigISM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMessageIdMultisigISM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMessageIdMultisigISM: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1166,7 +2228,7 @@ func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1176,29 +2238,31 @@ func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.Validators = append(m.Validators, WeightedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdWeight", wireType)
 			}
-			m.Threshold = 0
+			m.ThresholdWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1208,7 +2272,7 @@ func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threshold |= uint32(b&0x7F) << shift
+				m.ThresholdWeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1234,7 +2298,7 @@ func (m *MerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoopISM) Unmarshal(dAtA []byte) error {
+func (m *WeightedMerkleRootMultisigISM) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1257,10 +2321,10 @@ func (m *NoopISM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoopISM: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedMerkleRootMultisigISM: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoopISM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedMerkleRootMultisigISM: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1329,6 +2393,59 @@ func (m *NoopISM) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, WeightedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdWeight", wireType)
+			}
+			m.ThresholdWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+)
+
+var _ HyperlaneInterchainSecurityModule = &WeightedMerkleRootMultisigISM{}
+
+func (m *WeightedMerkleRootMultisigISM) GetId() (util.HexAddress, error) {
+	return m.Id, nil
+}
+
+func (m *WeightedMerkleRootMultisigISM) ModuleType() uint8 {
+	return INTERCHAIN_SECURITY_MODULE_TYPE_WEIGHTED_MERKLE_ROOT_MULTISIG
+}
+
+// Verify uses the same metadata format as the MerkleRootMultisigISM.
+func (m *WeightedMerkleRootMultisigISM) Verify(_ context.Context, rawMetadata []byte, message util.HyperlaneMessage) (bool, error) {
+	metadata, err := NewMerkleRootMultisigMetadata(rawMetadata)
+	if err != nil {
+		return false, err
+	}
+
+	if metadata.MessageIndex > metadata.SignedIndex {
+		return false, fmt.Errorf("invalid signed index")
+	}
+
+	digest := metadata.Digest(&message)
+
+	return VerifyWeightedMultisig(m.Validators, m.ThresholdWeight, metadata.Signatures, digest)
+}
+
+func (m *WeightedMerkleRootMultisigISM) GetThresholdWeight() uint64 {
+	return m.ThresholdWeight
+}
+
+func (m *WeightedMerkleRootMultisigISM) GetValidators() []WeightedValidator {
+	return m.Validators
+}
+
+func (m *WeightedMerkleRootMultisigISM) Validate() error {
+	return ValidateNewWeightedMultisig(m)
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"context"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+)
+
+var _ HyperlaneInterchainSecurityModule = &WeightedMessageIdMultisigISM{}
+
+func (m *WeightedMessageIdMultisigISM) GetId() (util.HexAddress, error) {
+	return m.Id, nil
+}
+
+func (m *WeightedMessageIdMultisigISM) ModuleType() uint8 {
+	return INTERCHAIN_SECURITY_MODULE_TYPE_WEIGHTED_MESSAGE_ID_MULTISIG
+}
+
+// Verify uses the same metadata format as the MessageIdMultisigISM.
+func (m *WeightedMessageIdMultisigISM) Verify(_ context.Context, rawMetadata []byte, message util.HyperlaneMessage) (bool, error) {
+	metadata, err := NewMessageIdMultisigMetadata(rawMetadata)
+	if err != nil {
+		return false, err
+	}
+
+	digest := metadata.Digest(&message)
+
+	return VerifyWeightedMultisig(m.Validators, m.ThresholdWeight, metadata.Signatures, digest)
+}
+
+func (m *WeightedMessageIdMultisigISM) GetThresholdWeight() uint64 {
+	return m.ThresholdWeight
+}
+
+func (m *WeightedMessageIdMultisigISM) GetValidators() []WeightedValidator {
+	return m.Validators
+}
+
+func (m *WeightedMessageIdMultisigISM) Validate() error {
+	return ValidateNewWeightedMultisig(m)
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+)
+
+type WeightedMultisigISM interface {
+	GetValidators() []WeightedValidator
+	GetThresholdWeight() uint64
+}
+
+// VerifyWeightedMultisig checks if a message digest is signed by validators whose accumulated
+// weight reaches the threshold weight.
+func VerifyWeightedMultisig(validators []WeightedValidator, thresholdWeight uint64, signatures [][]byte, digest [32]byte) (bool, error) {
+	validatorCount := len(validators)
+	validatorIndex := 0
+
+	var signedWeight uint64
+
+	// It is assumed that the signatures are ordered the same way as the validators.
+	for i := 0; i < len(signatures); i++ {
+		recoveredPubkey, err := util.RecoverEthSignature(digest[:], signatures[i])
+		if err != nil {
+			return false, fmt.Errorf("failed to recover validator signature: %w", err)
+		}
+
+		signerBytes := crypto.PubkeyToAddress(*recoveredPubkey)
+		signer := util.EncodeEthHex(signerBytes[:])
+
+		// Loop through remaining validators to find a match for the recovered signer
+		for validatorIndex < validatorCount && signer != strings.ToLower(validators[validatorIndex].Address) {
+			// If no match, increment the validator index and continue searching
+			validatorIndex++
+		}
+
+		// If the validator list was iterated without finding a match, the signature is invalid
+		if validatorIndex >= validatorCount {
+			return false, nil
+		}
+
+		// The total weight is validated to not overflow on creation.
+		signedWeight += validators[validatorIndex].Weight
+		if signedWeight >= thresholdWeight {
+			return true, nil
+		}
+
+		// Move to the next validator for the next signature
+		validatorIndex++
+	}
+
+	return false, fmt.Errorf("threshold weight can not be reached")
+}
+
+// ValidateNewWeightedMultisig ensures the Weighted Multisig ISM configuration is valid.
+func ValidateNewWeightedMultisig(m WeightedMultisigISM) error {
+	if m.GetThresholdWeight() == 0 {
+		return fmt.Errorf("threshold weight must be greater than zero")
+	}
+
+	validators := m.GetValidators()
+	addresses := make([]string, len(validators))
+
+	var totalWeight uint64
+	for i, validator := range validators {
+		if validator.Weight == 0 {
+			return fmt.Errorf("validator weight must be greater than zero: %s", validator.Address)
+		}
+
+		if validator.Weight > math.MaxUint64-totalWeight {
+			return fmt.Errorf("total validator weight overflows")
+		}
+		totalWeight += validator.Weight
+
+		addresses[i] = validator.Address
+	}
+
+	if totalWeight < m.GetThresholdWeight() {
+		return fmt.Errorf("total validator weight less than threshold weight")
+	}
+
+	return validateValidatorAddresses(addresses)
+}
@@ -0,0 +1,254 @@
+package types_test
+
+import (
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - weighted_multisig.go
+
+* Validate (invalid) zero threshold weight
+* Validate (invalid) zero validator weight
+* Validate (invalid) total weight less than threshold weight
+* Validate (invalid) total weight overflow
+* Validate (invalid) unsorted validators
+* Validate (invalid) duplicated validators
+* Verify (invalid) threshold weight can't be reached
+* Verify (invalid) wrong signature
+* Verify (valid) single heavy validator reaches threshold weight
+* Verify (valid) multiple light validators reach threshold weight
+* Verify (valid) WeightedMerkleRootMultisigISM
+
+*/
+
+var _ = Describe("weighted_multisig.go", Ordered, func() {
+	weightedValidators := func(weights ...uint64) []types.WeightedValidator {
+		validators := make([]types.WeightedValidator, len(weights))
+		for i, weight := range weights {
+			validators[i] = types.WeightedValidator{
+				Address: PrivateKeys[i].address,
+				Weight:  weight,
+			}
+		}
+		return validators
+	}
+
+	signMessageIdMetadata := func(message util.HyperlaneMessage, signers ...int) []byte {
+		metadata := types.MessageIdMultisigMetadata{
+			MerkleTreeHook: [32]byte{},
+			MerkleRoot:     [32]byte{},
+			MerkleIndex:    uint32(0),
+		}
+
+		digest := metadata.Digest(&message)
+
+		for _, signer := range signers {
+			metadata.Signatures = append(metadata.Signatures, signDigest(digest[:], PrivateKeys[signer].privateKey))
+		}
+
+		return metadata.Bytes()
+	}
+
+	It("Validate (invalid) zero threshold weight", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(1, 1),
+			ThresholdWeight: 0,
+		}
+
+		// Act
+		err := ism.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("threshold weight must be greater than zero"))
+	})
+
+	It("Validate (invalid) zero validator weight", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(1, 0),
+			ThresholdWeight: 1,
+		}
+
+		// Act
+		err := ism.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("validator weight must be greater than zero: " + PrivateKeys[1].address))
+	})
+
+	It("Validate (invalid) total weight less than threshold weight", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(10, 20),
+			ThresholdWeight: 31,
+		}
+
+		// Act
+		err := ism.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("total validator weight less than threshold weight"))
+	})
+
+	It("Validate (invalid) total weight overflow", func() {
+		// Arrange
+		ism := types.WeightedMerkleRootMultisigISM{
+			Validators:      weightedValidators(^uint64(0), 1),
+			ThresholdWeight: 1,
+		}
+
+		// Act
+		err := ism.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("total validator weight overflows"))
+	})
+
+	It("Validate (invalid) unsorted validators", func() {
+		// Arrange
+		validators := weightedValidators(1, 1)
+		validators[0], validators[1] = validators[1], validators[0]
+
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      validators,
+			ThresholdWeight: 2,
+		}
+
+		// Act
+		err := ism.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("validator addresses are not sorted correctly in ascending order"))
+	})
+
+	It("Validate (invalid) duplicated validators", func() {
+		// Arrange
+		validators := weightedValidators(1, 1)
+		validators[1].Address = validators[0].Address
+
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      validators,
+			ThresholdWeight: 2,
+		}
+
+		// Act
+		err := ism.Validate()
+
+		// Assert
+		Expect(err.Error()).To(Equal("duplicate validator address: " + PrivateKeys[0].address))
+	})
+
+	It("Verify (invalid) threshold weight can't be reached", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(10, 20, 30),
+			ThresholdWeight: 40,
+		}
+		Expect(ism.Validate()).To(BeNil())
+
+		message := util.HyperlaneMessage{}
+		metadata := signMessageIdMetadata(message, 0, 1)
+
+		// Act
+		verify, err := ism.Verify(sdk.Context{}, metadata, message)
+
+		// Assert
+		Expect(err.Error()).To(Equal("threshold weight can not be reached"))
+		Expect(verify).To(BeFalse())
+	})
+
+	It("Verify (invalid) wrong signature", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(10, 20),
+			ThresholdWeight: 10,
+		}
+		Expect(ism.Validate()).To(BeNil())
+
+		message := util.HyperlaneMessage{}
+		metadata := signMessageIdMetadata(message, 2)
+
+		// Act
+		verify, err := ism.Verify(sdk.Context{}, metadata, message)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(verify).To(BeFalse())
+	})
+
+	It("Verify (valid) single heavy validator reaches threshold weight", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(10, 10, 60),
+			ThresholdWeight: 60,
+		}
+		Expect(ism.Validate()).To(BeNil())
+
+		message := util.HyperlaneMessage{}
+		metadata := signMessageIdMetadata(message, 2)
+
+		// Act
+		verify, err := ism.Verify(sdk.Context{}, metadata, message)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(verify).To(BeTrue())
+	})
+
+	It("Verify (valid) multiple light validators reach threshold weight", func() {
+		// Arrange
+		ism := types.WeightedMessageIdMultisigISM{
+			Validators:      weightedValidators(10, 20, 60),
+			ThresholdWeight: 30,
+		}
+		Expect(ism.Validate()).To(BeNil())
+
+		message := util.HyperlaneMessage{}
+		metadata := signMessageIdMetadata(message, 0, 1)
+
+		// Act
+		verify, err := ism.Verify(sdk.Context{}, metadata, message)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(verify).To(BeTrue())
+	})
+
+	It("Verify (valid) WeightedMerkleRootMultisigISM", func() {
+		// Arrange
+		ism := types.WeightedMerkleRootMultisigISM{
+			Validators:      weightedValidators(10, 20, 30),
+			ThresholdWeight: 50,
+		}
+		Expect(ism.Validate()).To(BeNil())
+
+		message := util.HyperlaneMessage{}
+
+		metadata := types.MerkleRootMultisigMetadata{
+			MerkleTreeHook:  [32]byte{},
+			MessageIndex:    0,
+			MerkleProof:     [32][32]byte{},
+			SignedIndex:     0,
+			SignedMessageId: message.Id(),
+		}
+
+		digest := metadata.Digest(&message)
+		metadata.Signatures = [][]byte{
+			signDigest(digest[:], PrivateKeys[1].privateKey),
+			signDigest(digest[:], PrivateKeys[2].privateKey),
+		}
+
+		// Act
+		verify, err := ism.Verify(sdk.Context{}, metadata.Bytes(), message)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(verify).To(BeTrue())
+	})
+})
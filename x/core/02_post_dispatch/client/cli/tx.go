@@ -8,6 +8,11 @@ import (
 var (
 	newOwner          string
 	renounceOwnership bool
+
+	fallbackHookId string
+
+	protocolFee string
+	beneficiary string
 )
 
 func GetTxCmd() *cobra.Command {
@@ -20,9 +25,12 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	txCmd.AddCommand(
+		NewAggregationHookCmd(),
+		NewDomainRoutingHookCmd(),
 		NewIgpCmd(),
 		NewMerkleCmd(),
 		NewNoopHookCmd(),
+		NewProtocolFeeHookCmd(),
 	)
 
 	return txCmd
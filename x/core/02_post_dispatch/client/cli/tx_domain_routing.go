@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func NewDomainRoutingHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "routing",
+		Short: "Hyperlane Domain Routing Hook commands",
+	}
+
+	cmd.AddCommand(
+		CmdCreateDomainRoutingHook(),
+		CmdRemoveDomainRoutingHookRoute(),
+		CmdSetDomainRoutingHookRoute(),
+	)
+
+	return cmd
+}
+
+func CmdCreateDomainRoutingHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new domain routing hook, or a fallback routing hook if a fallback hook is given",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateDomainRoutingHook{
+				Owner: clientCtx.GetFromAddress().String(),
+			}
+
+			if fallbackHookId != "" {
+				hookId, err := util.DecodeHexAddress(fallbackHookId)
+				if err != nil {
+					return err
+				}
+				msg.FallbackHookId = &hookId
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&fallbackHookId, "fallback-hook-id", "", "hook which is called for destination domains without a route")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetDomainRoutingHookRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-route [routing-hook-id] [destination] [hook-id]",
+		Short: "Route the messages of a destination domain to a hook",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			routingHookId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			destination, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			hookId, err := util.DecodeHexAddress(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetDomainRoutingHookRoute{
+				Owner: clientCtx.GetFromAddress().String(),
+				Id:    routingHookId,
+				Route: types.HookRoute{
+					Destination: uint32(destination),
+					HookId:      hookId,
+				},
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveDomainRoutingHookRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-route [routing-hook-id] [destination]",
+		Short: "Remove the route of a destination domain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			routingHookId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			destination, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRemoveDomainRoutingHookRoute{
+				Owner:       clientCtx.GetFromAddress().String(),
+				Id:          routingHookId,
+				Destination: uint32(destination),
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func NewProtocolFeeHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fee",
+		Short: "Hyperlane Protocol Fee Hook commands",
+	}
+
+	cmd.AddCommand(
+		CmdClaimProtocolFees(),
+		CmdCreateProtocolFeeHook(),
+		CmdSetProtocolFeeHook(),
+	)
+
+	return cmd
+}
+
+func CmdCreateProtocolFeeHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [beneficiary] [protocol-fee] [max-protocol-fee]",
+		Short: "Create a new protocol fee hook",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			protocolFee, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxProtocolFee, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateProtocolFeeHook{
+				Owner:          clientCtx.GetFromAddress().String(),
+				Beneficiary:    args[0],
+				ProtocolFee:    protocolFee,
+				MaxProtocolFee: maxProtocolFee,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetProtocolFeeHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [hook-id]",
+		Short: "Update a protocol fee hook",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			yes, err := cmd.Flags().GetBool("yes")
+			if err != nil {
+				return err
+			}
+
+			if renounceOwnership && !yes {
+				fmt.Print("Are you sure you want to renounce ownership? This action is irreversible. (yes/no): ")
+				var response string
+
+				_, err := fmt.Scanln(&response)
+				if err != nil {
+					return err
+				}
+
+				if strings.ToLower(response) != "yes" {
+					return fmt.Errorf("canceled transaction")
+				}
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hookId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetProtocolFeeHook{
+				Owner:             clientCtx.GetFromAddress().String(),
+				HookId:            hookId,
+				Beneficiary:       beneficiary,
+				NewOwner:          newOwner,
+				RenounceOwnership: renounceOwnership,
+			}
+
+			if protocolFee != "" {
+				fee, err := sdk.ParseCoinNormalized(protocolFee)
+				if err != nil {
+					return err
+				}
+				msg.ProtocolFee = &fee
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&protocolFee, "protocol-fee", "", "set updated protocol fee")
+	cmd.Flags().StringVar(&beneficiary, "beneficiary", "", "set updated beneficiary")
+	cmd.Flags().StringVar(&newOwner, "new-owner", "", "set updated owner")
+	cmd.Flags().BoolVar(&renounceOwnership, "renounce-ownership", false, "renounce ownership")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClaimProtocolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [hook-id]",
+		Short: "Send the collected protocol fees to the beneficiary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hookId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgClaimProtocolFees{
+				Sender: clientCtx.GetFromAddress().String(),
+				HookId: hookId,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Sender)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Sender))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
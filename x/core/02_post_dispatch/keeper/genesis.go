@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"cosmossdk.io/collections"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,9 +32,40 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 	}
 
 	for _, merkleTreeHook := range data.MerkleTreeHooks {
+		tree := util.NewTree(util.ZeroHashes, 0)
+		if merkleTreeHook.Tree != nil {
+			var err error
+			if tree, err = types.TreeFromProto(merkleTreeHook.Tree); err != nil {
+				panic(err)
+			}
+		}
+
+		// The tree is stored in separate entries per branch level.
+		merkleTreeHook.Tree = nil
 		if err := k.merkleTreeHooks.Set(ctx, merkleTreeHook.Id.GetInternalId(), merkleTreeHook); err != nil {
 			panic(err)
 		}
+		if err := k.setMerkleTree(ctx, merkleTreeHook.Id.GetInternalId(), tree); err != nil {
+			panic(err)
+		}
+		// Nodes of trees exported before nodes were recorded are derived from the branch.
+		if err := k.seedMerkleTreeNodes(ctx, merkleTreeHook.Id.GetInternalId(), tree); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, node := range data.MerkleTreeNodes {
+		key := collections.Join3(node.MerkleTreeHookId, node.Level, node.Position)
+		if err := k.merkleTreeNodes.Set(ctx, key, node.Node); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, checkpoint := range data.MerkleTreeCheckpoints {
+		key := collections.Join(checkpoint.MerkleTreeHookId, checkpoint.Height)
+		if err := k.merkleTreeCheckpoints.Set(ctx, key, checkpoint.Count); err != nil {
+			panic(err)
+		}
 	}
 
 	for _, noopHook := range data.NoopHooks {
@@ -41,6 +73,31 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, aggregationHook := range data.AggregationHooks {
+		if err := k.aggregationHooks.Set(ctx, aggregationHook.Id.GetInternalId(), aggregationHook); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, domainRoutingHook := range data.DomainRoutingHooks {
+		if err := k.domainRoutingHooks.Set(ctx, domainRoutingHook.Id.GetInternalId(), domainRoutingHook); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, route := range data.DomainRoutingHookRoutes {
+		key := collections.Join(route.RoutingHookId, route.Destination)
+		if err := k.domainRoutingHookRoutes.Set(ctx, key, types.HookRoute{Destination: route.Destination, HookId: route.HookId}); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, protocolFeeHook := range data.ProtocolFeeHooks {
+		if err := k.protocolFeeHooks.Set(ctx, protocolFeeHook.Id.GetInternalId(), protocolFeeHook); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
@@ -85,6 +142,53 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	for i := range merkleTreeHooks {
+		tree, err := k.merkleTree(ctx, merkleTreeHooks[i].Id.GetInternalId())
+		if err != nil {
+			panic(err)
+		}
+		merkleTreeHooks[i].Tree = types.ProtoFromTree(tree)
+	}
+
+	iterNodes, err := k.merkleTreeNodes.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	nodes, err := iterNodes.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+
+	merkleTreeNodes := make([]types.GenesisMerkleTreeNodeWrapper, len(nodes))
+	for i := range nodes {
+		merkleTreeNodes[i] = types.GenesisMerkleTreeNodeWrapper{
+			Level:            nodes[i].Key.K2(),
+			Position:         nodes[i].Key.K3(),
+			Node:             nodes[i].Value,
+			MerkleTreeHookId: nodes[i].Key.K1(),
+		}
+	}
+
+	iterCheckpoints, err := k.merkleTreeCheckpoints.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	checkpoints, err := iterCheckpoints.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+
+	merkleTreeCheckpoints := make([]types.GenesisMerkleTreeCheckpointWrapper, len(checkpoints))
+	for i := range checkpoints {
+		merkleTreeCheckpoints[i] = types.GenesisMerkleTreeCheckpointWrapper{
+			Height:           checkpoints[i].Key.K2(),
+			MerkleTreeHookId: checkpoints[i].Key.K1(),
+			Count:            checkpoints[i].Value,
+		}
+	}
+
 	iterNoopHooks, err := k.noopHooks.Iterate(ctx, nil)
 	if err != nil {
 		panic(err)
@@ -95,10 +199,65 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	iterAggregationHooks, err := k.aggregationHooks.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	aggregationHooks, err := iterAggregationHooks.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	iterDomainRoutingHooks, err := k.domainRoutingHooks.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	domainRoutingHooks, err := iterDomainRoutingHooks.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	iterRoutes, err := k.domainRoutingHookRoutes.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	routes, err := iterRoutes.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+
+	domainRoutingHookRoutes := make([]types.GenesisHookRouteWrapper, len(routes))
+	for i := range routes {
+		domainRoutingHookRoutes[i] = types.GenesisHookRouteWrapper{
+			Destination:   routes[i].Value.Destination,
+			HookId:        routes[i].Value.HookId,
+			RoutingHookId: routes[i].Key.K1(),
+		}
+	}
+
+	iterProtocolFeeHooks, err := k.protocolFeeHooks.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	protocolFeeHooks, err := iterProtocolFeeHooks.Values()
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Igps:            igps,
-		IgpGasConfigs:   gasConfigs,
-		MerkleTreeHooks: merkleTreeHooks,
-		NoopHooks:       noopHooks,
+		Igps:                    igps,
+		IgpGasConfigs:           gasConfigs,
+		MerkleTreeHooks:         merkleTreeHooks,
+		MerkleTreeNodes:         merkleTreeNodes,
+		MerkleTreeCheckpoints:   merkleTreeCheckpoints,
+		NoopHooks:               noopHooks,
+		AggregationHooks:        aggregationHooks,
+		DomainRoutingHooks:      domainRoutingHooks,
+		DomainRoutingHookRoutes: domainRoutingHookRoutes,
+		ProtocolFeeHooks:        protocolFeeHooks,
 	}
 }
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type AggregationHookHandler struct {
+	k Keeper
+}
+
+var _ util.PostDispatchModule = AggregationHookHandler{}
+
+func (i AggregationHookHandler) Exists(ctx context.Context, hookId util.HexAddress) (bool, error) {
+	has, err := i.k.aggregationHooks.Has(ctx, hookId.GetInternalId())
+	if err != nil {
+		return false, err
+	}
+	return has, nil
+}
+
+func (i AggregationHookHandler) HookType() uint8 {
+	return types.POST_DISPATCH_HOOK_TYPE_AGGREGATION
+}
+
+// PostDispatch calls each aggregated hook in order. The charged coins of a hook are deducted
+// from the maxFee which is available to the following hooks. It returns the sum of all charges.
+func (i AggregationHookHandler) PostDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage, maxFee sdk.Coins) (sdk.Coins, error) {
+	aggregationHook, err := i.k.aggregationHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find aggregation hook with id: %s", hookId.String())
+	}
+
+	chargedCoins := sdk.NewCoins()
+	remainingCoins := maxFee
+	for _, childId := range aggregationHook.HookIds {
+		charged, err := i.k.coreKeeper.PostDispatch(ctx, mailboxId, childId, metadata, message, remainingCoins)
+		if err != nil {
+			return nil, err
+		}
+
+		var neg bool
+		remainingCoins, neg = remainingCoins.SafeSub(charged...)
+		if neg {
+			return nil, fmt.Errorf("hook %s charged %s which exceeds the remaining max fee", childId.String(), charged.String())
+		}
+
+		chargedCoins = chargedCoins.Add(charged...)
+	}
+
+	return chargedCoins, nil
+}
+
+// QuoteDispatch returns the sum of the quotes of all aggregated hooks.
+func (i AggregationHookHandler) QuoteDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage) (sdk.Coins, error) {
+	aggregationHook, err := i.k.aggregationHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find aggregation hook with id: %s", hookId.String())
+	}
+
+	quote := sdk.NewCoins()
+	for _, childId := range aggregationHook.HookIds {
+		handler, err := i.k.coreKeeper.PostDispatchRouter().GetModule(childId)
+		if err != nil {
+			return nil, err
+		}
+
+		childQuote, err := (*handler).QuoteDispatch(ctx, mailboxId, childId, metadata, message)
+		if err != nil {
+			return nil, err
+		}
+
+		quote = quote.Add(childQuote...)
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - hook_aggregation_test.go
+
+* Create (invalid) Aggregation Hook without hooks
+* Create (invalid) Aggregation Hook with non-existing hook
+* Create (valid) Aggregation Hook
+* AggregationHook PostDispatch calls all hooks
+* AggregationHook (invalid) PostDispatch with insufficient max fee
+* AggregationHook PostDispatch consumes gas for each nested hook
+* AggregationHook QuoteDispatch
+* AggregationHook HookType
+
+*/
+
+var _ = Describe("hook_aggregation_test.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var creator i.TestValidatorAddress
+
+	var mailboxId util.HexAddress
+	var merkleTreeHookId util.HexAddress
+
+	denom := "acoin"
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		creator = i.GenerateTestValidatorAddress("Creator")
+
+		err := s.MintBaseCoins(creator.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		mailboxId, err = createDummyMailbox(s, creator.Address)
+		Expect(err).To(BeNil())
+
+		merkleTreeHookId, err = createDummyMerkleTreeHook(s, creator.Address, mailboxId)
+		Expect(err).To(BeNil())
+	})
+
+	createIgp := func(gasPrice int64) util.HexAddress {
+		return createDummyIgp(s, creator.Address, denom, gasPrice)
+	}
+
+	createAggregationHook := func(hookIds ...util.HexAddress) util.HexAddress {
+		res, err := s.RunTx(&types.MsgCreateAggregationHook{
+			Owner:   creator.Address,
+			HookIds: hookIds,
+		})
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateAggregationHookResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		return response.Id
+	}
+
+	message := util.HyperlaneMessage{
+		Version:     3,
+		Origin:      11,
+		Destination: 1,
+		Body:        []byte("aggregation"),
+	}
+
+	It("Create (invalid) Aggregation Hook without hooks", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgCreateAggregationHook{
+			Owner: creator.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("at least one hook id is required"))
+	})
+
+	It("Create (invalid) Aggregation Hook with non-existing hook", func() {
+		// Arrange
+		nonExistingHookId := merkleTreeHookId
+		nonExistingHookId[31] = byte(10)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateAggregationHook{
+			Owner:   creator.Address,
+			HookIds: []util.HexAddress{merkleTreeHookId, nonExistingHookId},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(nonExistingHookId.String() + ": hook does not exist or isn't registered"))
+	})
+
+	It("Create (valid) Aggregation Hook", func() {
+		// Arrange
+		igpId := createIgp(1)
+
+		// Act
+		hookId := createAggregationHook(merkleTreeHookId, igpId)
+
+		// Assert
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		hook, err := qs.AggregationHook(s.Ctx(), &types.QueryAggregationHookRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+		Expect(hook.AggregationHook.Owner).To(Equal(creator.Address))
+		Expect(hook.AggregationHook.HookIds).To(Equal([]util.HexAddress{merkleTreeHookId, igpId}))
+
+		hooks, err := qs.AggregationHooks(s.Ctx(), &types.QueryAggregationHooksRequest{})
+		Expect(err).To(BeNil())
+		Expect(hooks.AggregationHooks).To(HaveLen(1))
+	})
+
+	It("AggregationHook PostDispatch calls all hooks", func() {
+		// Arrange
+		igpId := createIgp(1)
+		hookId := createAggregationHook(merkleTreeHookId, igpId)
+		metadata := util.StandardHookMetadata{Address: creator.AccAddress, GasLimit: math.NewInt(50000)}
+
+		// Act
+		charged, err := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), mailboxId, hookId, metadata, message, sdk.NewCoins(sdk.NewInt64Coin(denom, 300000)))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(charged).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 250000))))
+
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		merkleTreeHook, err := qs.MerkleTreeHook(s.Ctx(), &types.QueryMerkleTreeHookRequest{Id: merkleTreeHookId.String()})
+		Expect(err).To(BeNil())
+		Expect(merkleTreeHook.MerkleTreeHook.MerkleTree.Count).To(Equal(uint32(1)))
+
+		igp, err := qs.Igp(s.Ctx(), &types.QueryIgpRequest{Id: igpId.String()})
+		Expect(err).To(BeNil())
+		Expect(igp.Igp.ClaimableFees).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 250000))))
+	})
+
+	It("AggregationHook (invalid) PostDispatch with insufficient max fee", func() {
+		// Arrange
+		hookId := createAggregationHook(createIgp(1), createIgp(2))
+		metadata := util.StandardHookMetadata{Address: creator.AccAddress, GasLimit: math.NewInt(50000)}
+
+		// Act
+		_, err := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), mailboxId, hookId, metadata, message, sdk.NewCoins(sdk.NewInt64Coin(denom, 600000)))
+
+		// Assert
+		Expect(err.Error()).To(Equal("required payment exceeds max hyperlane fee: 500000acoin"))
+	})
+
+	It("AggregationHook PostDispatch consumes gas for each nested hook", func() {
+		// Arrange
+		innerHookId := createAggregationHook(merkleTreeHookId)
+		hookId := createAggregationHook(innerHookId, innerHookId)
+		ctx := s.Ctx().WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+		// Act
+		_, err := s.App().HyperlaneKeeper.PostDispatch(ctx, mailboxId, hookId, util.StandardHookMetadata{}, message, sdk.NewCoins())
+
+		// Assert
+		Expect(err).To(BeNil())
+		// the outer hook, both inner hooks and both merkle tree hook calls are charged
+		Expect(ctx.GasMeter().GasConsumed()).Should(BeNumerically(">=", 5*10000))
+	})
+
+	It("AggregationHook QuoteDispatch", func() {
+		// Arrange
+		hookId := createAggregationHook(merkleTreeHookId, createIgp(1), createIgp(2))
+		metadata := util.StandardHookMetadata{Address: creator.AccAddress, GasLimit: math.NewInt(50000)}
+
+		// Act
+		handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(hookId)
+		Expect(err).To(BeNil())
+		quote, err := (*handler).QuoteDispatch(s.Ctx(), mailboxId, hookId, metadata, message)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(quote).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 750000))))
+	})
+
+	It("AggregationHook HookType", func() {
+		// Arrange
+		hookId := createAggregationHook(merkleTreeHookId)
+
+		// Act
+		handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(hookId)
+		Expect(err).To(BeNil())
+		hookType := (*handler).HookType()
+
+		// Assert
+		Expect(hookType).To(Equal(uint8(2)))
+	})
+})
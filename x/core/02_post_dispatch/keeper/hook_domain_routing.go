@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type DomainRoutingHookHandler struct {
+	k Keeper
+}
+
+var _ util.PostDispatchModule = DomainRoutingHookHandler{}
+
+func (i DomainRoutingHookHandler) Exists(ctx context.Context, hookId util.HexAddress) (bool, error) {
+	hook, err := i.k.domainRoutingHooks.Get(ctx, hookId.GetInternalId())
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return hook.Id == hookId, nil
+}
+
+func (i DomainRoutingHookHandler) HookType() uint8 {
+	return types.POST_DISPATCH_HOOK_TYPE_ROUTING
+}
+
+// PostDispatch calls the hook which is routed to for the destination domain of the message.
+func (i DomainRoutingHookHandler) PostDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage, maxFee sdk.Coins) (sdk.Coins, error) {
+	routedHookId, err := i.k.routedHook(ctx, hookId, message.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.k.coreKeeper.PostDispatch(ctx, mailboxId, routedHookId, metadata, message, maxFee)
+}
+
+// QuoteDispatch returns the quote of the hook which is routed to for the destination domain of the message.
+func (i DomainRoutingHookHandler) QuoteDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage) (sdk.Coins, error) {
+	routedHookId, err := i.k.routedHook(ctx, hookId, message.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, err := i.k.coreKeeper.PostDispatchRouter().GetModule(routedHookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*handler).QuoteDispatch(ctx, mailboxId, routedHookId, metadata, message)
+}
+
+// FallbackRoutingHookHandler behaves like the DomainRoutingHookHandler, but its hooks call the
+// fallback hook for destination domains without a route.
+type FallbackRoutingHookHandler struct {
+	DomainRoutingHookHandler
+}
+
+var _ util.PostDispatchModule = FallbackRoutingHookHandler{}
+
+func (i FallbackRoutingHookHandler) HookType() uint8 {
+	return types.POST_DISPATCH_HOOK_TYPE_FALLBACK_ROUTING
+}
+
+// routedHook returns the hook of a routing hook for the destination domain. If there is no route,
+// the fallback hook is returned if one is set.
+func (k *Keeper) routedHook(ctx context.Context, hookId util.HexAddress, destination uint32) (util.HexAddress, error) {
+	hook, err := k.domainRoutingHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil || hook.Id != hookId {
+		return util.HexAddress{}, fmt.Errorf("failed to find domain routing hook with id: %s", hookId.String())
+	}
+
+	route, err := k.domainRoutingHookRoutes.Get(ctx, collections.Join(hookId.GetInternalId(), destination))
+	if err == nil {
+		return route.HookId, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return util.HexAddress{}, err
+	}
+
+	if hook.FallbackHookId != nil {
+		return *hook.FallbackHookId, nil
+	}
+	return util.HexAddress{}, fmt.Errorf("no route for destination domain %d in hook %s", destination, hookId.String())
+}
+
+// childHooks returns all hooks which can be called by the given aggregation or routing hook.
+func (k *Keeper) childHooks(ctx context.Context, hookId util.HexAddress) ([]util.HexAddress, error) {
+	switch uint8(hookId.GetType()) {
+	case types.POST_DISPATCH_HOOK_TYPE_AGGREGATION:
+		hook, err := k.aggregationHooks.Get(ctx, hookId.GetInternalId())
+		if err != nil {
+			return nil, err
+		}
+		return hook.HookIds, nil
+	case types.POST_DISPATCH_HOOK_TYPE_ROUTING, types.POST_DISPATCH_HOOK_TYPE_FALLBACK_ROUTING:
+		hook, err := k.domainRoutingHooks.Get(ctx, hookId.GetInternalId())
+		if err != nil {
+			return nil, err
+		}
+
+		var children []util.HexAddress
+		if hook.FallbackHookId != nil {
+			children = append(children, *hook.FallbackHookId)
+		}
+
+		rng := collections.NewPrefixedPairRange[uint64, uint32](hookId.GetInternalId())
+		err = k.domainRoutingHookRoutes.Walk(ctx, rng, func(_ collections.Pair[uint64, uint32], route types.HookRoute) (bool, error) {
+			children = append(children, route.HookId)
+			return false, nil
+		})
+		return children, err
+	default:
+		return nil, nil
+	}
+}
+
+// hookReaches returns true if the target hook is the given hook or is called by it, either
+// directly or through other aggregation and routing hooks.
+func (k *Keeper) hookReaches(ctx context.Context, hookId, target util.HexAddress) (bool, error) {
+	if hookId == target {
+		return true, nil
+	}
+
+	children, err := k.childHooks(ctx, hookId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, child := range children {
+		reaches, err := k.hookReaches(ctx, child, target)
+		if err != nil || reaches {
+			return reaches, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - hook_domain_routing_test.go
+
+* Create (valid) Domain Routing Hook
+* Create (invalid) Fallback Routing Hook with non-existing fallback hook
+* Create (valid) Fallback Routing Hook
+* SetDomainRoutingHookRoute (invalid) non-owner
+* SetDomainRoutingHookRoute (invalid) cycle
+* SetDomainRoutingHookRoute and RemoveDomainRoutingHookRoute (valid)
+* RemoveDomainRoutingHookRoute (invalid) non-existing route
+* DomainRoutingHook PostDispatch routes by destination
+* DomainRoutingHook (invalid) PostDispatch without route
+* FallbackRoutingHook PostDispatch and QuoteDispatch use fallback hook
+
+*/
+
+var _ = Describe("hook_domain_routing_test.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var creator i.TestValidatorAddress
+	var nonOwner i.TestValidatorAddress
+
+	denom := "acoin"
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		creator = i.GenerateTestValidatorAddress("Creator")
+		nonOwner = i.GenerateTestValidatorAddress("NonOwner")
+
+		err := s.MintBaseCoins(creator.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	createRoutingHook := func(fallbackHookId *util.HexAddress) util.HexAddress {
+		res, err := s.RunTx(&types.MsgCreateDomainRoutingHook{
+			Owner:          creator.Address,
+			FallbackHookId: fallbackHookId,
+		})
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateDomainRoutingHookResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		return response.Id
+	}
+
+	setRoute := func(owner string, routingHookId util.HexAddress, destination uint32, hookId util.HexAddress) error {
+		_, err := s.RunTx(&types.MsgSetDomainRoutingHookRoute{
+			Owner: owner,
+			Id:    routingHookId,
+			Route: types.HookRoute{Destination: destination, HookId: hookId},
+		})
+		return err
+	}
+
+	queryRoutingHook := func(routingHookId util.HexAddress) *types.QueryDomainRoutingHookResponse {
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		res, err := qs.DomainRoutingHook(s.Ctx(), &types.QueryDomainRoutingHookRequest{Id: routingHookId.String()})
+		Expect(err).To(BeNil())
+		return res
+	}
+
+	metadata := func() util.StandardHookMetadata {
+		return util.StandardHookMetadata{Address: creator.AccAddress, GasLimit: math.NewInt(50000)}
+	}
+
+	message := func(destination uint32) util.HyperlaneMessage {
+		return util.HyperlaneMessage{Version: 3, Origin: 11, Destination: destination, Body: []byte("routing")}
+	}
+
+	It("Create (valid) Domain Routing Hook", func() {
+		// Act
+		routingHookId := createRoutingHook(nil)
+
+		// Assert
+		Expect(routingHookId.GetType()).To(Equal(uint32(types.POST_DISPATCH_HOOK_TYPE_ROUTING)))
+
+		res := queryRoutingHook(routingHookId)
+		Expect(res.DomainRoutingHook.Owner).To(Equal(creator.Address))
+		Expect(res.DomainRoutingHook.FallbackHookId).To(BeNil())
+		Expect(res.Routes).To(BeEmpty())
+
+		exists, err := s.App().HyperlaneKeeper.PostDispatchHookExists(s.Ctx(), routingHookId)
+		Expect(err).To(BeNil())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("Create (invalid) Fallback Routing Hook with non-existing fallback hook", func() {
+		// Arrange
+		nonExistingHookId := createDummyIgp(s, creator.Address, denom, 1)
+		nonExistingHookId[31] = byte(10)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateDomainRoutingHook{
+			Owner:          creator.Address,
+			FallbackHookId: &nonExistingHookId,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(nonExistingHookId.String() + ": hook does not exist or isn't registered"))
+	})
+
+	It("Create (valid) Fallback Routing Hook", func() {
+		// Arrange
+		igpId := createDummyIgp(s, creator.Address, denom, 1)
+
+		// Act
+		routingHookId := createRoutingHook(&igpId)
+
+		// Assert
+		Expect(routingHookId.GetType()).To(Equal(uint32(types.POST_DISPATCH_HOOK_TYPE_FALLBACK_ROUTING)))
+		Expect(*queryRoutingHook(routingHookId).DomainRoutingHook.FallbackHookId).To(Equal(igpId))
+
+		handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(routingHookId)
+		Expect(err).To(BeNil())
+		Expect((*handler).HookType()).To(Equal(types.POST_DISPATCH_HOOK_TYPE_FALLBACK_ROUTING))
+	})
+
+	It("SetDomainRoutingHookRoute (invalid) non-owner", func() {
+		// Arrange
+		routingHookId := createRoutingHook(nil)
+		igpId := createDummyIgp(s, creator.Address, denom, 1)
+
+		// Act
+		err := setRoute(nonOwner.Address, routingHookId, 1, igpId)
+
+		// Assert
+		Expect(err.Error()).To(Equal(nonOwner.Address + " does not own domain routing hook with id " + routingHookId.String() + ": unauthorized"))
+		Expect(queryRoutingHook(routingHookId).Routes).To(BeEmpty())
+	})
+
+	It("SetDomainRoutingHookRoute (invalid) cycle", func() {
+		// Arrange
+		routingHookId := createRoutingHook(nil)
+		res, err := s.RunTx(&types.MsgCreateAggregationHook{
+			Owner:   creator.Address,
+			HookIds: []util.HexAddress{routingHookId},
+		})
+		Expect(err).To(BeNil())
+		var response types.MsgCreateAggregationHookResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		// Act
+		errSelf := setRoute(creator.Address, routingHookId, 1, routingHookId)
+		errAggregation := setRoute(creator.Address, routingHookId, 1, response.Id)
+
+		// Assert
+		Expect(errSelf.Error()).To(Equal("route to hook " + routingHookId.String() + " would create a cycle"))
+		Expect(errAggregation.Error()).To(Equal("route to hook " + response.Id.String() + " would create a cycle"))
+	})
+
+	It("SetDomainRoutingHookRoute and RemoveDomainRoutingHookRoute (valid)", func() {
+		// Arrange
+		routingHookId := createRoutingHook(nil)
+		igpId := createDummyIgp(s, creator.Address, denom, 1)
+		nestedRoutingHookId := createRoutingHook(nil)
+
+		// Act
+		Expect(setRoute(creator.Address, routingHookId, 1, igpId)).To(Succeed())
+		Expect(setRoute(creator.Address, routingHookId, 2, nestedRoutingHookId)).To(Succeed())
+
+		// Assert
+		Expect(queryRoutingHook(routingHookId).Routes).To(Equal([]types.HookRoute{
+			{Destination: 1, HookId: igpId},
+			{Destination: 2, HookId: nestedRoutingHookId},
+		}))
+
+		_, err := s.RunTx(&types.MsgRemoveDomainRoutingHookRoute{
+			Owner:       creator.Address,
+			Id:          routingHookId,
+			Destination: 1,
+		})
+		Expect(err).To(BeNil())
+		Expect(queryRoutingHook(routingHookId).Routes).To(Equal([]types.HookRoute{
+			{Destination: 2, HookId: nestedRoutingHookId},
+		}))
+	})
+
+	It("RemoveDomainRoutingHookRoute (invalid) non-existing route", func() {
+		// Arrange
+		routingHookId := createRoutingHook(nil)
+
+		// Act
+		_, err := s.RunTx(&types.MsgRemoveDomainRoutingHookRoute{
+			Owner:       creator.Address,
+			Id:          routingHookId,
+			Destination: 1,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("no route for destination domain 1 in hook " + routingHookId.String()))
+	})
+
+	It("DomainRoutingHook PostDispatch routes by destination", func() {
+		// Arrange
+		routingHookId := createRoutingHook(nil)
+		cheapIgpId := createDummyIgp(s, creator.Address, denom, 1)
+		expensiveIgpId := createDummyIgp(s, creator.Address, denom, 2)
+		Expect(setRoute(creator.Address, routingHookId, 1, cheapIgpId)).To(Succeed())
+		Expect(setRoute(creator.Address, routingHookId, 2, expensiveIgpId)).To(Succeed())
+		maxFee := sdk.NewCoins(sdk.NewInt64Coin(denom, 600000))
+
+		// Act
+		chargedDomain1, err1 := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), util.HexAddress{}, routingHookId, metadata(), message(1), maxFee)
+		chargedDomain2, err2 := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), util.HexAddress{}, routingHookId, metadata(), message(2), maxFee)
+
+		// Assert
+		Expect(err1).To(BeNil())
+		Expect(err2).To(BeNil())
+		Expect(chargedDomain1).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 250000))))
+		Expect(chargedDomain2).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 500000))))
+	})
+
+	It("DomainRoutingHook (invalid) PostDispatch without route", func() {
+		// Arrange
+		routingHookId := createRoutingHook(nil)
+		Expect(setRoute(creator.Address, routingHookId, 1, createDummyIgp(s, creator.Address, denom, 1))).To(Succeed())
+
+		// Act
+		_, err := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), util.HexAddress{}, routingHookId, metadata(), message(2), sdk.NewCoins(sdk.NewInt64Coin(denom, 600000)))
+
+		// Assert
+		Expect(err.Error()).To(Equal("no route for destination domain 2 in hook " + routingHookId.String()))
+	})
+
+	It("FallbackRoutingHook PostDispatch and QuoteDispatch use fallback hook", func() {
+		// Arrange
+		fallbackIgpId := createDummyIgp(s, creator.Address, denom, 2)
+		routingHookId := createRoutingHook(&fallbackIgpId)
+		Expect(setRoute(creator.Address, routingHookId, 1, createDummyIgp(s, creator.Address, denom, 1))).To(Succeed())
+
+		// Act
+		handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(routingHookId)
+		Expect(err).To(BeNil())
+		quote, err := (*handler).QuoteDispatch(s.Ctx(), util.HexAddress{}, routingHookId, metadata(), message(2))
+		Expect(err).To(BeNil())
+		charged, err := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), util.HexAddress{}, routingHookId, metadata(), message(2), sdk.NewCoins(sdk.NewInt64Coin(denom, 600000)))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(quote).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 500000))))
+		Expect(charged).To(Equal(quote))
+
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		igp, err := qs.Igp(s.Ctx(), &types.QueryIgpRequest{Id: fallbackIgpId.String()})
+		Expect(err).To(BeNil())
+		Expect(igp.Igp.ClaimableFees).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 500000))))
+	})
+})
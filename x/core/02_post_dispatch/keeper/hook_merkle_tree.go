@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -29,7 +30,8 @@ func (i MerkleTreeHookHandler) HookType() uint8 {
 }
 
 // PostDispatch inserts a message ID into the Merkle tree of a mailbox.
-// It updates the tree, emits an event, and stores the changes.
+// It updates the changed levels of the tree, records the number of leaves for the current
+// block height and emits an event.
 func (i MerkleTreeHookHandler) PostDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, _ util.StandardHookMetadata, message util.HyperlaneMessage, _ sdk.Coins) (sdk.Coins, error) {
 	merkleTreeHook, err := i.k.merkleTreeHooks.Get(ctx, hookId.GetInternalId())
 	if err != nil {
@@ -40,31 +42,23 @@ func (i MerkleTreeHookHandler) PostDispatch(ctx context.Context, mailboxId, hook
 		return nil, errors.Wrapf(types.ErrSenderIsNotDesignatedMailbox, "required mailbox id: %s, sender mailbox id: %s", merkleTreeHook.MailboxId, mailboxId.String())
 	}
 
-	tree, err := types.TreeFromProto(merkleTreeHook.Tree)
+	index, err := i.k.insertIntoMerkleTree(ctx, hookId.GetInternalId(), message.Id())
 	if err != nil {
 		return nil, err
 	}
 
-	count := tree.GetCount()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	if err = tree.Insert(message.Id()); err != nil {
+	if err = i.k.merkleTreeCheckpoints.Set(ctx, collections.Join(hookId.GetInternalId(), sdkCtx.BlockHeight()), index+1); err != nil {
 		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
 	_ = sdkCtx.EventManager().EmitTypedEvent(&types.InsertedIntoTree{
 		MessageId:        message.Id().String(),
-		Index:            count,
+		Index:            index,
 		MerkleTreeHookId: merkleTreeHook.Id.String(),
 	})
 
-	merkleTreeHook.Tree = types.ProtoFromTree(tree)
-
-	if err := i.k.merkleTreeHooks.Set(ctx, hookId.GetInternalId(), merkleTreeHook); err != nil {
-		return nil, err
-	}
-
 	return sdk.NewCoins(), nil
 }
 
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/gomega"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+)
+
+// Compare the gas consumption of a merkle tree hook insertion with:
+//
+//	go test ./x/core/02_post_dispatch/keeper/ -run '^$' -bench MerkleTreeHook
+
+// setupMerkleTreeHookBenchmark creates a chain with a mailbox and a merkle tree hook.
+func setupMerkleTreeHookBenchmark(b *testing.B) (*i.KeeperTestSuite, util.HexAddress, util.HexAddress) {
+	b.Helper()
+	RegisterTestingT(b)
+
+	s := i.NewCleanChain()
+	creator := i.GenerateTestValidatorAddress("Creator")
+	Expect(s.MintBaseCoins(creator.Address, 1_000_000)).To(Succeed())
+
+	mailboxId, err := createDummyMailbox(s, creator.Address)
+	Expect(err).To(BeNil())
+
+	hookId, err := createDummyMerkleTreeHook(s, creator.Address, mailboxId)
+	Expect(err).To(BeNil())
+
+	return s, mailboxId, hookId
+}
+
+func benchmarkMessage(n int) util.HyperlaneMessage {
+	return util.HyperlaneMessage{
+		Version:     3,
+		Nonce:       uint32(n),
+		Origin:      11,
+		Destination: 22,
+		Body:        []byte(fmt.Sprintf("message %d", n)),
+	}
+}
+
+// BenchmarkMerkleTreeHookPostDispatch measures an insertion which only touches the changed
+// branch levels, including the stored nodes and the checkpoint of the block height.
+func BenchmarkMerkleTreeHookPostDispatch(b *testing.B) {
+	s, mailboxId, hookId := setupMerkleTreeHookBenchmark(b)
+
+	handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(hookId)
+	Expect(err).To(BeNil())
+
+	var gas storetypes.Gas
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ctx := s.Ctx().WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		_, err = (*handler).PostDispatch(ctx, mailboxId, hookId, util.StandardHookMetadata{}, benchmarkMessage(n), sdk.NewCoins())
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		gas += ctx.GasMeter().GasConsumed()
+	}
+
+	b.ReportMetric(float64(gas)/float64(b.N), "gas/op")
+}
+
+// BenchmarkLegacyMerkleTreeHookPostDispatch measures an insertion as performed before consensus
+// version 3, which reads and writes the whole tree stored within the hook.
+func BenchmarkLegacyMerkleTreeHookPostDispatch(b *testing.B) {
+	s, mailboxId, hookId := setupMerkleTreeHookBenchmark(b)
+
+	storeKey := s.App().UnsafeFindStoreKey(coreTypes.ModuleName).(*storetypes.KVStoreKey)
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	legacyMerkleTreeHooks := collections.NewMap(sb, types.MerkleTreeHooksKey, "merkle_tree_hooks_key", collections.Uint64Key, codec.CollValue[types.MerkleTreeHook](s.App().AppCodec()))
+
+	merkleTreeHook, err := legacyMerkleTreeHooks.Get(s.Ctx(), hookId.GetInternalId())
+	Expect(err).To(BeNil())
+	merkleTreeHook.Tree = types.ProtoFromTree(util.NewTree(util.ZeroHashes, 0))
+	Expect(legacyMerkleTreeHooks.Set(s.Ctx(), hookId.GetInternalId(), merkleTreeHook)).To(Succeed())
+
+	var gas storetypes.Gas
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ctx := s.Ctx().WithGasMeter(storetypes.NewInfiniteGasMeter())
+		message := benchmarkMessage(n)
+
+		hook, err := legacyMerkleTreeHooks.Get(ctx, hookId.GetInternalId())
+		if err != nil {
+			b.Fatal(err)
+		}
+		if hook.MailboxId != mailboxId.String() {
+			b.Fatal("unexpected mailbox")
+		}
+
+		tree, err := types.TreeFromProto(hook.Tree)
+		if err != nil {
+			b.Fatal(err)
+		}
+		count := tree.GetCount()
+		if err = tree.Insert(message.Id()); err != nil {
+			b.Fatal(err)
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.InsertedIntoTree{
+			MessageId:        message.Id().String(),
+			Index:            count,
+			MerkleTreeHookId: hook.Id.String(),
+		})
+
+		hook.Tree = types.ProtoFromTree(tree)
+		if err = legacyMerkleTreeHooks.Set(ctx, hookId.GetInternalId(), hook); err != nil {
+			b.Fatal(err)
+		}
+
+		gas += ctx.GasMeter().GasConsumed()
+	}
+
+	b.ReportMetric(float64(gas)/float64(b.N), "gas/op")
+}
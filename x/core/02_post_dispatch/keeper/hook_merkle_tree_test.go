@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/keeper"
@@ -19,6 +21,15 @@ TEST CASES - hook_merkle_tree_test.go
 * MerkleTreeHook HookType
 * MerkleTreeHook (valid) exists
 * MerkleTreeHook (invalid) exists
+* MerkleTreeRoot (valid) empty tree
+* MerkleTreeProof (valid) proofs for all leaves
+* MerkleTreeProof (valid) proof of old leaf after further insertions
+* MerkleTreeProof (invalid) index out of range
+* MerkleTreeProof (valid) new leaves of a tree imported without nodes
+* MerkleTreeLatestCheckpoint (invalid) empty tree
+* MerkleTreeLatestCheckpoint (valid)
+* MerkleTreeCheckpoint (valid) per block height
+* MerkleTreeCheckpoint (invalid) no checkpoint before height
 
 */
 
@@ -29,6 +40,25 @@ var _ = Describe("hook_merkle_tree_test.go", Ordered, func() {
 	var mailboxId util.HexAddress
 	var hookId util.HexAddress
 
+	// dispatch inserts the given number of distinct messages into the merkle tree hook
+	// and returns their ids.
+	dispatch := func(n int) []util.HexAddress {
+		ids := make([]util.HexAddress, n)
+		for k := 0; k < n; k++ {
+			message := util.HyperlaneMessage{
+				Version:     3,
+				Nonce:       uint32(k),
+				Origin:      11,
+				Destination: 22,
+				Body:        []byte(fmt.Sprintf("message %d at height %d", k, s.Ctx().BlockHeight())),
+			}
+			_, err := s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), mailboxId, hookId, util.StandardHookMetadata{}, message, sdk.NewCoins())
+			Expect(err).To(BeNil())
+			ids[k] = message.Id()
+		}
+		return ids
+	}
+
 	BeforeEach(func() {
 		s = i.NewCleanChain()
 		creator = i.GenerateTestValidatorAddress("Creator")
@@ -147,4 +177,206 @@ var _ = Describe("hook_merkle_tree_test.go", Ordered, func() {
 		Expect(err).To(BeNil())
 		Expect(exists).To(BeFalse())
 	})
+
+	It("MerkleTreeRoot (valid) empty tree", func() {
+		// Arrange
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		// Act
+		res, err := qs.MerkleTreeRoot(s.Ctx(), &types.QueryMerkleTreeRootRequest{Id: hookId.String()})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Count).To(Equal(uint32(0)))
+		Expect(util.HexAddress(res.Root).String()).To(Equal("0x27ae5ba08d7291c96c8cbddcc148bf48a6d68c7974b94356f53754ef6171d757"))
+	})
+
+	It("MerkleTreeProof (valid) proofs for all leaves", func() {
+		// Arrange
+		ids := dispatch(13)
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		root, err := qs.MerkleTreeRoot(s.Ctx(), &types.QueryMerkleTreeRootRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+		Expect(root.Count).To(Equal(uint32(13)))
+
+		for index := range ids {
+			// Act
+			res, err := qs.MerkleTreeProof(s.Ctx(), &types.QueryMerkleTreeProofRequest{Id: hookId.String(), Index: uint32(index)})
+
+			// Assert
+			Expect(err).To(BeNil())
+			Expect(res.Leaf).To(Equal(ids[index].Bytes()))
+			Expect(res.Root).To(Equal(root.Root))
+			Expect(res.Proof).To(HaveLen(util.TreeDepth))
+
+			var branch [util.TreeDepth][32]byte
+			for level := range res.Proof {
+				branch[level] = [32]byte(res.Proof[level])
+			}
+			computed := util.BranchRoot(ids[index], branch, uint32(index))
+			Expect(computed[:]).To(Equal(root.Root))
+		}
+	})
+
+	It("MerkleTreeProof (valid) proof of old leaf after further insertions", func() {
+		// Arrange
+		ids := dispatch(3)
+		s.Commit()
+		dispatch(6)
+		// trigger genesis import and export
+		err := s.MintBaseCoins(creator.Address, 1)
+		Expect(err).To(BeNil())
+
+		// Act
+		leaf, proof, root, err := s.App().HyperlaneKeeper.PostDispatchKeeper.MerkleTreeProof(s.Ctx(), hookId, 2)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(leaf).To(Equal([32]byte(ids[2])))
+		Expect(util.BranchRoot(leaf, proof, 2)).To(Equal(root))
+	})
+
+	It("MerkleTreeProof (invalid) index out of range", func() {
+		// Arrange
+		dispatch(2)
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		// Act
+		_, err := qs.MerkleTreeProof(s.Ctx(), &types.QueryMerkleTreeProofRequest{Id: hookId.String(), Index: 2})
+
+		// Assert
+		Expect(err.Error()).To(Equal("leaf index 2 out of range, tree contains 2 leaves"))
+	})
+
+	It("MerkleTreeProof (valid) new leaves of a tree imported without nodes", func() {
+		// Arrange
+		tree := util.NewTree(util.ZeroHashes, 0)
+		for k := int64(0); k < 6; k++ {
+			Expect(tree.Insert(util.CreateMockHexAddress("leaf", k))).To(Succeed())
+		}
+		keeper.InitGenesis(s.Ctx(), s.App().HyperlaneKeeper.PostDispatchKeeper, &types.GenesisState{
+			Igps:          []types.InterchainGasPaymaster{},
+			IgpGasConfigs: []types.GenesisDestinationGasConfigWrapper{},
+			MerkleTreeHooks: []types.MerkleTreeHook{{
+				Id:        hookId,
+				MailboxId: mailboxId.String(),
+				Owner:     creator.Address,
+				Tree:      types.ProtoFromTree(tree),
+			}},
+			NoopHooks: []types.NoopHook{},
+		})
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		// the last imported leaf is unknown
+		latest, err := qs.MerkleTreeLatestCheckpoint(s.Ctx(), &types.QueryMerkleTreeLatestCheckpointRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+		root := tree.GetRoot()
+		Expect(latest.Checkpoint.Root).To(Equal(root[:]))
+		Expect(latest.Checkpoint.Index).To(Equal(uint32(5)))
+		Expect(latest.Checkpoint.MessageId).To(Equal(util.HexAddress{}))
+
+		// Act
+		ids := dispatch(3)
+
+		// Assert
+		for _, id := range ids {
+			Expect(tree.Insert(id)).To(Succeed())
+		}
+		for index := uint32(6); index < 9; index++ {
+			leaf, proof, root, err := s.App().HyperlaneKeeper.PostDispatchKeeper.MerkleTreeProof(s.Ctx(), hookId, index)
+			Expect(err).To(BeNil())
+			Expect(leaf).To(Equal([32]byte(ids[index-6])))
+			Expect(root).To(Equal(tree.GetRoot()))
+			Expect(util.BranchRoot(leaf, proof, index)).To(Equal(root))
+		}
+
+		checkpoint, err := qs.MerkleTreeCheckpoint(s.Ctx(), &types.QueryMerkleTreeCheckpointRequest{Id: hookId.String(), Height: s.Ctx().BlockHeight()})
+		Expect(err).To(BeNil())
+		root = tree.GetRoot()
+		Expect(checkpoint.Checkpoint.Root).To(Equal(root[:]))
+		Expect(checkpoint.Checkpoint.MessageId).To(Equal(ids[2]))
+
+		// imported leaves can not be proven
+		_, _, _, err = s.App().HyperlaneKeeper.PostDispatchKeeper.MerkleTreeProof(s.Ctx(), hookId, 2)
+		Expect(err).To(MatchError(types.ErrMerkleTreeNodeNotStored))
+	})
+
+	It("MerkleTreeLatestCheckpoint (invalid) empty tree", func() {
+		// Arrange
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		// Act
+		_, err := qs.MerkleTreeLatestCheckpoint(s.Ctx(), &types.QueryMerkleTreeLatestCheckpointRequest{Id: hookId.String()})
+
+		// Assert
+		Expect(err.Error()).To(Equal("no leaf inserted yet"))
+	})
+
+	It("MerkleTreeLatestCheckpoint (valid)", func() {
+		// Arrange
+		ids := dispatch(5)
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		root, err := qs.MerkleTreeRoot(s.Ctx(), &types.QueryMerkleTreeRootRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+
+		// Act
+		res, err := qs.MerkleTreeLatestCheckpoint(s.Ctx(), &types.QueryMerkleTreeLatestCheckpointRequest{Id: hookId.String()})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Checkpoint.Root).To(Equal(root.Root))
+		Expect(res.Checkpoint.Index).To(Equal(uint32(4)))
+		Expect(res.Checkpoint.MessageId).To(Equal(ids[4]))
+	})
+
+	It("MerkleTreeCheckpoint (valid) per block height", func() {
+		// Arrange
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+
+		firstHeight := s.Ctx().BlockHeight()
+		firstIds := dispatch(2)
+		firstRoot, err := qs.MerkleTreeRoot(s.Ctx(), &types.QueryMerkleTreeRootRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+
+		s.Commit()
+		s.Commit()
+
+		secondHeight := s.Ctx().BlockHeight()
+		secondIds := dispatch(3)
+
+		// Act
+		first, err := qs.MerkleTreeCheckpoint(s.Ctx(), &types.QueryMerkleTreeCheckpointRequest{Id: hookId.String(), Height: secondHeight - 1})
+		Expect(err).To(BeNil())
+		second, err := qs.MerkleTreeCheckpoint(s.Ctx(), &types.QueryMerkleTreeCheckpointRequest{Id: hookId.String(), Height: secondHeight})
+		Expect(err).To(BeNil())
+		latest, err := qs.MerkleTreeLatestCheckpoint(s.Ctx(), &types.QueryMerkleTreeLatestCheckpointRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+
+		// Assert
+		Expect(first.Height).To(Equal(firstHeight))
+		Expect(first.Checkpoint.Index).To(Equal(uint32(1)))
+		Expect(first.Checkpoint.MessageId).To(Equal(firstIds[1]))
+		Expect(first.Checkpoint.Root).To(Equal(firstRoot.Root))
+
+		Expect(second.Height).To(Equal(secondHeight))
+		Expect(second.Checkpoint.Index).To(Equal(uint32(4)))
+		Expect(second.Checkpoint.MessageId).To(Equal(secondIds[2]))
+		Expect(second.Checkpoint).To(Equal(latest.Checkpoint))
+	})
+
+	It("MerkleTreeCheckpoint (invalid) no checkpoint before height", func() {
+		// Arrange
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		height := s.Ctx().BlockHeight()
+		s.Commit()
+		dispatch(1)
+
+		// Act
+		_, err := qs.MerkleTreeCheckpoint(s.Ctx(), &types.QueryMerkleTreeCheckpointRequest{Id: hookId.String(), Height: height})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("no checkpoint recorded at or before height %d", height)))
+	})
 })
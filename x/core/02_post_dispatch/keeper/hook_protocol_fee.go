@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ProtocolFeeHookHandler struct {
+	k Keeper
+}
+
+var _ util.PostDispatchModule = ProtocolFeeHookHandler{}
+
+func (i ProtocolFeeHookHandler) Exists(ctx context.Context, hookId util.HexAddress) (bool, error) {
+	has, err := i.k.protocolFeeHooks.Has(ctx, hookId.GetInternalId())
+	if err != nil {
+		return false, err
+	}
+	return has, nil
+}
+
+func (i ProtocolFeeHookHandler) HookType() uint8 {
+	return types.POST_DISPATCH_HOOK_TYPE_PROTOCOL_FEE
+}
+
+// PostDispatch charges the protocol fee from the message sender given in the metadata.
+// The fee is collected by the hook until it is claimed for the beneficiary.
+func (i ProtocolFeeHookHandler) PostDispatch(ctx context.Context, _, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage, maxFee sdk.Coins) (sdk.Coins, error) {
+	protocolFeeHook, err := i.k.protocolFeeHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protocol fee hook with id: %s", hookId.String())
+	}
+
+	if protocolFeeHook.ProtocolFee.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	fee := sdk.NewCoins(protocolFeeHook.ProtocolFee)
+	if !maxFee.IsAllGTE(fee) {
+		return nil, fmt.Errorf("required protocol fee exceeds max hyperlane fee: %s", fee)
+	}
+
+	if metadata.Address.Empty() {
+		return nil, fmt.Errorf("protocol fee payer is required")
+	}
+
+	// the fees are held by the core module account, like the igp fees
+	err = i.k.bankKeeper.SendCoinsFromAccountToModule(ctx, metadata.Address, "hyperlane", fee)
+	if err != nil {
+		return nil, err
+	}
+
+	protocolFeeHook.ClaimableFees = protocolFeeHook.ClaimableFees.Add(fee...)
+
+	if err = i.k.protocolFeeHooks.Set(ctx, hookId.GetInternalId(), protocolFeeHook); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.ProtocolFeePayment{
+		MessageId: message.Id().String(),
+		Payer:     metadata.Address.String(),
+		Payment:   fee.String(),
+		HookId:    hookId.String(),
+	})
+
+	return fee, nil
+}
+
+// QuoteDispatch returns the protocol fee of the hook.
+func (i ProtocolFeeHookHandler) QuoteDispatch(ctx context.Context, _, hookId util.HexAddress, _ util.StandardHookMetadata, _ util.HyperlaneMessage) (sdk.Coins, error) {
+	protocolFeeHook, err := i.k.protocolFeeHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protocol fee hook with id: %s", hookId.String())
+	}
+
+	return sdk.NewCoins(protocolFeeHook.ProtocolFee), nil
+}
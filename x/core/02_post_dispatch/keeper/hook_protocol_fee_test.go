@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - hook_protocol_fee_test.go
+
+* Create (invalid) Protocol Fee Hook with fee above max fee
+* Create (invalid) Protocol Fee Hook with different denoms
+* Create (valid) Protocol Fee Hook
+* ProtocolFeeHook PostDispatch and QuoteDispatch
+* ProtocolFeeHook (invalid) PostDispatch with insufficient max fee
+* SetProtocolFeeHook (invalid) non-owner
+* SetProtocolFeeHook (invalid) fee above max fee
+* SetProtocolFeeHook (valid) fee, beneficiary and owner
+* ClaimProtocolFees (invalid) unauthorized sender
+* ClaimProtocolFees (valid) by beneficiary
+
+*/
+
+var _ = Describe("hook_protocol_fee_test.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var creator i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var beneficiary i.TestValidatorAddress
+
+	denom := "acoin"
+	message := util.HyperlaneMessage{Version: 3, Origin: 11, Destination: 1, Body: []byte("protocol fee")}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		creator = i.GenerateTestValidatorAddress("Creator")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		beneficiary = i.GenerateTestValidatorAddress("Beneficiary")
+
+		err := s.MintBaseCoins(creator.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	createProtocolFeeHook := func(fee, maxFee int64) util.HexAddress {
+		res, err := s.RunTx(&types.MsgCreateProtocolFeeHook{
+			Owner:          creator.Address,
+			Beneficiary:    beneficiary.Address,
+			ProtocolFee:    sdk.NewInt64Coin(denom, fee),
+			MaxProtocolFee: sdk.NewInt64Coin(denom, maxFee),
+		})
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateProtocolFeeHookResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		return response.Id
+	}
+
+	queryProtocolFeeHook := func(hookId util.HexAddress) types.ProtocolFeeHook {
+		qs := keeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		res, err := qs.ProtocolFeeHook(s.Ctx(), &types.QueryProtocolFeeHookRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+		return res.ProtocolFeeHook
+	}
+
+	postDispatch := func(hookId util.HexAddress, maxFee int64) (sdk.Coins, error) {
+		metadata := util.StandardHookMetadata{Address: sender.AccAddress, GasLimit: math.NewInt(50000)}
+		return s.App().HyperlaneKeeper.PostDispatch(s.Ctx(), util.HexAddress{}, hookId, metadata, message, sdk.NewCoins(sdk.NewInt64Coin(denom, maxFee)))
+	}
+
+	It("Create (invalid) Protocol Fee Hook with fee above max fee", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgCreateProtocolFeeHook{
+			Owner:          creator.Address,
+			Beneficiary:    beneficiary.Address,
+			ProtocolFee:    sdk.NewInt64Coin(denom, 101),
+			MaxProtocolFee: sdk.NewInt64Coin(denom, 100),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("protocol fee 101acoin exceeds max protocol fee 100acoin"))
+	})
+
+	It("Create (invalid) Protocol Fee Hook with different denoms", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgCreateProtocolFeeHook{
+			Owner:          creator.Address,
+			Beneficiary:    beneficiary.Address,
+			ProtocolFee:    sdk.NewInt64Coin(denom, 10),
+			MaxProtocolFee: sdk.NewInt64Coin("bcoin", 100),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("protocol fee and max protocol fee must have the same denom"))
+	})
+
+	It("Create (valid) Protocol Fee Hook", func() {
+		// Act
+		hookId := createProtocolFeeHook(10, 100)
+
+		// Assert
+		Expect(hookId.GetType()).To(Equal(uint32(types.POST_DISPATCH_HOOK_TYPE_PROTOCOL_FEE)))
+
+		hook := queryProtocolFeeHook(hookId)
+		Expect(hook.Owner).To(Equal(creator.Address))
+		Expect(hook.Beneficiary).To(Equal(beneficiary.Address))
+		Expect(hook.ProtocolFee).To(Equal(sdk.NewInt64Coin(denom, 10)))
+		Expect(hook.MaxProtocolFee).To(Equal(sdk.NewInt64Coin(denom, 100)))
+		Expect(hook.ClaimableFees.IsZero()).To(BeTrue())
+	})
+
+	It("ProtocolFeeHook PostDispatch and QuoteDispatch", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)
+
+		// Act
+		handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(hookId)
+		Expect(err).To(BeNil())
+		quote, err := (*handler).QuoteDispatch(s.Ctx(), util.HexAddress{}, hookId, util.StandardHookMetadata{}, message)
+		Expect(err).To(BeNil())
+
+		charged, err := postDispatch(hookId, 50)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(quote).To(Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+		Expect(charged).To(Equal(quote))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount).To(Equal(senderBalance.Amount.SubRaw(10)))
+		Expect(queryProtocolFeeHook(hookId).ClaimableFees).To(Equal(quote))
+	})
+
+	It("ProtocolFeeHook (invalid) PostDispatch with insufficient max fee", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+
+		// Act
+		_, err := postDispatch(hookId, 9)
+
+		// Assert
+		Expect(err.Error()).To(Equal("required protocol fee exceeds max hyperlane fee: 10acoin"))
+		Expect(queryProtocolFeeHook(hookId).ClaimableFees.IsZero()).To(BeTrue())
+	})
+
+	It("SetProtocolFeeHook (invalid) non-owner", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+		fee := sdk.NewInt64Coin(denom, 20)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetProtocolFeeHook{
+			Owner:       sender.Address,
+			HookId:      hookId,
+			ProtocolFee: &fee,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(sender.Address + " does not own protocol fee hook with id " + hookId.String() + ": unauthorized"))
+	})
+
+	It("SetProtocolFeeHook (invalid) fee above max fee", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+		fee := sdk.NewInt64Coin(denom, 200)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetProtocolFeeHook{
+			Owner:       creator.Address,
+			HookId:      hookId,
+			ProtocolFee: &fee,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("protocol fee 200acoin exceeds max protocol fee 100acoin"))
+		Expect(queryProtocolFeeHook(hookId).ProtocolFee).To(Equal(sdk.NewInt64Coin(denom, 10)))
+	})
+
+	It("SetProtocolFeeHook (valid) fee, beneficiary and owner", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+		fee := sdk.NewInt64Coin(denom, 100)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetProtocolFeeHook{
+			Owner:       creator.Address,
+			HookId:      hookId,
+			ProtocolFee: &fee,
+			Beneficiary: creator.Address,
+			NewOwner:    sender.Address,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		hook := queryProtocolFeeHook(hookId)
+		Expect(hook.ProtocolFee).To(Equal(fee))
+		Expect(hook.Beneficiary).To(Equal(creator.Address))
+		Expect(hook.Owner).To(Equal(sender.Address))
+	})
+
+	It("ClaimProtocolFees (invalid) unauthorized sender", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+		_, err := postDispatch(hookId, 10)
+		Expect(err).To(BeNil())
+
+		// Act
+		_, err = s.RunTx(&types.MsgClaimProtocolFees{
+			Sender: sender.Address,
+			HookId: hookId,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("failed to claim: " + sender.Address + " is not permitted to claim"))
+	})
+
+	It("ClaimProtocolFees (valid) by beneficiary", func() {
+		// Arrange
+		hookId := createProtocolFeeHook(10, 100)
+		for range 3 {
+			_, err := postDispatch(hookId, 10)
+			Expect(err).To(BeNil())
+		}
+
+		// Act
+		_, err := s.RunTx(&types.MsgClaimProtocolFees{
+			Sender: beneficiary.Address,
+			HookId: hookId,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), beneficiary.AccAddress, denom).Amount).To(Equal(math.NewInt(30)))
+		Expect(queryProtocolFeeHook(hookId).ClaimableFees.IsZero()).To(BeTrue())
+
+		_, err = s.RunTx(&types.MsgClaimProtocolFees{
+			Sender: beneficiary.Address,
+			HookId: hookId,
+		})
+		Expect(err.Error()).To(Equal("no claimable fees left"))
+	})
+})
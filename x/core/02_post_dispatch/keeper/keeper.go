@@ -13,9 +13,26 @@ type Keeper struct {
 	IgpDestinationGasConfigs collections.Map[collections.Pair[uint64, uint32], types.DestinationGasConfig]
 
 	merkleTreeHooks collections.Map[uint64, types.MerkleTreeHook]
+	// merkleTreeBranches stores the branch of a merkle tree hook per level, keyed by (hook id, level).
+	merkleTreeBranches collections.Map[collections.Pair[uint64, uint32], []byte]
+	// merkleTreeCounts stores the number of inserted leaves of a merkle tree hook.
+	merkleTreeCounts collections.Map[uint64, uint32]
+	// merkleTreeNodes stores the leaves and all completed subtree roots of a merkle tree hook,
+	// keyed by (hook id, level, position).
+	merkleTreeNodes collections.Map[collections.Triple[uint64, uint32, uint32], []byte]
+	// merkleTreeCheckpoints stores the number of leaves of a merkle tree hook at the end of each
+	// block height in which a leaf was inserted.
+	merkleTreeCheckpoints collections.Map[collections.Pair[uint64, int64], uint32]
 
 	noopHooks collections.Map[uint64, types.NoopHook]
 
+	aggregationHooks collections.Map[uint64, types.AggregationHook]
+
+	domainRoutingHooks      collections.Map[uint64, types.DomainRoutingHook]
+	domainRoutingHookRoutes collections.Map[collections.Pair[uint64, uint32], types.HookRoute]
+
+	protocolFeeHooks collections.Map[uint64, types.ProtocolFeeHook]
+
 	schema collections.Schema
 
 	coreKeeper types.CoreKeeper
@@ -29,8 +46,19 @@ func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, ba
 		Igps:                     collections.NewMap(sb, types.InterchainGasPaymasterKey, "interchain_gas_paymasters", collections.Uint64Key, codec.CollValue[types.InterchainGasPaymaster](cdc)),
 		IgpDestinationGasConfigs: collections.NewMap(sb, types.InterchainGasPaymasterConfigsKey, "interchain_gas_paymaster_configs", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.DestinationGasConfig](cdc)),
 
-		merkleTreeHooks: collections.NewMap(sb, types.MerkleTreeHooksKey, "merkle_tree_hooks_key", collections.Uint64Key, codec.CollValue[types.MerkleTreeHook](cdc)),
-		noopHooks:       collections.NewMap(sb, types.NoopHooksKey, "noop_hooks_key", collections.Uint64Key, codec.CollValue[types.NoopHook](cdc)),
+		merkleTreeHooks:       collections.NewMap(sb, types.MerkleTreeHooksKey, "merkle_tree_hooks_key", collections.Uint64Key, codec.CollValue[types.MerkleTreeHook](cdc)),
+		merkleTreeBranches:    collections.NewMap(sb, types.MerkleTreeBranchesKey, "merkle_tree_branches_key", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.BytesValue),
+		merkleTreeCounts:      collections.NewMap(sb, types.MerkleTreeCountsKey, "merkle_tree_counts_key", collections.Uint64Key, collections.Uint32Value),
+		merkleTreeNodes:       collections.NewMap(sb, types.MerkleTreeNodesKey, "merkle_tree_nodes_key", collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.Uint32Key), collections.BytesValue),
+		merkleTreeCheckpoints: collections.NewMap(sb, types.MerkleTreeCheckpointsKey, "merkle_tree_checkpoints_key", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), collections.Uint32Value),
+		noopHooks:             collections.NewMap(sb, types.NoopHooksKey, "noop_hooks_key", collections.Uint64Key, codec.CollValue[types.NoopHook](cdc)),
+
+		aggregationHooks: collections.NewMap(sb, types.AggregationHooksKey, "aggregation_hooks_key", collections.Uint64Key, codec.CollValue[types.AggregationHook](cdc)),
+
+		domainRoutingHooks:      collections.NewMap(sb, types.DomainRoutingHooksKey, "domain_routing_hooks_key", collections.Uint64Key, codec.CollValue[types.DomainRoutingHook](cdc)),
+		domainRoutingHookRoutes: collections.NewMap(sb, types.DomainRoutingHookRoutesKey, "domain_routing_hook_routes_key", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.HookRoute](cdc)),
+
+		protocolFeeHooks: collections.NewMap(sb, types.ProtocolFeeHooksKey, "protocol_fee_hooks_key", collections.Uint64Key, codec.CollValue[types.ProtocolFeeHook](cdc)),
 
 		bankKeeper: bankKeeper,
 	}
@@ -57,4 +85,8 @@ func (k *Keeper) SetCoreKeeper(coreKeeper types.CoreKeeper) {
 	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_MERKLE_TREE, MerkleTreeHookHandler{*k})
 	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_INTERCHAIN_GAS_PAYMASTER, InterchainGasPaymasterHookHandler{*k})
 	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_UNUSED, NoopHookHandler{*k})
+	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_AGGREGATION, AggregationHookHandler{*k})
+	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_ROUTING, DomainRoutingHookHandler{*k})
+	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_FALLBACK_ROUTING, FallbackRoutingHookHandler{DomainRoutingHookHandler{*k}})
+	router.RegisterModule(types.POST_DISPATCH_HOOK_TYPE_PROTOCOL_FEE, ProtocolFeeHookHandler{*k})
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"cosmossdk.io/math"
+
 	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	ismTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
@@ -77,3 +79,34 @@ func createDummyMerkleTreeHook(s *i.KeeperTestSuite, creator string, mailboxId u
 
 	return response.Id, nil
 }
+
+// createDummyIgp creates an igp with gas configs for the domains 1 and 2.
+func createDummyIgp(s *i.KeeperTestSuite, creator, denom string, gasPrice int64) util.HexAddress {
+	res, err := s.RunTx(&types.MsgCreateIgp{
+		Owner: creator,
+		Denom: denom,
+	})
+	Expect(err).To(BeNil())
+
+	var response types.MsgCreateIgpResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	for _, domain := range []uint32{1, 2} {
+		_, err = s.RunTx(&types.MsgSetDestinationGasConfig{
+			Owner: creator,
+			IgpId: response.Id,
+			DestinationGasConfig: &types.DestinationGasConfig{
+				RemoteDomain: domain,
+				GasOracle: &types.GasOracle{
+					TokenExchangeRate: math.NewInt(1e10),
+					GasPrice:          math.NewInt(gasPrice),
+				},
+				GasOverhead: math.NewInt(200000),
+			},
+		})
+		Expect(err).To(BeNil())
+	}
+
+	return response.Id
+}
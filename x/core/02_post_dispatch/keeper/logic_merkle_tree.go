@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+)
+
+// merkleTree loads the branch and count of a merkle tree hook.
+// Branch levels which have never been written are zero hashes, as in a newly created tree.
+func (k Keeper) merkleTree(ctx context.Context, hookId uint64) (*util.MerkleTree, error) {
+	count, err := k.merkleTreeCounts.Get(ctx, hookId)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := util.NewTree(util.ZeroHashes, count)
+
+	iter, err := k.merkleTreeBranches.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint32](hookId))
+	if err != nil {
+		return nil, err
+	}
+
+	branches, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, branch := range branches {
+		level := branch.Key.K2()
+		if level >= util.TreeDepth || len(branch.Value) != 32 {
+			return nil, fmt.Errorf("invalid branch at level %d of merkle tree %d", level, hookId)
+		}
+		tree.Branch[level] = [32]byte(branch.Value)
+	}
+
+	return tree, nil
+}
+
+// setMerkleTree overwrites the branch and count of a merkle tree hook.
+func (k Keeper) setMerkleTree(ctx context.Context, hookId uint64, tree *util.MerkleTree) error {
+	for level := uint32(0); level < util.TreeDepth; level++ {
+		if err := k.merkleTreeBranches.Set(ctx, collections.Join(hookId, level), tree.Branch[level][:]); err != nil {
+			return err
+		}
+	}
+
+	return k.merkleTreeCounts.Set(ctx, hookId, tree.GetCount())
+}
+
+// seedMerkleTreeNodes stores the roots of the completed subtrees of a tree whose leaves were
+// inserted before nodes were recorded. For every set bit L of the count, branch level L holds the
+// root of the subtree at level L and position (count >> L) - 1. These subtrees cover all existing
+// leaves, so that roots and proofs of leaves inserted afterwards can be built. The leaves of these
+// subtrees and their inner nodes are unknown, hence existing leaves can not be proven.
+func (k Keeper) seedMerkleTreeNodes(ctx context.Context, hookId uint64, tree *util.MerkleTree) error {
+	count := tree.GetCount()
+	for level := uint32(0); level < util.TreeDepth; level++ {
+		if (count>>level)&1 == 0 {
+			continue
+		}
+
+		position := (count >> level) - 1
+		if err := k.merkleTreeNodes.Set(ctx, collections.Join3(hookId, level, position), tree.Branch[level][:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertIntoMerkleTree appends a leaf to the tree of a merkle tree hook and returns its index.
+// Equal to util.MerkleTree.Insert, but only the branch levels which are combined with the new leaf
+// are read and only the level receiving the new subtree root is written. Additionally, the leaf
+// and the roots of all subtrees which are completed by it are stored as nodes. Once stored, a node
+// never changes, which allows building proofs for any leaf without replaying the whole tree.
+func (k Keeper) insertIntoMerkleTree(ctx context.Context, hookId uint64, leaf [32]byte) (uint32, error) {
+	count, err := k.merkleTreeCounts.Get(ctx, hookId)
+	if err != nil {
+		return 0, err
+	}
+
+	if count >= util.MaxLeaves {
+		return 0, fmt.Errorf("merkle tree full")
+	}
+
+	node := leaf
+	position := count
+
+	for level := uint32(0); level < util.TreeDepth; level++ {
+		if err = k.merkleTreeNodes.Set(ctx, collections.Join3(hookId, level, position), node[:]); err != nil {
+			return 0, err
+		}
+
+		// A left child does not complete its parent and becomes the branch of its level.
+		if position%2 == 0 {
+			if err = k.merkleTreeBranches.Set(ctx, collections.Join(hookId, level), node[:]); err != nil {
+				return 0, err
+			}
+			break
+		}
+
+		var sibling []byte
+		sibling, err = k.merkleTreeBranches.Get(ctx, collections.Join(hookId, level))
+		if err != nil {
+			return 0, err
+		}
+
+		node = crypto.Keccak256Hash(sibling, node[:])
+		position /= 2
+	}
+
+	if err = k.merkleTreeCounts.Set(ctx, hookId, count+1); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// merkleTreeNode returns the node at the given level and position of a tree containing count leaves.
+// Nodes of completed subtrees are read from the store, nodes without any leaves are zero hashes
+// and partially filled subtrees are computed from their children. The root of the tree is the node
+// at position 0 of level util.TreeDepth.
+func (k Keeper) merkleTreeNode(ctx context.Context, hookId uint64, level, position, count uint32) ([32]byte, error) {
+	firstLeaf := uint64(position) << level
+	if firstLeaf >= uint64(count) {
+		return util.ZeroHashes[level], nil
+	}
+
+	if (uint64(position)+1)<<level <= uint64(count) {
+		node, err := k.merkleTreeNodes.Get(ctx, collections.Join3(hookId, level, position))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return [32]byte{}, errorsmod.Wrapf(types.ErrMerkleTreeNodeNotStored, "level %d, position %d", level, position)
+			}
+			return [32]byte{}, err
+		}
+		return [32]byte(node), nil
+	}
+
+	left, err := k.merkleTreeNode(ctx, hookId, level-1, 2*position, count)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	right, err := k.merkleTreeNode(ctx, hookId, level-1, 2*position+1, count)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return crypto.Keccak256Hash(left[:], right[:]), nil
+}
+
+// MerkleTreeProof returns the leaf at the given index together with its inclusion proof against
+// the current root of the tree. Leaves inserted before nodes were recorded can not be proven.
+func (k Keeper) MerkleTreeProof(ctx context.Context, hookId util.HexAddress, index uint32) (leaf [32]byte, proof [util.TreeDepth][32]byte, root [32]byte, err error) {
+	tree, err := k.merkleTree(ctx, hookId.GetInternalId())
+	if err != nil {
+		return leaf, proof, root, err
+	}
+
+	if index >= tree.GetCount() {
+		return leaf, proof, root, fmt.Errorf("leaf index %d out of range, tree contains %d leaves", index, tree.GetCount())
+	}
+
+	leaf, err = k.merkleTreeNode(ctx, hookId.GetInternalId(), 0, index, tree.GetCount())
+	if err != nil {
+		return leaf, proof, root, err
+	}
+
+	for level := uint32(0); level < util.TreeDepth; level++ {
+		sibling := (index >> level) ^ 1
+		proof[level], err = k.merkleTreeNode(ctx, hookId.GetInternalId(), level, sibling, tree.GetCount())
+		if err != nil {
+			return leaf, proof, root, err
+		}
+	}
+
+	return leaf, proof, tree.GetRoot(), nil
+}
+
+// MerkleTreeLatestCheckpoint returns the root, index and message id of the last inserted leaf.
+// The message id is empty if the leaf was inserted before nodes were recorded and is not stored.
+func (k Keeper) MerkleTreeLatestCheckpoint(ctx context.Context, hookId util.HexAddress) (types.MerkleTreeCheckpoint, error) {
+	tree, err := k.merkleTree(ctx, hookId.GetInternalId())
+	if err != nil {
+		return types.MerkleTreeCheckpoint{}, err
+	}
+
+	root, index, err := tree.GetLatestCheckpoint()
+	if err != nil {
+		return types.MerkleTreeCheckpoint{}, err
+	}
+
+	messageId, err := k.merkleTreeNode(ctx, hookId.GetInternalId(), 0, index, tree.GetCount())
+	if err != nil && !errors.Is(err, types.ErrMerkleTreeNodeNotStored) {
+		return types.MerkleTreeCheckpoint{}, err
+	}
+
+	return types.MerkleTreeCheckpoint{
+		Root:      root[:],
+		Index:     index,
+		MessageId: messageId,
+	}, nil
+}
+
+// MerkleTreeCheckpointAtHeight returns the last checkpoint recorded at or before the given block height
+// together with the height it was recorded at. Only the number of leaves is recorded per height,
+// the root is computed from the stored nodes.
+func (k Keeper) MerkleTreeCheckpointAtHeight(ctx context.Context, hookId util.HexAddress, height int64) (int64, types.MerkleTreeCheckpoint, error) {
+	rng := collections.NewPrefixedPairRange[uint64, int64](hookId.GetInternalId()).EndInclusive(height).Descending()
+
+	iter, err := k.merkleTreeCheckpoints.Iterate(ctx, rng)
+	if err != nil {
+		return 0, types.MerkleTreeCheckpoint{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, types.MerkleTreeCheckpoint{}, fmt.Errorf("no checkpoint recorded at or before height %d", height)
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, types.MerkleTreeCheckpoint{}, err
+	}
+
+	count := kv.Value
+	root, err := k.merkleTreeNode(ctx, hookId.GetInternalId(), util.TreeDepth, 0, count)
+	if err != nil {
+		return 0, types.MerkleTreeCheckpoint{}, err
+	}
+
+	messageId, err := k.merkleTreeNode(ctx, hookId.GetInternalId(), 0, count-1, count)
+	if err != nil {
+		return 0, types.MerkleTreeCheckpoint{}, err
+	}
+
+	return kv.Key.K2(), types.MerkleTreeCheckpoint{
+		Root:      root[:],
+		Index:     count - 1,
+		MessageId: messageId,
+	}, nil
+}
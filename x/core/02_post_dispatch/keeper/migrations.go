@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+)
+
+// MigrateMerkleTreeHooks moves the tree of all merkle tree hooks out of the hook itself into
+// separate entries per branch level and a count.
+// The roots of the completed subtrees are derived from the branch, so that roots and proofs of
+// leaves inserted after the migration can be built. Leaves inserted before can not be proven.
+func (k Keeper) MigrateMerkleTreeHooks(ctx context.Context) error {
+	iter, err := k.merkleTreeHooks.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	merkleTreeHooks, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, merkleTreeHook := range merkleTreeHooks {
+		if merkleTreeHook.Tree == nil {
+			continue
+		}
+
+		tree, err := types.TreeFromProto(merkleTreeHook.Tree)
+		if err != nil {
+			return err
+		}
+
+		if err = k.setMerkleTree(ctx, merkleTreeHook.Id.GetInternalId(), tree); err != nil {
+			return err
+		}
+
+		if err = k.seedMerkleTreeNodes(ctx, merkleTreeHook.Id.GetInternalId(), tree); err != nil {
+			return err
+		}
+
+		merkleTreeHook.Tree = nil
+		if err = k.merkleTreeHooks.Set(ctx, merkleTreeHook.Id.GetInternalId(), merkleTreeHook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
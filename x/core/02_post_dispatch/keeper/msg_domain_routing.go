@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// assertHookExists returns an error if the hook is not registered by any post dispatch module.
+func (k *Keeper) assertHookExists(ctx context.Context, hookId util.HexAddress) error {
+	handler, err := k.coreKeeper.PostDispatchRouter().GetModule(hookId)
+	if err != nil {
+		return errors.Wrapf(types.ErrHookDoesNotExistOrIsNotRegistered, "%s", hookId.String())
+	}
+	if exists, err := (*handler).Exists(ctx, hookId); !exists || err != nil {
+		return errors.Wrapf(types.ErrHookDoesNotExistOrIsNotRegistered, "%s", hookId.String())
+	}
+	return nil
+}
+
+// ownedDomainRoutingHook returns the domain routing hook if it is owned by the given owner.
+func (k *Keeper) ownedDomainRoutingHook(ctx context.Context, hookId util.HexAddress, owner string) (types.DomainRoutingHook, error) {
+	hook, err := k.domainRoutingHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil || hook.Id != hookId {
+		return types.DomainRoutingHook{}, fmt.Errorf("failed to find domain routing hook with id: %s", hookId.String())
+	}
+
+	if hook.Owner != owner {
+		return types.DomainRoutingHook{}, errors.Wrapf(types.ErrUnauthorized, "%s does not own domain routing hook with id %s", owner, hookId.String())
+	}
+	return hook, nil
+}
+
+func (ms msgServer) CreateDomainRoutingHook(ctx context.Context, msg *types.MsgCreateDomainRoutingHook) (*types.MsgCreateDomainRoutingHookResponse, error) {
+	hookType := types.POST_DISPATCH_HOOK_TYPE_ROUTING
+	fallbackHookId := ""
+	if msg.FallbackHookId != nil {
+		if err := ms.k.assertHookExists(ctx, *msg.FallbackHookId); err != nil {
+			return nil, err
+		}
+		hookType = types.POST_DISPATCH_HOOK_TYPE_FALLBACK_ROUTING
+		fallbackHookId = msg.FallbackHookId.String()
+	}
+
+	nextId, err := ms.k.coreKeeper.PostDispatchRouter().GetNextSequence(ctx, hookType)
+	if err != nil {
+		return nil, err
+	}
+	domainRoutingHook := types.DomainRoutingHook{
+		Id:             nextId,
+		Owner:          msg.Owner,
+		FallbackHookId: msg.FallbackHookId,
+	}
+
+	err = ms.k.domainRoutingHooks.Set(ctx, nextId.GetInternalId(), domainRoutingHook)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCreateDomainRoutingHook{
+		Id:             domainRoutingHook.Id.String(),
+		Owner:          domainRoutingHook.Owner,
+		FallbackHookId: fallbackHookId,
+	})
+
+	return &types.MsgCreateDomainRoutingHookResponse{
+		Id: nextId,
+	}, nil
+}
+
+func (ms msgServer) SetDomainRoutingHookRoute(ctx context.Context, msg *types.MsgSetDomainRoutingHookRoute) (*types.MsgSetDomainRoutingHookRouteResponse, error) {
+	if _, err := ms.k.ownedDomainRoutingHook(ctx, msg.Id, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.assertHookExists(ctx, msg.Route.HookId); err != nil {
+		return nil, err
+	}
+
+	// A route must not call the routing hook again, otherwise dispatching would never terminate.
+	cycle, err := ms.k.hookReaches(ctx, msg.Route.HookId, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle {
+		return nil, fmt.Errorf("route to hook %s would create a cycle", msg.Route.HookId.String())
+	}
+
+	if err = ms.k.domainRoutingHookRoutes.Set(ctx, collections.Join(msg.Id.GetInternalId(), msg.Route.Destination), msg.Route); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSetDomainRoutingHookRoute{
+		Id:          msg.Id.String(),
+		Destination: msg.Route.Destination,
+		HookId:      msg.Route.HookId.String(),
+	})
+
+	return &types.MsgSetDomainRoutingHookRouteResponse{}, nil
+}
+
+func (ms msgServer) RemoveDomainRoutingHookRoute(ctx context.Context, msg *types.MsgRemoveDomainRoutingHookRoute) (*types.MsgRemoveDomainRoutingHookRouteResponse, error) {
+	if _, err := ms.k.ownedDomainRoutingHook(ctx, msg.Id, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.Id.GetInternalId(), msg.Destination)
+	exists, err := ms.k.domainRoutingHookRoutes.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("no route for destination domain %d in hook %s", msg.Destination, msg.Id.String())
+	}
+
+	if err = ms.k.domainRoutingHookRoutes.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRemoveDomainRoutingHookRoute{
+		Id:          msg.Id.String(),
+		Destination: msg.Destination,
+	})
+
+	return &types.MsgRemoveDomainRoutingHookRouteResponse{}, nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (ms msgServer) CreateProtocolFeeHook(ctx context.Context, msg *types.MsgCreateProtocolFeeHook) (*types.MsgCreateProtocolFeeHookResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+		return nil, fmt.Errorf("invalid beneficiary: %w", err)
+	}
+
+	if err := types.ValidateProtocolFee(msg.ProtocolFee, msg.MaxProtocolFee); err != nil {
+		return nil, err
+	}
+
+	nextId, err := ms.k.coreKeeper.PostDispatchRouter().GetNextSequence(ctx, types.POST_DISPATCH_HOOK_TYPE_PROTOCOL_FEE)
+	if err != nil {
+		return nil, err
+	}
+	protocolFeeHook := types.ProtocolFeeHook{
+		Id:             nextId,
+		Owner:          msg.Owner,
+		Beneficiary:    msg.Beneficiary,
+		ProtocolFee:    msg.ProtocolFee,
+		MaxProtocolFee: msg.MaxProtocolFee,
+		ClaimableFees:  sdk.NewCoins(),
+	}
+
+	if err = ms.k.protocolFeeHooks.Set(ctx, nextId.GetInternalId(), protocolFeeHook); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCreateProtocolFeeHook{
+		Id:             protocolFeeHook.Id.String(),
+		Owner:          protocolFeeHook.Owner,
+		Beneficiary:    protocolFeeHook.Beneficiary,
+		ProtocolFee:    protocolFeeHook.ProtocolFee.String(),
+		MaxProtocolFee: protocolFeeHook.MaxProtocolFee.String(),
+	})
+
+	return &types.MsgCreateProtocolFeeHookResponse{
+		Id: nextId,
+	}, nil
+}
+
+func (ms msgServer) SetProtocolFeeHook(ctx context.Context, msg *types.MsgSetProtocolFeeHook) (*types.MsgSetProtocolFeeHookResponse, error) {
+	protocolFeeHook, err := ms.k.protocolFeeHooks.Get(ctx, msg.HookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protocol fee hook with id: %s", msg.HookId.String())
+	}
+
+	if protocolFeeHook.Owner != msg.Owner {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "%s does not own protocol fee hook with id %s", msg.Owner, msg.HookId.String())
+	}
+
+	if msg.ProtocolFee == nil && msg.Beneficiary == "" && msg.NewOwner == "" && !msg.RenounceOwnership {
+		return nil, fmt.Errorf("protocol fee, beneficiary, new owner or renounce ownership required")
+	}
+
+	if msg.ProtocolFee != nil {
+		if err = types.ValidateProtocolFee(*msg.ProtocolFee, protocolFeeHook.MaxProtocolFee); err != nil {
+			return nil, err
+		}
+		protocolFeeHook.ProtocolFee = *msg.ProtocolFee
+	}
+
+	if msg.Beneficiary != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return nil, fmt.Errorf("invalid beneficiary: %w", err)
+		}
+		protocolFeeHook.Beneficiary = msg.Beneficiary
+	}
+
+	if msg.RenounceOwnership && msg.NewOwner != "" {
+		return nil, errors.Wrap(types.ErrInvalidOwner, "cannot set new owner and renounce ownership at the same time")
+	}
+
+	if msg.NewOwner != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+			return nil, errors.Wrap(types.ErrInvalidOwner, "invalid new owner")
+		}
+		protocolFeeHook.Owner = msg.NewOwner
+	}
+
+	if msg.RenounceOwnership {
+		protocolFeeHook.Owner = ""
+	}
+
+	if err = ms.k.protocolFeeHooks.Set(ctx, msg.HookId.GetInternalId(), protocolFeeHook); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSetProtocolFeeHook{
+		Id:          protocolFeeHook.Id.String(),
+		Owner:       protocolFeeHook.Owner,
+		Beneficiary: protocolFeeHook.Beneficiary,
+		ProtocolFee: protocolFeeHook.ProtocolFee.String(),
+	})
+
+	return &types.MsgSetProtocolFeeHookResponse{}, nil
+}
+
+// ClaimProtocolFees sends the collected fees of a protocol fee hook to its beneficiary.
+// Only the owner or the beneficiary are allowed to do so.
+func (ms msgServer) ClaimProtocolFees(ctx context.Context, msg *types.MsgClaimProtocolFees) (*types.MsgClaimProtocolFeesResponse, error) {
+	protocolFeeHook, err := ms.k.protocolFeeHooks.Get(ctx, msg.HookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protocol fee hook with id: %s", msg.HookId.String())
+	}
+
+	if msg.Sender != protocolFeeHook.Owner && msg.Sender != protocolFeeHook.Beneficiary {
+		return nil, fmt.Errorf("failed to claim: %s is not permitted to claim", msg.Sender)
+	}
+
+	if protocolFeeHook.ClaimableFees.IsZero() {
+		return nil, fmt.Errorf("no claimable fees left")
+	}
+
+	beneficiary, err := sdk.AccAddressFromBech32(protocolFeeHook.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, "hyperlane", beneficiary, protocolFeeHook.ClaimableFees)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := protocolFeeHook.ClaimableFees
+	protocolFeeHook.ClaimableFees = sdk.NewCoins()
+
+	if err = ms.k.protocolFeeHooks.Set(ctx, msg.HookId.GetInternalId(), protocolFeeHook); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventClaimProtocolFees{
+		Id:          protocolFeeHook.Id.String(),
+		Beneficiary: protocolFeeHook.Beneficiary,
+		Amount:      claimed.String(),
+	})
+
+	return &types.MsgClaimProtocolFeesResponse{}, nil
+}
@@ -2,11 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
 )
 
@@ -34,7 +34,6 @@ func (ms msgServer) CreateMerkleTreeHook(ctx context.Context, msg *types.MsgCrea
 		Id:        nextId,
 		MailboxId: msg.MailboxId.String(),
 		Owner:     msg.Owner,
-		Tree:      types.ProtoFromTree(util.NewTree(util.ZeroHashes, 0)),
 	}
 
 	err = ms.k.merkleTreeHooks.Set(ctx, merkleTreeHook.Id.GetInternalId(), merkleTreeHook)
@@ -42,6 +41,11 @@ func (ms msgServer) CreateMerkleTreeHook(ctx context.Context, msg *types.MsgCrea
 		return nil, err
 	}
 
+	err = ms.k.merkleTreeCounts.Set(ctx, merkleTreeHook.Id.GetInternalId(), 0)
+	if err != nil {
+		return nil, err
+	}
+
 	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCreateMerkleTreeHook{
 		Id:        merkleTreeHook.Id.String(),
 		MailboxId: merkleTreeHook.MailboxId,
@@ -77,3 +81,42 @@ func (ms msgServer) CreateNoopHook(ctx context.Context, msg *types.MsgCreateNoop
 		Id: nextId,
 	}, nil
 }
+
+func (ms msgServer) CreateAggregationHook(ctx context.Context, msg *types.MsgCreateAggregationHook) (*types.MsgCreateAggregationHookResponse, error) {
+	if len(msg.HookIds) == 0 {
+		return nil, fmt.Errorf("at least one hook id is required")
+	}
+
+	hookIds := make([]string, len(msg.HookIds))
+	for idx, hookId := range msg.HookIds {
+		if err := ms.k.assertHookExists(ctx, hookId); err != nil {
+			return nil, err
+		}
+		hookIds[idx] = hookId.String()
+	}
+
+	nextId, err := ms.k.coreKeeper.PostDispatchRouter().GetNextSequence(ctx, types.POST_DISPATCH_HOOK_TYPE_AGGREGATION)
+	if err != nil {
+		return nil, err
+	}
+	aggregationHook := types.AggregationHook{
+		Id:      nextId,
+		Owner:   msg.Owner,
+		HookIds: msg.HookIds,
+	}
+
+	err = ms.k.aggregationHooks.Set(ctx, nextId.GetInternalId(), aggregationHook)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCreateAggregationHook{
+		Id:      aggregationHook.Id.String(),
+		Owner:   aggregationHook.Owner,
+		HookIds: hookIds,
+	})
+
+	return &types.MsgCreateAggregationHookResponse{
+		Id: nextId,
+	}, nil
+}
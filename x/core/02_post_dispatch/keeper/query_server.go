@@ -134,7 +134,7 @@ func (qs queryServer) MerkleTreeHooks(ctx context.Context, req *types.QueryMerkl
 	responses := make([]types.WrappedMerkleTreeHookResponse, len(values))
 	for i := 0; i < len(values); i++ {
 		merkleTreeHook := values[i]
-		tree, err := types.TreeFromProto(merkleTreeHook.Tree)
+		tree, err := qs.k.merkleTree(ctx, merkleTreeHook.Id.GetInternalId())
 		if err != nil {
 			return nil, err
 		}
@@ -146,9 +146,9 @@ func (qs queryServer) MerkleTreeHooks(ctx context.Context, req *types.QueryMerkl
 			Owner:     merkleTreeHook.Owner,
 			MailboxId: merkleTreeHook.MailboxId,
 			MerkleTree: &types.TreeResponse{
-				Count: merkleTreeHook.Tree.Count,
+				Count: tree.GetCount(),
 				Root:  root[:],
-				Leafs: merkleTreeHook.Tree.Branch,
+				Leafs: types.ProtoFromTree(tree).Branch,
 			},
 		}
 	}
@@ -170,7 +170,7 @@ func (qs queryServer) MerkleTreeHook(ctx context.Context, req *types.QueryMerkle
 		return nil, err
 	}
 
-	tree, err := types.TreeFromProto(merkleTreeHook.Tree)
+	tree, err := qs.k.merkleTree(ctx, merkleTreeHook.Id.GetInternalId())
 	if err != nil {
 		return nil, err
 	}
@@ -182,13 +182,87 @@ func (qs queryServer) MerkleTreeHook(ctx context.Context, req *types.QueryMerkle
 		Owner:     merkleTreeHook.Owner,
 		MailboxId: merkleTreeHook.MailboxId,
 		MerkleTree: &types.TreeResponse{
-			Count: merkleTreeHook.Tree.Count,
+			Count: tree.GetCount(),
 			Root:  root[:],
-			Leafs: merkleTreeHook.Tree.Branch,
+			Leafs: types.ProtoFromTree(tree).Branch,
 		},
 	}}, nil
 }
 
+func (qs queryServer) MerkleTreeRoot(ctx context.Context, req *types.QueryMerkleTreeRootRequest) (*types.QueryMerkleTreeRootResponse, error) {
+	merkleTreeHookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := qs.k.merkleTree(ctx, merkleTreeHookId.GetInternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	root := tree.GetRoot()
+
+	return &types.QueryMerkleTreeRootResponse{
+		Root:  root[:],
+		Count: tree.GetCount(),
+	}, nil
+}
+
+func (qs queryServer) MerkleTreeLatestCheckpoint(ctx context.Context, req *types.QueryMerkleTreeLatestCheckpointRequest) (*types.QueryMerkleTreeLatestCheckpointResponse, error) {
+	merkleTreeHookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := qs.k.MerkleTreeLatestCheckpoint(ctx, merkleTreeHookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMerkleTreeLatestCheckpointResponse{Checkpoint: checkpoint}, nil
+}
+
+func (qs queryServer) MerkleTreeCheckpoint(ctx context.Context, req *types.QueryMerkleTreeCheckpointRequest) (*types.QueryMerkleTreeCheckpointResponse, error) {
+	merkleTreeHookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	height, checkpoint, err := qs.k.MerkleTreeCheckpointAtHeight(ctx, merkleTreeHookId, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMerkleTreeCheckpointResponse{
+		Height:     height,
+		Checkpoint: checkpoint,
+	}, nil
+}
+
+func (qs queryServer) MerkleTreeProof(ctx context.Context, req *types.QueryMerkleTreeProofRequest) (*types.QueryMerkleTreeProofResponse, error) {
+	merkleTreeHookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf, proof, root, err := qs.k.MerkleTreeProof(ctx, merkleTreeHookId, req.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := make([][]byte, len(proof))
+	for i := range proof {
+		siblings[i] = proof[i][:]
+	}
+
+	return &types.QueryMerkleTreeProofResponse{
+		Leaf:  leaf[:],
+		Index: req.Index,
+		Proof: siblings,
+		Root:  root[:],
+	}, nil
+}
+
 //
 // Noop Hook
 
@@ -219,3 +293,109 @@ func (qs queryServer) NoopHooks(ctx context.Context, req *types.QueryNoopHooksRe
 		Pagination: pagination,
 	}, nil
 }
+
+//
+// Aggregation Hook
+
+func (qs queryServer) AggregationHooks(ctx context.Context, req *types.QueryAggregationHooksRequest) (*types.QueryAggregationHooksResponse, error) {
+	values, pagination, err := util.GetPaginatedFromMap(ctx, qs.k.aggregationHooks, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregationHooksResponse{
+		AggregationHooks: values,
+		Pagination:       pagination,
+	}, nil
+}
+
+func (qs queryServer) AggregationHook(ctx context.Context, req *types.QueryAggregationHookRequest) (*types.QueryAggregationHookResponse, error) {
+	hookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregationHook, err := qs.k.aggregationHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find aggregation hook with id: %v", hookId.String())
+	}
+
+	return &types.QueryAggregationHookResponse{
+		AggregationHook: aggregationHook,
+	}, nil
+}
+
+//
+// Domain Routing Hook
+
+func (qs queryServer) DomainRoutingHooks(ctx context.Context, req *types.QueryDomainRoutingHooksRequest) (*types.QueryDomainRoutingHooksResponse, error) {
+	values, pagination, err := util.GetPaginatedFromMap(ctx, qs.k.domainRoutingHooks, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDomainRoutingHooksResponse{
+		DomainRoutingHooks: values,
+		Pagination:         pagination,
+	}, nil
+}
+
+func (qs queryServer) DomainRoutingHook(ctx context.Context, req *types.QueryDomainRoutingHookRequest) (*types.QueryDomainRoutingHookResponse, error) {
+	hookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	domainRoutingHook, err := qs.k.domainRoutingHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find domain routing hook with id: %v", hookId.String())
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, uint32](hookId.GetInternalId())
+
+	iter, err := qs.k.domainRoutingHookRoutes.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDomainRoutingHookResponse{
+		DomainRoutingHook: domainRoutingHook,
+		Routes:            routes,
+	}, nil
+}
+
+//
+// Protocol Fee Hook
+
+func (qs queryServer) ProtocolFeeHooks(ctx context.Context, req *types.QueryProtocolFeeHooksRequest) (*types.QueryProtocolFeeHooksResponse, error) {
+	values, pagination, err := util.GetPaginatedFromMap(ctx, qs.k.protocolFeeHooks, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProtocolFeeHooksResponse{
+		ProtocolFeeHooks: values,
+		Pagination:       pagination,
+	}, nil
+}
+
+func (qs queryServer) ProtocolFeeHook(ctx context.Context, req *types.QueryProtocolFeeHookRequest) (*types.QueryProtocolFeeHookResponse, error) {
+	hookId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	protocolFeeHook, err := qs.k.protocolFeeHooks.Get(ctx, hookId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find protocol fee hook with id: %v", hookId.String())
+	}
+
+	return &types.QueryProtocolFeeHookResponse{
+		ProtocolFeeHook: protocolFeeHook,
+	}, nil
+}
@@ -16,6 +16,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgClaim{},
 		&MsgCreateMerkleTreeHook{},
 		&MsgCreateNoopHook{},
+		&MsgCreateAggregationHook{},
+		&MsgCreateDomainRoutingHook{},
+		&MsgSetDomainRoutingHookRoute{},
+		&MsgRemoveDomainRoutingHookRoute{},
+		&MsgCreateProtocolFeeHook{},
+		&MsgSetProtocolFeeHook{},
+		&MsgClaimProtocolFees{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -8,4 +8,5 @@ var (
 	ErrHookDoesNotExistOrIsNotRegistered = errors.Register(SubModuleName, 3, "hook does not exist or isn't registered")
 	ErrUnauthorized                      = errors.Register(SubModuleName, 4, "unauthorized")
 	ErrInvalidOwner                      = errors.Register(SubModuleName, 5, "invalid owner")
+	ErrMerkleTreeNodeNotStored           = errors.Register(SubModuleName, 6, "merkle tree node is not stored")
 )
@@ -286,204 +286,624 @@ func (m *EventCreateNoopHook) GetOwner() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventCreateMerkleTreeHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateMerkleTreeHook")
-	proto.RegisterType((*InsertedIntoTree)(nil), "hyperlane.core.post_dispatch.v1.InsertedIntoTree")
-	proto.RegisterType((*GasPayment)(nil), "hyperlane.core.post_dispatch.v1.GasPayment")
-	proto.RegisterType((*EventCreateNoopHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateNoopHook")
+// EventCreateAggregationHook ...
+type EventCreateAggregationHook struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// hook_ids ...
+	HookIds []string `protobuf:"bytes,3,rep,name=hook_ids,json=hookIds,proto3" json:"hook_ids,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("hyperlane/core/post_dispatch/v1/events.proto", fileDescriptor_158483b25b83c3db)
+func (m *EventCreateAggregationHook) Reset()         { *m = EventCreateAggregationHook{} }
+func (m *EventCreateAggregationHook) String() string { return proto.CompactTextString(m) }
+func (*EventCreateAggregationHook) ProtoMessage()    {}
+func (*EventCreateAggregationHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{4}
 }
-
-var fileDescriptor_158483b25b83c3db = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x9b, 0x40, 0x8b, 0x62, 0x04, 0xaa, 0x52, 0x90, 0xc2, 0x40, 0xa8, 0x32, 0x31, 0xd0,
-	0x84, 0xc2, 0xc8, 0x04, 0x08, 0x41, 0x06, 0x10, 0xaa, 0x98, 0x58, 0x82, 0x13, 0x1f, 0x25, 0x56,
-	0x1b, 0x1f, 0xcb, 0x76, 0x43, 0xfb, 0x16, 0xbc, 0x00, 0xef, 0xc3, 0xd8, 0x91, 0xf1, 0xaa, 0x7d,
-	0x91, 0xab, 0x38, 0xb9, 0x51, 0x2b, 0x5d, 0xe9, 0x8e, 0xe7, 0xf7, 0xf1, 0xff, 0xd9, 0xbf, 0x7e,
-	0xf2, 0xaa, 0xda, 0x4b, 0x50, 0x1b, 0x2a, 0x20, 0x29, 0x50, 0x41, 0x22, 0x51, 0x9b, 0x8c, 0x71,
-	0x2d, 0xa9, 0x29, 0xaa, 0xa4, 0x59, 0x26, 0xd0, 0x80, 0x30, 0x3a, 0x96, 0x0a, 0x0d, 0xfa, 0x2f,
-	0x86, 0xed, 0xb8, 0xdd, 0x8e, 0x2f, 0xb6, 0xe3, 0x66, 0x19, 0xfd, 0x22, 0xcf, 0x3e, 0xb5, 0x17,
-	0x3e, 0x2a, 0xa0, 0x06, 0xbe, 0x82, 0x5a, 0x6f, 0xe0, 0x87, 0x02, 0xf8, 0x82, 0xb8, 0xf6, 0x1f,
-	0x13, 0x97, 0xb3, 0xc0, 0x99, 0x3b, 0x2f, 0xbd, 0x95, 0xcb, 0x99, 0xff, 0x9c, 0x90, 0x9a, 0xf2,
-	0x4d, 0x8e, 0xbb, 0x8c, 0xb3, 0xc0, 0xb5, 0xba, 0xd7, 0x2b, 0x29, 0xf3, 0x9f, 0x90, 0x31, 0xfe,
-	0x16, 0xa0, 0x82, 0x7b, 0xf6, 0xa4, 0x1b, 0xa2, 0x86, 0x4c, 0x53, 0xa1, 0x41, 0x19, 0x60, 0xa9,
-	0x30, 0xd8, 0x9a, 0x5b, 0x23, 0xd0, 0x9a, 0x96, 0x90, 0x0d, 0x00, 0xaf, 0x57, 0x3a, 0x23, 0x2e,
-	0x18, 0xec, 0x2c, 0xe2, 0xd1, 0xaa, 0x1b, 0xfc, 0x05, 0x99, 0xd5, 0xf6, 0x7d, 0x99, 0x51, 0x00,
-	0x59, 0x85, 0xb8, 0x6e, 0x6f, 0x77, 0xb0, 0x69, 0x7d, 0xf1, 0xf4, 0x94, 0x45, 0x7f, 0x1d, 0x42,
-	0x3e, 0x53, 0xfd, 0x9d, 0xee, 0x6b, 0x10, 0xe6, 0x2e, 0xe4, 0x9c, 0x3c, 0x64, 0xa0, 0x0d, 0x17,
-	0xd4, 0x70, 0x14, 0x3d, 0xf8, 0x5c, 0x6a, 0x0d, 0x4a, 0xaa, 0x33, 0x5a, 0xe3, 0x56, 0x98, 0x9e,
-	0xea, 0x95, 0x54, 0xbf, 0xb7, 0x82, 0x1f, 0x90, 0x07, 0xb2, 0x43, 0x05, 0xf7, 0xed, 0xd9, 0xcd,
-	0xe8, 0x3f, 0x25, 0x13, 0x5e, 0xca, 0x96, 0x3a, 0xee, 0x72, 0xe1, 0xa5, 0x4c, 0x59, 0xf4, 0x8e,
-	0xcc, 0xce, 0x92, 0xff, 0x86, 0x28, 0x6f, 0xcd, 0x7c, 0x08, 0xd5, 0x3d, 0x0b, 0xf5, 0x43, 0xf1,
-	0xef, 0x18, 0x3a, 0x87, 0x63, 0xe8, 0x5c, 0x1d, 0x43, 0xe7, 0xcf, 0x29, 0x1c, 0x1d, 0x4e, 0xe1,
-	0xe8, 0xff, 0x29, 0x1c, 0xfd, 0x4c, 0x4b, 0x6e, 0xaa, 0x6d, 0x1e, 0x17, 0x58, 0x27, 0x79, 0x21,
-	0x17, 0x5c, 0x08, 0x6c, 0xec, 0x0f, 0x74, 0x32, 0x94, 0x61, 0x51, 0xa0, 0xae, 0x51, 0x27, 0xbb,
-	0xae, 0x43, 0xaf, 0xdf, 0x64, 0x97, 0x35, 0x32, 0x7b, 0x09, 0x3a, 0x9f, 0xd8, 0x0e, 0xbd, 0xbd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x60, 0xad, 0x12, 0x73, 0x02, 0x00, 0x00,
+func (m *EventCreateAggregationHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventCreateMerkleTreeHook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCreateAggregationHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateAggregationHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventCreateMerkleTreeHook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCreateAggregationHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateAggregationHook.Merge(m, src)
+}
+func (m *EventCreateAggregationHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateAggregationHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateAggregationHook.DiscardUnknown(m)
 }
 
-func (m *EventCreateMerkleTreeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MailboxId) > 0 {
-		i -= len(m.MailboxId)
-		copy(dAtA[i:], m.MailboxId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MailboxId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventCreateAggregationHook proto.InternalMessageInfo
+
+func (m *EventCreateAggregationHook) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventCreateAggregationHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *InsertedIntoTree) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCreateAggregationHook) GetHookIds() []string {
+	if m != nil {
+		return m.HookIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *InsertedIntoTree) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCreateDomainRoutingHook ...
+type EventCreateDomainRoutingHook struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// fallback_hook_id ...
+	FallbackHookId string `protobuf:"bytes,3,opt,name=fallback_hook_id,json=fallbackHookId,proto3" json:"fallback_hook_id,omitempty"`
 }
 
-func (m *InsertedIntoTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MerkleTreeHookId) > 0 {
-		i -= len(m.MerkleTreeHookId)
-		copy(dAtA[i:], m.MerkleTreeHookId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MerkleTreeHookId)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventCreateDomainRoutingHook) Reset()         { *m = EventCreateDomainRoutingHook{} }
+func (m *EventCreateDomainRoutingHook) String() string { return proto.CompactTextString(m) }
+func (*EventCreateDomainRoutingHook) ProtoMessage()    {}
+func (*EventCreateDomainRoutingHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{5}
+}
+func (m *EventCreateDomainRoutingHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateDomainRoutingHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateDomainRoutingHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Index != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventCreateDomainRoutingHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateDomainRoutingHook.Merge(m, src)
+}
+func (m *EventCreateDomainRoutingHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateDomainRoutingHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateDomainRoutingHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateDomainRoutingHook proto.InternalMessageInfo
+
+func (m *EventCreateDomainRoutingHook) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.MessageId) > 0 {
-		i -= len(m.MessageId)
-		copy(dAtA[i:], m.MessageId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventCreateDomainRoutingHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GasPayment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCreateDomainRoutingHook) GetFallbackHookId() string {
+	if m != nil {
+		return m.FallbackHookId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GasPayment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetDomainRoutingHookRoute ...
+type EventSetDomainRoutingHookRoute struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// destination ...
+	Destination uint32 `protobuf:"varint,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// hook_id ...
+	HookId string `protobuf:"bytes,3,opt,name=hook_id,json=hookId,proto3" json:"hook_id,omitempty"`
 }
 
-func (m *GasPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IgpId) > 0 {
-		i -= len(m.IgpId)
-		copy(dAtA[i:], m.IgpId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.IgpId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventSetDomainRoutingHookRoute) Reset()         { *m = EventSetDomainRoutingHookRoute{} }
+func (m *EventSetDomainRoutingHookRoute) String() string { return proto.CompactTextString(m) }
+func (*EventSetDomainRoutingHookRoute) ProtoMessage()    {}
+func (*EventSetDomainRoutingHookRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{6}
+}
+func (m *EventSetDomainRoutingHookRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetDomainRoutingHookRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetDomainRoutingHookRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Payment) > 0 {
-		i -= len(m.Payment)
-		copy(dAtA[i:], m.Payment)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payment)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventSetDomainRoutingHookRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetDomainRoutingHookRoute.Merge(m, src)
+}
+func (m *EventSetDomainRoutingHookRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetDomainRoutingHookRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetDomainRoutingHookRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetDomainRoutingHookRoute proto.InternalMessageInfo
+
+func (m *EventSetDomainRoutingHookRoute) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.GasAmount) > 0 {
-		i -= len(m.GasAmount)
-		copy(dAtA[i:], m.GasAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GasAmount)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventSetDomainRoutingHookRoute) GetDestination() uint32 {
+	if m != nil {
+		return m.Destination
 	}
-	if m.Destination != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventSetDomainRoutingHookRoute) GetHookId() string {
+	if m != nil {
+		return m.HookId
 	}
-	if len(m.MessageId) > 0 {
-		i -= len(m.MessageId)
-		copy(dAtA[i:], m.MessageId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// EventRemoveDomainRoutingHookRoute ...
+type EventRemoveDomainRoutingHookRoute struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// destination ...
+	Destination uint32 `protobuf:"varint,2,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (m *EventRemoveDomainRoutingHookRoute) Reset()         { *m = EventRemoveDomainRoutingHookRoute{} }
+func (m *EventRemoveDomainRoutingHookRoute) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveDomainRoutingHookRoute) ProtoMessage()    {}
+func (*EventRemoveDomainRoutingHookRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{7}
+}
+func (m *EventRemoveDomainRoutingHookRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveDomainRoutingHookRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveDomainRoutingHookRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventRemoveDomainRoutingHookRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveDomainRoutingHookRoute.Merge(m, src)
+}
+func (m *EventRemoveDomainRoutingHookRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveDomainRoutingHookRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveDomainRoutingHookRoute.DiscardUnknown(m)
 }
 
-func (m *EventCreateNoopHook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventRemoveDomainRoutingHookRoute proto.InternalMessageInfo
+
+func (m *EventRemoveDomainRoutingHookRoute) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCreateNoopHook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRemoveDomainRoutingHookRoute) GetDestination() uint32 {
+	if m != nil {
+		return m.Destination
+	}
+	return 0
 }
 
-func (m *EventCreateNoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+// EventCreateProtocolFeeHook ...
+type EventCreateProtocolFeeHook struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// beneficiary ...
+	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// protocol_fee ...
+	ProtocolFee string `protobuf:"bytes,4,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee,omitempty"`
+	// max_protocol_fee ...
+	MaxProtocolFee string `protobuf:"bytes,5,opt,name=max_protocol_fee,json=maxProtocolFee,proto3" json:"max_protocol_fee,omitempty"`
+}
+
+func (m *EventCreateProtocolFeeHook) Reset()         { *m = EventCreateProtocolFeeHook{} }
+func (m *EventCreateProtocolFeeHook) String() string { return proto.CompactTextString(m) }
+func (*EventCreateProtocolFeeHook) ProtoMessage()    {}
+func (*EventCreateProtocolFeeHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{8}
+}
+func (m *EventCreateProtocolFeeHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateProtocolFeeHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateProtocolFeeHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateProtocolFeeHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateProtocolFeeHook.Merge(m, src)
+}
+func (m *EventCreateProtocolFeeHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateProtocolFeeHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateProtocolFeeHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateProtocolFeeHook proto.InternalMessageInfo
+
+func (m *EventCreateProtocolFeeHook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventCreateProtocolFeeHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventCreateProtocolFeeHook) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *EventCreateProtocolFeeHook) GetProtocolFee() string {
+	if m != nil {
+		return m.ProtocolFee
+	}
+	return ""
+}
+
+func (m *EventCreateProtocolFeeHook) GetMaxProtocolFee() string {
+	if m != nil {
+		return m.MaxProtocolFee
+	}
+	return ""
+}
+
+// EventSetProtocolFeeHook ...
+type EventSetProtocolFeeHook struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// beneficiary ...
+	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// protocol_fee ...
+	ProtocolFee string `protobuf:"bytes,4,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee,omitempty"`
+}
+
+func (m *EventSetProtocolFeeHook) Reset()         { *m = EventSetProtocolFeeHook{} }
+func (m *EventSetProtocolFeeHook) String() string { return proto.CompactTextString(m) }
+func (*EventSetProtocolFeeHook) ProtoMessage()    {}
+func (*EventSetProtocolFeeHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{9}
+}
+func (m *EventSetProtocolFeeHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetProtocolFeeHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetProtocolFeeHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetProtocolFeeHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetProtocolFeeHook.Merge(m, src)
+}
+func (m *EventSetProtocolFeeHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetProtocolFeeHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetProtocolFeeHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetProtocolFeeHook proto.InternalMessageInfo
+
+func (m *EventSetProtocolFeeHook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventSetProtocolFeeHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventSetProtocolFeeHook) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *EventSetProtocolFeeHook) GetProtocolFee() string {
+	if m != nil {
+		return m.ProtocolFee
+	}
+	return ""
+}
+
+// ProtocolFeePayment ...
+type ProtocolFeePayment struct {
+	// message_id ...
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// payer ...
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// payment ...
+	Payment string `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	// hook_id ...
+	HookId string `protobuf:"bytes,4,opt,name=hook_id,json=hookId,proto3" json:"hook_id,omitempty"`
+}
+
+func (m *ProtocolFeePayment) Reset()         { *m = ProtocolFeePayment{} }
+func (m *ProtocolFeePayment) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFeePayment) ProtoMessage()    {}
+func (*ProtocolFeePayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{10}
+}
+func (m *ProtocolFeePayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFeePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFeePayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFeePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFeePayment.Merge(m, src)
+}
+func (m *ProtocolFeePayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFeePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFeePayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFeePayment proto.InternalMessageInfo
+
+func (m *ProtocolFeePayment) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *ProtocolFeePayment) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *ProtocolFeePayment) GetPayment() string {
+	if m != nil {
+		return m.Payment
+	}
+	return ""
+}
+
+func (m *ProtocolFeePayment) GetHookId() string {
+	if m != nil {
+		return m.HookId
+	}
+	return ""
+}
+
+// EventClaimProtocolFees ...
+type EventClaimProtocolFees struct {
+	// id ...
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// beneficiary ...
+	Beneficiary string `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// amount ...
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventClaimProtocolFees) Reset()         { *m = EventClaimProtocolFees{} }
+func (m *EventClaimProtocolFees) String() string { return proto.CompactTextString(m) }
+func (*EventClaimProtocolFees) ProtoMessage()    {}
+func (*EventClaimProtocolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_158483b25b83c3db, []int{11}
+}
+func (m *EventClaimProtocolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimProtocolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimProtocolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimProtocolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimProtocolFees.Merge(m, src)
+}
+func (m *EventClaimProtocolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimProtocolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimProtocolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimProtocolFees proto.InternalMessageInfo
+
+func (m *EventClaimProtocolFees) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventClaimProtocolFees) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *EventClaimProtocolFees) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventCreateMerkleTreeHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateMerkleTreeHook")
+	proto.RegisterType((*InsertedIntoTree)(nil), "hyperlane.core.post_dispatch.v1.InsertedIntoTree")
+	proto.RegisterType((*GasPayment)(nil), "hyperlane.core.post_dispatch.v1.GasPayment")
+	proto.RegisterType((*EventCreateNoopHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateNoopHook")
+	proto.RegisterType((*EventCreateAggregationHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateAggregationHook")
+	proto.RegisterType((*EventCreateDomainRoutingHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateDomainRoutingHook")
+	proto.RegisterType((*EventSetDomainRoutingHookRoute)(nil), "hyperlane.core.post_dispatch.v1.EventSetDomainRoutingHookRoute")
+	proto.RegisterType((*EventRemoveDomainRoutingHookRoute)(nil), "hyperlane.core.post_dispatch.v1.EventRemoveDomainRoutingHookRoute")
+	proto.RegisterType((*EventCreateProtocolFeeHook)(nil), "hyperlane.core.post_dispatch.v1.EventCreateProtocolFeeHook")
+	proto.RegisterType((*EventSetProtocolFeeHook)(nil), "hyperlane.core.post_dispatch.v1.EventSetProtocolFeeHook")
+	proto.RegisterType((*ProtocolFeePayment)(nil), "hyperlane.core.post_dispatch.v1.ProtocolFeePayment")
+	proto.RegisterType((*EventClaimProtocolFees)(nil), "hyperlane.core.post_dispatch.v1.EventClaimProtocolFees")
+}
+
+func init() {
+	proto.RegisterFile("hyperlane/core/post_dispatch/v1/events.proto", fileDescriptor_158483b25b83c3db)
+}
+
+var fileDescriptor_158483b25b83c3db = []byte{
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xeb, 0xa4, 0x4d, 0x7f, 0x99, 0xfe, 0xa8, 0x2a, 0xb7, 0xb4, 0x29, 0x02, 0x93, 0xfa,
+	0xd4, 0x03, 0x8d, 0x29, 0x1c, 0x39, 0x95, 0xff, 0x39, 0x80, 0xaa, 0x00, 0x17, 0x24, 0x64, 0xd6,
+	0xf6, 0xd4, 0x59, 0xc5, 0xfb, 0x47, 0xde, 0x6d, 0x48, 0x24, 0x8e, 0x3c, 0x00, 0x2f, 0xc0, 0x43,
+	0xf0, 0x16, 0x1c, 0x7b, 0xe4, 0x88, 0xda, 0x17, 0x41, 0x5e, 0xbb, 0xe9, 0x9a, 0x56, 0x6a, 0x2b,
+	0x21, 0x71, 0xf3, 0x8c, 0x77, 0xe7, 0xf3, 0xdd, 0xef, 0xcc, 0x2e, 0xdc, 0x1b, 0x4e, 0x25, 0xe6,
+	0x19, 0xe1, 0x18, 0xc4, 0x22, 0xc7, 0x40, 0x0a, 0xa5, 0xc3, 0x84, 0x2a, 0x49, 0x74, 0x3c, 0x0c,
+	0xc6, 0xbb, 0x01, 0x8e, 0x91, 0x6b, 0xd5, 0x93, 0xb9, 0xd0, 0xc2, 0xbd, 0x3b, 0x5b, 0xdd, 0x2b,
+	0x56, 0xf7, 0x6a, 0xab, 0x7b, 0xe3, 0x5d, 0xff, 0x23, 0x6c, 0x3e, 0x2b, 0x36, 0x3c, 0xc9, 0x91,
+	0x68, 0x7c, 0x85, 0xf9, 0x28, 0xc3, 0xb7, 0x39, 0xe2, 0x4b, 0x21, 0x46, 0xee, 0x32, 0x34, 0x68,
+	0xd2, 0x71, 0xba, 0xce, 0x76, 0x7b, 0xd0, 0xa0, 0x89, 0x7b, 0x07, 0x80, 0x11, 0x9a, 0x45, 0x62,
+	0x12, 0xd2, 0xa4, 0xd3, 0x30, 0xf9, 0x76, 0x95, 0xe9, 0x27, 0xee, 0x1a, 0x2c, 0x88, 0x4f, 0x1c,
+	0xf3, 0x4e, 0xd3, 0xfc, 0x29, 0x03, 0x7f, 0x0c, 0x2b, 0x7d, 0xae, 0x30, 0xd7, 0x98, 0xf4, 0xb9,
+	0x16, 0x45, 0x71, 0x53, 0x08, 0x95, 0x22, 0x29, 0x86, 0x33, 0x40, 0xbb, 0xca, 0x94, 0x85, 0x28,
+	0x4f, 0x70, 0x62, 0x10, 0x37, 0x06, 0x65, 0xe0, 0xee, 0xc0, 0x2a, 0x33, 0xfa, 0x42, 0x9d, 0x23,
+	0x86, 0x43, 0x21, 0x46, 0xc5, 0xee, 0x12, 0xb6, 0xc2, 0x6a, 0xd2, 0xfb, 0x89, 0xff, 0xcd, 0x01,
+	0x78, 0x41, 0xd4, 0x3e, 0x99, 0x32, 0xe4, 0xfa, 0x32, 0x64, 0x17, 0x96, 0x12, 0x54, 0x9a, 0x72,
+	0xa2, 0xa9, 0xe0, 0x15, 0xd8, 0x4e, 0x15, 0x05, 0x52, 0xa2, 0x42, 0xc2, 0xc4, 0x21, 0xd7, 0x15,
+	0xb5, 0x9d, 0x12, 0xb5, 0x67, 0x12, 0x6e, 0x07, 0x16, 0x65, 0x89, 0xea, 0xcc, 0x9b, 0x7f, 0xa7,
+	0xa1, 0x7b, 0x13, 0x5a, 0x34, 0x95, 0x05, 0x75, 0xa1, 0xf4, 0x85, 0xa6, 0xb2, 0x9f, 0xf8, 0x8f,
+	0x60, 0xd5, 0x72, 0xfe, 0xb5, 0x10, 0xf2, 0x42, 0xcf, 0x67, 0xa6, 0x36, 0x6c, 0x53, 0x3f, 0xc0,
+	0x2d, 0x6b, 0xf3, 0x5e, 0x9a, 0xe6, 0x98, 0x1a, 0x99, 0x57, 0xaf, 0xe1, 0x6e, 0xc2, 0x7f, 0x95,
+	0x87, 0xaa, 0xd3, 0xec, 0x36, 0x0b, 0xc9, 0x43, 0x63, 0x9d, 0xf2, 0x39, 0xdc, 0xb6, 0xca, 0x3f,
+	0x15, 0x8c, 0x50, 0x3e, 0x10, 0x87, 0x9a, 0xf2, 0xf4, 0x1a, 0x80, 0x6d, 0x58, 0x39, 0x20, 0x59,
+	0x16, 0x91, 0x78, 0xf4, 0x47, 0xb7, 0x96, 0x4f, 0xf3, 0x55, 0xaf, 0x46, 0xe0, 0x19, 0xde, 0x1b,
+	0xd4, 0xe7, 0x60, 0xc5, 0x27, 0x9e, 0x23, 0x5e, 0xde, 0xaf, 0x0d, 0x58, 0xac, 0x43, 0x5b, 0xe5,
+	0xe9, 0xfc, 0x77, 0xb0, 0x65, 0x60, 0x03, 0x64, 0x62, 0x8c, 0x7f, 0x8b, 0xe7, 0x7f, 0x77, 0x6a,
+	0x3d, 0xd9, 0x2f, 0xee, 0x5f, 0x2c, 0xb2, 0xe7, 0x88, 0xd7, 0xb0, 0xac, 0x0b, 0x4b, 0x11, 0x72,
+	0x3c, 0xa0, 0x31, 0x25, 0xf9, 0xb4, 0x12, 0x6e, 0xa7, 0xdc, 0x2d, 0xf8, 0x5f, 0x56, 0xa5, 0xc3,
+	0x03, 0xc4, 0x6a, 0xd8, 0x96, 0xe4, 0x19, 0xae, 0xf0, 0x9d, 0x91, 0x49, 0x58, 0x5b, 0x56, 0x8e,
+	0xde, 0x32, 0x23, 0x13, 0x4b, 0x98, 0xff, 0xc5, 0x81, 0x8d, 0x53, 0xe3, 0xff, 0x9d, 0x60, 0xff,
+	0x33, 0xb8, 0x16, 0xfd, 0x8a, 0x37, 0x76, 0x0d, 0x16, 0x24, 0x99, 0x9e, 0xe9, 0x31, 0x81, 0x7d,
+	0x0d, 0x9b, 0xf5, 0x6b, 0x68, 0xcd, 0xc3, 0x7c, 0x6d, 0x1e, 0x22, 0x58, 0x2f, 0xfb, 0x96, 0x11,
+	0xca, 0x2c, 0x1d, 0xea, 0xa2, 0x21, 0xb0, 0x0f, 0xdb, 0x38, 0x7f, 0xd8, 0x75, 0x68, 0xd5, 0x1e,
+	0x88, 0x2a, 0x7a, 0x1c, 0xff, 0x38, 0xf6, 0x9c, 0xa3, 0x63, 0xcf, 0xf9, 0x75, 0xec, 0x39, 0x5f,
+	0x4f, 0xbc, 0xb9, 0xa3, 0x13, 0x6f, 0xee, 0xe7, 0x89, 0x37, 0xf7, 0xbe, 0x9f, 0x52, 0x3d, 0x3c,
+	0x8c, 0x7a, 0xb1, 0x60, 0x41, 0x14, 0xcb, 0x1d, 0xca, 0xb9, 0x18, 0x9b, 0x89, 0x52, 0xc1, 0xec,
+	0xf1, 0xde, 0x89, 0x85, 0x62, 0x42, 0x05, 0x93, 0xf2, 0xcd, 0xbf, 0xff, 0x20, 0xac, 0x3f, 0xfb,
+	0x7a, 0x2a, 0x51, 0x45, 0x2d, 0xe3, 0xe9, 0xc3, 0xdf, 0x03, 0x00, 0xbf, 0xf7, 0x3a, 0xcb, 0x23,
+	0x06, 0x00, 0x00,
+}
+
+func (m *EventCreateMerkleTreeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateMerkleTreeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateMerkleTreeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -493,6 +913,13 @@ func (m *EventCreateNoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MailboxId) > 0 {
+		i -= len(m.MailboxId)
+		copy(dAtA[i:], m.MailboxId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MailboxId)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Id) > 0 {
@@ -505,110 +932,1912 @@ func (m *EventCreateNoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InsertedIntoTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateMerkleTreeHook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InsertedIntoTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsertedIntoTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.MailboxId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MerkleTreeHookId) > 0 {
+		i -= len(m.MerkleTreeHookId)
+		copy(dAtA[i:], m.MerkleTreeHookId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MerkleTreeHookId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InsertedIntoTree) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GasPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MessageId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.IgpId) > 0 {
+		i -= len(m.IgpId)
+		copy(dAtA[i:], m.IgpId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.IgpId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Index != 0 {
-		n += 1 + sovEvents(uint64(m.Index))
+	if len(m.Payment) > 0 {
+		i -= len(m.Payment)
+		copy(dAtA[i:], m.Payment)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payment)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.MerkleTreeHookId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.GasAmount) > 0 {
+		i -= len(m.GasAmount)
+		copy(dAtA[i:], m.GasAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GasAmount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Destination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GasPayment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCreateNoopHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateNoopHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateNoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MessageId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateAggregationHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateAggregationHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateAggregationHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookIds) > 0 {
+		for iNdEx := len(m.HookIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HookIds[iNdEx])
+			copy(dAtA[i:], m.HookIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.HookIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateDomainRoutingHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateDomainRoutingHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateDomainRoutingHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackHookId) > 0 {
+		i -= len(m.FallbackHookId)
+		copy(dAtA[i:], m.FallbackHookId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FallbackHookId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetDomainRoutingHookRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetDomainRoutingHookRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetDomainRoutingHookRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookId) > 0 {
+		i -= len(m.HookId)
+		copy(dAtA[i:], m.HookId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.HookId)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Destination != 0 {
-		n += 1 + sovEvents(uint64(m.Destination))
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.GasAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Payment)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveDomainRoutingHookRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.IgpId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveDomainRoutingHookRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveDomainRoutingHookRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateProtocolFeeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateProtocolFeeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateProtocolFeeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxProtocolFee) > 0 {
+		i -= len(m.MaxProtocolFee)
+		copy(dAtA[i:], m.MaxProtocolFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxProtocolFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProtocolFee) > 0 {
+		i -= len(m.ProtocolFee)
+		copy(dAtA[i:], m.ProtocolFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProtocolFee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetProtocolFeeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetProtocolFeeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetProtocolFeeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolFee) > 0 {
+		i -= len(m.ProtocolFee)
+		copy(dAtA[i:], m.ProtocolFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProtocolFee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolFeePayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFeePayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFeePayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookId) > 0 {
+		i -= len(m.HookId)
+		copy(dAtA[i:], m.HookId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.HookId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payment) > 0 {
+		i -= len(m.Payment)
+		copy(dAtA[i:], m.Payment)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateMerkleTreeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MailboxId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertedIntoTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEvents(uint64(m.Index))
+	}
+	l = len(m.MerkleTreeHookId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	l = len(m.GasAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payment)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.IgpId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreateNoopHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreateAggregationHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.HookIds) > 0 {
+		for _, s := range m.HookIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCreateDomainRoutingHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FallbackHookId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetDomainRoutingHookRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	l = len(m.HookId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveDomainRoutingHookRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *EventCreateProtocolFeeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProtocolFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxProtocolFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetProtocolFeeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProtocolFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ProtocolFeePayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payment)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.HookId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClaimProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateMerkleTreeHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateMerkleTreeHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertedIntoTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertedIntoTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateNoopHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateNoopHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateNoopHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateAggregationHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateAggregationHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateAggregationHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookIds = append(m.HookIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateDomainRoutingHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateDomainRoutingHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateDomainRoutingHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackHookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackHookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetDomainRoutingHookRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetDomainRoutingHookRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetDomainRoutingHookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveDomainRoutingHookRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveDomainRoutingHookRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveDomainRoutingHookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventCreateNoopHook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
+func (m *EventCreateProtocolFeeHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -631,10 +2860,10 @@ func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateMerkleTreeHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreateProtocolFeeHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateMerkleTreeHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreateProtocolFeeHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -671,7 +2900,7 @@ func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -699,11 +2928,11 @@ func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailboxId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -731,7 +2960,71 @@ func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProtocolFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxProtocolFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -754,7 +3047,7 @@ func (m *EventCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
+func (m *EventSetProtocolFeeHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -777,15 +3070,15 @@ func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertedIntoTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetProtocolFeeHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertedIntoTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetProtocolFeeHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -813,13 +3106,13 @@ func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -829,14 +3122,27 @@ func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHookId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -864,7 +3170,39 @@ func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleTreeHookId = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -887,7 +3225,7 @@ func (m *InsertedIntoTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPayment) Unmarshal(dAtA []byte) error {
+func (m *ProtocolFeePayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -910,10 +3248,10 @@ func (m *GasPayment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPayment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtocolFeePayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtocolFeePayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -949,27 +3287,8 @@ func (m *GasPayment) Unmarshal(dAtA []byte) error {
 			m.MessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			m.Destination = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Destination |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -997,9 +3316,9 @@ func (m *GasPayment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasAmount = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
@@ -1031,9 +3350,9 @@ func (m *GasPayment) Unmarshal(dAtA []byte) error {
 			}
 			m.Payment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,7 +3380,7 @@ func (m *GasPayment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgpId = string(dAtA[iNdEx:postIndex])
+			m.HookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1084,7 +3403,7 @@ func (m *GasPayment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateNoopHook) Unmarshal(dAtA []byte) error {
+func (m *EventClaimProtocolFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,10 +3426,10 @@ func (m *EventCreateNoopHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateNoopHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClaimProtocolFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateNoopHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClaimProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1147,7 +3466,7 @@ func (m *EventCreateNoopHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,7 +3494,39 @@ func (m *EventCreateNoopHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12,6 +12,7 @@ type CoreKeeper interface {
 	LocalDomain(ctx context.Context, mailboxId util.HexAddress) (uint32, error)
 	MailboxIdExists(ctx context.Context, mailboxId util.HexAddress) (bool, error)
 	PostDispatchRouter() *util.Router[util.PostDispatchModule]
+	PostDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage, maxFee sdk.Coins) (sdk.Coins, error)
 }
 
 type BankKeeper interface {
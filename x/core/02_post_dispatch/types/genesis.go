@@ -2,14 +2,22 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func NewGenesisState() *GenesisState {
 	return &GenesisState{
-		Igps:            []InterchainGasPaymaster{},
-		IgpGasConfigs:   []GenesisDestinationGasConfigWrapper{},
-		MerkleTreeHooks: []MerkleTreeHook{},
-		NoopHooks:       []NoopHook{},
+		Igps:                    []InterchainGasPaymaster{},
+		IgpGasConfigs:           []GenesisDestinationGasConfigWrapper{},
+		MerkleTreeHooks:         []MerkleTreeHook{},
+		NoopHooks:               []NoopHook{},
+		AggregationHooks:        []AggregationHook{},
+		DomainRoutingHooks:      []DomainRoutingHook{},
+		DomainRoutingHookRoutes: []GenesisHookRouteWrapper{},
+		ProtocolFeeHooks:        []ProtocolFeeHook{},
+		MerkleTreeNodes:         []GenesisMerkleTreeNodeWrapper{},
+		MerkleTreeCheckpoints:   []GenesisMerkleTreeCheckpointWrapper{},
 	}
 }
 
@@ -28,5 +36,82 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	merkleTreeHookMap := make(map[uint64]struct{})
+	for _, merkleTreeHook := range gs.MerkleTreeHooks {
+		merkleTreeHookMap[merkleTreeHook.Id.GetInternalId()] = struct{}{}
+	}
+
+	for _, node := range gs.MerkleTreeNodes {
+		if _, ok := merkleTreeHookMap[node.MerkleTreeHookId]; !ok {
+			return fmt.Errorf("merkle tree hook does not exist: %d", node.MerkleTreeHookId)
+		}
+		if len(node.Node) != 32 {
+			return fmt.Errorf("invalid merkle tree node length at level %d and position %d: %d", node.Level, node.Position, len(node.Node))
+		}
+	}
+
+	for _, checkpoint := range gs.MerkleTreeCheckpoints {
+		if _, ok := merkleTreeHookMap[checkpoint.MerkleTreeHookId]; !ok {
+			return fmt.Errorf("merkle tree hook does not exist: %d", checkpoint.MerkleTreeHookId)
+		}
+	}
+
+	aggregationHookMap := make(map[uint64]struct{})
+	for _, aggregationHook := range gs.AggregationHooks {
+		if _, ok := aggregationHookMap[aggregationHook.Id.GetInternalId()]; ok {
+			return fmt.Errorf("duplicate aggregation hook: %s", aggregationHook.Id)
+		}
+		aggregationHookMap[aggregationHook.Id.GetInternalId()] = struct{}{}
+
+		if len(aggregationHook.HookIds) == 0 {
+			return fmt.Errorf("aggregation hook %s has no hooks", aggregationHook.Id)
+		}
+	}
+
+	domainRoutingHookMap := make(map[uint64]struct{})
+	for _, domainRoutingHook := range gs.DomainRoutingHooks {
+		if _, ok := domainRoutingHookMap[domainRoutingHook.Id.GetInternalId()]; ok {
+			return fmt.Errorf("duplicate domain routing hook: %s", domainRoutingHook.Id)
+		}
+		domainRoutingHookMap[domainRoutingHook.Id.GetInternalId()] = struct{}{}
+	}
+
+	for _, route := range gs.DomainRoutingHookRoutes {
+		if _, ok := domainRoutingHookMap[route.RoutingHookId]; !ok {
+			return fmt.Errorf("domain routing hook does not exist: %d", route.RoutingHookId)
+		}
+	}
+
+	protocolFeeHookMap := make(map[uint64]struct{})
+	for _, protocolFeeHook := range gs.ProtocolFeeHooks {
+		if _, ok := protocolFeeHookMap[protocolFeeHook.Id.GetInternalId()]; ok {
+			return fmt.Errorf("duplicate protocol fee hook: %s", protocolFeeHook.Id)
+		}
+		protocolFeeHookMap[protocolFeeHook.Id.GetInternalId()] = struct{}{}
+
+		if err := ValidateProtocolFee(protocolFeeHook.ProtocolFee, protocolFeeHook.MaxProtocolFee); err != nil {
+			return fmt.Errorf("invalid protocol fee hook %s: %w", protocolFeeHook.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProtocolFee checks that the protocol fee is a valid coin which does not exceed the max
+// protocol fee.
+func ValidateProtocolFee(protocolFee, maxProtocolFee sdk.Coin) error {
+	if err := protocolFee.Validate(); err != nil {
+		return fmt.Errorf("invalid protocol fee: %w", err)
+	}
+	if err := maxProtocolFee.Validate(); err != nil {
+		return fmt.Errorf("invalid max protocol fee: %w", err)
+	}
+
+	if protocolFee.Denom != maxProtocolFee.Denom {
+		return fmt.Errorf("protocol fee and max protocol fee must have the same denom")
+	}
+	if protocolFee.Amount.GT(maxProtocolFee.Amount) {
+		return fmt.Errorf("protocol fee %s exceeds max protocol fee %s", protocolFee, maxProtocolFee)
+	}
 	return nil
 }
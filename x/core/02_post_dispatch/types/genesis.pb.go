@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -26,10 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the post dispatch submodule's genesis state.
 type GenesisState struct {
-	Igps            []InterchainGasPaymaster             `protobuf:"bytes,1,rep,name=igps,proto3" json:"igps"`
-	IgpGasConfigs   []GenesisDestinationGasConfigWrapper `protobuf:"bytes,2,rep,name=igp_gas_configs,json=igpGasConfigs,proto3" json:"igp_gas_configs"`
-	MerkleTreeHooks []MerkleTreeHook                     `protobuf:"bytes,3,rep,name=merkle_tree_hooks,json=merkleTreeHooks,proto3" json:"merkle_tree_hooks"`
-	NoopHooks       []NoopHook                           `protobuf:"bytes,4,rep,name=noop_hooks,json=noopHooks,proto3" json:"noop_hooks"`
+	Igps                    []InterchainGasPaymaster             `protobuf:"bytes,1,rep,name=igps,proto3" json:"igps"`
+	IgpGasConfigs           []GenesisDestinationGasConfigWrapper `protobuf:"bytes,2,rep,name=igp_gas_configs,json=igpGasConfigs,proto3" json:"igp_gas_configs"`
+	MerkleTreeHooks         []MerkleTreeHook                     `protobuf:"bytes,3,rep,name=merkle_tree_hooks,json=merkleTreeHooks,proto3" json:"merkle_tree_hooks"`
+	NoopHooks               []NoopHook                           `protobuf:"bytes,4,rep,name=noop_hooks,json=noopHooks,proto3" json:"noop_hooks"`
+	AggregationHooks        []AggregationHook                    `protobuf:"bytes,5,rep,name=aggregation_hooks,json=aggregationHooks,proto3" json:"aggregation_hooks"`
+	DomainRoutingHooks      []DomainRoutingHook                  `protobuf:"bytes,6,rep,name=domain_routing_hooks,json=domainRoutingHooks,proto3" json:"domain_routing_hooks"`
+	DomainRoutingHookRoutes []GenesisHookRouteWrapper            `protobuf:"bytes,7,rep,name=domain_routing_hook_routes,json=domainRoutingHookRoutes,proto3" json:"domain_routing_hook_routes"`
+	ProtocolFeeHooks        []ProtocolFeeHook                    `protobuf:"bytes,8,rep,name=protocol_fee_hooks,json=protocolFeeHooks,proto3" json:"protocol_fee_hooks"`
+	MerkleTreeNodes         []GenesisMerkleTreeNodeWrapper       `protobuf:"bytes,9,rep,name=merkle_tree_nodes,json=merkleTreeNodes,proto3" json:"merkle_tree_nodes"`
+	MerkleTreeCheckpoints   []GenesisMerkleTreeCheckpointWrapper `protobuf:"bytes,10,rep,name=merkle_tree_checkpoints,json=merkleTreeCheckpoints,proto3" json:"merkle_tree_checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,6 +100,48 @@ func (m *GenesisState) GetNoopHooks() []NoopHook {
 	return nil
 }
 
+func (m *GenesisState) GetAggregationHooks() []AggregationHook {
+	if m != nil {
+		return m.AggregationHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDomainRoutingHooks() []DomainRoutingHook {
+	if m != nil {
+		return m.DomainRoutingHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDomainRoutingHookRoutes() []GenesisHookRouteWrapper {
+	if m != nil {
+		return m.DomainRoutingHookRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProtocolFeeHooks() []ProtocolFeeHook {
+	if m != nil {
+		return m.ProtocolFeeHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleTreeNodes() []GenesisMerkleTreeNodeWrapper {
+	if m != nil {
+		return m.MerkleTreeNodes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleTreeCheckpoints() []GenesisMerkleTreeCheckpointWrapper {
+	if m != nil {
+		return m.MerkleTreeCheckpoints
+	}
+	return nil
+}
+
 // GenesisDestinationGasConfigWrapper ...
 type GenesisDestinationGasConfigWrapper struct {
 	// remote_domain ...
@@ -159,9 +208,206 @@ func (m *GenesisDestinationGasConfigWrapper) GetIgpId() uint64 {
 	return 0
 }
 
+// GenesisHookRouteWrapper ...
+type GenesisHookRouteWrapper struct {
+	// destination ...
+	Destination uint32 `protobuf:"varint,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// hook_id ...
+	HookId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id"`
+	// routing_hook_id is required for the Genesis handling.
+	RoutingHookId uint64 `protobuf:"varint,3,opt,name=routing_hook_id,json=routingHookId,proto3" json:"routing_hook_id,omitempty"`
+}
+
+func (m *GenesisHookRouteWrapper) Reset()         { *m = GenesisHookRouteWrapper{} }
+func (m *GenesisHookRouteWrapper) String() string { return proto.CompactTextString(m) }
+func (*GenesisHookRouteWrapper) ProtoMessage()    {}
+func (*GenesisHookRouteWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8864b1a76aa43cd2, []int{2}
+}
+func (m *GenesisHookRouteWrapper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisHookRouteWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisHookRouteWrapper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisHookRouteWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisHookRouteWrapper.Merge(m, src)
+}
+func (m *GenesisHookRouteWrapper) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisHookRouteWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisHookRouteWrapper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisHookRouteWrapper proto.InternalMessageInfo
+
+func (m *GenesisHookRouteWrapper) GetDestination() uint32 {
+	if m != nil {
+		return m.Destination
+	}
+	return 0
+}
+
+func (m *GenesisHookRouteWrapper) GetRoutingHookId() uint64 {
+	if m != nil {
+		return m.RoutingHookId
+	}
+	return 0
+}
+
+// GenesisMerkleTreeNodeWrapper ...
+type GenesisMerkleTreeNodeWrapper struct {
+	// level of the node, leaves are at level 0.
+	Level uint32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// position of the node within its level.
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// node ...
+	Node []byte `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// merkle_tree_hook_id is required for the Genesis handling.
+	MerkleTreeHookId uint64 `protobuf:"varint,4,opt,name=merkle_tree_hook_id,json=merkleTreeHookId,proto3" json:"merkle_tree_hook_id,omitempty"`
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) Reset()         { *m = GenesisMerkleTreeNodeWrapper{} }
+func (m *GenesisMerkleTreeNodeWrapper) String() string { return proto.CompactTextString(m) }
+func (*GenesisMerkleTreeNodeWrapper) ProtoMessage()    {}
+func (*GenesisMerkleTreeNodeWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8864b1a76aa43cd2, []int{3}
+}
+func (m *GenesisMerkleTreeNodeWrapper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisMerkleTreeNodeWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisMerkleTreeNodeWrapper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisMerkleTreeNodeWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisMerkleTreeNodeWrapper.Merge(m, src)
+}
+func (m *GenesisMerkleTreeNodeWrapper) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisMerkleTreeNodeWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisMerkleTreeNodeWrapper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisMerkleTreeNodeWrapper proto.InternalMessageInfo
+
+func (m *GenesisMerkleTreeNodeWrapper) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) GetNode() []byte {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) GetMerkleTreeHookId() uint64 {
+	if m != nil {
+		return m.MerkleTreeHookId
+	}
+	return 0
+}
+
+// GenesisMerkleTreeCheckpointWrapper ...
+type GenesisMerkleTreeCheckpointWrapper struct {
+	// height ...
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// merkle_tree_hook_id is required for the Genesis handling.
+	MerkleTreeHookId uint64 `protobuf:"varint,3,opt,name=merkle_tree_hook_id,json=merkleTreeHookId,proto3" json:"merkle_tree_hook_id,omitempty"`
+	// count of leaves in the tree at the end of the block.
+	Count uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) Reset()         { *m = GenesisMerkleTreeCheckpointWrapper{} }
+func (m *GenesisMerkleTreeCheckpointWrapper) String() string { return proto.CompactTextString(m) }
+func (*GenesisMerkleTreeCheckpointWrapper) ProtoMessage()    {}
+func (*GenesisMerkleTreeCheckpointWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8864b1a76aa43cd2, []int{4}
+}
+func (m *GenesisMerkleTreeCheckpointWrapper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisMerkleTreeCheckpointWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisMerkleTreeCheckpointWrapper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisMerkleTreeCheckpointWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisMerkleTreeCheckpointWrapper.Merge(m, src)
+}
+func (m *GenesisMerkleTreeCheckpointWrapper) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisMerkleTreeCheckpointWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisMerkleTreeCheckpointWrapper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisMerkleTreeCheckpointWrapper proto.InternalMessageInfo
+
+func (m *GenesisMerkleTreeCheckpointWrapper) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) GetMerkleTreeHookId() uint64 {
+	if m != nil {
+		return m.MerkleTreeHookId
+	}
+	return 0
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hyperlane.core.post_dispatch.v1.GenesisState")
 	proto.RegisterType((*GenesisDestinationGasConfigWrapper)(nil), "hyperlane.core.post_dispatch.v1.GenesisDestinationGasConfigWrapper")
+	proto.RegisterType((*GenesisHookRouteWrapper)(nil), "hyperlane.core.post_dispatch.v1.GenesisHookRouteWrapper")
+	proto.RegisterType((*GenesisMerkleTreeNodeWrapper)(nil), "hyperlane.core.post_dispatch.v1.GenesisMerkleTreeNodeWrapper")
+	proto.RegisterType((*GenesisMerkleTreeCheckpointWrapper)(nil), "hyperlane.core.post_dispatch.v1.GenesisMerkleTreeCheckpointWrapper")
 }
 
 func init() {
@@ -169,38 +415,59 @@ func init() {
 }
 
 var fileDescriptor_8864b1a76aa43cd2 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0x26, 0x54, 0xca, 0x26, 0x51, 0x85, 0x45, 0x25, 0xab, 0x12, 0x4e, 0x14, 0x2e,
-	0x01, 0x14, 0x2f, 0x0d, 0x07, 0xae, 0x28, 0xad, 0x54, 0x72, 0xa0, 0x40, 0x40, 0x42, 0xe2, 0x62,
-	0x6d, 0xec, 0x61, 0xbd, 0x4a, 0xbc, 0xb3, 0xec, 0x2e, 0x11, 0x79, 0x02, 0xae, 0x3c, 0x56, 0x8f,
-	0x3d, 0x22, 0x0e, 0x15, 0x4a, 0xce, 0xbc, 0x03, 0xf2, 0xda, 0x44, 0xe4, 0xe4, 0xdb, 0x78, 0x3c,
-	0xf3, 0xfd, 0x33, 0xb3, 0x3f, 0x19, 0x67, 0x1b, 0x05, 0x7a, 0xc5, 0x24, 0xd0, 0x04, 0x35, 0x50,
-	0x85, 0xc6, 0xc6, 0xa9, 0x30, 0x8a, 0xd9, 0x24, 0xa3, 0xeb, 0x73, 0xca, 0x41, 0x82, 0x11, 0x26,
-	0x52, 0x1a, 0x2d, 0xfa, 0xfd, 0x7d, 0x79, 0x54, 0x94, 0x47, 0x07, 0xe5, 0xd1, 0xfa, 0xfc, 0xec,
-	0x69, 0x1d, 0xcf, 0x6e, 0x14, 0x54, 0xb4, 0xb3, 0x07, 0x1c, 0x39, 0xba, 0x90, 0x16, 0x51, 0x99,
-	0x1d, 0x7e, 0x6f, 0x92, 0xee, 0x55, 0xa9, 0xfa, 0xde, 0x32, 0x0b, 0xfe, 0x3b, 0xd2, 0x12, 0x5c,
-	0x99, 0xc0, 0x1b, 0x34, 0x47, 0x9d, 0xc9, 0x8b, 0xa8, 0x66, 0x86, 0x68, 0x26, 0x2d, 0xe8, 0x24,
-	0x63, 0x42, 0x5e, 0x31, 0xf3, 0x96, 0x6d, 0x72, 0x66, 0x2c, 0xe8, 0x69, 0xeb, 0xe6, 0xae, 0xdf,
-	0x98, 0x3b, 0x94, 0xff, 0x85, 0x9c, 0x08, 0xae, 0x62, 0xce, 0x4c, 0x9c, 0xa0, 0xfc, 0x2c, 0xb8,
-	0x09, 0x8e, 0x1c, 0xfd, 0xa2, 0x96, 0x5e, 0x8d, 0x76, 0x09, 0xc6, 0x0a, 0xc9, 0xac, 0xc0, 0x42,
-	0xe5, 0xc2, 0x41, 0x3e, 0x6a, 0xa6, 0xd4, 0x5e, 0xa9, 0x27, 0xb8, 0xda, 0xff, 0x32, 0x3e, 0x23,
-	0xf7, 0x73, 0xd0, 0xcb, 0x15, 0xc4, 0x56, 0x03, 0xc4, 0x19, 0xe2, 0xd2, 0x04, 0x4d, 0x27, 0x4a,
-	0x6b, 0x45, 0x5f, 0xbb, 0xce, 0x0f, 0x1a, 0xe0, 0x15, 0xe2, 0xb2, 0x12, 0x38, 0xc9, 0x0f, 0xb2,
-	0xc6, 0xbf, 0x26, 0x44, 0x22, 0xaa, 0x8a, 0xdd, 0x72, 0xec, 0xc7, 0xb5, 0xec, 0x6b, 0x44, 0xf5,
-	0x1f, 0xb5, 0x2d, 0xab, 0x6f, 0x33, 0xfc, 0xe3, 0x91, 0x61, 0xfd, 0xba, 0xfe, 0x23, 0xd2, 0xd3,
-	0x90, 0xa3, 0x85, 0x38, 0xc5, 0x9c, 0x09, 0x19, 0x78, 0x03, 0x6f, 0xd4, 0x9b, 0x77, 0xcb, 0xe4,
-	0xa5, 0xcb, 0xf9, 0x33, 0x42, 0x8a, 0x6b, 0xa3, 0x66, 0xc9, 0x0a, 0x82, 0xa3, 0x81, 0x37, 0xea,
-	0x4c, 0x9e, 0xd4, 0x1f, 0x9b, 0x99, 0x37, 0xae, 0x63, 0xde, 0xe6, 0xff, 0x42, 0xff, 0x25, 0xe9,
-	0x3a, 0xd4, 0x1a, 0x74, 0x06, 0x2c, 0x0d, 0x9a, 0x03, 0x6f, 0xd4, 0x9e, 0x3e, 0x2c, 0xa6, 0xff,
-	0x75, 0xd7, 0x3f, 0x4d, 0xd0, 0xe4, 0x68, 0x4c, 0xba, 0x8c, 0x04, 0xd2, 0x9c, 0xd9, 0xac, 0x30,
-	0xc4, 0xbc, 0x53, 0xf4, 0x57, 0x1d, 0xfe, 0x29, 0x39, 0x2e, 0x9e, 0x5f, 0xa4, 0x41, 0x6b, 0xe0,
-	0x8d, 0x5a, 0xf3, 0x7b, 0x82, 0xab, 0x59, 0x3a, 0x4d, 0x6e, 0xb6, 0xa1, 0x77, 0xbb, 0x0d, 0xbd,
-	0xdf, 0xdb, 0xd0, 0xfb, 0xb1, 0x0b, 0x1b, 0xb7, 0xbb, 0xb0, 0xf1, 0x73, 0x17, 0x36, 0x3e, 0xcd,
-	0xb8, 0xb0, 0xd9, 0xd7, 0x45, 0x94, 0x60, 0x4e, 0x17, 0x89, 0x1a, 0x0b, 0x29, 0x71, 0xed, 0xae,
-	0x61, 0xe8, 0x7e, 0x87, 0x71, 0xa9, 0x4c, 0xbf, 0x95, 0xd6, 0x7f, 0x36, 0x89, 0x0f, 0xdd, 0xef,
-	0xac, 0xbf, 0x38, 0x76, 0x2e, 0x7f, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xa8, 0x32, 0xde,
-	0x7a, 0x03, 0x00, 0x00,
+	// 831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x1b, 0x37, 0x9e, 0xc4, 0x4a, 0x3a, 0x24, 0x64, 0x15, 0x81, 0x63, 0x19, 0x09,
+	0x19, 0x90, 0x77, 0x5b, 0x73, 0x00, 0x09, 0x21, 0x51, 0xbb, 0xa2, 0x35, 0x12, 0xa1, 0x2c, 0x48,
+	0x48, 0x08, 0x69, 0x35, 0xd9, 0x99, 0xce, 0x0e, 0xf6, 0xee, 0x1b, 0x66, 0xc6, 0x56, 0x23, 0x4e,
+	0x9c, 0xb8, 0x22, 0xf1, 0x47, 0xf8, 0x0b, 0xdc, 0x7a, 0xec, 0x11, 0x71, 0xa8, 0x50, 0x72, 0xe6,
+	0x3f, 0xa0, 0x9d, 0x5d, 0x6c, 0x6f, 0x9a, 0x76, 0xd3, 0xdb, 0xbc, 0x37, 0xf3, 0xbe, 0xef, 0x7d,
+	0xb3, 0xdf, 0xbc, 0x45, 0x83, 0xe4, 0x5c, 0x32, 0x35, 0x23, 0x19, 0x0b, 0x62, 0x50, 0x2c, 0x90,
+	0xa0, 0x4d, 0x44, 0x85, 0x96, 0xc4, 0xc4, 0x49, 0xb0, 0xb8, 0x1b, 0x70, 0x96, 0x31, 0x2d, 0xb4,
+	0x2f, 0x15, 0x18, 0xc0, 0x27, 0xcb, 0xe3, 0x7e, 0x7e, 0xdc, 0xaf, 0x1c, 0xf7, 0x17, 0x77, 0x8f,
+	0x3f, 0xa8, 0xc3, 0x33, 0xe7, 0x92, 0x95, 0x68, 0xc7, 0x07, 0x1c, 0x38, 0xd8, 0x65, 0x90, 0xaf,
+	0x8a, 0x6c, 0xef, 0x8f, 0x6d, 0xb4, 0xfb, 0xa0, 0x60, 0xfd, 0xc6, 0x10, 0xc3, 0xf0, 0xd7, 0xc8,
+	0x15, 0x5c, 0x6a, 0xcf, 0xe9, 0x36, 0xfa, 0x3b, 0xc3, 0x8f, 0xfc, 0x9a, 0x1e, 0xfc, 0x49, 0x66,
+	0x98, 0x8a, 0x13, 0x22, 0xb2, 0x07, 0x44, 0x3f, 0x22, 0xe7, 0x29, 0xd1, 0x86, 0xa9, 0x91, 0xfb,
+	0xf4, 0xf9, 0xc9, 0x46, 0x68, 0xa1, 0xf0, 0x4f, 0x68, 0x4f, 0x70, 0x19, 0x71, 0xa2, 0xa3, 0x18,
+	0xb2, 0xc7, 0x82, 0x6b, 0x6f, 0xd3, 0xa2, 0x8f, 0x6b, 0xd1, 0xcb, 0xd6, 0xee, 0x33, 0x6d, 0x44,
+	0x46, 0x8c, 0x80, 0x9c, 0x65, 0x6c, 0x41, 0xbe, 0x53, 0x44, 0xca, 0x25, 0x53, 0x5b, 0x70, 0xb9,
+	0xdc, 0xd2, 0x98, 0xa0, 0xdb, 0x29, 0x53, 0xd3, 0x19, 0x8b, 0x8c, 0x62, 0x2c, 0x4a, 0x00, 0xa6,
+	0xda, 0x6b, 0x58, 0xd2, 0xa0, 0x96, 0xf4, 0x4b, 0x5b, 0xf9, 0xad, 0x62, 0xec, 0x21, 0xc0, 0xb4,
+	0x24, 0xd8, 0x4b, 0x2b, 0x59, 0x8d, 0x4f, 0x11, 0xca, 0x00, 0x64, 0x89, 0xed, 0x5a, 0xec, 0xf7,
+	0x6a, 0xb1, 0x4f, 0x01, 0xe4, 0x1a, 0x6a, 0x2b, 0x2b, 0x63, 0x8d, 0x63, 0x74, 0x9b, 0x70, 0xae,
+	0x18, 0xb7, 0x32, 0x4b, 0xd8, 0x2d, 0x0b, 0x7b, 0xa7, 0x16, 0xf6, 0xde, 0xaa, 0x72, 0x0d, 0x7d,
+	0x9f, 0x54, 0xd3, 0x1a, 0xff, 0x88, 0x0e, 0x28, 0xa4, 0x44, 0x64, 0x91, 0x82, 0xb9, 0x11, 0x19,
+	0x2f, 0x79, 0x9a, 0x96, 0x67, 0x58, 0xcb, 0x73, 0xdf, 0x16, 0x87, 0x45, 0xed, 0x1a, 0x13, 0xa6,
+	0x57, 0x37, 0x34, 0xfe, 0x19, 0x1d, 0x5f, 0xc3, 0x65, 0x03, 0xa6, 0xbd, 0x5b, 0x96, 0xf1, 0xe3,
+	0x9b, 0x3a, 0x20, 0x87, 0xcc, 0xd1, 0x59, 0xf5, 0xb3, 0x1f, 0xbd, 0xc0, 0x6b, 0x0f, 0x69, 0x4c,
+	0x11, 0xb6, 0x06, 0x8f, 0x61, 0x16, 0x3d, 0x5e, 0x3a, 0x60, 0xfb, 0x86, 0xd7, 0xf9, 0xa8, 0x2c,
+	0xfd, 0xbc, 0x62, 0x81, 0x7d, 0x59, 0x4d, 0x6b, 0x0c, 0x55, 0x9b, 0x65, 0x40, 0x99, 0xf6, 0x5a,
+	0x96, 0xe4, 0xd3, 0x9b, 0x2a, 0x5b, 0xb9, 0xed, 0x14, 0xe8, 0x15, 0x79, 0x7b, 0x69, 0x65, 0x53,
+	0xe3, 0x5f, 0x1c, 0x74, 0xb4, 0xce, 0x18, 0x27, 0x2c, 0x9e, 0x4a, 0x10, 0x99, 0xd1, 0x1e, 0x7a,
+	0xbd, 0x37, 0xb5, 0xe2, 0x1d, 0x2f, 0x41, 0xaa, 0xec, 0x87, 0xe9, 0x35, 0x47, 0x74, 0xef, 0x5f,
+	0x07, 0xf5, 0xea, 0xdf, 0x25, 0x7e, 0x07, 0xb5, 0x15, 0x4b, 0xc1, 0xb0, 0xa8, 0xf8, 0x46, 0x9e,
+	0xd3, 0x75, 0xfa, 0xed, 0x70, 0xb7, 0x48, 0x16, 0x46, 0xc2, 0x13, 0x84, 0xf2, 0xb1, 0x00, 0x8a,
+	0xc4, 0x33, 0xe6, 0x6d, 0x76, 0x9d, 0xfe, 0xce, 0xf0, 0xfd, 0x7a, 0x05, 0x44, 0x7f, 0x65, 0x2b,
+	0xc2, 0x16, 0xff, 0x7f, 0x89, 0x3f, 0x43, 0xbb, 0x16, 0x6a, 0xc1, 0x54, 0xc2, 0x08, 0xf5, 0x1a,
+	0x5d, 0xa7, 0xdf, 0x1a, 0xbd, 0x9d, 0x2b, 0xf9, 0xfb, 0xf9, 0xc9, 0x61, 0x0c, 0x3a, 0x05, 0xad,
+	0xe9, 0xd4, 0x17, 0x10, 0xa4, 0xc4, 0x24, 0xf9, 0xe4, 0x0a, 0x77, 0xf2, 0xfa, 0xb2, 0x02, 0x1f,
+	0xa2, 0x66, 0x3e, 0xa7, 0x04, 0xf5, 0xdc, 0xae, 0xd3, 0x77, 0xc3, 0x2d, 0xc1, 0xe5, 0x84, 0xf6,
+	0xfe, 0x74, 0xd0, 0xd1, 0x4b, 0x5c, 0x88, 0xbb, 0x68, 0x87, 0xae, 0xee, 0xa0, 0x94, 0xb8, 0x9e,
+	0xc2, 0x3f, 0xa0, 0x5b, 0xd6, 0xf6, 0x82, 0x5a, 0x79, 0xad, 0xd1, 0xb8, 0xec, 0xe8, 0x13, 0x2e,
+	0x4c, 0x32, 0x3f, 0xf3, 0x63, 0x48, 0x83, 0xb3, 0x58, 0x0e, 0x44, 0x96, 0xc1, 0xc2, 0xd6, 0xe8,
+	0x60, 0x79, 0x01, 0x83, 0xa2, 0xed, 0x60, 0x6e, 0xc4, 0xcc, 0x7f, 0xc8, 0x9e, 0xdc, 0xa3, 0x54,
+	0x31, 0xad, 0xc3, 0x66, 0x8e, 0x39, 0xa1, 0xf8, 0x5d, 0xb4, 0x57, 0x79, 0x5c, 0xa2, 0xd0, 0xed,
+	0x86, 0x6d, 0xb5, 0x7a, 0x12, 0x13, 0xda, 0xfb, 0xdd, 0x41, 0x6f, 0xbd, 0xca, 0x6f, 0xf8, 0x00,
+	0x6d, 0xcd, 0xd8, 0x82, 0xcd, 0x4a, 0x09, 0x45, 0x80, 0x8f, 0xd1, 0xb6, 0x04, 0x2d, 0xac, 0xb6,
+	0x4d, 0xbb, 0xb1, 0x8c, 0x31, 0x46, 0x6e, 0xee, 0x77, 0xcb, 0xb7, 0x1b, 0xda, 0x35, 0x1e, 0xa0,
+	0x37, 0xae, 0x8e, 0xdd, 0xd5, 0x75, 0xee, 0x57, 0x27, 0xe8, 0x84, 0xf6, 0x7e, 0x5d, 0x39, 0xe9,
+	0x15, 0x6e, 0xc4, 0x6f, 0xa2, 0x66, 0xc2, 0x04, 0x4f, 0x8c, 0x6d, 0xae, 0x11, 0x96, 0xd1, 0xcb,
+	0xd8, 0x1a, 0xd7, 0xb3, 0xe5, 0x12, 0x63, 0x98, 0x67, 0xc6, 0xb6, 0xd3, 0x0e, 0x8b, 0xe0, 0x0b,
+	0x77, 0x7b, 0x73, 0xbf, 0x31, 0x8a, 0x9f, 0x5e, 0x74, 0x9c, 0x67, 0x17, 0x1d, 0xe7, 0x9f, 0x8b,
+	0x8e, 0xf3, 0xdb, 0x65, 0x67, 0xe3, 0xd9, 0x65, 0x67, 0xe3, 0xaf, 0xcb, 0xce, 0xc6, 0xf7, 0x93,
+	0xd7, 0xf9, 0x4c, 0x4f, 0x8a, 0xff, 0xf0, 0x9d, 0x61, 0x54, 0xfd, 0x15, 0xdb, 0xff, 0xf0, 0x59,
+	0xd3, 0xce, 0x8f, 0x0f, 0xff, 0x1b, 0x00, 0xec, 0x52, 0x39, 0x88, 0x07, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -223,6 +490,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MerkleTreeCheckpoints) > 0 {
+		for iNdEx := len(m.MerkleTreeCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleTreeCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MerkleTreeNodes) > 0 {
+		for iNdEx := len(m.MerkleTreeNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleTreeNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProtocolFeeHooks) > 0 {
+		for iNdEx := len(m.ProtocolFeeHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeeHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DomainRoutingHookRoutes) > 0 {
+		for iNdEx := len(m.DomainRoutingHookRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainRoutingHookRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DomainRoutingHooks) > 0 {
+		for iNdEx := len(m.DomainRoutingHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainRoutingHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AggregationHooks) > 0 {
+		for iNdEx := len(m.AggregationHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregationHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.NoopHooks) > 0 {
 		for iNdEx := len(m.NoopHooks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -337,52 +688,214 @@ func (m *GenesisDestinationGasConfigWrapper) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisHookRouteWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GenesisHookRouteWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisHookRouteWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Igps) > 0 {
-		for _, e := range m.Igps {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.IgpGasConfigs) > 0 {
-		for _, e := range m.IgpGasConfigs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.RoutingHookId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RoutingHookId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.MerkleTreeHooks) > 0 {
-		for _, e := range m.MerkleTreeHooks {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.HookId.Size()
+		i -= size
+		if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.NoopHooks) > 0 {
-		for _, e := range m.NoopHooks {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if m.Destination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisDestinationGasConfigWrapper) Size() (n int) {
-	if m == nil {
+func (m *GenesisMerkleTreeNodeWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MerkleTreeHookId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MerkleTreeHookId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Position != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Level != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MerkleTreeHookId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MerkleTreeHookId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Igps) > 0 {
+		for _, e := range m.Igps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IgpGasConfigs) > 0 {
+		for _, e := range m.IgpGasConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleTreeHooks) > 0 {
+		for _, e := range m.MerkleTreeHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NoopHooks) > 0 {
+		for _, e := range m.NoopHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AggregationHooks) > 0 {
+		for _, e := range m.AggregationHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DomainRoutingHooks) > 0 {
+		for _, e := range m.DomainRoutingHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DomainRoutingHookRoutes) > 0 {
+		for _, e := range m.DomainRoutingHookRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProtocolFeeHooks) > 0 {
+		for _, e := range m.ProtocolFeeHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleTreeNodes) > 0 {
+		for _, e := range m.MerkleTreeNodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleTreeCheckpoints) > 0 {
+		for _, e := range m.MerkleTreeCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisDestinationGasConfigWrapper) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -402,6 +915,63 @@ func (m *GenesisDestinationGasConfigWrapper) Size() (n int) {
 	return n
 }
 
+func (m *GenesisHookRouteWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovGenesis(uint64(m.Destination))
+	}
+	l = m.HookId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RoutingHookId != 0 {
+		n += 1 + sovGenesis(uint64(m.RoutingHookId))
+	}
+	return n
+}
+
+func (m *GenesisMerkleTreeNodeWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovGenesis(uint64(m.Level))
+	}
+	if m.Position != 0 {
+		n += 1 + sovGenesis(uint64(m.Position))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MerkleTreeHookId != 0 {
+		n += 1 + sovGenesis(uint64(m.MerkleTreeHookId))
+	}
+	return n
+}
+
+func (m *GenesisMerkleTreeCheckpointWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.MerkleTreeHookId != 0 {
+		n += 1 + sovGenesis(uint64(m.MerkleTreeHookId))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -439,7 +1009,416 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Igps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Igps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Igps = append(m.Igps, InterchainGasPaymaster{})
+			if err := m.Igps[len(m.Igps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpGasConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgpGasConfigs = append(m.IgpGasConfigs, GenesisDestinationGasConfigWrapper{})
+			if err := m.IgpGasConfigs[len(m.IgpGasConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHooks = append(m.MerkleTreeHooks, MerkleTreeHook{})
+			if err := m.MerkleTreeHooks[len(m.MerkleTreeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoopHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoopHooks = append(m.NoopHooks, NoopHook{})
+			if err := m.NoopHooks[len(m.NoopHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregationHooks = append(m.AggregationHooks, AggregationHook{})
+			if err := m.AggregationHooks[len(m.AggregationHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainRoutingHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainRoutingHooks = append(m.DomainRoutingHooks, DomainRoutingHook{})
+			if err := m.DomainRoutingHooks[len(m.DomainRoutingHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainRoutingHookRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainRoutingHookRoutes = append(m.DomainRoutingHookRoutes, GenesisHookRouteWrapper{})
+			if err := m.DomainRoutingHookRoutes[len(m.DomainRoutingHookRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeHooks = append(m.ProtocolFeeHooks, ProtocolFeeHook{})
+			if err := m.ProtocolFeeHooks[len(m.ProtocolFeeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeNodes = append(m.MerkleTreeNodes, GenesisMerkleTreeNodeWrapper{})
+			if err := m.MerkleTreeNodes[len(m.MerkleTreeNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeCheckpoints = append(m.MerkleTreeCheckpoints, GenesisMerkleTreeCheckpointWrapper{})
+			if err := m.MerkleTreeCheckpoints[len(m.MerkleTreeCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisDestinationGasConfigWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisDestinationGasConfigWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			}
+			m.RemoteDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasOracle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -466,16 +1445,18 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Igps = append(m.Igps, InterchainGasPaymaster{})
-			if err := m.Igps[len(m.Igps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GasOracle == nil {
+				m.GasOracle = &GasOracle{}
+			}
+			if err := m.GasOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpGasConfigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasOverhead", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -485,31 +1466,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgpGasConfigs = append(m.IgpGasConfigs, GenesisDestinationGasConfigWrapper{})
-			if err := m.IgpGasConfigs[len(m.IgpGasConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasOverhead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHooks", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
 			}
-			var msglen int
+			m.IgpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -519,31 +1500,85 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IgpId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleTreeHooks = append(m.MerkleTreeHooks, MerkleTreeHook{})
-			if err := m.MerkleTreeHooks[len(m.MerkleTreeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisHookRouteWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisHookRouteWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisHookRouteWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoopHooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -553,26 +1588,45 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoopHooks = append(m.NoopHooks, NoopHook{})
-			if err := m.NoopHooks[len(m.NoopHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingHookId", wireType)
+			}
+			m.RoutingHookId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoutingHookId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -594,7 +1648,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
+func (m *GenesisMerkleTreeNodeWrapper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -617,17 +1671,17 @@ func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisDestinationGasConfigWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisMerkleTreeNodeWrapper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisDestinationGasConfigWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisMerkleTreeNodeWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			m.RemoteDomain = 0
+			m.Level = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -637,16 +1691,35 @@ func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
+				m.Level |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasOracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -656,33 +1729,31 @@ func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasOracle == nil {
-				m.GasOracle = &GasOracle{}
-			}
-			if err := m.GasOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Node = append(m.Node[:0], dAtA[iNdEx:postIndex]...)
+			if m.Node == nil {
+				m.Node = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasOverhead", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHookId", wireType)
 			}
-			var stringLen uint64
+			m.MerkleTreeHookId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -692,31 +1763,104 @@ func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MerkleTreeHookId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasOverhead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisMerkleTreeCheckpointWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisMerkleTreeCheckpointWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisMerkleTreeCheckpointWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHookId", wireType)
+			}
+			m.MerkleTreeHookId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerkleTreeHookId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.IgpId = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -726,7 +1870,7 @@ func (m *GenesisDestinationGasConfigWrapper) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IgpId |= uint64(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
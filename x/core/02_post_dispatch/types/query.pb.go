@@ -946,1478 +946,5659 @@ func (m *QueryNoopHooksResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryIgpsRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpsRequest")
-	proto.RegisterType((*QueryIgpsResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpsResponse")
-	proto.RegisterType((*QueryIgpRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpRequest")
-	proto.RegisterType((*QueryIgpResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpResponse")
-	proto.RegisterType((*QueryDestinationGasConfigsRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryDestinationGasConfigsRequest")
-	proto.RegisterType((*QueryDestinationGasConfigsResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryDestinationGasConfigsResponse")
-	proto.RegisterType((*QueryQuoteGasPaymentRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryQuoteGasPaymentRequest")
-	proto.RegisterType((*QueryQuoteGasPaymentResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryQuoteGasPaymentResponse")
-	proto.RegisterType((*QueryMerkleTreeHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHooksRequest")
-	proto.RegisterType((*QueryMerkleTreeHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHooksResponse")
-	proto.RegisterType((*QueryMerkleTreeHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHookRequest")
-	proto.RegisterType((*QueryMerkleTreeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHookResponse")
-	proto.RegisterType((*WrappedMerkleTreeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.WrappedMerkleTreeHookResponse")
-	proto.RegisterType((*TreeResponse)(nil), "hyperlane.core.post_dispatch.v1.TreeResponse")
-	proto.RegisterType((*QueryNoopHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHookRequest")
-	proto.RegisterType((*QueryNoopHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHookResponse")
-	proto.RegisterType((*QueryNoopHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHooksRequest")
-	proto.RegisterType((*QueryNoopHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHooksResponse")
+// QueryAggregationHooksRequest ...
+type QueryAggregationHooksRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("hyperlane/core/post_dispatch/v1/query.proto", fileDescriptor_32e5ceb03adb8f60)
+func (m *QueryAggregationHooksRequest) Reset()         { *m = QueryAggregationHooksRequest{} }
+func (m *QueryAggregationHooksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregationHooksRequest) ProtoMessage()    {}
+func (*QueryAggregationHooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{18}
 }
-
-var fileDescriptor_32e5ceb03adb8f60 = []byte{
-	// 1139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xe4, 0x47, 0x15, 0xbf, 0x84, 0xa4, 0x19, 0x25, 0x34, 0x75, 0x1b, 0x3b, 0x59, 0x41,
-	0x1a, 0x4a, 0xbd, 0x5b, 0x07, 0xa5, 0x39, 0x50, 0x38, 0x24, 0x55, 0x83, 0x25, 0x88, 0x5a, 0x53,
-	0x81, 0xd4, 0x8b, 0x35, 0xf6, 0x4e, 0xd7, 0xa3, 0x78, 0x77, 0x36, 0x3b, 0xeb, 0xd0, 0xa8, 0xaa,
-	0x84, 0xb8, 0x21, 0x2e, 0xfc, 0xb8, 0x23, 0x8e, 0x88, 0x13, 0x07, 0x04, 0xe2, 0x0f, 0x40, 0xea,
-	0xb1, 0x12, 0x17, 0x90, 0x50, 0x41, 0x09, 0x12, 0x7f, 0x06, 0x68, 0x67, 0x66, 0xed, 0x5d, 0xc7,
-	0x8e, 0x9d, 0xe0, 0x8b, 0xbd, 0x3b, 0x33, 0xef, 0xbd, 0xef, 0xfb, 0x66, 0xde, 0x9b, 0xb7, 0xf0,
-	0x7a, 0xfd, 0xd0, 0xa7, 0x41, 0x83, 0x78, 0xd4, 0xaa, 0xf1, 0x80, 0x5a, 0x3e, 0x17, 0x61, 0xc5,
-	0x66, 0xc2, 0x27, 0x61, 0xad, 0x6e, 0x1d, 0x14, 0xad, 0xfd, 0x26, 0x0d, 0x0e, 0x4d, 0x3f, 0xe0,
-	0x21, 0xc7, 0xf9, 0xd6, 0x62, 0x33, 0x5a, 0x6c, 0xa6, 0x16, 0x9b, 0x07, 0xc5, 0xec, 0xf5, 0x1a,
-	0x17, 0x2e, 0x17, 0x56, 0x95, 0x08, 0xaa, 0x2c, 0xad, 0x83, 0x62, 0x95, 0x86, 0xa4, 0x68, 0xf9,
-	0xc4, 0x61, 0x1e, 0x09, 0x19, 0xf7, 0x94, 0xb3, 0xec, 0x55, 0x87, 0x73, 0xa7, 0x41, 0x2d, 0xe2,
-	0x33, 0x8b, 0x78, 0x1e, 0x0f, 0xe5, 0xa4, 0xd0, 0xb3, 0x73, 0xc4, 0x65, 0x1e, 0xb7, 0xe4, 0xaf,
-	0x1e, 0x9a, 0x77, 0xb8, 0xc3, 0xe5, 0xa3, 0x15, 0x3d, 0xe9, 0xd1, 0xbe, 0x04, 0xc2, 0x43, 0x9f,
-	0xc6, 0x5e, 0x73, 0x49, 0x7c, 0x31, 0xb2, 0x1a, 0x67, 0x1a, 0x93, 0xf1, 0x10, 0x2e, 0xde, 0x8f,
-	0x50, 0x97, 0x1c, 0x5f, 0x94, 0xe9, 0x7e, 0x93, 0x8a, 0x10, 0xdf, 0x05, 0x68, 0x63, 0x5f, 0x44,
-	0xcb, 0x68, 0x6d, 0x6a, 0x7d, 0xd5, 0x54, 0x8e, 0xcc, 0xc8, 0x91, 0xa9, 0x24, 0xd2, 0xee, 0xcc,
-	0x7b, 0xc4, 0xa1, 0xda, 0xb6, 0x9c, 0xb0, 0x34, 0x7e, 0x40, 0x30, 0x97, 0x70, 0x2e, 0x7c, 0xee,
-	0x09, 0x8a, 0x3f, 0x80, 0x71, 0xe6, 0xf8, 0x62, 0x11, 0x2d, 0x8f, 0xad, 0x4d, 0xad, 0x6f, 0x9a,
-	0x7d, 0x14, 0x36, 0x4b, 0x5e, 0x48, 0x83, 0x5a, 0x9d, 0x30, 0x6f, 0x87, 0x88, 0x7b, 0xe4, 0xd0,
-	0x25, 0x22, 0xa4, 0xc1, 0x56, 0xe6, 0xd9, 0x8b, 0xfc, 0xc8, 0xb7, 0xff, 0x7c, 0x7f, 0x1d, 0x95,
-	0xa5, 0x3f, 0xbc, 0x93, 0x42, 0x3d, 0x2a, 0x51, 0x5f, 0xeb, 0x8b, 0x5a, 0x81, 0x4a, 0xc1, 0x5e,
-	0x81, 0xd9, 0x18, 0x75, 0xac, 0xc8, 0x0c, 0x8c, 0x32, 0x5b, 0x2a, 0x91, 0x29, 0x8f, 0x32, 0xdb,
-	0xa8, 0xb7, 0x55, 0x6b, 0xf1, 0x7a, 0x00, 0x63, 0xcc, 0xf1, 0xb5, 0x5c, 0xc3, 0xa0, 0x15, 0xb9,
-	0x33, 0x9e, 0xc0, 0x8a, 0x8c, 0x74, 0x87, 0x8a, 0x50, 0x03, 0xdc, 0x21, 0x62, 0x9b, 0x7b, 0x8f,
-	0x98, 0x23, 0x7a, 0xc0, 0xeb, 0xd8, 0xc0, 0xd1, 0x73, 0x6f, 0xe0, 0x1f, 0x08, 0x8c, 0xd3, 0xa2,
-	0x6b, 0xe6, 0x2e, 0x5c, 0xb2, 0xdb, 0x0b, 0x2a, 0x0e, 0x11, 0x95, 0x9a, 0x5a, 0xa2, 0x37, 0x79,
-	0xa3, 0xaf, 0x1a, 0xdd, 0x02, 0x94, 0x17, 0xec, 0x6e, 0x61, 0x87, 0xb7, 0xd1, 0x1f, 0x23, 0xb8,
-	0x22, 0xe9, 0xdd, 0x6f, 0xf2, 0x90, 0xea, 0x6d, 0xa0, 0x5e, 0x18, 0xcb, 0xba, 0x00, 0x17, 0x98,
-	0xe3, 0x57, 0x5a, 0xd2, 0x4e, 0x30, 0xc7, 0x2f, 0xd9, 0xb8, 0x00, 0x38, 0x49, 0xd7, 0xe6, 0x2e,
-	0x61, 0x0a, 0x47, 0xa6, 0x3c, 0x97, 0x98, 0xb9, 0x23, 0x27, 0xf0, 0x15, 0xc8, 0x44, 0x8a, 0x34,
-	0x98, 0xcb, 0xc2, 0xc5, 0x31, 0xb9, 0x6a, 0xd2, 0x21, 0xe2, 0xdd, 0xe8, 0xdd, 0xf8, 0x02, 0xc1,
-	0xd5, 0xee, 0x10, 0xb4, 0xb6, 0xfb, 0x30, 0x15, 0x59, 0xfb, 0x6a, 0x58, 0xeb, 0x79, 0x39, 0xc5,
-	0x36, 0xe6, 0xb9, 0xcd, 0x99, 0xb7, 0xb5, 0x11, 0x9d, 0x9f, 0xef, 0xfe, 0xcc, 0xaf, 0x39, 0x2c,
-	0xac, 0x37, 0xab, 0x66, 0x8d, 0xbb, 0x96, 0x2e, 0x01, 0xea, 0xaf, 0x20, 0xec, 0x3d, 0x5d, 0x21,
-	0x22, 0x03, 0xa1, 0xce, 0x1a, 0x38, 0xad, 0xd0, 0x06, 0xd5, 0xaa, 0xbc, 0x47, 0x83, 0xbd, 0x06,
-	0x7d, 0x10, 0x50, 0xfa, 0x0e, 0xe7, 0x7b, 0x43, 0xaf, 0x0e, 0x2f, 0x62, 0xea, 0x27, 0xe2, 0x68,
-	0xea, 0x4d, 0x98, 0x73, 0xe5, 0x54, 0x25, 0x0c, 0x28, 0xad, 0xd4, 0xa3, 0x49, 0x2d, 0xc0, 0xdb,
-	0x7d, 0x0f, 0xd4, 0x87, 0x01, 0xf1, 0x7d, 0x6a, 0xa7, 0x7d, 0xc7, 0xae, 0x93, 0x59, 0x36, 0xeb,
-	0xa6, 0xc3, 0x0f, 0xef, 0x78, 0xdd, 0x80, 0x6c, 0x17, 0x7e, 0xbd, 0x4a, 0xca, 0x97, 0xa8, 0xab,
-	0xec, 0x2d, 0x35, 0x04, 0x5c, 0xec, 0x54, 0x43, 0x8b, 0x3f, 0x44, 0x31, 0x66, 0xd2, 0x62, 0x44,
-	0x15, 0x7c, 0xe9, 0x54, 0xe3, 0x13, 0xa5, 0x67, 0x1e, 0x26, 0xf8, 0x47, 0x1e, 0x0d, 0x74, 0x3e,
-	0xa8, 0x17, 0xbc, 0x04, 0xe0, 0x12, 0xd6, 0xa8, 0xf2, 0xc7, 0x51, 0x36, 0xa9, 0x24, 0xc8, 0xe8,
-	0x91, 0x92, 0x8d, 0x77, 0x61, 0x2a, 0xc1, 0x6d, 0x71, 0x5c, 0xd2, 0x2a, 0xf4, 0xa5, 0x15, 0x81,
-	0x69, 0x2b, 0xdf, 0x86, 0x6e, 0xec, 0xc2, 0x74, 0x72, 0x2e, 0x02, 0xd5, 0xa0, 0xe4, 0x91, 0x3a,
-	0x3d, 0xd3, 0x65, 0xf5, 0x12, 0x8d, 0xd6, 0x78, 0xd3, 0x0b, 0x25, 0xd4, 0x97, 0xca, 0xea, 0x05,
-	0x63, 0x18, 0x0f, 0x38, 0x57, 0x99, 0x3a, 0x5d, 0x96, 0xcf, 0xc6, 0x2a, 0xcc, 0xcb, 0xad, 0xd9,
-	0xe5, 0xdc, 0x3f, 0x6d, 0x0f, 0x2b, 0xb0, 0xd0, 0xb1, 0x4e, 0x03, 0xb8, 0x0b, 0x19, 0x8f, 0x73,
-	0x3f, 0xb9, 0x6b, 0xaf, 0xf5, 0xa5, 0xd7, 0xf2, 0x32, 0xe9, 0xe9, 0xa7, 0x13, 0x01, 0x86, 0x9e,
-	0x94, 0x3f, 0x22, 0x78, 0xb9, 0x33, 0x82, 0xe6, 0xf0, 0x3e, 0x40, 0x8b, 0x43, 0x9c, 0x87, 0x83,
-	0x93, 0x48, 0x9e, 0xb2, 0x4c, 0xcc, 0x67, 0x78, 0xc9, 0xb6, 0xfe, 0x2f, 0xc0, 0x84, 0x04, 0x8e,
-	0x3f, 0x45, 0x30, 0x1e, 0x35, 0x1c, 0xb8, 0xd8, 0x17, 0x5c, 0x67, 0xe7, 0x93, 0x5d, 0x3f, 0x8b,
-	0x89, 0x42, 0x61, 0x64, 0x3f, 0xf9, 0xf5, 0xef, 0xaf, 0x46, 0xe7, 0x31, 0xb6, 0xda, 0x7d, 0xd9,
-	0x41, 0xd1, 0x92, 0x3d, 0xc9, 0x67, 0x08, 0xc6, 0x4a, 0x8e, 0x8f, 0x6f, 0x0e, 0xec, 0x37, 0x46,
-	0x52, 0x3c, 0x83, 0x85, 0x06, 0x92, 0x97, 0x40, 0x2e, 0xe3, 0x4b, 0x27, 0x81, 0x58, 0x4f, 0x98,
-	0xfd, 0x14, 0xff, 0x8e, 0x60, 0xa1, 0xeb, 0x4d, 0x8e, 0xb7, 0x06, 0x8b, 0x76, 0x5a, 0x13, 0x92,
-	0xdd, 0xfe, 0x5f, 0x3e, 0x34, 0x87, 0x4d, 0xc9, 0xa1, 0x88, 0xad, 0x1e, 0x1c, 0xac, 0x1e, 0x8d,
-	0x06, 0xfe, 0x05, 0x45, 0x5d, 0x5b, 0xea, 0x0e, 0xc5, 0xb7, 0x07, 0x43, 0xd4, 0xfd, 0xf6, 0xcf,
-	0xbe, 0x75, 0x4e, 0x6b, 0xcd, 0x64, 0x43, 0x32, 0xb1, 0x70, 0xa1, 0x2b, 0x13, 0xd9, 0x56, 0x3c,
-	0xb5, 0xf6, 0x23, 0xe3, 0x4a, 0xe2, 0x82, 0xc7, 0x3f, 0x21, 0x98, 0xed, 0xb8, 0x10, 0x07, 0xe5,
-	0xd1, 0xfd, 0xbe, 0x1e, 0x94, 0x47, 0x8f, 0x5b, 0xd8, 0xb8, 0x26, 0x79, 0xac, 0xe0, 0x7c, 0x9a,
-	0xc7, 0x89, 0x9b, 0x19, 0xff, 0x8c, 0x60, 0x26, 0xed, 0x04, 0xbf, 0x79, 0x9e, 0xd0, 0x31, 0xee,
-	0xdb, 0xe7, 0x33, 0xd6, 0xb0, 0x6f, 0x48, 0xd8, 0xab, 0xf8, 0x95, 0x3e, 0xb0, 0x55, 0x66, 0x7c,
-	0x8d, 0x20, 0xd3, 0xaa, 0x78, 0xf8, 0xd6, 0x60, 0x91, 0x3b, 0x8b, 0x70, 0x76, 0xf3, 0xcc, 0x76,
-	0x1a, 0xec, 0xb2, 0x04, 0x9b, 0xc5, 0x8b, 0x69, 0xb0, 0xed, 0x72, 0x8b, 0xbf, 0x41, 0x30, 0x19,
-	0xdb, 0xe1, 0x8d, 0xb3, 0xc5, 0x89, 0xe1, 0xdd, 0x3a, 0xab, 0x99, 0x46, 0xf7, 0xaa, 0x44, 0x97,
-	0xc7, 0x4b, 0xbd, 0xd0, 0x49, 0x0d, 0xb7, 0x6a, 0xcf, 0x8e, 0x72, 0xe8, 0xf9, 0x51, 0x0e, 0xfd,
-	0x75, 0x94, 0x43, 0x9f, 0x1f, 0xe7, 0x46, 0x9e, 0x1f, 0xe7, 0x46, 0x7e, 0x3b, 0xce, 0x8d, 0x3c,
-	0x2c, 0x25, 0x7a, 0xd1, 0x6a, 0xcd, 0x2f, 0x30, 0xcf, 0xe3, 0x07, 0xea, 0xdb, 0xb7, 0xed, 0xb2,
-	0xa0, 0xbb, 0xd4, 0xc7, 0xea, 0xa3, 0xf6, 0xe6, 0x7a, 0x25, 0xfd, 0x5d, 0x2b, 0x5b, 0xd6, 0xea,
-	0x05, 0xf9, 0xd5, 0xfa, 0xc6, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x40, 0x2e, 0x8d, 0xc5,
-	0x0f, 0x00, 0x00,
+func (m *QueryAggregationHooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregationHooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregationHooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregationHooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregationHooksRequest.Merge(m, src)
+}
+func (m *QueryAggregationHooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregationHooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregationHooksRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAggregationHooksRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Igps ...
-	Igps(ctx context.Context, in *QueryIgpsRequest, opts ...grpc.CallOption) (*QueryIgpsResponse, error)
-	// Igp ...
-	Igp(ctx context.Context, in *QueryIgpRequest, opts ...grpc.CallOption) (*QueryIgpResponse, error)
-	// DestinationGasConfigs ...
-	DestinationGasConfigs(ctx context.Context, in *QueryDestinationGasConfigsRequest, opts ...grpc.CallOption) (*QueryDestinationGasConfigsResponse, error)
-	// QuoteGasPayment ...
-	QuoteGasPayment(ctx context.Context, in *QueryQuoteGasPaymentRequest, opts ...grpc.CallOption) (*QueryQuoteGasPaymentResponse, error)
-	// MerkleTreeHooks ...
-	MerkleTreeHooks(ctx context.Context, in *QueryMerkleTreeHooksRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHooksResponse, error)
-	// MerkleTreeHook ...
-	MerkleTreeHook(ctx context.Context, in *QueryMerkleTreeHookRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHookResponse, error)
-	// NoopHooks ...
-	NoopHooks(ctx context.Context, in *QueryNoopHooksRequest, opts ...grpc.CallOption) (*QueryNoopHooksResponse, error)
-	// NoopHook ...
-	NoopHook(ctx context.Context, in *QueryNoopHookRequest, opts ...grpc.CallOption) (*QueryNoopHookResponse, error)
+func (m *QueryAggregationHooksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAggregationHooksResponse ...
+type QueryAggregationHooksResponse struct {
+	AggregationHooks []AggregationHook   `protobuf:"bytes,1,rep,name=aggregation_hooks,json=aggregationHooks,proto3" json:"aggregation_hooks"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAggregationHooksResponse) Reset()         { *m = QueryAggregationHooksResponse{} }
+func (m *QueryAggregationHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregationHooksResponse) ProtoMessage()    {}
+func (*QueryAggregationHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{19}
 }
-
-func (c *queryClient) Igps(ctx context.Context, in *QueryIgpsRequest, opts ...grpc.CallOption) (*QueryIgpsResponse, error) {
-	out := new(QueryIgpsResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/Igps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregationHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregationHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregationHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAggregationHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregationHooksResponse.Merge(m, src)
+}
+func (m *QueryAggregationHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregationHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregationHooksResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Igp(ctx context.Context, in *QueryIgpRequest, opts ...grpc.CallOption) (*QueryIgpResponse, error) {
-	out := new(QueryIgpResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/Igp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregationHooksResponse proto.InternalMessageInfo
+
+func (m *QueryAggregationHooksResponse) GetAggregationHooks() []AggregationHook {
+	if m != nil {
+		return m.AggregationHooks
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DestinationGasConfigs(ctx context.Context, in *QueryDestinationGasConfigsRequest, opts ...grpc.CallOption) (*QueryDestinationGasConfigsResponse, error) {
-	out := new(QueryDestinationGasConfigsResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/DestinationGasConfigs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregationHooksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QuoteGasPayment(ctx context.Context, in *QueryQuoteGasPaymentRequest, opts ...grpc.CallOption) (*QueryQuoteGasPaymentResponse, error) {
-	out := new(QueryQuoteGasPaymentResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/QuoteGasPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAggregationHookRequest ...
+type QueryAggregationHookRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) MerkleTreeHooks(ctx context.Context, in *QueryMerkleTreeHooksRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHooksResponse, error) {
-	out := new(QueryMerkleTreeHooksResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHooks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregationHookRequest) Reset()         { *m = QueryAggregationHookRequest{} }
+func (m *QueryAggregationHookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregationHookRequest) ProtoMessage()    {}
+func (*QueryAggregationHookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{20}
+}
+func (m *QueryAggregationHookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregationHookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregationHookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAggregationHookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregationHookRequest.Merge(m, src)
+}
+func (m *QueryAggregationHookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregationHookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregationHookRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MerkleTreeHook(ctx context.Context, in *QueryMerkleTreeHookRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHookResponse, error) {
-	out := new(QueryMerkleTreeHookResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHook", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAggregationHookRequest proto.InternalMessageInfo
+
+func (m *QueryAggregationHookRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) NoopHooks(ctx context.Context, in *QueryNoopHooksRequest, opts ...grpc.CallOption) (*QueryNoopHooksResponse, error) {
-	out := new(QueryNoopHooksResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/NoopHooks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAggregationHookResponse ...
+type QueryAggregationHookResponse struct {
+	AggregationHook AggregationHook `protobuf:"bytes,1,opt,name=aggregation_hook,json=aggregationHook,proto3" json:"aggregation_hook"`
 }
 
-func (c *queryClient) NoopHook(ctx context.Context, in *QueryNoopHookRequest, opts ...grpc.CallOption) (*QueryNoopHookResponse, error) {
-	out := new(QueryNoopHookResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/NoopHook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAggregationHookResponse) Reset()         { *m = QueryAggregationHookResponse{} }
+func (m *QueryAggregationHookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregationHookResponse) ProtoMessage()    {}
+func (*QueryAggregationHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{21}
+}
+func (m *QueryAggregationHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregationHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregationHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Igps ...
-	Igps(context.Context, *QueryIgpsRequest) (*QueryIgpsResponse, error)
-	// Igp ...
-	Igp(context.Context, *QueryIgpRequest) (*QueryIgpResponse, error)
-	// DestinationGasConfigs ...
-	DestinationGasConfigs(context.Context, *QueryDestinationGasConfigsRequest) (*QueryDestinationGasConfigsResponse, error)
-	// QuoteGasPayment ...
-	QuoteGasPayment(context.Context, *QueryQuoteGasPaymentRequest) (*QueryQuoteGasPaymentResponse, error)
-	// MerkleTreeHooks ...
-	MerkleTreeHooks(context.Context, *QueryMerkleTreeHooksRequest) (*QueryMerkleTreeHooksResponse, error)
-	// MerkleTreeHook ...
-	MerkleTreeHook(context.Context, *QueryMerkleTreeHookRequest) (*QueryMerkleTreeHookResponse, error)
-	// NoopHooks ...
-	NoopHooks(context.Context, *QueryNoopHooksRequest) (*QueryNoopHooksResponse, error)
-	// NoopHook ...
-	NoopHook(context.Context, *QueryNoopHookRequest) (*QueryNoopHookResponse, error)
+func (m *QueryAggregationHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregationHookResponse.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAggregationHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregationHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregationHookResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Igps(ctx context.Context, req *QueryIgpsRequest) (*QueryIgpsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Igps not implemented")
+var xxx_messageInfo_QueryAggregationHookResponse proto.InternalMessageInfo
+
+func (m *QueryAggregationHookResponse) GetAggregationHook() AggregationHook {
+	if m != nil {
+		return m.AggregationHook
+	}
+	return AggregationHook{}
 }
-func (*UnimplementedQueryServer) Igp(ctx context.Context, req *QueryIgpRequest) (*QueryIgpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Igp not implemented")
+
+// QueryDomainRoutingHooksRequest ...
+type QueryDomainRoutingHooksRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) DestinationGasConfigs(ctx context.Context, req *QueryDestinationGasConfigsRequest) (*QueryDestinationGasConfigsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DestinationGasConfigs not implemented")
+
+func (m *QueryDomainRoutingHooksRequest) Reset()         { *m = QueryDomainRoutingHooksRequest{} }
+func (m *QueryDomainRoutingHooksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainRoutingHooksRequest) ProtoMessage()    {}
+func (*QueryDomainRoutingHooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{22}
 }
-func (*UnimplementedQueryServer) QuoteGasPayment(ctx context.Context, req *QueryQuoteGasPaymentRequest) (*QueryQuoteGasPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuoteGasPayment not implemented")
+func (m *QueryDomainRoutingHooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MerkleTreeHooks(ctx context.Context, req *QueryMerkleTreeHooksRequest) (*QueryMerkleTreeHooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeHooks not implemented")
+func (m *QueryDomainRoutingHooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainRoutingHooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MerkleTreeHook(ctx context.Context, req *QueryMerkleTreeHookRequest) (*QueryMerkleTreeHookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeHook not implemented")
+func (m *QueryDomainRoutingHooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainRoutingHooksRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) NoopHooks(ctx context.Context, req *QueryNoopHooksRequest) (*QueryNoopHooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NoopHooks not implemented")
+func (m *QueryDomainRoutingHooksRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) NoopHook(ctx context.Context, req *QueryNoopHookRequest) (*QueryNoopHookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NoopHook not implemented")
+func (m *QueryDomainRoutingHooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainRoutingHooksRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryDomainRoutingHooksRequest proto.InternalMessageInfo
 
-func _Query_Igps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIgpsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Igps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/Igps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Igps(ctx, req.(*QueryIgpsRequest))
+func (m *QueryDomainRoutingHooksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Igp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIgpRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Igp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/Igp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Igp(ctx, req.(*QueryIgpRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDomainRoutingHooksResponse ...
+type QueryDomainRoutingHooksResponse struct {
+	DomainRoutingHooks []DomainRoutingHook `protobuf:"bytes,1,rep,name=domain_routing_hooks,json=domainRoutingHooks,proto3" json:"domain_routing_hooks"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DestinationGasConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDestinationGasConfigsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DestinationGasConfigs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/DestinationGasConfigs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DestinationGasConfigs(ctx, req.(*QueryDestinationGasConfigsRequest))
+func (m *QueryDomainRoutingHooksResponse) Reset()         { *m = QueryDomainRoutingHooksResponse{} }
+func (m *QueryDomainRoutingHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainRoutingHooksResponse) ProtoMessage()    {}
+func (*QueryDomainRoutingHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{23}
+}
+func (m *QueryDomainRoutingHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainRoutingHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainRoutingHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDomainRoutingHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainRoutingHooksResponse.Merge(m, src)
+}
+func (m *QueryDomainRoutingHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainRoutingHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainRoutingHooksResponse.DiscardUnknown(m)
 }
 
-func _Query_QuoteGasPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQuoteGasPaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuoteGasPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/QuoteGasPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuoteGasPayment(ctx, req.(*QueryQuoteGasPaymentRequest))
+var xxx_messageInfo_QueryDomainRoutingHooksResponse proto.InternalMessageInfo
+
+func (m *QueryDomainRoutingHooksResponse) GetDomainRoutingHooks() []DomainRoutingHook {
+	if m != nil {
+		return m.DomainRoutingHooks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MerkleTreeHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMerkleTreeHooksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MerkleTreeHooks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHooks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MerkleTreeHooks(ctx, req.(*QueryMerkleTreeHooksRequest))
+func (m *QueryDomainRoutingHooksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MerkleTreeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMerkleTreeHookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MerkleTreeHook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MerkleTreeHook(ctx, req.(*QueryMerkleTreeHookRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDomainRoutingHookRequest ...
+type QueryDomainRoutingHookRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_NoopHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNoopHooksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NoopHooks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/NoopHooks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NoopHooks(ctx, req.(*QueryNoopHooksRequest))
+func (m *QueryDomainRoutingHookRequest) Reset()         { *m = QueryDomainRoutingHookRequest{} }
+func (m *QueryDomainRoutingHookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainRoutingHookRequest) ProtoMessage()    {}
+func (*QueryDomainRoutingHookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{24}
+}
+func (m *QueryDomainRoutingHookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainRoutingHookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainRoutingHookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDomainRoutingHookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainRoutingHookRequest.Merge(m, src)
+}
+func (m *QueryDomainRoutingHookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainRoutingHookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainRoutingHookRequest.DiscardUnknown(m)
 }
 
-func _Query_NoopHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNoopHookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NoopHook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/NoopHook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NoopHook(ctx, req.(*QueryNoopHookRequest))
+var xxx_messageInfo_QueryDomainRoutingHookRequest proto.InternalMessageInfo
+
+func (m *QueryDomainRoutingHookRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hyperlane.core.post_dispatch.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Igps",
-			Handler:    _Query_Igps_Handler,
-		},
-		{
-			MethodName: "Igp",
-			Handler:    _Query_Igp_Handler,
-		},
-		{
-			MethodName: "DestinationGasConfigs",
-			Handler:    _Query_DestinationGasConfigs_Handler,
-		},
-		{
-			MethodName: "QuoteGasPayment",
-			Handler:    _Query_QuoteGasPayment_Handler,
-		},
-		{
-			MethodName: "MerkleTreeHooks",
-			Handler:    _Query_MerkleTreeHooks_Handler,
-		},
-		{
-			MethodName: "MerkleTreeHook",
-			Handler:    _Query_MerkleTreeHook_Handler,
-		},
-		{
-			MethodName: "NoopHooks",
-			Handler:    _Query_NoopHooks_Handler,
-		},
-		{
-			MethodName: "NoopHook",
-			Handler:    _Query_NoopHook_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hyperlane/core/post_dispatch/v1/query.proto",
+// QueryDomainRoutingHookResponse ...
+type QueryDomainRoutingHookResponse struct {
+	DomainRoutingHook DomainRoutingHook `protobuf:"bytes,1,opt,name=domain_routing_hook,json=domainRoutingHook,proto3" json:"domain_routing_hook"`
+	Routes            []HookRoute       `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 }
 
-func (m *QueryIgpsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDomainRoutingHookResponse) Reset()         { *m = QueryDomainRoutingHookResponse{} }
+func (m *QueryDomainRoutingHookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainRoutingHookResponse) ProtoMessage()    {}
+func (*QueryDomainRoutingHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{25}
+}
+func (m *QueryDomainRoutingHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainRoutingHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainRoutingHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryIgpsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDomainRoutingHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainRoutingHookResponse.Merge(m, src)
+}
+func (m *QueryDomainRoutingHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainRoutingHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainRoutingHookResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIgpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDomainRoutingHookResponse proto.InternalMessageInfo
+
+func (m *QueryDomainRoutingHookResponse) GetDomainRoutingHook() DomainRoutingHook {
+	if m != nil {
+		return m.DomainRoutingHook
 	}
-	return len(dAtA) - i, nil
+	return DomainRoutingHook{}
 }
 
-func (m *QueryIgpsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDomainRoutingHookResponse) GetRoutes() []HookRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIgpsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProtocolFeeHooksRequest ...
+type QueryProtocolFeeHooksRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIgpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Igps) > 0 {
-		for iNdEx := len(m.Igps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Igps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryProtocolFeeHooksRequest) Reset()         { *m = QueryProtocolFeeHooksRequest{} }
+func (m *QueryProtocolFeeHooksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeeHooksRequest) ProtoMessage()    {}
+func (*QueryProtocolFeeHooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{26}
+}
+func (m *QueryProtocolFeeHooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeeHooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeeHooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProtocolFeeHooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeeHooksRequest.Merge(m, src)
+}
+func (m *QueryProtocolFeeHooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeeHooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeeHooksRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIgpRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProtocolFeeHooksRequest proto.InternalMessageInfo
+
+func (m *QueryProtocolFeeHooksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIgpRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProtocolFeeHooksResponse ...
+type QueryProtocolFeeHooksResponse struct {
+	ProtocolFeeHooks []ProtocolFeeHook   `protobuf:"bytes,1,rep,name=protocol_fee_hooks,json=protocolFeeHooks,proto3" json:"protocol_fee_hooks"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIgpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProtocolFeeHooksResponse) Reset()         { *m = QueryProtocolFeeHooksResponse{} }
+func (m *QueryProtocolFeeHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeeHooksResponse) ProtoMessage()    {}
+func (*QueryProtocolFeeHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{27}
+}
+func (m *QueryProtocolFeeHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeeHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeeHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProtocolFeeHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeeHooksResponse.Merge(m, src)
+}
+func (m *QueryProtocolFeeHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeeHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeeHooksResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIgpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProtocolFeeHooksResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeeHooksResponse) GetProtocolFeeHooks() []ProtocolFeeHook {
+	if m != nil {
+		return m.ProtocolFeeHooks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIgpResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProtocolFeeHooksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIgpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Igp.MarshalToSizedBuffer(dAtA[:i])
+// QueryProtocolFeeHookRequest ...
+type QueryProtocolFeeHookRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryProtocolFeeHookRequest) Reset()         { *m = QueryProtocolFeeHookRequest{} }
+func (m *QueryProtocolFeeHookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeeHookRequest) ProtoMessage()    {}
+func (*QueryProtocolFeeHookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{28}
+}
+func (m *QueryProtocolFeeHookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeeHookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeeHookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProtocolFeeHookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeeHookRequest.Merge(m, src)
+}
+func (m *QueryProtocolFeeHookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeeHookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeeHookRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDestinationGasConfigsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProtocolFeeHookRequest proto.InternalMessageInfo
+
+func (m *QueryProtocolFeeHookRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDestinationGasConfigsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProtocolFeeHookResponse ...
+type QueryProtocolFeeHookResponse struct {
+	ProtocolFeeHook ProtocolFeeHook `protobuf:"bytes,1,opt,name=protocol_fee_hook,json=protocolFeeHook,proto3" json:"protocol_fee_hook"`
 }
 
-func (m *QueryDestinationGasConfigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProtocolFeeHookResponse) Reset()         { *m = QueryProtocolFeeHookResponse{} }
+func (m *QueryProtocolFeeHookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeeHookResponse) ProtoMessage()    {}
+func (*QueryProtocolFeeHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{29}
+}
+func (m *QueryProtocolFeeHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeeHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeeHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProtocolFeeHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeeHookResponse.Merge(m, src)
+}
+func (m *QueryProtocolFeeHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeeHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeeHookResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDestinationGasConfigsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProtocolFeeHookResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeeHookResponse) GetProtocolFeeHook() ProtocolFeeHook {
+	if m != nil {
+		return m.ProtocolFeeHook
 	}
-	return dAtA[:n], nil
+	return ProtocolFeeHook{}
 }
 
-func (m *QueryDestinationGasConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeRootRequest ...
+type QueryMerkleTreeRootRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryDestinationGasConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DestinationGasConfigs) > 0 {
-		for iNdEx := len(m.DestinationGasConfigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DestinationGasConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMerkleTreeRootRequest) Reset()         { *m = QueryMerkleTreeRootRequest{} }
+func (m *QueryMerkleTreeRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeRootRequest) ProtoMessage()    {}
+func (*QueryMerkleTreeRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{30}
+}
+func (m *QueryMerkleTreeRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMerkleTreeRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeRootRequest.Merge(m, src)
+}
+func (m *QueryMerkleTreeRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeRootRequest.DiscardUnknown(m)
 }
 
-func (m *QueryQuoteGasPaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleTreeRootRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeRootRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryQuoteGasPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeRootResponse ...
+type QueryMerkleTreeRootResponse struct {
+	Root  []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *QueryQuoteGasPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GasLimit) > 0 {
-		i -= len(m.GasLimit)
-		copy(dAtA[i:], m.GasLimit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DestinationDomain) > 0 {
-		i -= len(m.DestinationDomain)
-		copy(dAtA[i:], m.DestinationDomain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationDomain)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryMerkleTreeRootResponse) Reset()         { *m = QueryMerkleTreeRootResponse{} }
+func (m *QueryMerkleTreeRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeRootResponse) ProtoMessage()    {}
+func (*QueryMerkleTreeRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{31}
+}
+func (m *QueryMerkleTreeRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.IgpId) > 0 {
-		i -= len(m.IgpId)
-		copy(dAtA[i:], m.IgpId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IgpId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryMerkleTreeRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeRootResponse.Merge(m, src)
+}
+func (m *QueryMerkleTreeRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleTreeRootResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeRootResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryQuoteGasPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleTreeRootResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryQuoteGasPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeLatestCheckpointRequest ...
+type QueryMerkleTreeLatestCheckpointRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryQuoteGasPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GasPayment) > 0 {
-		for iNdEx := len(m.GasPayment) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GasPayment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMerkleTreeLatestCheckpointRequest) Reset() {
+	*m = QueryMerkleTreeLatestCheckpointRequest{}
+}
+func (m *QueryMerkleTreeLatestCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeLatestCheckpointRequest) ProtoMessage()    {}
+func (*QueryMerkleTreeLatestCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{32}
+}
+func (m *QueryMerkleTreeLatestCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeLatestCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeLatestCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMerkleTreeLatestCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeLatestCheckpointRequest.Merge(m, src)
+}
+func (m *QueryMerkleTreeLatestCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeLatestCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeLatestCheckpointRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMerkleTreeHooksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleTreeLatestCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeLatestCheckpointRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMerkleTreeHooksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeLatestCheckpointResponse ...
+type QueryMerkleTreeLatestCheckpointResponse struct {
+	Checkpoint MerkleTreeCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryMerkleTreeHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryMerkleTreeLatestCheckpointResponse) Reset() {
+	*m = QueryMerkleTreeLatestCheckpointResponse{}
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeLatestCheckpointResponse) ProtoMessage()    {}
+func (*QueryMerkleTreeLatestCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{33}
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeLatestCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeLatestCheckpointResponse.Merge(m, src)
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeLatestCheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMerkleTreeHooksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleTreeLatestCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeLatestCheckpointResponse) GetCheckpoint() MerkleTreeCheckpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return dAtA[:n], nil
+	return MerkleTreeCheckpoint{}
 }
 
-func (m *QueryMerkleTreeHooksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeCheckpointRequest ...
+type QueryMerkleTreeCheckpointRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryMerkleTreeHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MerkleTreeHooks) > 0 {
-		for iNdEx := len(m.MerkleTreeHooks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MerkleTreeHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMerkleTreeCheckpointRequest) Reset()         { *m = QueryMerkleTreeCheckpointRequest{} }
+func (m *QueryMerkleTreeCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeCheckpointRequest) ProtoMessage()    {}
+func (*QueryMerkleTreeCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{34}
+}
+func (m *QueryMerkleTreeCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMerkleTreeHookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMerkleTreeCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeCheckpointRequest.Merge(m, src)
 }
-
-func (m *QueryMerkleTreeHookRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMerkleTreeCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeCheckpointRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMerkleTreeHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMerkleTreeCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeCheckpointRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryMerkleTreeHookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleTreeCheckpointRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryMerkleTreeHookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeCheckpointResponse ...
+type QueryMerkleTreeCheckpointResponse struct {
+	// height at which the checkpoint was recorded.
+	Height     int64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Checkpoint MerkleTreeCheckpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryMerkleTreeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MerkleTreeHook.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMerkleTreeCheckpointResponse) Reset()         { *m = QueryMerkleTreeCheckpointResponse{} }
+func (m *QueryMerkleTreeCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeCheckpointResponse) ProtoMessage()    {}
+func (*QueryMerkleTreeCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{35}
+}
+func (m *QueryMerkleTreeCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryMerkleTreeCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeCheckpointResponse.Merge(m, src)
+}
+func (m *QueryMerkleTreeCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeCheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *WrappedMerkleTreeHookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleTreeCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeCheckpointResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WrappedMerkleTreeHookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMerkleTreeCheckpointResponse) GetCheckpoint() MerkleTreeCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return MerkleTreeCheckpoint{}
 }
 
-func (m *WrappedMerkleTreeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MerkleTree != nil {
-		{
-			size, err := m.MerkleTree.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryMerkleTreeProofRequest ...
+type QueryMerkleTreeProofRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryMerkleTreeProofRequest) Reset()         { *m = QueryMerkleTreeProofRequest{} }
+func (m *QueryMerkleTreeProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeProofRequest) ProtoMessage()    {}
+func (*QueryMerkleTreeProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{36}
+}
+func (m *QueryMerkleTreeProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MailboxId) > 0 {
-		i -= len(m.MailboxId)
-		copy(dAtA[i:], m.MailboxId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryMerkleTreeProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeProofRequest.Merge(m, src)
+}
+func (m *QueryMerkleTreeProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleTreeProofRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeProofRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleTreeProofRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMerkleTreeProofResponse ...
+type QueryMerkleTreeProofResponse struct {
+	// leaf is the message id stored at the requested index.
+	Leaf  []byte `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// proof contains the sibling of each level, starting at the leaves.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	// root the proof resolves to.
+	Root []byte `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
 }
 
-func (m *TreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Leafs) > 0 {
-		for iNdEx := len(m.Leafs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Leafs[iNdEx])
-			copy(dAtA[i:], m.Leafs[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Leafs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMerkleTreeProofResponse) Reset()         { *m = QueryMerkleTreeProofResponse{} }
+func (m *QueryMerkleTreeProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleTreeProofResponse) ProtoMessage()    {}
+func (*QueryMerkleTreeProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e5ceb03adb8f60, []int{37}
+}
+func (m *QueryMerkleTreeProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleTreeProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleTreeProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMerkleTreeProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleTreeProofResponse.Merge(m, src)
+}
+func (m *QueryMerkleTreeProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleTreeProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleTreeProofResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNoopHookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMerkleTreeProofResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleTreeProofResponse) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNoopHookRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMerkleTreeProofResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *QueryNoopHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryMerkleTreeProofResponse) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryNoopHookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMerkleTreeProofResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNoopHookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryIgpsRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpsRequest")
+	proto.RegisterType((*QueryIgpsResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpsResponse")
+	proto.RegisterType((*QueryIgpRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpRequest")
+	proto.RegisterType((*QueryIgpResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryIgpResponse")
+	proto.RegisterType((*QueryDestinationGasConfigsRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryDestinationGasConfigsRequest")
+	proto.RegisterType((*QueryDestinationGasConfigsResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryDestinationGasConfigsResponse")
+	proto.RegisterType((*QueryQuoteGasPaymentRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryQuoteGasPaymentRequest")
+	proto.RegisterType((*QueryQuoteGasPaymentResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryQuoteGasPaymentResponse")
+	proto.RegisterType((*QueryMerkleTreeHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHooksRequest")
+	proto.RegisterType((*QueryMerkleTreeHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHooksResponse")
+	proto.RegisterType((*QueryMerkleTreeHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHookRequest")
+	proto.RegisterType((*QueryMerkleTreeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeHookResponse")
+	proto.RegisterType((*WrappedMerkleTreeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.WrappedMerkleTreeHookResponse")
+	proto.RegisterType((*TreeResponse)(nil), "hyperlane.core.post_dispatch.v1.TreeResponse")
+	proto.RegisterType((*QueryNoopHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHookRequest")
+	proto.RegisterType((*QueryNoopHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHookResponse")
+	proto.RegisterType((*QueryNoopHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHooksRequest")
+	proto.RegisterType((*QueryNoopHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryNoopHooksResponse")
+	proto.RegisterType((*QueryAggregationHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryAggregationHooksRequest")
+	proto.RegisterType((*QueryAggregationHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryAggregationHooksResponse")
+	proto.RegisterType((*QueryAggregationHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryAggregationHookRequest")
+	proto.RegisterType((*QueryAggregationHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryAggregationHookResponse")
+	proto.RegisterType((*QueryDomainRoutingHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryDomainRoutingHooksRequest")
+	proto.RegisterType((*QueryDomainRoutingHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryDomainRoutingHooksResponse")
+	proto.RegisterType((*QueryDomainRoutingHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryDomainRoutingHookRequest")
+	proto.RegisterType((*QueryDomainRoutingHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryDomainRoutingHookResponse")
+	proto.RegisterType((*QueryProtocolFeeHooksRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryProtocolFeeHooksRequest")
+	proto.RegisterType((*QueryProtocolFeeHooksResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryProtocolFeeHooksResponse")
+	proto.RegisterType((*QueryProtocolFeeHookRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryProtocolFeeHookRequest")
+	proto.RegisterType((*QueryProtocolFeeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryProtocolFeeHookResponse")
+	proto.RegisterType((*QueryMerkleTreeRootRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeRootRequest")
+	proto.RegisterType((*QueryMerkleTreeRootResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeRootResponse")
+	proto.RegisterType((*QueryMerkleTreeLatestCheckpointRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeLatestCheckpointRequest")
+	proto.RegisterType((*QueryMerkleTreeLatestCheckpointResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeLatestCheckpointResponse")
+	proto.RegisterType((*QueryMerkleTreeCheckpointRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeCheckpointRequest")
+	proto.RegisterType((*QueryMerkleTreeCheckpointResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeCheckpointResponse")
+	proto.RegisterType((*QueryMerkleTreeProofRequest)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeProofRequest")
+	proto.RegisterType((*QueryMerkleTreeProofResponse)(nil), "hyperlane.core.post_dispatch.v1.QueryMerkleTreeProofResponse")
 }
 
-func (m *QueryNoopHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NoopHook != nil {
-		{
-			size, err := m.NoopHook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("hyperlane/core/post_dispatch/v1/query.proto", fileDescriptor_32e5ceb03adb8f60)
 }
 
-func (m *QueryNoopHooksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_32e5ceb03adb8f60 = []byte{
+	// 1830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x77, 0xf9, 0xa5, 0xcc, 0xe7, 0x90, 0xf1, 0x14, 0xf6, 0xae, 0xb7, 0x13, 0x8f, 0xed, 0xd6,
+	0xae, 0xed, 0x78, 0x77, 0xa6, 0x33, 0x06, 0x27, 0x0b, 0x1b, 0x7b, 0x89, 0xbd, 0xb2, 0xd7, 0x68,
+	0x37, 0x72, 0x86, 0x08, 0xa4, 0x5c, 0x86, 0xf6, 0x4c, 0xb9, 0xa7, 0xe5, 0x99, 0xae, 0x76, 0x77,
+	0xdb, 0xc4, 0xb2, 0x2c, 0x21, 0x0e, 0x20, 0x94, 0x0b, 0x8f, 0x33, 0x88, 0x23, 0xe2, 0xc4, 0x01,
+	0x81, 0x78, 0x5e, 0x10, 0x52, 0xc4, 0x29, 0x22, 0x17, 0x10, 0x28, 0x44, 0x09, 0x12, 0x12, 0x17,
+	0xfe, 0x85, 0x55, 0x57, 0x57, 0x4f, 0xbf, 0xa7, 0x7b, 0xc6, 0xe3, 0x4b, 0x32, 0xdd, 0x55, 0xdf,
+	0xef, 0xfb, 0xfd, 0x7e, 0xf5, 0xf4, 0xd7, 0xf0, 0x6e, 0xf3, 0x54, 0x27, 0x46, 0x4b, 0xd6, 0x88,
+	0x54, 0xa7, 0x06, 0x91, 0x74, 0x6a, 0x5a, 0xb5, 0x86, 0x6a, 0xea, 0xb2, 0x55, 0x6f, 0x4a, 0x27,
+	0x15, 0xe9, 0xe8, 0x98, 0x18, 0xa7, 0x65, 0xdd, 0xa0, 0x16, 0xc5, 0x73, 0x9d, 0xce, 0x65, 0xbb,
+	0x73, 0x39, 0xd0, 0xb9, 0x7c, 0x52, 0x11, 0x56, 0xea, 0xd4, 0x6c, 0x53, 0x53, 0xda, 0x97, 0x4d,
+	0xe2, 0x44, 0x4a, 0x27, 0x95, 0x7d, 0x62, 0xc9, 0x15, 0x49, 0x97, 0x15, 0x55, 0x93, 0x2d, 0x95,
+	0x6a, 0x0e, 0x98, 0x70, 0x43, 0xa1, 0x54, 0x69, 0x11, 0x49, 0xd6, 0x55, 0x49, 0xd6, 0x34, 0x6a,
+	0xb1, 0x46, 0x93, 0xb7, 0x16, 0xe4, 0xb6, 0xaa, 0x51, 0x89, 0xfd, 0xcb, 0x5f, 0x4d, 0x29, 0x54,
+	0xa1, 0xec, 0xa7, 0x64, 0xff, 0xe2, 0x6f, 0x53, 0x05, 0x58, 0xa7, 0x3a, 0x71, 0x51, 0x8b, 0x7e,
+	0x7e, 0x2e, 0xb3, 0x3a, 0x55, 0x39, 0x27, 0xf1, 0x11, 0x4c, 0x3e, 0xb0, 0x59, 0xef, 0x2a, 0xba,
+	0x59, 0x25, 0x47, 0xc7, 0xc4, 0xb4, 0xf0, 0x36, 0x80, 0xc7, 0x7d, 0x06, 0xcd, 0xa3, 0xe5, 0x89,
+	0xd5, 0xc5, 0xb2, 0x03, 0x54, 0xb6, 0x81, 0xca, 0x8e, 0x45, 0x1c, 0xae, 0xbc, 0x27, 0x2b, 0x84,
+	0xc7, 0x56, 0x7d, 0x91, 0xe2, 0xaf, 0x10, 0x14, 0x7c, 0xe0, 0xa6, 0x4e, 0x35, 0x93, 0xe0, 0xaf,
+	0xc3, 0xa8, 0xaa, 0xe8, 0xe6, 0x0c, 0x9a, 0x1f, 0x59, 0x9e, 0x58, 0xbd, 0x53, 0x4e, 0x71, 0xb8,
+	0xbc, 0xab, 0x59, 0xc4, 0xa8, 0x37, 0x65, 0x55, 0xdb, 0x91, 0xcd, 0x3d, 0xf9, 0xb4, 0x2d, 0x9b,
+	0x16, 0x31, 0x36, 0x73, 0x4f, 0x5f, 0xcc, 0x0d, 0xfd, 0xfc, 0xbf, 0xbf, 0x5c, 0x41, 0x55, 0x86,
+	0x87, 0x77, 0x02, 0xac, 0x87, 0x19, 0xeb, 0xa5, 0x54, 0xd6, 0x0e, 0xa9, 0x00, 0xed, 0x05, 0xc8,
+	0xbb, 0xac, 0x5d, 0x47, 0xae, 0xc1, 0xb0, 0xda, 0x60, 0x4e, 0xe4, 0xaa, 0xc3, 0x6a, 0x43, 0x6c,
+	0x7a, 0xae, 0x75, 0x74, 0x3d, 0x84, 0x11, 0x55, 0xd1, 0xb9, 0x5d, 0x83, 0x90, 0x65, 0xc3, 0x89,
+	0x67, 0xb0, 0xc0, 0x32, 0x7d, 0x44, 0x4c, 0x8b, 0x13, 0xdc, 0x91, 0xcd, 0x2d, 0xaa, 0x1d, 0xa8,
+	0x8a, 0x99, 0x40, 0x0f, 0x6f, 0xc7, 0x58, 0xd1, 0xcf, 0x00, 0xfe, 0x0b, 0x81, 0xd8, 0x2d, 0x3b,
+	0x57, 0xde, 0x86, 0x37, 0x1b, 0x5e, 0x87, 0x9a, 0x22, 0x9b, 0xb5, 0xba, 0xd3, 0x85, 0x0f, 0xf2,
+	0x5a, 0xaa, 0x1b, 0x71, 0x09, 0xaa, 0xd3, 0x8d, 0xb8, 0xb4, 0x83, 0x1b, 0xe8, 0x6f, 0x23, 0xb8,
+	0xce, 0xe4, 0x3d, 0x38, 0xa6, 0x16, 0xe1, 0xc3, 0x40, 0x34, 0xcb, 0xb5, 0x75, 0x1a, 0xc6, 0x55,
+	0x45, 0xaf, 0x75, 0xac, 0x1d, 0x53, 0x15, 0x7d, 0xb7, 0x81, 0x4b, 0x80, 0xfd, 0x72, 0x1b, 0xb4,
+	0x2d, 0xab, 0x0e, 0x8f, 0x5c, 0xb5, 0xe0, 0x6b, 0xf9, 0x88, 0x35, 0xe0, 0xeb, 0x90, 0xb3, 0x1d,
+	0x69, 0xa9, 0x6d, 0xd5, 0x9a, 0x19, 0x61, 0xbd, 0xae, 0x28, 0xb2, 0xf9, 0x89, 0xfd, 0x2c, 0xfe,
+	0x10, 0xc1, 0x8d, 0x78, 0x0a, 0xdc, 0xdb, 0x23, 0x98, 0xb0, 0xa3, 0x75, 0xe7, 0x35, 0xf7, 0xf3,
+	0xad, 0x80, 0x5a, 0x57, 0xe7, 0x16, 0x55, 0xb5, 0xcd, 0x35, 0x7b, 0xfe, 0xfc, 0xe2, 0xdf, 0x73,
+	0xcb, 0x8a, 0x6a, 0x35, 0x8f, 0xf7, 0xcb, 0x75, 0xda, 0x96, 0xf8, 0x16, 0xe0, 0xfc, 0x57, 0x32,
+	0x1b, 0x87, 0x7c, 0x87, 0xb0, 0x03, 0x4c, 0x67, 0xae, 0x81, 0xd2, 0x49, 0x2d, 0x12, 0xee, 0xca,
+	0xa7, 0xc4, 0x38, 0x6c, 0x91, 0x87, 0x06, 0x21, 0x1f, 0x53, 0x7a, 0x38, 0xf0, 0xdd, 0xe1, 0x85,
+	0x2b, 0x3d, 0x92, 0x87, 0x4b, 0x3f, 0x86, 0x42, 0x9b, 0x35, 0xd5, 0x2c, 0x83, 0x90, 0x5a, 0xd3,
+	0x6e, 0xe4, 0x06, 0x6c, 0xa4, 0x4e, 0xa8, 0x6f, 0x18, 0xb2, 0xae, 0x93, 0x46, 0x10, 0xdb, 0x85,
+	0xf6, 0xaf, 0xb2, 0x7c, 0x3b, 0x98, 0x7e, 0x70, 0xd3, 0xeb, 0x3d, 0x10, 0x62, 0xf4, 0x25, 0x6d,
+	0x29, 0x3f, 0x42, 0xb1, 0xb6, 0x77, 0xdc, 0x30, 0x61, 0x32, 0xec, 0x06, 0x37, 0x7f, 0x80, 0x66,
+	0x5c, 0x0b, 0x9a, 0x61, 0xef, 0xe0, 0xb3, 0x5d, 0x83, 0x23, 0x5b, 0xcf, 0x14, 0x8c, 0xd1, 0x6f,
+	0x69, 0xc4, 0xe0, 0xeb, 0xc1, 0x79, 0xc0, 0xb3, 0x00, 0x6d, 0x59, 0x6d, 0xed, 0xd3, 0xc7, 0xf6,
+	0x6a, 0x72, 0x16, 0x41, 0x8e, 0xbf, 0xd9, 0x6d, 0xe0, 0xfb, 0x30, 0xe1, 0xd3, 0x36, 0x33, 0xca,
+	0x64, 0x95, 0x52, 0x65, 0xd9, 0x64, 0x3c, 0xe7, 0x3d, 0xea, 0xe2, 0x7d, 0xb8, 0xea, 0x6f, 0xb3,
+	0x49, 0xb5, 0x88, 0x7c, 0xe0, 0xcc, 0x9e, 0xab, 0x55, 0xe7, 0xc1, 0x7e, 0x5b, 0xa7, 0xc7, 0x9a,
+	0xc5, 0xa8, 0x7e, 0xae, 0xea, 0x3c, 0x60, 0x0c, 0xa3, 0x06, 0xa5, 0xce, 0x4a, 0xbd, 0x5a, 0x65,
+	0xbf, 0xc5, 0x45, 0x98, 0x62, 0x43, 0x73, 0x9f, 0x52, 0xbd, 0xdb, 0x18, 0xd6, 0x60, 0x3a, 0xd4,
+	0x8f, 0x13, 0xd8, 0x86, 0x9c, 0x46, 0xa9, 0xee, 0x1f, 0xb5, 0x9b, 0xa9, 0xf2, 0x3a, 0x28, 0x57,
+	0x34, 0xfe, 0x2b, 0x92, 0x60, 0xe0, 0x8b, 0xf2, 0xd7, 0x08, 0xde, 0x08, 0x67, 0xe0, 0x1a, 0xbe,
+	0x06, 0xd0, 0xd1, 0xe0, 0xae, 0xc3, 0xec, 0x22, 0xfc, 0xb3, 0x2c, 0xe7, 0xea, 0x19, 0xe0, 0x62,
+	0x3b, 0xe0, 0x9b, 0xc9, 0x3d, 0x45, 0x31, 0x88, 0xc2, 0xde, 0x5d, 0x8a, 0x41, 0x7f, 0x43, 0x30,
+	0x9b, 0x90, 0x88, 0xfb, 0xd4, 0x84, 0x82, 0xec, 0xb5, 0x05, 0xec, 0xba, 0x95, 0x6a, 0x57, 0x08,
+	0xd5, 0xef, 0xda, 0xa4, 0x1c, 0xca, 0x38, 0x38, 0xf3, 0x4a, 0x7c, 0xeb, 0x09, 0x65, 0x4f, 0x9a,
+	0xe6, 0xdf, 0x45, 0xf1, 0x66, 0x77, 0x2c, 0x38, 0x80, 0xc9, 0xb0, 0x05, 0xdc, 0xf2, 0x0b, 0x39,
+	0x90, 0x0f, 0x39, 0x20, 0x36, 0xa1, 0xe8, 0x5c, 0x4f, 0xd8, 0x49, 0x5b, 0xa5, 0xc7, 0x96, 0xaa,
+	0x29, 0x97, 0x32, 0xec, 0xff, 0x44, 0x30, 0x97, 0x98, 0x8a, 0xab, 0xa6, 0x30, 0xe5, 0xdc, 0x05,
+	0x6a, 0x86, 0xd3, 0x1c, 0x18, 0xfb, 0xd5, 0xf4, 0x3b, 0x50, 0x18, 0xda, 0xaf, 0x1d, 0x37, 0x22,
+	0x89, 0x07, 0x37, 0xfe, 0x12, 0x9f, 0xd3, 0x11, 0x06, 0x49, 0x33, 0xe0, 0x05, 0x4a, 0x72, 0xde,
+	0x77, 0x29, 0xfc, 0x7c, 0x8c, 0x1b, 0x7c, 0x08, 0x2e, 0x68, 0x46, 0x21, 0x62, 0x06, 0xfe, 0x14,
+	0xc6, 0xed, 0x3c, 0xc4, 0x9c, 0x19, 0x66, 0x76, 0xaf, 0xa4, 0x66, 0x60, 0x6c, 0xed, 0x10, 0x3f,
+	0x32, 0x07, 0xe9, 0x6c, 0x27, 0x7b, 0x06, 0xb5, 0x68, 0x9d, 0xb6, 0xb6, 0x2f, 0xe9, 0x12, 0xf4,
+	0xdc, 0xdd, 0x4e, 0xa2, 0x89, 0xb8, 0x8f, 0x2a, 0x60, 0x9d, 0xb7, 0xd5, 0x0e, 0x42, 0xd7, 0xa0,
+	0xf4, 0xd5, 0x14, 0x82, 0x0d, 0xec, 0x27, 0x7a, 0x28, 0xe5, 0xe0, 0xf7, 0x93, 0x50, 0xf6, 0xa4,
+	0xd9, 0xf4, 0x3d, 0x14, 0xef, 0x76, 0xc7, 0x03, 0x05, 0x0a, 0x11, 0x0f, 0x32, 0x6f, 0x28, 0x5d,
+	0x2c, 0xc8, 0x87, 0x2c, 0x88, 0xb9, 0xb2, 0x55, 0x29, 0xb5, 0x92, 0x78, 0xef, 0xc0, 0xf5, 0xd8,
+	0xde, 0x9c, 0xb5, 0x7b, 0x93, 0x40, 0xde, 0x4d, 0x22, 0xfe, 0xce, 0x21, 0xbe, 0x0f, 0x8b, 0x21,
+	0xa0, 0x4f, 0x64, 0x8b, 0x98, 0xd6, 0x56, 0x93, 0xd4, 0x0f, 0x75, 0xaa, 0x6a, 0x89, 0x14, 0x9e,
+	0x20, 0x58, 0x4a, 0x0d, 0xe5, 0x7c, 0xbe, 0x09, 0x50, 0xef, 0xbc, 0xe5, 0xf6, 0xa5, 0xff, 0x65,
+	0xe6, 0x01, 0x7b, 0x90, 0x7e, 0x0f, 0x7d, 0x98, 0xe2, 0x57, 0x61, 0x3e, 0x44, 0x26, 0x55, 0x01,
+	0x7e, 0x03, 0xc6, 0x9b, 0x44, 0x55, 0x9a, 0x8e, 0x25, 0x23, 0x55, 0xfe, 0x24, 0xfe, 0x04, 0xc1,
+	0x42, 0x17, 0x30, 0xae, 0xc9, 0x8b, 0x46, 0xfe, 0xe8, 0x90, 0xd6, 0xe1, 0x4b, 0xd0, 0xba, 0x15,
+	0x19, 0xfc, 0x3d, 0x83, 0xd2, 0x83, 0x24, 0x99, 0x53, 0x30, 0xa6, 0x6a, 0x0d, 0xf2, 0xd8, 0x1d,
+	0x78, 0xf6, 0x20, 0x1a, 0x70, 0x23, 0x1e, 0xc4, 0x9b, 0x42, 0xf6, 0x5d, 0xd5, 0x9d, 0x42, 0xf6,
+	0xef, 0x78, 0x24, 0xfb, 0xad, 0x6e, 0x87, 0xce, 0x8c, 0x38, 0x57, 0x5c, 0xf6, 0xd0, 0x99, 0x82,
+	0xa3, 0xde, 0x14, 0x5c, 0xfd, 0x9f, 0x00, 0x63, 0x2c, 0x29, 0xfe, 0x3e, 0x82, 0x51, 0xbb, 0x34,
+	0x83, 0x2b, 0xa9, 0xce, 0x84, 0x6b, 0x44, 0xc2, 0x6a, 0x2f, 0x21, 0x8e, 0x1a, 0x51, 0xf8, 0xce,
+	0xf3, 0xff, 0xfc, 0x78, 0x78, 0x0a, 0x63, 0xa9, 0x13, 0x6b, 0x97, 0xab, 0x58, 0xf5, 0xe6, 0x09,
+	0x82, 0x91, 0x5d, 0x45, 0xc7, 0xb7, 0x32, 0xe3, 0xba, 0x4c, 0x2a, 0x3d, 0x44, 0x70, 0x22, 0x73,
+	0x8c, 0xc8, 0x5b, 0xf8, 0xcd, 0x28, 0x11, 0xe9, 0x4c, 0x6d, 0x9c, 0xe3, 0x7f, 0x20, 0x98, 0x8e,
+	0xad, 0x79, 0xe0, 0xcd, 0x6c, 0xd9, 0xba, 0x95, 0x6b, 0x84, 0xad, 0x0b, 0x61, 0x70, 0x0d, 0x77,
+	0x98, 0x86, 0x0a, 0x96, 0x12, 0x34, 0x48, 0x09, 0x25, 0x19, 0xfc, 0x17, 0x04, 0xf9, 0x50, 0xb5,
+	0x01, 0xdf, 0xcd, 0xc6, 0x28, 0xbe, 0x4e, 0x22, 0xac, 0xf7, 0x19, 0xcd, 0x95, 0xac, 0x31, 0x25,
+	0x12, 0x2e, 0xc5, 0x2a, 0x61, 0x05, 0x98, 0x73, 0xe9, 0xc8, 0x0e, 0xae, 0xf9, 0x4a, 0x21, 0xf8,
+	0x37, 0x08, 0xf2, 0xa1, 0xd2, 0x41, 0x56, 0x1d, 0xf1, 0x95, 0x0d, 0x61, 0xbd, 0xcf, 0x68, 0xae,
+	0x63, 0x89, 0xe9, 0x58, 0xc0, 0x73, 0x41, 0x1d, 0x91, 0x1a, 0x06, 0xfe, 0x2d, 0x82, 0x6b, 0x41,
+	0x10, 0xfc, 0x41, 0x3f, 0xa9, 0x5d, 0xde, 0x77, 0xfb, 0x0b, 0xe6, 0xb4, 0xdf, 0x63, 0xb4, 0x17,
+	0xf1, 0xdb, 0x29, 0xb4, 0x9d, 0x95, 0xf1, 0xa7, 0x00, 0x77, 0xfb, 0xbc, 0xeb, 0x9d, 0xbb, 0xef,
+	0x4c, 0x15, 0xee, 0xf6, 0x17, 0xcc, 0xb9, 0x57, 0x18, 0xf7, 0x77, 0xf1, 0xcd, 0x2c, 0xdc, 0x25,
+	0x76, 0x02, 0xff, 0x1f, 0x81, 0x90, 0x7c, 0x58, 0xe2, 0x9d, 0x5e, 0xf9, 0x24, 0x9c, 0xd4, 0xc2,
+	0xc7, 0x17, 0x07, 0xe2, 0x22, 0x37, 0x98, 0xc8, 0xf7, 0xf1, 0xed, 0x4c, 0x22, 0x5b, 0x0c, 0xa6,
+	0xe6, 0x9d, 0x54, 0xf8, 0x25, 0x82, 0xa9, 0xb8, 0x93, 0x0d, 0xdf, 0xeb, 0x95, 0x62, 0x54, 0xe5,
+	0xe6, 0x45, 0x20, 0xb8, 0xbe, 0x7b, 0x4c, 0xdf, 0x07, 0xf8, 0x4b, 0x99, 0xf4, 0x79, 0xc2, 0x4c,
+	0xe9, 0xcc, 0x39, 0xed, 0xcf, 0xf1, 0x5f, 0x03, 0x7b, 0x01, 0x3b, 0x43, 0x7b, 0xdf, 0x0b, 0xfc,
+	0xe7, 0xb7, 0xb0, 0xde, 0x67, 0x34, 0xd7, 0xf4, 0x65, 0xa6, 0xe9, 0x8b, 0x78, 0x35, 0x93, 0x26,
+	0x76, 0x58, 0x4b, 0x67, 0xec, 0x24, 0x3f, 0xc7, 0x3f, 0x45, 0x90, 0xeb, 0x94, 0x5f, 0xf0, 0xed,
+	0x6c, 0x44, 0xc2, 0x15, 0x21, 0xe1, 0x4e, 0xcf, 0x71, 0x9c, 0xfa, 0x3c, 0xa3, 0x2e, 0xe0, 0x99,
+	0x20, 0x75, 0xaf, 0xf6, 0x83, 0x7f, 0x86, 0xe0, 0x8a, 0x1b, 0x87, 0xd7, 0x7a, 0xcb, 0xe3, 0xd2,
+	0xbb, 0xdd, 0x6b, 0x18, 0x67, 0xf7, 0x0e, 0x63, 0x37, 0x87, 0x67, 0x93, 0xd8, 0x39, 0xdb, 0xd4,
+	0xef, 0x10, 0x4c, 0x86, 0x2b, 0x34, 0x38, 0xe3, 0x98, 0x26, 0x94, 0x90, 0x84, 0x8d, 0x7e, 0xc3,
+	0xbb, 0x9f, 0x0f, 0x91, 0x62, 0x11, 0xfe, 0x03, 0x82, 0x7c, 0x08, 0x25, 0xeb, 0x6c, 0x8e, 0xaf,
+	0xe0, 0x08, 0xeb, 0x7d, 0x46, 0x77, 0x3f, 0x22, 0x22, 0xcc, 0x1d, 0xef, 0xff, 0x8c, 0x00, 0x47,
+	0xcb, 0x24, 0xf8, 0xc3, 0x8c, 0xb7, 0x9e, 0xa4, 0x5a, 0x8e, 0xf0, 0x95, 0xfe, 0x01, 0xb8, 0x8e,
+	0x15, 0xa6, 0xe3, 0x6d, 0x2c, 0x06, 0x75, 0xc4, 0x55, 0x6d, 0xec, 0x6b, 0x52, 0x21, 0x02, 0x85,
+	0x37, 0xfa, 0xe4, 0xe0, 0x6a, 0xf8, 0xb0, 0xef, 0x78, 0x2e, 0x41, 0x62, 0x12, 0x6e, 0xe2, 0xa5,
+	0x74, 0x09, 0xce, 0x68, 0xfc, 0x1e, 0xc1, 0x64, 0xb8, 0xb8, 0x90, 0x75, 0x25, 0x24, 0x54, 0x3f,
+	0x84, 0x8d, 0x7e, 0xc3, 0xb9, 0x88, 0x65, 0x26, 0x42, 0xc4, 0xf3, 0x41, 0x11, 0xd1, 0x3a, 0x07,
+	0xfe, 0x23, 0x82, 0x7c, 0x08, 0x26, 0xeb, 0x52, 0x88, 0x2f, 0x3e, 0x08, 0xeb, 0x7d, 0x46, 0x73,
+	0xea, 0x25, 0x46, 0x7d, 0x09, 0xbf, 0x93, 0x46, 0x9d, 0xb9, 0xbf, 0x59, 0x7f, 0xfa, 0xaa, 0x88,
+	0x9e, 0xbd, 0x2a, 0xa2, 0x97, 0xaf, 0x8a, 0xe8, 0x07, 0xaf, 0x8b, 0x43, 0xcf, 0x5e, 0x17, 0x87,
+	0xfe, 0xfe, 0xba, 0x38, 0xf4, 0x68, 0xd7, 0xf7, 0x81, 0x6e, 0xbf, 0xae, 0x97, 0x54, 0x4d, 0xa3,
+	0x27, 0x6c, 0x2d, 0x99, 0x1e, 0x74, 0x89, 0x7f, 0xba, 0x7b, 0xec, 0x7c, 0xe9, 0xbf, 0xb5, 0x5a,
+	0x0b, 0x7e, 0xec, 0x67, 0xdf, 0xf1, 0xf6, 0xc7, 0x59, 0xf6, 0x2f, 0x7c, 0x36, 0x00, 0x6d, 0x0b,
+	0x63, 0xc8, 0xda, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Igps ...
+	Igps(ctx context.Context, in *QueryIgpsRequest, opts ...grpc.CallOption) (*QueryIgpsResponse, error)
+	// Igp ...
+	Igp(ctx context.Context, in *QueryIgpRequest, opts ...grpc.CallOption) (*QueryIgpResponse, error)
+	// DestinationGasConfigs ...
+	DestinationGasConfigs(ctx context.Context, in *QueryDestinationGasConfigsRequest, opts ...grpc.CallOption) (*QueryDestinationGasConfigsResponse, error)
+	// QuoteGasPayment ...
+	QuoteGasPayment(ctx context.Context, in *QueryQuoteGasPaymentRequest, opts ...grpc.CallOption) (*QueryQuoteGasPaymentResponse, error)
+	// MerkleTreeHooks ...
+	MerkleTreeHooks(ctx context.Context, in *QueryMerkleTreeHooksRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHooksResponse, error)
+	// MerkleTreeHook ...
+	MerkleTreeHook(ctx context.Context, in *QueryMerkleTreeHookRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHookResponse, error)
+	// MerkleTreeRoot returns the current root of a merkle tree hook.
+	MerkleTreeRoot(ctx context.Context, in *QueryMerkleTreeRootRequest, opts ...grpc.CallOption) (*QueryMerkleTreeRootResponse, error)
+	// MerkleTreeLatestCheckpoint returns the root, index and message id of the
+	// last inserted leaf.
+	MerkleTreeLatestCheckpoint(ctx context.Context, in *QueryMerkleTreeLatestCheckpointRequest, opts ...grpc.CallOption) (*QueryMerkleTreeLatestCheckpointResponse, error)
+	// MerkleTreeCheckpoint returns the last checkpoint recorded at or before
+	// the given block height.
+	MerkleTreeCheckpoint(ctx context.Context, in *QueryMerkleTreeCheckpointRequest, opts ...grpc.CallOption) (*QueryMerkleTreeCheckpointResponse, error)
+	// MerkleTreeProof returns the inclusion proof of a leaf against the
+	// current root.
+	MerkleTreeProof(ctx context.Context, in *QueryMerkleTreeProofRequest, opts ...grpc.CallOption) (*QueryMerkleTreeProofResponse, error)
+	// NoopHooks ...
+	NoopHooks(ctx context.Context, in *QueryNoopHooksRequest, opts ...grpc.CallOption) (*QueryNoopHooksResponse, error)
+	// NoopHook ...
+	NoopHook(ctx context.Context, in *QueryNoopHookRequest, opts ...grpc.CallOption) (*QueryNoopHookResponse, error)
+	// AggregationHooks ...
+	AggregationHooks(ctx context.Context, in *QueryAggregationHooksRequest, opts ...grpc.CallOption) (*QueryAggregationHooksResponse, error)
+	// AggregationHook ...
+	AggregationHook(ctx context.Context, in *QueryAggregationHookRequest, opts ...grpc.CallOption) (*QueryAggregationHookResponse, error)
+	// DomainRoutingHooks ...
+	DomainRoutingHooks(ctx context.Context, in *QueryDomainRoutingHooksRequest, opts ...grpc.CallOption) (*QueryDomainRoutingHooksResponse, error)
+	// DomainRoutingHook returns a domain routing hook with all of its routes.
+	DomainRoutingHook(ctx context.Context, in *QueryDomainRoutingHookRequest, opts ...grpc.CallOption) (*QueryDomainRoutingHookResponse, error)
+	// ProtocolFeeHooks ...
+	ProtocolFeeHooks(ctx context.Context, in *QueryProtocolFeeHooksRequest, opts ...grpc.CallOption) (*QueryProtocolFeeHooksResponse, error)
+	// ProtocolFeeHook ...
+	ProtocolFeeHook(ctx context.Context, in *QueryProtocolFeeHookRequest, opts ...grpc.CallOption) (*QueryProtocolFeeHookResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Igps(ctx context.Context, in *QueryIgpsRequest, opts ...grpc.CallOption) (*QueryIgpsResponse, error) {
+	out := new(QueryIgpsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/Igps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNoopHooksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Igp(ctx context.Context, in *QueryIgpRequest, opts ...grpc.CallOption) (*QueryIgpResponse, error) {
+	out := new(QueryIgpResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/Igp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNoopHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DestinationGasConfigs(ctx context.Context, in *QueryDestinationGasConfigsRequest, opts ...grpc.CallOption) (*QueryDestinationGasConfigsResponse, error) {
+	out := new(QueryDestinationGasConfigsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/DestinationGasConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNoopHooksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuoteGasPayment(ctx context.Context, in *QueryQuoteGasPaymentRequest, opts ...grpc.CallOption) (*QueryQuoteGasPaymentResponse, error) {
+	out := new(QueryQuoteGasPaymentResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/QuoteGasPayment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryNoopHooksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MerkleTreeHooks(ctx context.Context, in *QueryMerkleTreeHooksRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHooksResponse, error) {
+	out := new(QueryMerkleTreeHooksResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryNoopHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NoopHooks) > 0 {
-		for iNdEx := len(m.NoopHooks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NoopHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) MerkleTreeHook(ctx context.Context, in *QueryMerkleTreeHookRequest, opts ...grpc.CallOption) (*QueryMerkleTreeHookResponse, error) {
+	out := new(QueryMerkleTreeHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) MerkleTreeRoot(ctx context.Context, in *QueryMerkleTreeRootRequest, opts ...grpc.CallOption) (*QueryMerkleTreeRootResponse, error) {
+	out := new(QueryMerkleTreeRootResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryIgpsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) MerkleTreeLatestCheckpoint(ctx context.Context, in *QueryMerkleTreeLatestCheckpointRequest, opts ...grpc.CallOption) (*QueryMerkleTreeLatestCheckpointResponse, error) {
+	out := new(QueryMerkleTreeLatestCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeLatestCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIgpsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Igps) > 0 {
-		for _, e := range m.Igps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) MerkleTreeCheckpoint(ctx context.Context, in *QueryMerkleTreeCheckpointRequest, opts ...grpc.CallOption) (*QueryMerkleTreeCheckpointResponse, error) {
+	out := new(QueryMerkleTreeCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIgpRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) MerkleTreeProof(ctx context.Context, in *QueryMerkleTreeProofRequest, opts ...grpc.CallOption) (*QueryMerkleTreeProofResponse, error) {
+	out := new(QueryMerkleTreeProofResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIgpResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) NoopHooks(ctx context.Context, in *QueryNoopHooksRequest, opts ...grpc.CallOption) (*QueryNoopHooksResponse, error) {
+	out := new(QueryNoopHooksResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/NoopHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Igp.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryDestinationGasConfigsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) NoopHook(ctx context.Context, in *QueryNoopHookRequest, opts ...grpc.CallOption) (*QueryNoopHookResponse, error) {
+	out := new(QueryNoopHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/NoopHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDestinationGasConfigsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DestinationGasConfigs) > 0 {
-		for _, e := range m.DestinationGasConfigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) AggregationHooks(ctx context.Context, in *QueryAggregationHooksRequest, opts ...grpc.CallOption) (*QueryAggregationHooksResponse, error) {
+	out := new(QueryAggregationHooksResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/AggregationHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryQuoteGasPaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IgpId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DestinationDomain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GasLimit)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) AggregationHook(ctx context.Context, in *QueryAggregationHookRequest, opts ...grpc.CallOption) (*QueryAggregationHookResponse, error) {
+	out := new(QueryAggregationHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/AggregationHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryQuoteGasPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GasPayment) > 0 {
-		for _, e := range m.GasPayment {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) DomainRoutingHooks(ctx context.Context, in *QueryDomainRoutingHooksRequest, opts ...grpc.CallOption) (*QueryDomainRoutingHooksResponse, error) {
+	out := new(QueryDomainRoutingHooksResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/DomainRoutingHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryMerkleTreeHooksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DomainRoutingHook(ctx context.Context, in *QueryDomainRoutingHookRequest, opts ...grpc.CallOption) (*QueryDomainRoutingHookResponse, error) {
+	out := new(QueryDomainRoutingHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/DomainRoutingHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryMerkleTreeHooksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MerkleTreeHooks) > 0 {
-		for _, e := range m.MerkleTreeHooks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ProtocolFeeHooks(ctx context.Context, in *QueryProtocolFeeHooksRequest, opts ...grpc.CallOption) (*QueryProtocolFeeHooksResponse, error) {
+	out := new(QueryProtocolFeeHooksResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/ProtocolFeeHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryMerkleTreeHookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ProtocolFeeHook(ctx context.Context, in *QueryProtocolFeeHookRequest, opts ...grpc.CallOption) (*QueryProtocolFeeHookResponse, error) {
+	out := new(QueryProtocolFeeHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Query/ProtocolFeeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Igps ...
+	Igps(context.Context, *QueryIgpsRequest) (*QueryIgpsResponse, error)
+	// Igp ...
+	Igp(context.Context, *QueryIgpRequest) (*QueryIgpResponse, error)
+	// DestinationGasConfigs ...
+	DestinationGasConfigs(context.Context, *QueryDestinationGasConfigsRequest) (*QueryDestinationGasConfigsResponse, error)
+	// QuoteGasPayment ...
+	QuoteGasPayment(context.Context, *QueryQuoteGasPaymentRequest) (*QueryQuoteGasPaymentResponse, error)
+	// MerkleTreeHooks ...
+	MerkleTreeHooks(context.Context, *QueryMerkleTreeHooksRequest) (*QueryMerkleTreeHooksResponse, error)
+	// MerkleTreeHook ...
+	MerkleTreeHook(context.Context, *QueryMerkleTreeHookRequest) (*QueryMerkleTreeHookResponse, error)
+	// MerkleTreeRoot returns the current root of a merkle tree hook.
+	MerkleTreeRoot(context.Context, *QueryMerkleTreeRootRequest) (*QueryMerkleTreeRootResponse, error)
+	// MerkleTreeLatestCheckpoint returns the root, index and message id of the
+	// last inserted leaf.
+	MerkleTreeLatestCheckpoint(context.Context, *QueryMerkleTreeLatestCheckpointRequest) (*QueryMerkleTreeLatestCheckpointResponse, error)
+	// MerkleTreeCheckpoint returns the last checkpoint recorded at or before
+	// the given block height.
+	MerkleTreeCheckpoint(context.Context, *QueryMerkleTreeCheckpointRequest) (*QueryMerkleTreeCheckpointResponse, error)
+	// MerkleTreeProof returns the inclusion proof of a leaf against the
+	// current root.
+	MerkleTreeProof(context.Context, *QueryMerkleTreeProofRequest) (*QueryMerkleTreeProofResponse, error)
+	// NoopHooks ...
+	NoopHooks(context.Context, *QueryNoopHooksRequest) (*QueryNoopHooksResponse, error)
+	// NoopHook ...
+	NoopHook(context.Context, *QueryNoopHookRequest) (*QueryNoopHookResponse, error)
+	// AggregationHooks ...
+	AggregationHooks(context.Context, *QueryAggregationHooksRequest) (*QueryAggregationHooksResponse, error)
+	// AggregationHook ...
+	AggregationHook(context.Context, *QueryAggregationHookRequest) (*QueryAggregationHookResponse, error)
+	// DomainRoutingHooks ...
+	DomainRoutingHooks(context.Context, *QueryDomainRoutingHooksRequest) (*QueryDomainRoutingHooksResponse, error)
+	// DomainRoutingHook returns a domain routing hook with all of its routes.
+	DomainRoutingHook(context.Context, *QueryDomainRoutingHookRequest) (*QueryDomainRoutingHookResponse, error)
+	// ProtocolFeeHooks ...
+	ProtocolFeeHooks(context.Context, *QueryProtocolFeeHooksRequest) (*QueryProtocolFeeHooksResponse, error)
+	// ProtocolFeeHook ...
+	ProtocolFeeHook(context.Context, *QueryProtocolFeeHookRequest) (*QueryProtocolFeeHookResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Igps(ctx context.Context, req *QueryIgpsRequest) (*QueryIgpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Igps not implemented")
+}
+func (*UnimplementedQueryServer) Igp(ctx context.Context, req *QueryIgpRequest) (*QueryIgpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Igp not implemented")
+}
+func (*UnimplementedQueryServer) DestinationGasConfigs(ctx context.Context, req *QueryDestinationGasConfigsRequest) (*QueryDestinationGasConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestinationGasConfigs not implemented")
+}
+func (*UnimplementedQueryServer) QuoteGasPayment(ctx context.Context, req *QueryQuoteGasPaymentRequest) (*QueryQuoteGasPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteGasPayment not implemented")
+}
+func (*UnimplementedQueryServer) MerkleTreeHooks(ctx context.Context, req *QueryMerkleTreeHooksRequest) (*QueryMerkleTreeHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeHooks not implemented")
+}
+func (*UnimplementedQueryServer) MerkleTreeHook(ctx context.Context, req *QueryMerkleTreeHookRequest) (*QueryMerkleTreeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeHook not implemented")
+}
+func (*UnimplementedQueryServer) MerkleTreeRoot(ctx context.Context, req *QueryMerkleTreeRootRequest) (*QueryMerkleTreeRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeRoot not implemented")
+}
+func (*UnimplementedQueryServer) MerkleTreeLatestCheckpoint(ctx context.Context, req *QueryMerkleTreeLatestCheckpointRequest) (*QueryMerkleTreeLatestCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeLatestCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) MerkleTreeCheckpoint(ctx context.Context, req *QueryMerkleTreeCheckpointRequest) (*QueryMerkleTreeCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) MerkleTreeProof(ctx context.Context, req *QueryMerkleTreeProofRequest) (*QueryMerkleTreeProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleTreeProof not implemented")
+}
+func (*UnimplementedQueryServer) NoopHooks(ctx context.Context, req *QueryNoopHooksRequest) (*QueryNoopHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NoopHooks not implemented")
+}
+func (*UnimplementedQueryServer) NoopHook(ctx context.Context, req *QueryNoopHookRequest) (*QueryNoopHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NoopHook not implemented")
+}
+func (*UnimplementedQueryServer) AggregationHooks(ctx context.Context, req *QueryAggregationHooksRequest) (*QueryAggregationHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregationHooks not implemented")
+}
+func (*UnimplementedQueryServer) AggregationHook(ctx context.Context, req *QueryAggregationHookRequest) (*QueryAggregationHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregationHook not implemented")
+}
+func (*UnimplementedQueryServer) DomainRoutingHooks(ctx context.Context, req *QueryDomainRoutingHooksRequest) (*QueryDomainRoutingHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainRoutingHooks not implemented")
+}
+func (*UnimplementedQueryServer) DomainRoutingHook(ctx context.Context, req *QueryDomainRoutingHookRequest) (*QueryDomainRoutingHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainRoutingHook not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFeeHooks(ctx context.Context, req *QueryProtocolFeeHooksRequest) (*QueryProtocolFeeHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFeeHooks not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFeeHook(ctx context.Context, req *QueryProtocolFeeHookRequest) (*QueryProtocolFeeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFeeHook not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Igps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIgpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Igps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/Igps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Igps(ctx, req.(*QueryIgpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMerkleTreeHookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Igp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIgpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MerkleTreeHook.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Igp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/Igp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Igp(ctx, req.(*QueryIgpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WrappedMerkleTreeHookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DestinationGasConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDestinationGasConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).DestinationGasConfigs(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/DestinationGasConfigs",
 	}
-	l = len(m.MailboxId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DestinationGasConfigs(ctx, req.(*QueryDestinationGasConfigsRequest))
 	}
-	if m.MerkleTree != nil {
-		l = m.MerkleTree.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteGasPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteGasPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteGasPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/QuoteGasPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteGasPayment(ctx, req.(*QueryQuoteGasPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MerkleTreeHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleTreeHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Leafs) > 0 {
-		for _, b := range m.Leafs {
-			l = len(b)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleTreeHooks(ctx, in)
 	}
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHooks",
 	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleTreeHooks(ctx, req.(*QueryMerkleTreeHooksRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNoopHookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MerkleTreeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleTreeHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleTreeHook(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleTreeHook(ctx, req.(*QueryMerkleTreeHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNoopHookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MerkleTreeRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleTreeRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NoopHook != nil {
-		l = m.NoopHook.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleTreeRoot(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleTreeRoot(ctx, req.(*QueryMerkleTreeRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNoopHooksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MerkleTreeLatestCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleTreeLatestCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleTreeLatestCheckpoint(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeLatestCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleTreeLatestCheckpoint(ctx, req.(*QueryMerkleTreeLatestCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNoopHooksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MerkleTreeCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleTreeCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleTreeCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleTreeCheckpoint(ctx, req.(*QueryMerkleTreeCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleTreeProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleTreeProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleTreeProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/MerkleTreeProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleTreeProof(ctx, req.(*QueryMerkleTreeProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NoopHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNoopHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NoopHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/NoopHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NoopHooks(ctx, req.(*QueryNoopHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NoopHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNoopHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NoopHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/NoopHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NoopHook(ctx, req.(*QueryNoopHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregationHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregationHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregationHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/AggregationHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregationHooks(ctx, req.(*QueryAggregationHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregationHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregationHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregationHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/AggregationHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregationHook(ctx, req.(*QueryAggregationHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainRoutingHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainRoutingHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainRoutingHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/DomainRoutingHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainRoutingHooks(ctx, req.(*QueryDomainRoutingHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DomainRoutingHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainRoutingHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DomainRoutingHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/DomainRoutingHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DomainRoutingHook(ctx, req.(*QueryDomainRoutingHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolFeeHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeeHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFeeHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/ProtocolFeeHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFeeHooks(ctx, req.(*QueryProtocolFeeHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolFeeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeeHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFeeHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Query/ProtocolFeeHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFeeHook(ctx, req.(*QueryProtocolFeeHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperlane.core.post_dispatch.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Igps",
+			Handler:    _Query_Igps_Handler,
+		},
+		{
+			MethodName: "Igp",
+			Handler:    _Query_Igp_Handler,
+		},
+		{
+			MethodName: "DestinationGasConfigs",
+			Handler:    _Query_DestinationGasConfigs_Handler,
+		},
+		{
+			MethodName: "QuoteGasPayment",
+			Handler:    _Query_QuoteGasPayment_Handler,
+		},
+		{
+			MethodName: "MerkleTreeHooks",
+			Handler:    _Query_MerkleTreeHooks_Handler,
+		},
+		{
+			MethodName: "MerkleTreeHook",
+			Handler:    _Query_MerkleTreeHook_Handler,
+		},
+		{
+			MethodName: "MerkleTreeRoot",
+			Handler:    _Query_MerkleTreeRoot_Handler,
+		},
+		{
+			MethodName: "MerkleTreeLatestCheckpoint",
+			Handler:    _Query_MerkleTreeLatestCheckpoint_Handler,
+		},
+		{
+			MethodName: "MerkleTreeCheckpoint",
+			Handler:    _Query_MerkleTreeCheckpoint_Handler,
+		},
+		{
+			MethodName: "MerkleTreeProof",
+			Handler:    _Query_MerkleTreeProof_Handler,
+		},
+		{
+			MethodName: "NoopHooks",
+			Handler:    _Query_NoopHooks_Handler,
+		},
+		{
+			MethodName: "NoopHook",
+			Handler:    _Query_NoopHook_Handler,
+		},
+		{
+			MethodName: "AggregationHooks",
+			Handler:    _Query_AggregationHooks_Handler,
+		},
+		{
+			MethodName: "AggregationHook",
+			Handler:    _Query_AggregationHook_Handler,
+		},
+		{
+			MethodName: "DomainRoutingHooks",
+			Handler:    _Query_DomainRoutingHooks_Handler,
+		},
+		{
+			MethodName: "DomainRoutingHook",
+			Handler:    _Query_DomainRoutingHook_Handler,
+		},
+		{
+			MethodName: "ProtocolFeeHooks",
+			Handler:    _Query_ProtocolFeeHooks_Handler,
+		},
+		{
+			MethodName: "ProtocolFeeHook",
+			Handler:    _Query_ProtocolFeeHook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyperlane/core/post_dispatch/v1/query.proto",
+}
+
+func (m *QueryIgpsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIgpsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIgpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIgpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIgpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIgpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Igps) > 0 {
+		for iNdEx := len(m.Igps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Igps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIgpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIgpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIgpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIgpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIgpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIgpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Igp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDestinationGasConfigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDestinationGasConfigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDestinationGasConfigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDestinationGasConfigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDestinationGasConfigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDestinationGasConfigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DestinationGasConfigs) > 0 {
+		for iNdEx := len(m.DestinationGasConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationGasConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteGasPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteGasPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteGasPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationDomain) > 0 {
+		i -= len(m.DestinationDomain)
+		copy(dAtA[i:], m.DestinationDomain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationDomain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IgpId) > 0 {
+		i -= len(m.IgpId)
+		copy(dAtA[i:], m.IgpId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IgpId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteGasPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteGasPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteGasPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPayment) > 0 {
+		for iNdEx := len(m.GasPayment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPayment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MerkleTreeHooks) > 0 {
+		for iNdEx := len(m.MerkleTreeHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleTreeHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MerkleTreeHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WrappedMerkleTreeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WrappedMerkleTreeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WrappedMerkleTreeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MerkleTree != nil {
+		{
+			size, err := m.MerkleTree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MailboxId) > 0 {
+		i -= len(m.MailboxId)
+		copy(dAtA[i:], m.MailboxId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MailboxId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Leafs) > 0 {
+		for iNdEx := len(m.Leafs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Leafs[iNdEx])
+			copy(dAtA[i:], m.Leafs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Leafs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNoopHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNoopHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNoopHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNoopHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNoopHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNoopHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NoopHook != nil {
+		{
+			size, err := m.NoopHook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNoopHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNoopHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNoopHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNoopHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNoopHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNoopHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NoopHooks) > 0 {
+		for iNdEx := len(m.NoopHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NoopHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregationHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregationHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregationHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregationHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregationHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregationHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AggregationHooks) > 0 {
+		for iNdEx := len(m.AggregationHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregationHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregationHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregationHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregationHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregationHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregationHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregationHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregationHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainRoutingHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainRoutingHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainRoutingHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainRoutingHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainRoutingHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainRoutingHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainRoutingHooks) > 0 {
+		for iNdEx := len(m.DomainRoutingHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainRoutingHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainRoutingHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainRoutingHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainRoutingHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainRoutingHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainRoutingHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainRoutingHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.DomainRoutingHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeeHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeeHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeeHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeeHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeeHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeeHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProtocolFeeHooks) > 0 {
+		for iNdEx := len(m.ProtocolFeeHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeeHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeeHookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeeHookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeeHookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProtocolFeeHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeLatestCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeLatestCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeLatestCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeLatestCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeLatestCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeLatestCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleTreeProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleTreeProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleTreeProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIgpsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIgpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Igps) > 0 {
+		for _, e := range m.Igps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIgpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIgpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Igp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDestinationGasConfigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDestinationGasConfigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DestinationGasConfigs) > 0 {
+		for _, e := range m.DestinationGasConfigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteGasPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IgpId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationDomain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteGasPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPayment) > 0 {
+		for _, e := range m.GasPayment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MerkleTreeHooks) > 0 {
+		for _, e := range m.MerkleTreeHooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MerkleTreeHook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *WrappedMerkleTreeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MailboxId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MerkleTree != nil {
+		l = m.MerkleTree.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leafs) > 0 {
+		for _, b := range m.Leafs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNoopHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNoopHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoopHook != nil {
+		l = m.NoopHook.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNoopHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNoopHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.NoopHooks) > 0 {
 		for _, e := range m.NoopHooks {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregationHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregationHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregationHooks) > 0 {
+		for _, e := range m.AggregationHooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregationHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregationHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregationHook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDomainRoutingHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainRoutingHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DomainRoutingHooks) > 0 {
+		for _, e := range m.DomainRoutingHooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainRoutingHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDomainRoutingHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DomainRoutingHook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProtocolFeeHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeeHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolFeeHooks) > 0 {
+		for _, e := range m.ProtocolFeeHooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeeHookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtocolFeeHook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleTreeRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeLatestCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeLatestCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleTreeCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleTreeProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryMerkleTreeProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIgpsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIgpsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIgpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIgpsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIgpsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIgpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Igps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Igps = append(m.Igps, InterchainGasPaymaster{})
+			if err := m.Igps[len(m.Igps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIgpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIgpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIgpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIgpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIgpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIgpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Igp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Igp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDestinationGasConfigsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDestinationGasConfigsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDestinationGasConfigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDestinationGasConfigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDestinationGasConfigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDestinationGasConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationGasConfigs = append(m.DestinationGasConfigs, &DestinationGasConfig{})
+			if err := m.DestinationGasConfigs[len(m.DestinationGasConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteGasPaymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteGasPaymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteGasPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteGasPaymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteGasPaymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteGasPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPayment = append(m.GasPayment, types.Coin{})
+			if err := m.GasPayment[len(m.GasPayment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleTreeHooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleTreeHooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleTreeHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleTreeHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleTreeHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleTreeHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleTreeHooks = append(m.MerkleTreeHooks, WrappedMerkleTreeHookResponse{})
+			if err := m.MerkleTreeHooks[len(m.MerkleTreeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleTreeHookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleTreeHookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleTreeHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleTreeHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleTreeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleTreeHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedMerkleTreeHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedMerkleTreeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MerkleTree == nil {
+				m.MerkleTree = &TreeResponse{}
+			}
+			if err := m.MerkleTree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leafs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leafs = append(m.Leafs, make([]byte, postIndex-iNdEx))
+			copy(m.Leafs[len(m.Leafs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNoopHookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNoopHookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNoopHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNoopHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNoopHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNoopHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoopHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NoopHook == nil {
+				m.NoopHook = &NoopHook{}
+			}
+			if err := m.NoopHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryIgpsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNoopHooksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2440,10 +6621,10 @@ func (m *QueryIgpsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIgpsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNoopHooksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIgpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNoopHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2503,7 +6684,7 @@ func (m *QueryIgpsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIgpsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNoopHooksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2526,15 +6707,15 @@ func (m *QueryIgpsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIgpsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNoopHooksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIgpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNoopHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Igps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoopHooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2561,8 +6742,8 @@ func (m *QueryIgpsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Igps = append(m.Igps, InterchainGasPaymaster{})
-			if err := m.Igps[len(m.Igps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NoopHooks = append(m.NoopHooks, NoopHook{})
+			if err := m.NoopHooks[len(m.NoopHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2623,7 +6804,7 @@ func (m *QueryIgpsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIgpRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregationHooksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,17 +6827,17 @@ func (m *QueryIgpRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIgpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregationHooksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIgpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregationHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2666,23 +6847,27 @@ func (m *QueryIgpRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2705,7 +6890,7 @@ func (m *QueryIgpRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIgpResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregationHooksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2728,15 +6913,15 @@ func (m *QueryIgpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIgpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregationHooksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIgpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregationHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Igp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationHooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2763,7 +6948,44 @@ func (m *QueryIgpResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Igp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggregationHooks = append(m.AggregationHooks, AggregationHook{})
+			if err := m.AggregationHooks[len(m.AggregationHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2788,7 +7010,7 @@ func (m *QueryIgpResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDestinationGasConfigsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregationHookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2811,10 +7033,10 @@ func (m *QueryDestinationGasConfigsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDestinationGasConfigsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregationHookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDestinationGasConfigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregationHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2849,7 +7071,140 @@ func (m *QueryDestinationGasConfigsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregationHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregationHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregationHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregationHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainRoutingHooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainRoutingHooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainRoutingHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2906,7 +7261,7 @@ func (m *QueryDestinationGasConfigsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDestinationGasConfigsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainRoutingHooksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2929,15 +7284,15 @@ func (m *QueryDestinationGasConfigsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDestinationGasConfigsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainRoutingHooksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDestinationGasConfigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainRoutingHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasConfigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainRoutingHooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2964,8 +7319,8 @@ func (m *QueryDestinationGasConfigsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationGasConfigs = append(m.DestinationGasConfigs, &DestinationGasConfig{})
-			if err := m.DestinationGasConfigs[len(m.DestinationGasConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainRoutingHooks = append(m.DomainRoutingHooks, DomainRoutingHook{})
+			if err := m.DomainRoutingHooks[len(m.DomainRoutingHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3026,7 +7381,7 @@ func (m *QueryDestinationGasConfigsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQuoteGasPaymentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainRoutingHookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3049,79 +7404,15 @@ func (m *QueryQuoteGasPaymentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteGasPaymentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainRoutingHookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteGasPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainRoutingHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IgpId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3149,7 +7440,7 @@ func (m *QueryQuoteGasPaymentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasLimit = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3172,7 +7463,7 @@ func (m *QueryQuoteGasPaymentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQuoteGasPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDomainRoutingHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3195,15 +7486,15 @@ func (m *QueryQuoteGasPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteGasPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDomainRoutingHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteGasPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDomainRoutingHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainRoutingHook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3230,8 +7521,41 @@ func (m *QueryQuoteGasPaymentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPayment = append(m.GasPayment, types.Coin{})
-			if err := m.GasPayment[len(m.GasPayment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DomainRoutingHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, HookRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3256,7 +7580,7 @@ func (m *QueryQuoteGasPaymentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMerkleTreeHooksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeeHooksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3279,10 +7603,10 @@ func (m *QueryMerkleTreeHooksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMerkleTreeHooksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeeHooksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMerkleTreeHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeeHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3342,7 +7666,7 @@ func (m *QueryMerkleTreeHooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMerkleTreeHooksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeeHooksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3365,15 +7689,15 @@ func (m *QueryMerkleTreeHooksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMerkleTreeHooksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeeHooksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMerkleTreeHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeeHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeHooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3400,8 +7724,8 @@ func (m *QueryMerkleTreeHooksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MerkleTreeHooks = append(m.MerkleTreeHooks, WrappedMerkleTreeHookResponse{})
-			if err := m.MerkleTreeHooks[len(m.MerkleTreeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProtocolFeeHooks = append(m.ProtocolFeeHooks, ProtocolFeeHook{})
+			if err := m.ProtocolFeeHooks[len(m.ProtocolFeeHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3462,7 +7786,7 @@ func (m *QueryMerkleTreeHooksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMerkleTreeHookRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeeHookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3485,10 +7809,10 @@ func (m *QueryMerkleTreeHookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMerkleTreeHookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeeHookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMerkleTreeHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeeHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3544,7 +7868,7 @@ func (m *QueryMerkleTreeHookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeeHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3567,15 +7891,15 @@ func (m *QueryMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMerkleTreeHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeeHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMerkleTreeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTreeHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeHook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3602,7 +7926,7 @@ func (m *QueryMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MerkleTreeHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtocolFeeHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3627,7 +7951,7 @@ func (m *QueryMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleTreeRootRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3650,10 +7974,10 @@ func (m *WrappedMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedMerkleTreeHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleTreeRootRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedMerkleTreeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleTreeRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3688,43 +8012,61 @@ func (m *WrappedMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleTreeRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleTreeRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleTreeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3734,60 +8076,45 @@ func (m *WrappedMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailboxId = string(dAtA[iNdEx:postIndex])
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTree", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MerkleTree == nil {
-				m.MerkleTree = &TreeResponse{}
-			}
-			if err := m.MerkleTree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3809,7 +8136,7 @@ func (m *WrappedMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TreeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleTreeLatestCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3832,17 +8159,17 @@ func (m *TreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleTreeLatestCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleTreeLatestCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leafs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3852,48 +8179,79 @@ func (m *TreeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leafs = append(m.Leafs, make([]byte, postIndex-iNdEx))
-			copy(m.Leafs[len(m.Leafs)-1], dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleTreeLatestCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleTreeLatestCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleTreeLatestCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3903,24 +8261,23 @@ func (m *TreeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3944,7 +8301,7 @@ func (m *TreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNoopHookRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleTreeCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3967,10 +8324,10 @@ func (m *QueryNoopHookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNoopHookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleTreeCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNoopHookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleTreeCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4005,6 +8362,25 @@ func (m *QueryNoopHookRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4026,7 +8402,7 @@ func (m *QueryNoopHookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNoopHookResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleTreeCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4049,15 +8425,34 @@ func (m *QueryNoopHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNoopHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleTreeCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNoopHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleTreeCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoopHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4084,10 +8479,7 @@ func (m *QueryNoopHookResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NoopHook == nil {
-				m.NoopHook = &NoopHook{}
-			}
-			if err := m.NoopHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4112,7 +8504,7 @@ func (m *QueryNoopHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNoopHooksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleTreeProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4135,17 +8527,17 @@ func (m *QueryNoopHooksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNoopHooksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleTreeProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNoopHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleTreeProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4155,28 +8547,43 @@ func (m *QueryNoopHooksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4198,7 +8605,7 @@ func (m *QueryNoopHooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNoopHooksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleTreeProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4221,17 +8628,17 @@ func (m *QueryNoopHooksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNoopHooksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleTreeProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNoopHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleTreeProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoopHooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4241,31 +8648,50 @@ func (m *QueryNoopHooksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoopHooks = append(m.NoopHooks, NoopHook{})
-			if err := m.NoopHooks[len(m.NoopHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4275,26 +8701,56 @@ func (m *QueryNoopHooksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -685,1137 +685,4153 @@ func (m *MsgCreateNoopHookResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateNoopHookResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateIgp)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateIgp")
-	proto.RegisterType((*MsgCreateIgpResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateIgpResponse")
-	proto.RegisterType((*MsgSetIgpOwner)(nil), "hyperlane.core.post_dispatch.v1.MsgSetIgpOwner")
-	proto.RegisterType((*MsgSetIgpOwnerResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgSetIgpOwnerResponse")
-	proto.RegisterType((*MsgSetDestinationGasConfig)(nil), "hyperlane.core.post_dispatch.v1.MsgSetDestinationGasConfig")
-	proto.RegisterType((*MsgSetDestinationGasConfigResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgSetDestinationGasConfigResponse")
-	proto.RegisterType((*MsgPayForGas)(nil), "hyperlane.core.post_dispatch.v1.MsgPayForGas")
-	proto.RegisterType((*MsgPayForGasResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgPayForGasResponse")
-	proto.RegisterType((*MsgClaim)(nil), "hyperlane.core.post_dispatch.v1.MsgClaim")
-	proto.RegisterType((*MsgClaimResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgClaimResponse")
-	proto.RegisterType((*MsgCreateMerkleTreeHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateMerkleTreeHook")
-	proto.RegisterType((*MsgCreateMerkleTreeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateMerkleTreeHookResponse")
-	proto.RegisterType((*MsgCreateNoopHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateNoopHook")
-	proto.RegisterType((*MsgCreateNoopHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateNoopHookResponse")
+// MsgCreateAggregationHook ...
+type MsgCreateAggregationHook struct {
+	// owner ...
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// hook_ids are the ordered ids of the aggregated hooks.
+	HookIds []github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,rep,name=hook_ids,json=hookIds,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_ids"`
 }
 
-func init() {
-	proto.RegisterFile("hyperlane/core/post_dispatch/v1/tx.proto", fileDescriptor_f936e5a203ea8b1d)
+func (m *MsgCreateAggregationHook) Reset()         { *m = MsgCreateAggregationHook{} }
+func (m *MsgCreateAggregationHook) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAggregationHook) ProtoMessage()    {}
+func (*MsgCreateAggregationHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{14}
 }
-
-var fileDescriptor_f936e5a203ea8b1d = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xd8, 0xc4, 0xaf, 0x50, 0x91, 0x95, 0xdb, 0x38, 0x5b, 0xe1, 0x54, 0xab, 0x22,
-	0xd2, 0x80, 0x77, 0xeb, 0x54, 0x05, 0xe4, 0x70, 0x80, 0xb8, 0x22, 0xb5, 0x44, 0x68, 0xb5, 0xe1,
-	0xd4, 0x8b, 0x35, 0xde, 0x1d, 0xc6, 0x23, 0x7b, 0x67, 0xb6, 0x3b, 0x13, 0xc7, 0xb9, 0x55, 0x9c,
-	0x10, 0x27, 0x40, 0xdc, 0xf8, 0x02, 0x1c, 0x73, 0xe0, 0xce, 0xb5, 0x17, 0xa4, 0x0a, 0x71, 0x40,
-	0x1c, 0x2a, 0x94, 0x1c, 0xf2, 0x35, 0xd0, 0xec, 0xae, 0xd7, 0x76, 0xe2, 0x28, 0xeb, 0x90, 0x48,
-	0xbd, 0x58, 0x9e, 0x79, 0xff, 0x7e, 0xef, 0xf7, 0xde, 0xbc, 0xd9, 0x81, 0xd5, 0xce, 0x7e, 0x80,
-	0xc3, 0x1e, 0x62, 0xd8, 0x76, 0x79, 0x88, 0xed, 0x80, 0x0b, 0xd9, 0xf2, 0xa8, 0x08, 0x90, 0x74,
-	0x3b, 0x76, 0xbf, 0x66, 0xcb, 0x81, 0x15, 0x84, 0x5c, 0x72, 0x7d, 0x25, 0xd5, 0xb4, 0x94, 0xa6,
-	0x35, 0xa1, 0x69, 0xf5, 0x6b, 0xc6, 0x92, 0xcb, 0x85, 0xcf, 0x85, 0xed, 0x0b, 0xa2, 0x0c, 0x7d,
-	0x41, 0x62, 0x4b, 0x63, 0x11, 0xf9, 0x94, 0x71, 0x3b, 0xfa, 0x4d, 0xb6, 0x96, 0x63, 0xdd, 0x56,
-	0xb4, 0xb2, 0xe3, 0x45, 0x22, 0x2a, 0x11, 0x4e, 0x78, 0xbc, 0xaf, 0xfe, 0x25, 0xbb, 0x1f, 0x9c,
-	0x8b, 0x73, 0x3f, 0xc0, 0x43, 0x17, 0x95, 0x04, 0x49, 0x1b, 0x09, 0x6c, 0xf7, 0x6b, 0x6d, 0x2c,
-	0x51, 0xcd, 0x76, 0x39, 0x65, 0xb1, 0xdc, 0xfc, 0x51, 0x83, 0xb7, 0xb6, 0x05, 0x69, 0x84, 0x18,
-	0x49, 0xdc, 0x24, 0x81, 0x6e, 0x41, 0x9e, 0xef, 0x31, 0x1c, 0x96, 0xb5, 0xdb, 0xda, 0x6a, 0x71,
-	0xb3, 0xfc, 0xe7, 0x6f, 0xd5, 0x52, 0x02, 0xea, 0x73, 0xcf, 0x0b, 0xb1, 0x10, 0x3b, 0x32, 0xa4,
-	0x8c, 0x38, 0xb1, 0x9a, 0x5e, 0x82, 0xbc, 0x87, 0x19, 0xf7, 0xcb, 0x39, 0xa5, 0xef, 0xc4, 0x8b,
-	0xfa, 0xc6, 0xb7, 0xc7, 0x07, 0x6b, 0xb1, 0xc6, 0xf7, 0xc7, 0x07, 0x6b, 0x1f, 0x8e, 0x20, 0xf7,
-	0x6b, 0xf6, 0x28, 0x1e, 0x93, 0x38, 0x74, 0x3b, 0x88, 0xb2, 0x2d, 0x24, 0x9e, 0xa0, 0x7d, 0x1f,
-	0x09, 0x89, 0x43, 0xb3, 0x0b, 0xa5, 0x71, 0x48, 0x0e, 0x16, 0x01, 0x67, 0x02, 0xeb, 0x3b, 0x90,
-	0xa3, 0x5e, 0x82, 0xab, 0xf1, 0xe2, 0xd5, 0xca, 0xdc, 0x3f, 0xaf, 0x56, 0x36, 0x08, 0x95, 0x9d,
-	0xdd, 0xb6, 0xe5, 0x72, 0xdf, 0x6e, 0xbb, 0x41, 0x95, 0x32, 0xc6, 0xfb, 0x48, 0x52, 0xce, 0x84,
-	0x9d, 0x06, 0xad, 0x26, 0x24, 0xec, 0x4a, 0xda, 0xb3, 0x1e, 0xe1, 0x41, 0x92, 0x88, 0x93, 0xa3,
-	0x9e, 0xf9, 0x73, 0x0e, 0xae, 0x6f, 0x0b, 0xb2, 0x83, 0x65, 0x93, 0x04, 0x8f, 0xa3, 0x94, 0x66,
-	0xa5, 0xe0, 0x29, 0x14, 0x28, 0x09, 0x5a, 0xd4, 0x8b, 0x39, 0xb8, 0x1c, 0x6c, 0x79, 0x4a, 0x82,
-	0xa6, 0xa7, 0xdf, 0x82, 0x22, 0xc3, 0x7b, 0xad, 0x18, 0xcf, 0x7c, 0x44, 0xf1, 0x02, 0xc3, 0x7b,
-	0x31, 0xd0, 0x2a, 0xe8, 0x21, 0x66, 0x7c, 0x97, 0xb9, 0x38, 0xd6, 0x10, 0x1d, 0x1a, 0x94, 0xdf,
-	0xb8, 0xad, 0xad, 0x2e, 0x38, 0x8b, 0x43, 0xc9, 0xe3, 0xa1, 0xa0, 0xbe, 0x36, 0x59, 0x94, 0x5b,
-	0x27, 0x8b, 0x32, 0xc6, 0x81, 0x59, 0x86, 0x9b, 0x93, 0x3b, 0xc3, 0x2a, 0x98, 0x7f, 0xe4, 0xc0,
-	0x88, 0x45, 0x0f, 0xb1, 0x90, 0x94, 0x45, 0x09, 0x6d, 0x21, 0xd1, 0xe0, 0xec, 0x1b, 0x4a, 0x5e,
-	0x2b, 0xf2, 0xba, 0x70, 0xd3, 0x1b, 0x61, 0x6c, 0x11, 0x24, 0x5a, 0x6e, 0x84, 0x32, 0x62, 0xf2,
-	0xda, 0xfa, 0x03, 0xeb, 0x9c, 0x83, 0x6c, 0x4d, 0x4b, 0xd1, 0x29, 0x79, 0x53, 0x76, 0xeb, 0x1f,
-	0x4d, 0xb2, 0xfb, 0xfe, 0x14, 0x76, 0xa7, 0x79, 0x33, 0xef, 0x80, 0x79, 0xb6, 0x34, 0x65, 0xfd,
-	0xaf, 0xf9, 0xe8, 0x9c, 0x3e, 0x41, 0xfb, 0x5f, 0xf0, 0x70, 0x0b, 0x09, 0xfd, 0x1e, 0x14, 0x04,
-	0x66, 0x5e, 0x06, 0xa2, 0x13, 0xbd, 0x2b, 0x65, 0xba, 0x0d, 0xe0, 0x63, 0x21, 0x10, 0xc1, 0xca,
-	0xff, 0xfc, 0xe5, 0xf9, 0x2f, 0x26, 0x6e, 0x9b, 0x9e, 0xea, 0xf6, 0xf1, 0x6a, 0x7a, 0xdc, 0x47,
-	0x94, 0x45, 0xdd, 0xfe, 0xb6, 0xb3, 0x38, 0x26, 0x79, 0x18, 0x09, 0xf4, 0x3a, 0x14, 0x55, 0xc1,
-	0x7b, 0xd4, 0xa7, 0xb2, 0x9c, 0x8f, 0x10, 0xbd, 0x9b, 0x20, 0xba, 0x11, 0x07, 0x13, 0x5e, 0xd7,
-	0xa2, 0xdc, 0xf6, 0x91, 0xec, 0x58, 0x4d, 0x26, 0x9d, 0x05, 0x82, 0xc4, 0x97, 0x4a, 0x5d, 0xff,
-	0x14, 0x0a, 0xc8, 0xe7, 0xbb, 0x4c, 0x96, 0x0b, 0x51, 0xa3, 0x2c, 0x5b, 0x09, 0xb3, 0x6a, 0x8c,
-	0x5a, 0xc9, 0x18, 0xb5, 0x1a, 0x9c, 0xb2, 0xcd, 0xa2, 0xf2, 0xf9, 0xeb, 0xf1, 0xc1, 0x9a, 0xe6,
-	0x24, 0x36, 0xf5, 0xbb, 0xaa, 0x13, 0x12, 0xd6, 0x55, 0x2b, 0x2c, 0x9f, 0x6c, 0x85, 0xb4, 0x8a,
-	0xe6, 0xcd, 0x68, 0xd4, 0xa5, 0xeb, 0xb4, 0xdc, 0xbf, 0x6b, 0xb0, 0xa0, 0x66, 0x60, 0x0f, 0x51,
-	0xff, 0xf5, 0x2a, 0x75, 0xfd, 0xbd, 0x13, 0xd9, 0xdd, 0x38, 0x35, 0xdb, 0x15, 0x68, 0x53, 0x87,
-	0x77, 0x86, 0xff, 0xd3, 0xac, 0x0e, 0x35, 0x58, 0x4a, 0x27, 0xfb, 0x36, 0x0e, 0xbb, 0x3d, 0xfc,
-	0x75, 0x88, 0xf1, 0x23, 0xce, 0xbb, 0x33, 0xcf, 0x0d, 0xd5, 0x71, 0x88, 0xf6, 0xda, 0x7c, 0x70,
-	0xc9, 0x69, 0x16, 0x13, 0xb7, 0x4d, 0xaf, 0x7e, 0x7f, 0xf2, 0x48, 0xdf, 0x99, 0x7e, 0x8b, 0x4d,
-	0x26, 0x62, 0xf6, 0x61, 0xe5, 0x0c, 0xd1, 0xd5, 0x5e, 0x64, 0x03, 0x58, 0x4c, 0xe3, 0x7e, 0xc5,
-	0x79, 0x70, 0x11, 0x56, 0x2f, 0x96, 0x71, 0x00, 0xcb, 0xa7, 0x22, 0x5f, 0x69, 0xae, 0xeb, 0xdf,
-	0xbd, 0x09, 0xf3, 0xdb, 0x82, 0xe8, 0xcf, 0xa0, 0x38, 0xfa, 0x72, 0xa9, 0x9e, 0x3b, 0xcd, 0xc7,
-	0xbf, 0x2a, 0x8c, 0x07, 0x33, 0xa9, 0xa7, 0xf9, 0xec, 0xc1, 0xb5, 0xf1, 0x6f, 0x05, 0x3b, 0x8b,
-	0x97, 0x31, 0x03, 0xe3, 0xe3, 0x19, 0x0d, 0xd2, 0xc0, 0xbf, 0x68, 0xb0, 0x74, 0xd6, 0xa5, 0xbb,
-	0x91, 0xd1, 0xe9, 0x34, 0x63, 0xa3, 0xf1, 0x3f, 0x8c, 0x53, 0x74, 0xcf, 0xa0, 0x38, 0xba, 0x9b,
-	0x32, 0x55, 0x22, 0x55, 0xcf, 0x56, 0x89, 0x53, 0x33, 0x52, 0xc7, 0x90, 0x8f, 0xe7, 0xe3, 0xdd,
-	0x4c, 0x95, 0x54, 0xaa, 0x46, 0x2d, 0xb3, 0x6a, 0x1a, 0xe6, 0x27, 0x0d, 0x4a, 0x53, 0x27, 0xd6,
-	0x27, 0xd9, 0x1b, 0x68, 0xd2, 0xd2, 0xf8, 0xec, 0xa2, 0x96, 0x29, 0xa8, 0xe7, 0x1a, 0x5c, 0x3f,
-	0x71, 0xd4, 0xd7, 0xb3, 0x3b, 0x1d, 0xda, 0x18, 0xf5, 0xd9, 0x6d, 0x86, 0x10, 0x8c, 0xfc, 0x73,
-	0x75, 0xe9, 0x6d, 0xba, 0x2f, 0x0e, 0x2b, 0xda, 0xcb, 0xc3, 0x8a, 0xf6, 0xef, 0x61, 0x45, 0xfb,
-	0xe1, 0xa8, 0x32, 0xf7, 0xf2, 0xa8, 0x32, 0xf7, 0xf7, 0x51, 0x65, 0xee, 0x69, 0x73, 0x96, 0x53,
-	0x3e, 0x88, 0xdf, 0x32, 0xf7, 0xd6, 0x5b, 0x93, 0xcf, 0x99, 0xe8, 0x2d, 0xd3, 0x2e, 0x44, 0x8f,
-	0x95, 0xfb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xcd, 0xa1, 0x27, 0xa3, 0x0d, 0x00, 0x00,
+func (m *MsgCreateAggregationHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAggregationHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAggregationHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAggregationHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAggregationHook.Merge(m, src)
+}
+func (m *MsgCreateAggregationHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAggregationHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAggregationHook.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgCreateAggregationHook proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCreateAggregationHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateIgp ...
-	CreateIgp(ctx context.Context, in *MsgCreateIgp, opts ...grpc.CallOption) (*MsgCreateIgpResponse, error)
-	// SetIgpOwner ...
-	SetIgpOwner(ctx context.Context, in *MsgSetIgpOwner, opts ...grpc.CallOption) (*MsgSetIgpOwnerResponse, error)
-	// SetDestinationGasConfig ...
-	SetDestinationGasConfig(ctx context.Context, in *MsgSetDestinationGasConfig, opts ...grpc.CallOption) (*MsgSetDestinationGasConfigResponse, error)
-	// PayForGas ...
-	PayForGas(ctx context.Context, in *MsgPayForGas, opts ...grpc.CallOption) (*MsgPayForGasResponse, error)
-	// Claim ...
-	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
-	// CreateMerkleTreeHook ...
-	CreateMerkleTreeHook(ctx context.Context, in *MsgCreateMerkleTreeHook, opts ...grpc.CallOption) (*MsgCreateMerkleTreeHookResponse, error)
-	// CreateNoopHook ...
-	CreateNoopHook(ctx context.Context, in *MsgCreateNoopHook, opts ...grpc.CallOption) (*MsgCreateNoopHookResponse, error)
+// MsgCreateAggregationHookResponse ...
+type MsgCreateAggregationHookResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateAggregationHookResponse) Reset()         { *m = MsgCreateAggregationHookResponse{} }
+func (m *MsgCreateAggregationHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAggregationHookResponse) ProtoMessage()    {}
+func (*MsgCreateAggregationHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{15}
+}
+func (m *MsgCreateAggregationHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAggregationHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAggregationHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAggregationHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAggregationHookResponse.Merge(m, src)
+}
+func (m *MsgCreateAggregationHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAggregationHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAggregationHookResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgCreateAggregationHookResponse proto.InternalMessageInfo
+
+// MsgCreateDomainRoutingHook creates a domain routing hook. If a fallback hook
+// is given, a fallback routing hook is created instead.
+type MsgCreateDomainRoutingHook struct {
+	// owner ...
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// fallback_hook_id ...
+	FallbackHookId *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=fallback_hook_id,json=fallbackHookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"fallback_hook_id,omitempty"`
 }
 
-func (c *msgClient) CreateIgp(ctx context.Context, in *MsgCreateIgp, opts ...grpc.CallOption) (*MsgCreateIgpResponse, error) {
-	out := new(MsgCreateIgpResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateIgp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateDomainRoutingHook) Reset()         { *m = MsgCreateDomainRoutingHook{} }
+func (m *MsgCreateDomainRoutingHook) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDomainRoutingHook) ProtoMessage()    {}
+func (*MsgCreateDomainRoutingHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{16}
+}
+func (m *MsgCreateDomainRoutingHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDomainRoutingHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDomainRoutingHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateDomainRoutingHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDomainRoutingHook.Merge(m, src)
+}
+func (m *MsgCreateDomainRoutingHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDomainRoutingHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDomainRoutingHook.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetIgpOwner(ctx context.Context, in *MsgSetIgpOwner, opts ...grpc.CallOption) (*MsgSetIgpOwnerResponse, error) {
-	out := new(MsgSetIgpOwnerResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/SetIgpOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateDomainRoutingHook proto.InternalMessageInfo
+
+func (m *MsgCreateDomainRoutingHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetDestinationGasConfig(ctx context.Context, in *MsgSetDestinationGasConfig, opts ...grpc.CallOption) (*MsgSetDestinationGasConfigResponse, error) {
-	out := new(MsgSetDestinationGasConfigResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/SetDestinationGasConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgCreateDomainRoutingHookResponse ...
+type MsgCreateDomainRoutingHookResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 }
 
-func (c *msgClient) PayForGas(ctx context.Context, in *MsgPayForGas, opts ...grpc.CallOption) (*MsgPayForGasResponse, error) {
-	out := new(MsgPayForGasResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/PayForGas", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateDomainRoutingHookResponse) Reset()         { *m = MsgCreateDomainRoutingHookResponse{} }
+func (m *MsgCreateDomainRoutingHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDomainRoutingHookResponse) ProtoMessage()    {}
+func (*MsgCreateDomainRoutingHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{17}
+}
+func (m *MsgCreateDomainRoutingHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDomainRoutingHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDomainRoutingHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateDomainRoutingHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDomainRoutingHookResponse.Merge(m, src)
+}
+func (m *MsgCreateDomainRoutingHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDomainRoutingHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDomainRoutingHookResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
-	out := new(MsgClaimResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/Claim", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCreateDomainRoutingHookResponse proto.InternalMessageInfo
+
+// MsgSetDomainRoutingHookRoute ...
+type MsgSetDomainRoutingHookRoute struct {
+	// owner ...
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// route ...
+	Route HookRoute `protobuf:"bytes,3,opt,name=route,proto3" json:"route"`
 }
 
-func (c *msgClient) CreateMerkleTreeHook(ctx context.Context, in *MsgCreateMerkleTreeHook, opts ...grpc.CallOption) (*MsgCreateMerkleTreeHookResponse, error) {
-	out := new(MsgCreateMerkleTreeHookResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateMerkleTreeHook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetDomainRoutingHookRoute) Reset()         { *m = MsgSetDomainRoutingHookRoute{} }
+func (m *MsgSetDomainRoutingHookRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainRoutingHookRoute) ProtoMessage()    {}
+func (*MsgSetDomainRoutingHookRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{18}
+}
+func (m *MsgSetDomainRoutingHookRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDomainRoutingHookRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainRoutingHookRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetDomainRoutingHookRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainRoutingHookRoute.Merge(m, src)
+}
+func (m *MsgSetDomainRoutingHookRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDomainRoutingHookRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainRoutingHookRoute.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateNoopHook(ctx context.Context, in *MsgCreateNoopHook, opts ...grpc.CallOption) (*MsgCreateNoopHookResponse, error) {
-	out := new(MsgCreateNoopHookResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateNoopHook", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetDomainRoutingHookRoute proto.InternalMessageInfo
+
+func (m *MsgSetDomainRoutingHookRoute) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateIgp ...
-	CreateIgp(context.Context, *MsgCreateIgp) (*MsgCreateIgpResponse, error)
-	// SetIgpOwner ...
-	SetIgpOwner(context.Context, *MsgSetIgpOwner) (*MsgSetIgpOwnerResponse, error)
-	// SetDestinationGasConfig ...
-	SetDestinationGasConfig(context.Context, *MsgSetDestinationGasConfig) (*MsgSetDestinationGasConfigResponse, error)
-	// PayForGas ...
-	PayForGas(context.Context, *MsgPayForGas) (*MsgPayForGasResponse, error)
-	// Claim ...
-	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
-	// CreateMerkleTreeHook ...
-	CreateMerkleTreeHook(context.Context, *MsgCreateMerkleTreeHook) (*MsgCreateMerkleTreeHookResponse, error)
-	// CreateNoopHook ...
-	CreateNoopHook(context.Context, *MsgCreateNoopHook) (*MsgCreateNoopHookResponse, error)
+func (m *MsgSetDomainRoutingHookRoute) GetRoute() HookRoute {
+	if m != nil {
+		return m.Route
+	}
+	return HookRoute{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetDomainRoutingHookRouteResponse ...
+type MsgSetDomainRoutingHookRouteResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateIgp(ctx context.Context, req *MsgCreateIgp) (*MsgCreateIgpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIgp not implemented")
+func (m *MsgSetDomainRoutingHookRouteResponse) Reset()         { *m = MsgSetDomainRoutingHookRouteResponse{} }
+func (m *MsgSetDomainRoutingHookRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDomainRoutingHookRouteResponse) ProtoMessage()    {}
+func (*MsgSetDomainRoutingHookRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{19}
 }
-func (*UnimplementedMsgServer) SetIgpOwner(ctx context.Context, req *MsgSetIgpOwner) (*MsgSetIgpOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIgpOwner not implemented")
-}
-func (*UnimplementedMsgServer) SetDestinationGasConfig(ctx context.Context, req *MsgSetDestinationGasConfig) (*MsgSetDestinationGasConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDestinationGasConfig not implemented")
+func (m *MsgSetDomainRoutingHookRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) PayForGas(ctx context.Context, req *MsgPayForGas) (*MsgPayForGasResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayForGas not implemented")
+func (m *MsgSetDomainRoutingHookRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDomainRoutingHookRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+func (m *MsgSetDomainRoutingHookRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDomainRoutingHookRouteResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateMerkleTreeHook(ctx context.Context, req *MsgCreateMerkleTreeHook) (*MsgCreateMerkleTreeHookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleTreeHook not implemented")
+func (m *MsgSetDomainRoutingHookRouteResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreateNoopHook(ctx context.Context, req *MsgCreateNoopHook) (*MsgCreateNoopHookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNoopHook not implemented")
+func (m *MsgSetDomainRoutingHookRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDomainRoutingHookRouteResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetDomainRoutingHookRouteResponse proto.InternalMessageInfo
+
+// MsgRemoveDomainRoutingHookRoute ...
+type MsgRemoveDomainRoutingHookRoute struct {
+	// owner ...
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// destination ...
+	Destination uint32 `protobuf:"varint,3,opt,name=destination,proto3" json:"destination,omitempty"`
 }
 
-func _Msg_CreateIgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateIgp)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRemoveDomainRoutingHookRoute) Reset()         { *m = MsgRemoveDomainRoutingHookRoute{} }
+func (m *MsgRemoveDomainRoutingHookRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDomainRoutingHookRoute) ProtoMessage()    {}
+func (*MsgRemoveDomainRoutingHookRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{20}
+}
+func (m *MsgRemoveDomainRoutingHookRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDomainRoutingHookRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDomainRoutingHookRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateIgp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateIgp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateIgp(ctx, req.(*MsgCreateIgp))
-	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveDomainRoutingHookRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDomainRoutingHookRoute.Merge(m, src)
+}
+func (m *MsgRemoveDomainRoutingHookRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDomainRoutingHookRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDomainRoutingHookRoute.DiscardUnknown(m)
 }
 
-func _Msg_SetIgpOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetIgpOwner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetIgpOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/SetIgpOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetIgpOwner(ctx, req.(*MsgSetIgpOwner))
+var xxx_messageInfo_MsgRemoveDomainRoutingHookRoute proto.InternalMessageInfo
+
+func (m *MsgRemoveDomainRoutingHookRoute) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetDestinationGasConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDestinationGasConfig)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDestinationGasConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/SetDestinationGasConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDestinationGasConfig(ctx, req.(*MsgSetDestinationGasConfig))
+func (m *MsgRemoveDomainRoutingHookRoute) GetDestination() uint32 {
+	if m != nil {
+		return m.Destination
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_PayForGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPayForGas)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PayForGas(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/PayForGas",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PayForGas(ctx, req.(*MsgPayForGas))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRemoveDomainRoutingHookRouteResponse ...
+type MsgRemoveDomainRoutingHookRouteResponse struct {
 }
 
-func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaim)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Claim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/Claim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
+func (m *MsgRemoveDomainRoutingHookRouteResponse) Reset() {
+	*m = MsgRemoveDomainRoutingHookRouteResponse{}
+}
+func (m *MsgRemoveDomainRoutingHookRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDomainRoutingHookRouteResponse) ProtoMessage()    {}
+func (*MsgRemoveDomainRoutingHookRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{21}
+}
+func (m *MsgRemoveDomainRoutingHookRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDomainRoutingHookRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDomainRoutingHookRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveDomainRoutingHookRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDomainRoutingHookRouteResponse.Merge(m, src)
+}
+func (m *MsgRemoveDomainRoutingHookRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDomainRoutingHookRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDomainRoutingHookRouteResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateMerkleTreeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMerkleTreeHook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMerkleTreeHook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateMerkleTreeHook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMerkleTreeHook(ctx, req.(*MsgCreateMerkleTreeHook))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRemoveDomainRoutingHookRouteResponse proto.InternalMessageInfo
+
+// MsgCreateProtocolFeeHook ...
+type MsgCreateProtocolFeeHook struct {
+	// owner ...
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// beneficiary ...
+	Beneficiary string `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// protocol_fee ...
+	ProtocolFee types.Coin `protobuf:"bytes,3,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee"`
+	// max_protocol_fee ...
+	MaxProtocolFee types.Coin `protobuf:"bytes,4,opt,name=max_protocol_fee,json=maxProtocolFee,proto3" json:"max_protocol_fee"`
 }
 
-func _Msg_CreateNoopHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateNoopHook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateNoopHook(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateNoopHook",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateNoopHook(ctx, req.(*MsgCreateNoopHook))
+func (m *MsgCreateProtocolFeeHook) Reset()         { *m = MsgCreateProtocolFeeHook{} }
+func (m *MsgCreateProtocolFeeHook) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateProtocolFeeHook) ProtoMessage()    {}
+func (*MsgCreateProtocolFeeHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{22}
+}
+func (m *MsgCreateProtocolFeeHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateProtocolFeeHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateProtocolFeeHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hyperlane.core.post_dispatch.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateIgp",
-			Handler:    _Msg_CreateIgp_Handler,
-		},
-		{
-			MethodName: "SetIgpOwner",
-			Handler:    _Msg_SetIgpOwner_Handler,
-		},
-		{
-			MethodName: "SetDestinationGasConfig",
-			Handler:    _Msg_SetDestinationGasConfig_Handler,
-		},
-		{
-			MethodName: "PayForGas",
-			Handler:    _Msg_PayForGas_Handler,
-		},
-		{
-			MethodName: "Claim",
-			Handler:    _Msg_Claim_Handler,
-		},
-		{
-			MethodName: "CreateMerkleTreeHook",
-			Handler:    _Msg_CreateMerkleTreeHook_Handler,
-		},
-		{
-			MethodName: "CreateNoopHook",
-			Handler:    _Msg_CreateNoopHook_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hyperlane/core/post_dispatch/v1/tx.proto",
+func (m *MsgCreateProtocolFeeHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateProtocolFeeHook.Merge(m, src)
+}
+func (m *MsgCreateProtocolFeeHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateProtocolFeeHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateProtocolFeeHook.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIgp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateProtocolFeeHook proto.InternalMessageInfo
+
+func (m *MsgCreateProtocolFeeHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateIgp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateProtocolFeeHook) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
 }
 
-func (m *MsgCreateIgp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateProtocolFeeHook) GetProtocolFee() types.Coin {
+	if m != nil {
+		return m.ProtocolFee
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgCreateIgpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateProtocolFeeHook) GetMaxProtocolFee() types.Coin {
+	if m != nil {
+		return m.MaxProtocolFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgCreateIgpResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateProtocolFeeHookResponse ...
+type MsgCreateProtocolFeeHookResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
 }
 
-func (m *MsgCreateIgpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCreateProtocolFeeHookResponse) Reset()         { *m = MsgCreateProtocolFeeHookResponse{} }
+func (m *MsgCreateProtocolFeeHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateProtocolFeeHookResponse) ProtoMessage()    {}
+func (*MsgCreateProtocolFeeHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{23}
+}
+func (m *MsgCreateProtocolFeeHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateProtocolFeeHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateProtocolFeeHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetIgpOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateProtocolFeeHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateProtocolFeeHookResponse.Merge(m, src)
 }
-
-func (m *MsgSetIgpOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateProtocolFeeHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateProtocolFeeHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateProtocolFeeHookResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetIgpOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RenounceOwnership {
-		i--
-		if m.RenounceOwnership {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.IgpId.Size()
-		i -= size
-		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+var xxx_messageInfo_MsgCreateProtocolFeeHookResponse proto.InternalMessageInfo
+
+// MsgSetProtocolFeeHook updates a protocol fee hook. Empty fields are not
+// updated.
+type MsgSetProtocolFeeHook struct {
+	// owner is the message sender.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// hook_id ...
+	HookId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id"`
+	// protocol_fee ...
+	ProtocolFee *types.Coin `protobuf:"bytes,3,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee,omitempty"`
+	// beneficiary ...
+	Beneficiary string `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// new_owner ...
+	NewOwner string `protobuf:"bytes,5,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// renounce_ownership ...
+	RenounceOwnership bool `protobuf:"varint,6,opt,name=renounce_ownership,json=renounceOwnership,proto3" json:"renounce_ownership,omitempty"`
+}
+
+func (m *MsgSetProtocolFeeHook) Reset()         { *m = MsgSetProtocolFeeHook{} }
+func (m *MsgSetProtocolFeeHook) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProtocolFeeHook) ProtoMessage()    {}
+func (*MsgSetProtocolFeeHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{24}
+}
+func (m *MsgSetProtocolFeeHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProtocolFeeHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProtocolFeeHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetProtocolFeeHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProtocolFeeHook.Merge(m, src)
+}
+func (m *MsgSetProtocolFeeHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProtocolFeeHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProtocolFeeHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProtocolFeeHook proto.InternalMessageInfo
+
+func (m *MsgSetProtocolFeeHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetIgpOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProtocolFeeHook) GetProtocolFee() *types.Coin {
+	if m != nil {
+		return m.ProtocolFee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetIgpOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetProtocolFeeHook) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
 }
 
-func (m *MsgSetIgpOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetProtocolFeeHook) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-func (m *MsgSetDestinationGasConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProtocolFeeHook) GetRenounceOwnership() bool {
+	if m != nil {
+		return m.RenounceOwnership
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgSetDestinationGasConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetProtocolFeeHookResponse ...
+type MsgSetProtocolFeeHookResponse struct {
 }
 
-func (m *MsgSetDestinationGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DestinationGasConfig != nil {
-		{
-			size, err := m.DestinationGasConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.IgpId.Size()
-		i -= size
-		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetProtocolFeeHookResponse) Reset()         { *m = MsgSetProtocolFeeHookResponse{} }
+func (m *MsgSetProtocolFeeHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProtocolFeeHookResponse) ProtoMessage()    {}
+func (*MsgSetProtocolFeeHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{25}
+}
+func (m *MsgSetProtocolFeeHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProtocolFeeHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProtocolFeeHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetDestinationGasConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetProtocolFeeHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProtocolFeeHookResponse.Merge(m, src)
+}
+func (m *MsgSetProtocolFeeHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProtocolFeeHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProtocolFeeHookResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetDestinationGasConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetProtocolFeeHookResponse proto.InternalMessageInfo
+
+// MsgClaimProtocolFees sends the collected fees of a protocol fee hook to its
+// beneficiary.
+type MsgClaimProtocolFees struct {
+	// sender ...
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hook_id ...
+	HookId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id"`
 }
 
-func (m *MsgSetDestinationGasConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimProtocolFees) Reset()         { *m = MsgClaimProtocolFees{} }
+func (m *MsgClaimProtocolFees) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimProtocolFees) ProtoMessage()    {}
+func (*MsgClaimProtocolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{26}
+}
+func (m *MsgClaimProtocolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimProtocolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimProtocolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimProtocolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimProtocolFees.Merge(m, src)
+}
+func (m *MsgClaimProtocolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimProtocolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimProtocolFees.DiscardUnknown(m)
 }
 
-func (m *MsgPayForGas) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimProtocolFees proto.InternalMessageInfo
+
+func (m *MsgClaimProtocolFees) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPayForGas) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClaimProtocolFeesResponse ...
+type MsgClaimProtocolFeesResponse struct {
 }
 
-func (m *MsgPayForGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimProtocolFeesResponse) Reset()         { *m = MsgClaimProtocolFeesResponse{} }
+func (m *MsgClaimProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimProtocolFeesResponse) ProtoMessage()    {}
+func (*MsgClaimProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f936e5a203ea8b1d, []int{27}
+}
+func (m *MsgClaimProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.GasLimit.Size()
-		i -= size
-		if _, err := m.GasLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.DestinationDomain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.MessageId.Size()
-		i -= size
-		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.IgpId.Size()
-		i -= size
-		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimProtocolFeesResponse.Merge(m, src)
+}
+func (m *MsgClaimProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimProtocolFeesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPayForGasResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgClaimProtocolFeesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateIgp)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateIgp")
+	proto.RegisterType((*MsgCreateIgpResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateIgpResponse")
+	proto.RegisterType((*MsgSetIgpOwner)(nil), "hyperlane.core.post_dispatch.v1.MsgSetIgpOwner")
+	proto.RegisterType((*MsgSetIgpOwnerResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgSetIgpOwnerResponse")
+	proto.RegisterType((*MsgSetDestinationGasConfig)(nil), "hyperlane.core.post_dispatch.v1.MsgSetDestinationGasConfig")
+	proto.RegisterType((*MsgSetDestinationGasConfigResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgSetDestinationGasConfigResponse")
+	proto.RegisterType((*MsgPayForGas)(nil), "hyperlane.core.post_dispatch.v1.MsgPayForGas")
+	proto.RegisterType((*MsgPayForGasResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgPayForGasResponse")
+	proto.RegisterType((*MsgClaim)(nil), "hyperlane.core.post_dispatch.v1.MsgClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgClaimResponse")
+	proto.RegisterType((*MsgCreateMerkleTreeHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateMerkleTreeHook")
+	proto.RegisterType((*MsgCreateMerkleTreeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateMerkleTreeHookResponse")
+	proto.RegisterType((*MsgCreateNoopHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateNoopHook")
+	proto.RegisterType((*MsgCreateNoopHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateNoopHookResponse")
+	proto.RegisterType((*MsgCreateAggregationHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateAggregationHook")
+	proto.RegisterType((*MsgCreateAggregationHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateAggregationHookResponse")
+	proto.RegisterType((*MsgCreateDomainRoutingHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateDomainRoutingHook")
+	proto.RegisterType((*MsgCreateDomainRoutingHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateDomainRoutingHookResponse")
+	proto.RegisterType((*MsgSetDomainRoutingHookRoute)(nil), "hyperlane.core.post_dispatch.v1.MsgSetDomainRoutingHookRoute")
+	proto.RegisterType((*MsgSetDomainRoutingHookRouteResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgSetDomainRoutingHookRouteResponse")
+	proto.RegisterType((*MsgRemoveDomainRoutingHookRoute)(nil), "hyperlane.core.post_dispatch.v1.MsgRemoveDomainRoutingHookRoute")
+	proto.RegisterType((*MsgRemoveDomainRoutingHookRouteResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgRemoveDomainRoutingHookRouteResponse")
+	proto.RegisterType((*MsgCreateProtocolFeeHook)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateProtocolFeeHook")
+	proto.RegisterType((*MsgCreateProtocolFeeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgCreateProtocolFeeHookResponse")
+	proto.RegisterType((*MsgSetProtocolFeeHook)(nil), "hyperlane.core.post_dispatch.v1.MsgSetProtocolFeeHook")
+	proto.RegisterType((*MsgSetProtocolFeeHookResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgSetProtocolFeeHookResponse")
+	proto.RegisterType((*MsgClaimProtocolFees)(nil), "hyperlane.core.post_dispatch.v1.MsgClaimProtocolFees")
+	proto.RegisterType((*MsgClaimProtocolFeesResponse)(nil), "hyperlane.core.post_dispatch.v1.MsgClaimProtocolFeesResponse")
 }
 
-func (m *MsgPayForGasResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("hyperlane/core/post_dispatch/v1/tx.proto", fileDescriptor_f936e5a203ea8b1d)
 }
 
-func (m *MsgPayForGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_f936e5a203ea8b1d = []byte{
+	// 1490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x65, 0xcb, 0xb1, 0xc6, 0x89, 0x5f, 0x4c, 0xc8, 0x89, 0xcc, 0x24, 0xb2, 0x1f, 0x91,
+	0xbc, 0xc4, 0x7e, 0xcf, 0x64, 0xec, 0x3c, 0xa7, 0xa9, 0x9c, 0x14, 0xb5, 0x9d, 0xc6, 0x16, 0x50,
+	0x27, 0x81, 0xd2, 0x53, 0x50, 0x54, 0x58, 0x89, 0x6b, 0x6a, 0x21, 0x91, 0xcb, 0x70, 0x69, 0x59,
+	0xbe, 0x05, 0x45, 0x7b, 0x68, 0xd1, 0x43, 0x5b, 0xb4, 0xa7, 0x9e, 0x7a, 0x28, 0x50, 0xf4, 0x94,
+	0x43, 0xef, 0x05, 0x7a, 0xca, 0xa5, 0x40, 0x50, 0xb4, 0x40, 0xdb, 0x43, 0x10, 0x38, 0x87, 0x7c,
+	0x80, 0x7e, 0x81, 0x82, 0x7f, 0x44, 0x93, 0x32, 0x65, 0x51, 0x92, 0x05, 0xe4, 0x62, 0x98, 0x9c,
+	0x3f, 0xfb, 0x9b, 0xdf, 0xcc, 0xce, 0xce, 0x52, 0x70, 0xa5, 0xb2, 0x67, 0x60, 0xb3, 0x86, 0x74,
+	0x2c, 0x97, 0xa9, 0x89, 0x65, 0x83, 0x32, 0xab, 0xa8, 0x10, 0x66, 0x20, 0xab, 0x5c, 0x91, 0xeb,
+	0x8b, 0xb2, 0xd5, 0x90, 0x0c, 0x93, 0x5a, 0x94, 0x9f, 0xf1, 0x35, 0x25, 0x5b, 0x53, 0x0a, 0x69,
+	0x4a, 0xf5, 0x45, 0xe1, 0x6c, 0x99, 0x32, 0x8d, 0x32, 0x59, 0x63, 0xaa, 0x6d, 0xa8, 0x31, 0xd5,
+	0xb5, 0x14, 0x26, 0x91, 0x46, 0x74, 0x2a, 0x3b, 0x7f, 0xbd, 0x57, 0xd3, 0xae, 0x6e, 0xd1, 0x79,
+	0x92, 0xdd, 0x07, 0x4f, 0x94, 0x56, 0xa9, 0x4a, 0xdd, 0xf7, 0xf6, 0x7f, 0xde, 0xdb, 0xff, 0x76,
+	0xc4, 0xb9, 0x67, 0xe0, 0xa6, 0x8b, 0xac, 0x87, 0xa4, 0x84, 0x18, 0x96, 0xeb, 0x8b, 0x25, 0x6c,
+	0xa1, 0x45, 0xb9, 0x4c, 0x89, 0xee, 0xca, 0xc5, 0x2f, 0x38, 0x38, 0xb9, 0xc5, 0xd4, 0x75, 0x13,
+	0x23, 0x0b, 0xe7, 0x55, 0x83, 0x97, 0x20, 0x49, 0x77, 0x75, 0x6c, 0x66, 0xb8, 0x59, 0xee, 0x4a,
+	0x6a, 0x2d, 0xf3, 0xeb, 0x8f, 0x0b, 0x69, 0x0f, 0xd4, 0xaa, 0xa2, 0x98, 0x98, 0xb1, 0x07, 0x96,
+	0x49, 0x74, 0xb5, 0xe0, 0xaa, 0xf1, 0x69, 0x48, 0x2a, 0x58, 0xa7, 0x5a, 0x26, 0x61, 0xeb, 0x17,
+	0xdc, 0x87, 0xdc, 0xca, 0x87, 0xaf, 0x9e, 0xcc, 0xbb, 0x1a, 0x9f, 0xbe, 0x7a, 0x32, 0xff, 0xbf,
+	0x03, 0xc8, 0xf5, 0x45, 0xf9, 0x60, 0x3d, 0xdd, 0xc2, 0x66, 0xb9, 0x82, 0x88, 0xbe, 0x81, 0xd8,
+	0x7d, 0xb4, 0xa7, 0x21, 0x66, 0x61, 0x53, 0xac, 0x42, 0x3a, 0x08, 0xa9, 0x80, 0x99, 0x41, 0x75,
+	0x86, 0xf9, 0x07, 0x90, 0x20, 0x8a, 0x87, 0x6b, 0xfd, 0xe9, 0xf3, 0x99, 0xa1, 0xbf, 0x9e, 0xcf,
+	0xac, 0xa8, 0xc4, 0xaa, 0xec, 0x94, 0xa4, 0x32, 0xd5, 0xe4, 0x52, 0xd9, 0x58, 0x20, 0xba, 0x4e,
+	0xeb, 0xc8, 0x22, 0x54, 0x67, 0xb2, 0xbf, 0xe8, 0x82, 0x47, 0xc2, 0x8e, 0x45, 0x6a, 0xd2, 0x26,
+	0x6e, 0x78, 0x81, 0x14, 0x12, 0x44, 0x11, 0xbf, 0x4a, 0xc0, 0xc4, 0x16, 0x53, 0x1f, 0x60, 0x2b,
+	0xaf, 0x1a, 0xf7, 0x9c, 0x90, 0xba, 0xa5, 0xe0, 0x21, 0x8c, 0x12, 0xd5, 0x28, 0x12, 0x25, 0x93,
+	0x38, 0x3e, 0x6c, 0x49, 0xa2, 0x1a, 0x79, 0x85, 0x3f, 0x07, 0x29, 0x1d, 0xef, 0x16, 0x5d, 0x3c,
+	0xc3, 0x0e, 0xc5, 0x63, 0x3a, 0xde, 0x75, 0x81, 0x2e, 0x00, 0x6f, 0x62, 0x9d, 0xee, 0xe8, 0x65,
+	0xec, 0x6a, 0xb0, 0x0a, 0x31, 0x32, 0x23, 0xb3, 0xdc, 0x95, 0xb1, 0xc2, 0x64, 0x53, 0x72, 0xaf,
+	0x29, 0xc8, 0xcd, 0x87, 0x93, 0x72, 0xae, 0x35, 0x29, 0x01, 0x0e, 0xc4, 0x0c, 0x9c, 0x09, 0xbf,
+	0x69, 0x66, 0x41, 0xfc, 0x25, 0x01, 0x82, 0x2b, 0xba, 0x8d, 0x99, 0x45, 0x74, 0x27, 0xa0, 0x0d,
+	0xc4, 0xd6, 0xa9, 0xbe, 0x4d, 0xd4, 0xd7, 0x8a, 0xbc, 0x2a, 0x9c, 0x51, 0x0e, 0x30, 0x16, 0x55,
+	0xc4, 0x8a, 0x65, 0x07, 0xa5, 0xc3, 0xe4, 0xf8, 0xd2, 0xb2, 0xd4, 0x61, 0x23, 0x4b, 0x51, 0x21,
+	0x16, 0xd2, 0x4a, 0xc4, 0xdb, 0xdc, 0xf5, 0x30, 0xbb, 0x97, 0x23, 0xd8, 0x8d, 0xf2, 0x26, 0x5e,
+	0x04, 0xb1, 0xbd, 0xd4, 0x67, 0xfd, 0xb7, 0x61, 0x67, 0x9f, 0xde, 0x47, 0x7b, 0x77, 0xa8, 0xb9,
+	0x81, 0x18, 0x7f, 0x15, 0x46, 0x19, 0xd6, 0x95, 0x18, 0x44, 0x7b, 0x7a, 0x03, 0x65, 0xba, 0x04,
+	0xa0, 0x61, 0xc6, 0x90, 0x8a, 0x6d, 0xff, 0xc3, 0xc7, 0xe7, 0x3f, 0xe5, 0xb9, 0xcd, 0x2b, 0x76,
+	0xb5, 0x07, 0xb3, 0xa9, 0x50, 0x0d, 0x11, 0xdd, 0xa9, 0xf6, 0x53, 0x85, 0xc9, 0x80, 0xe4, 0xb6,
+	0x23, 0xe0, 0x73, 0x90, 0xb2, 0x13, 0x5e, 0x23, 0x1a, 0xb1, 0x32, 0x49, 0x07, 0xd1, 0x05, 0x0f,
+	0xd1, 0x94, 0xbb, 0x18, 0x53, 0xaa, 0x12, 0xa1, 0xb2, 0x86, 0xac, 0x8a, 0x94, 0xd7, 0xad, 0xc2,
+	0x98, 0x8a, 0xd8, 0xbb, 0xb6, 0x3a, 0x7f, 0x13, 0x46, 0x91, 0x46, 0x77, 0x74, 0x2b, 0x33, 0xea,
+	0x14, 0xca, 0xb4, 0xe4, 0x31, 0x6b, 0xb7, 0x51, 0xc9, 0x6b, 0xa3, 0xd2, 0x3a, 0x25, 0xfa, 0x5a,
+	0xca, 0xf6, 0xf9, 0xfd, 0xab, 0x27, 0xf3, 0x5c, 0xc1, 0xb3, 0xc9, 0xcd, 0xd9, 0x95, 0xe0, 0xb1,
+	0x6e, 0x97, 0xc2, 0x74, 0x6b, 0x29, 0xf8, 0x59, 0x14, 0xcf, 0x40, 0x3a, 0xf8, 0xec, 0xa7, 0xfb,
+	0x27, 0x0e, 0xc6, 0xec, 0x1e, 0x58, 0x43, 0x44, 0x7b, 0xbd, 0x52, 0x9d, 0xbb, 0xd4, 0x12, 0xdd,
+	0xd4, 0xa1, 0xde, 0x6e, 0x83, 0x16, 0x79, 0x38, 0xdd, 0xfc, 0xdf, 0x8f, 0x6a, 0x9f, 0x83, 0xb3,
+	0x7e, 0x67, 0xdf, 0xc2, 0x66, 0xb5, 0x86, 0xdf, 0x33, 0x31, 0xde, 0xa4, 0xb4, 0xda, 0x75, 0xdf,
+	0xb0, 0x2b, 0x0e, 0x91, 0x5a, 0x89, 0x36, 0x8e, 0x39, 0xcc, 0x94, 0xe7, 0x36, 0xaf, 0xe4, 0xae,
+	0x85, 0xb7, 0xf4, 0xc5, 0xe8, 0x53, 0x2c, 0x1c, 0x88, 0x58, 0x87, 0x99, 0x36, 0xa2, 0xc1, 0x1e,
+	0x64, 0x0d, 0x98, 0xf4, 0xd7, 0xbd, 0x4b, 0xa9, 0xd1, 0x0b, 0xab, 0xbd, 0x45, 0x6c, 0xc0, 0xf4,
+	0xa1, 0x95, 0x07, 0x1b, 0xeb, 0x0b, 0x0e, 0x32, 0xfe, 0x92, 0xab, 0xaa, 0x6a, 0x62, 0xd5, 0x31,
+	0xef, 0xa9, 0x92, 0x3e, 0x80, 0xb1, 0x0a, 0xa5, 0xd5, 0x22, 0x51, 0x58, 0x26, 0x31, 0x3b, 0x7c,
+	0x5c, 0x38, 0x4f, 0xd8, 0x4e, 0xf3, 0x0a, 0xcb, 0xfd, 0x3f, 0xcc, 0xe9, 0xa5, 0x68, 0x4e, 0x5b,
+	0xa2, 0x10, 0x77, 0x61, 0xb6, 0x9d, 0x6c, 0xb0, 0xdc, 0xfe, 0xcd, 0x81, 0xe0, 0xaf, 0xec, 0xf6,
+	0xd2, 0x02, 0xdd, 0xb1, 0x88, 0xae, 0xf6, 0xc4, 0xae, 0x06, 0xa7, 0xb7, 0x51, 0xad, 0x56, 0x42,
+	0xe5, 0x6a, 0xd1, 0xa3, 0x39, 0xb0, 0x5b, 0xb9, 0x7e, 0x11, 0x4f, 0x34, 0x9d, 0x6f, 0x3a, 0x6c,
+	0x77, 0x3c, 0x85, 0xdb, 0x84, 0x25, 0xee, 0x81, 0xd8, 0x5e, 0x3a, 0x58, 0xc2, 0xbf, 0x4b, 0xc0,
+	0x79, 0x6f, 0x02, 0x38, 0xb4, 0x30, 0xdd, 0xb1, 0x70, 0xd7, 0x94, 0xbb, 0x28, 0x13, 0xc7, 0x8a,
+	0x92, 0xbf, 0x03, 0x49, 0xd3, 0x46, 0xe3, 0x8d, 0x4e, 0xf3, 0x1d, 0x47, 0x27, 0x1f, 0xff, 0xda,
+	0x88, 0x8d, 0xa1, 0xe0, 0x9a, 0xe7, 0x6e, 0x84, 0x13, 0x34, 0x17, 0x35, 0x26, 0x45, 0xd2, 0x20,
+	0xfe, 0x07, 0x2e, 0x1e, 0x25, 0xf7, 0x4f, 0x99, 0x8f, 0x13, 0x4e, 0x07, 0x2e, 0x60, 0x8d, 0xd6,
+	0xf1, 0xeb, 0x4c, 0xe9, 0x2c, 0x8c, 0x07, 0xc6, 0x16, 0x87, 0xd8, 0x53, 0x85, 0xe0, 0xab, 0x8e,
+	0xd7, 0xa8, 0xa3, 0x62, 0x14, 0xe7, 0xe0, 0x72, 0x07, 0x15, 0x9f, 0xb2, 0xdf, 0x13, 0x81, 0x7e,
+	0x7a, 0xdf, 0xa4, 0x16, 0x2d, 0xd3, 0xda, 0x9d, 0x1e, 0x4f, 0xe6, 0x1c, 0x8c, 0x97, 0xb0, 0x8e,
+	0xb7, 0x49, 0x99, 0x20, 0x73, 0x2f, 0x93, 0xe8, 0x60, 0x15, 0x54, 0xe6, 0x37, 0xe0, 0xa4, 0xe1,
+	0x2d, 0x5f, 0xdc, 0xc6, 0xcd, 0x62, 0x8b, 0x37, 0x7e, 0x8d, 0x1b, 0x07, 0xc0, 0xf9, 0xbb, 0x70,
+	0x5a, 0x43, 0x8d, 0x62, 0xc8, 0xd9, 0x48, 0x17, 0xce, 0x26, 0x34, 0xd4, 0x08, 0x10, 0x11, 0xb3,
+	0x89, 0xb7, 0x50, 0x17, 0x6a, 0xe2, 0x2d, 0xb2, 0xc1, 0xf6, 0x94, 0x8f, 0x86, 0x61, 0xca, 0xdd,
+	0x2c, 0xfd, 0x66, 0xf3, 0x7d, 0x38, 0x71, 0xb8, 0x6d, 0xf7, 0x8d, 0x71, 0xd4, 0x3d, 0x1c, 0xf9,
+	0x9b, 0x5d, 0xe6, 0x3b, 0x9c, 0xe4, 0xd9, 0x70, 0xa5, 0x8d, 0x38, 0xd7, 0xe3, 0x50, 0x3d, 0x85,
+	0xae, 0xcf, 0xc9, 0x58, 0xd7, 0xe7, 0xd1, 0x76, 0xd7, 0xe7, 0xc5, 0x70, 0x09, 0x88, 0x11, 0x9d,
+	0xab, 0x35, 0xff, 0x33, 0x70, 0x21, 0x52, 0xe0, 0x6f, 0xbc, 0x3f, 0x39, 0x48, 0x37, 0xc7, 0xe4,
+	0x80, 0x4e, 0x2f, 0xd7, 0xbb, 0x81, 0x26, 0xca, 0x0d, 0x3e, 0x30, 0xf5, 0xff, 0x3b, 0x72, 0xea,
+	0x0f, 0x86, 0x20, 0x66, 0xe1, 0x7c, 0xd4, 0xfb, 0x66, 0xec, 0x4b, 0x3f, 0xff, 0x0b, 0x86, 0xb7,
+	0x98, 0xca, 0x3f, 0x82, 0xd4, 0xc1, 0xe7, 0xa7, 0x85, 0x8e, 0xe7, 0x4a, 0xf0, 0xd3, 0x90, 0xb0,
+	0xdc, 0x95, 0xba, 0xbf, 0xe7, 0x76, 0x61, 0x3c, 0xf8, 0xc1, 0x47, 0x8e, 0xe3, 0x25, 0x60, 0x20,
+	0xbc, 0xd1, 0xa5, 0x81, 0xbf, 0xf0, 0x37, 0x1c, 0x9c, 0x6d, 0xf7, 0xe5, 0x64, 0x25, 0xa6, 0xd3,
+	0x28, 0x63, 0x61, 0xbd, 0x0f, 0x63, 0x1f, 0xdd, 0x23, 0x48, 0x1d, 0x7c, 0x60, 0x88, 0x95, 0x09,
+	0x5f, 0x5d, 0x58, 0xee, 0x4a, 0xdd, 0x5f, 0x12, 0x43, 0xd2, 0xbd, 0xe4, 0xce, 0xc5, 0xca, 0xa4,
+	0xad, 0x2a, 0x2c, 0xc6, 0x56, 0xf5, 0x97, 0xf9, 0x92, 0x83, 0x74, 0xe4, 0xb5, 0xf3, 0x46, 0xfc,
+	0x02, 0x0a, 0x5b, 0x0a, 0x6f, 0xf7, 0x6a, 0xe9, 0x83, 0x7a, 0xcc, 0xc1, 0x44, 0xcb, 0x7d, 0x6d,
+	0x29, 0xbe, 0xd3, 0xa6, 0x8d, 0x90, 0xeb, 0xde, 0xc6, 0x87, 0xf0, 0x35, 0x07, 0x53, 0xd1, 0xb7,
+	0xa8, 0x37, 0xe3, 0x7b, 0x6d, 0x31, 0x15, 0x56, 0x7b, 0x36, 0x0d, 0xed, 0x93, 0x76, 0x37, 0x90,
+	0x95, 0xf8, 0xee, 0x0f, 0x19, 0x0b, 0xeb, 0x7d, 0x18, 0xfb, 0xe8, 0xbe, 0xe5, 0x60, 0xba, 0xfd,
+	0xb8, 0x7e, 0x2b, 0xee, 0x56, 0x8c, 0x34, 0x17, 0xde, 0xe9, 0xcb, 0xdc, 0xc7, 0xf8, 0x03, 0x07,
+	0xe7, 0x8f, 0x1c, 0x81, 0x63, 0xd5, 0xef, 0x51, 0x1e, 0x84, 0xcd, 0x7e, 0x3d, 0x44, 0x94, 0x61,
+	0xeb, 0xb8, 0xd2, 0x45, 0x19, 0xb6, 0x98, 0x0a, 0xab, 0x3d, 0x9b, 0xfa, 0xb8, 0x3e, 0xe3, 0x80,
+	0x8f, 0x98, 0xa1, 0xae, 0xc7, 0x4c, 0x51, 0x2b, 0xa2, 0xb7, 0x7a, 0xb3, 0xf3, 0xe1, 0x7c, 0xc2,
+	0xc1, 0xe4, 0xe1, 0x51, 0x61, 0x39, 0x76, 0x3b, 0x0c, 0x9a, 0x09, 0xb7, 0x7a, 0x32, 0x6b, 0x62,
+	0x11, 0x92, 0x8f, 0xed, 0x39, 0x79, 0xad, 0xfc, 0x74, 0x3f, 0xcb, 0x3d, 0xdb, 0xcf, 0x72, 0x2f,
+	0xf6, 0xb3, 0xdc, 0xe7, 0x2f, 0xb3, 0x43, 0xcf, 0x5e, 0x66, 0x87, 0xfe, 0x78, 0x99, 0x1d, 0x7a,
+	0x98, 0xef, 0x66, 0xec, 0x68, 0xb8, 0x3f, 0x65, 0x5d, 0x5d, 0x2a, 0x86, 0x7f, 0xcd, 0x72, 0x7e,
+	0xca, 0x2a, 0x8d, 0x3a, 0x43, 0xdf, 0xb5, 0x7f, 0x06, 0x00, 0x99, 0xbd, 0x30, 0x66, 0xa2, 0x1b,
+	0x00, 0x00,
 }
 
-func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateIgp ...
+	CreateIgp(ctx context.Context, in *MsgCreateIgp, opts ...grpc.CallOption) (*MsgCreateIgpResponse, error)
+	// SetIgpOwner ...
+	SetIgpOwner(ctx context.Context, in *MsgSetIgpOwner, opts ...grpc.CallOption) (*MsgSetIgpOwnerResponse, error)
+	// SetDestinationGasConfig ...
+	SetDestinationGasConfig(ctx context.Context, in *MsgSetDestinationGasConfig, opts ...grpc.CallOption) (*MsgSetDestinationGasConfigResponse, error)
+	// PayForGas ...
+	PayForGas(ctx context.Context, in *MsgPayForGas, opts ...grpc.CallOption) (*MsgPayForGasResponse, error)
+	// Claim ...
+	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	// CreateMerkleTreeHook ...
+	CreateMerkleTreeHook(ctx context.Context, in *MsgCreateMerkleTreeHook, opts ...grpc.CallOption) (*MsgCreateMerkleTreeHookResponse, error)
+	// CreateNoopHook ...
+	CreateNoopHook(ctx context.Context, in *MsgCreateNoopHook, opts ...grpc.CallOption) (*MsgCreateNoopHookResponse, error)
+	// CreateAggregationHook ...
+	CreateAggregationHook(ctx context.Context, in *MsgCreateAggregationHook, opts ...grpc.CallOption) (*MsgCreateAggregationHookResponse, error)
+	// CreateDomainRoutingHook ...
+	CreateDomainRoutingHook(ctx context.Context, in *MsgCreateDomainRoutingHook, opts ...grpc.CallOption) (*MsgCreateDomainRoutingHookResponse, error)
+	// SetDomainRoutingHookRoute ...
+	SetDomainRoutingHookRoute(ctx context.Context, in *MsgSetDomainRoutingHookRoute, opts ...grpc.CallOption) (*MsgSetDomainRoutingHookRouteResponse, error)
+	// RemoveDomainRoutingHookRoute ...
+	RemoveDomainRoutingHookRoute(ctx context.Context, in *MsgRemoveDomainRoutingHookRoute, opts ...grpc.CallOption) (*MsgRemoveDomainRoutingHookRouteResponse, error)
+	// CreateProtocolFeeHook ...
+	CreateProtocolFeeHook(ctx context.Context, in *MsgCreateProtocolFeeHook, opts ...grpc.CallOption) (*MsgCreateProtocolFeeHookResponse, error)
+	// SetProtocolFeeHook ...
+	SetProtocolFeeHook(ctx context.Context, in *MsgSetProtocolFeeHook, opts ...grpc.CallOption) (*MsgSetProtocolFeeHookResponse, error)
+	// ClaimProtocolFees ...
+	ClaimProtocolFees(ctx context.Context, in *MsgClaimProtocolFees, opts ...grpc.CallOption) (*MsgClaimProtocolFeesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateIgp(ctx context.Context, in *MsgCreateIgp, opts ...grpc.CallOption) (*MsgCreateIgpResponse, error) {
+	out := new(MsgCreateIgpResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateIgp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetIgpOwner(ctx context.Context, in *MsgSetIgpOwner, opts ...grpc.CallOption) (*MsgSetIgpOwnerResponse, error) {
+	out := new(MsgSetIgpOwnerResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/SetIgpOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.IgpId.Size()
-		i -= size
-		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetDestinationGasConfig(ctx context.Context, in *MsgSetDestinationGasConfig, opts ...grpc.CallOption) (*MsgSetDestinationGasConfigResponse, error) {
+	out := new(MsgSetDestinationGasConfigResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/SetDestinationGasConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PayForGas(ctx context.Context, in *MsgPayForGas, opts ...grpc.CallOption) (*MsgPayForGasResponse, error) {
+	out := new(MsgPayForGasResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/PayForGas", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateMerkleTreeHook(ctx context.Context, in *MsgCreateMerkleTreeHook, opts ...grpc.CallOption) (*MsgCreateMerkleTreeHookResponse, error) {
+	out := new(MsgCreateMerkleTreeHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateMerkleTreeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateMerkleTreeHook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateNoopHook(ctx context.Context, in *MsgCreateNoopHook, opts ...grpc.CallOption) (*MsgCreateNoopHookResponse, error) {
+	out := new(MsgCreateNoopHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateNoopHook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateMerkleTreeHook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateAggregationHook(ctx context.Context, in *MsgCreateAggregationHook, opts ...grpc.CallOption) (*MsgCreateAggregationHookResponse, error) {
+	out := new(MsgCreateAggregationHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateAggregationHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateMerkleTreeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MailboxId.Size()
-		i -= size
-		if _, err := m.MailboxId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) CreateDomainRoutingHook(ctx context.Context, in *MsgCreateDomainRoutingHook, opts ...grpc.CallOption) (*MsgCreateDomainRoutingHookResponse, error) {
+	out := new(MsgCreateDomainRoutingHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateDomainRoutingHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SetDomainRoutingHookRoute(ctx context.Context, in *MsgSetDomainRoutingHookRoute, opts ...grpc.CallOption) (*MsgSetDomainRoutingHookRouteResponse, error) {
+	out := new(MsgSetDomainRoutingHookRouteResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/SetDomainRoutingHookRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateMerkleTreeHookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveDomainRoutingHookRoute(ctx context.Context, in *MsgRemoveDomainRoutingHookRoute, opts ...grpc.CallOption) (*MsgRemoveDomainRoutingHookRouteResponse, error) {
+	out := new(MsgRemoveDomainRoutingHookRouteResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/RemoveDomainRoutingHookRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateMerkleTreeHookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateProtocolFeeHook(ctx context.Context, in *MsgCreateProtocolFeeHook, opts ...grpc.CallOption) (*MsgCreateProtocolFeeHookResponse, error) {
+	out := new(MsgCreateProtocolFeeHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/CreateProtocolFeeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateMerkleTreeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) SetProtocolFeeHook(ctx context.Context, in *MsgSetProtocolFeeHook, opts ...grpc.CallOption) (*MsgSetProtocolFeeHookResponse, error) {
+	out := new(MsgSetProtocolFeeHookResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/SetProtocolFeeHook", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateNoopHook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimProtocolFees(ctx context.Context, in *MsgClaimProtocolFees, opts ...grpc.CallOption) (*MsgClaimProtocolFeesResponse, error) {
+	out := new(MsgClaimProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.post_dispatch.v1.Msg/ClaimProtocolFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateNoopHook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateIgp ...
+	CreateIgp(context.Context, *MsgCreateIgp) (*MsgCreateIgpResponse, error)
+	// SetIgpOwner ...
+	SetIgpOwner(context.Context, *MsgSetIgpOwner) (*MsgSetIgpOwnerResponse, error)
+	// SetDestinationGasConfig ...
+	SetDestinationGasConfig(context.Context, *MsgSetDestinationGasConfig) (*MsgSetDestinationGasConfigResponse, error)
+	// PayForGas ...
+	PayForGas(context.Context, *MsgPayForGas) (*MsgPayForGasResponse, error)
+	// Claim ...
+	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	// CreateMerkleTreeHook ...
+	CreateMerkleTreeHook(context.Context, *MsgCreateMerkleTreeHook) (*MsgCreateMerkleTreeHookResponse, error)
+	// CreateNoopHook ...
+	CreateNoopHook(context.Context, *MsgCreateNoopHook) (*MsgCreateNoopHookResponse, error)
+	// CreateAggregationHook ...
+	CreateAggregationHook(context.Context, *MsgCreateAggregationHook) (*MsgCreateAggregationHookResponse, error)
+	// CreateDomainRoutingHook ...
+	CreateDomainRoutingHook(context.Context, *MsgCreateDomainRoutingHook) (*MsgCreateDomainRoutingHookResponse, error)
+	// SetDomainRoutingHookRoute ...
+	SetDomainRoutingHookRoute(context.Context, *MsgSetDomainRoutingHookRoute) (*MsgSetDomainRoutingHookRouteResponse, error)
+	// RemoveDomainRoutingHookRoute ...
+	RemoveDomainRoutingHookRoute(context.Context, *MsgRemoveDomainRoutingHookRoute) (*MsgRemoveDomainRoutingHookRouteResponse, error)
+	// CreateProtocolFeeHook ...
+	CreateProtocolFeeHook(context.Context, *MsgCreateProtocolFeeHook) (*MsgCreateProtocolFeeHookResponse, error)
+	// SetProtocolFeeHook ...
+	SetProtocolFeeHook(context.Context, *MsgSetProtocolFeeHook) (*MsgSetProtocolFeeHookResponse, error)
+	// ClaimProtocolFees ...
+	ClaimProtocolFees(context.Context, *MsgClaimProtocolFees) (*MsgClaimProtocolFeesResponse, error)
 }
 
-func (m *MsgCreateNoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCreateNoopHookResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateIgp(ctx context.Context, req *MsgCreateIgp) (*MsgCreateIgpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIgp not implemented")
 }
-
-func (m *MsgCreateNoopHookResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SetIgpOwner(ctx context.Context, req *MsgSetIgpOwner) (*MsgSetIgpOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIgpOwner not implemented")
+}
+func (*UnimplementedMsgServer) SetDestinationGasConfig(ctx context.Context, req *MsgSetDestinationGasConfig) (*MsgSetDestinationGasConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDestinationGasConfig not implemented")
+}
+func (*UnimplementedMsgServer) PayForGas(ctx context.Context, req *MsgPayForGas) (*MsgPayForGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayForGas not implemented")
+}
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (*UnimplementedMsgServer) CreateMerkleTreeHook(ctx context.Context, req *MsgCreateMerkleTreeHook) (*MsgCreateMerkleTreeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleTreeHook not implemented")
+}
+func (*UnimplementedMsgServer) CreateNoopHook(ctx context.Context, req *MsgCreateNoopHook) (*MsgCreateNoopHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoopHook not implemented")
+}
+func (*UnimplementedMsgServer) CreateAggregationHook(ctx context.Context, req *MsgCreateAggregationHook) (*MsgCreateAggregationHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAggregationHook not implemented")
+}
+func (*UnimplementedMsgServer) CreateDomainRoutingHook(ctx context.Context, req *MsgCreateDomainRoutingHook) (*MsgCreateDomainRoutingHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDomainRoutingHook not implemented")
+}
+func (*UnimplementedMsgServer) SetDomainRoutingHookRoute(ctx context.Context, req *MsgSetDomainRoutingHookRoute) (*MsgSetDomainRoutingHookRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainRoutingHookRoute not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDomainRoutingHookRoute(ctx context.Context, req *MsgRemoveDomainRoutingHookRoute) (*MsgRemoveDomainRoutingHookRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDomainRoutingHookRoute not implemented")
+}
+func (*UnimplementedMsgServer) CreateProtocolFeeHook(ctx context.Context, req *MsgCreateProtocolFeeHook) (*MsgCreateProtocolFeeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProtocolFeeHook not implemented")
+}
+func (*UnimplementedMsgServer) SetProtocolFeeHook(ctx context.Context, req *MsgSetProtocolFeeHook) (*MsgSetProtocolFeeHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProtocolFeeHook not implemented")
+}
+func (*UnimplementedMsgServer) ClaimProtocolFees(ctx context.Context, req *MsgClaimProtocolFees) (*MsgClaimProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimProtocolFees not implemented")
 }
 
-func (m *MsgCreateNoopHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_CreateIgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIgp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateIgp) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateIgp(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateIgp",
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateIgp(ctx, req.(*MsgCreateIgp))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateIgpResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetIgpOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIgpOwner)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetIgpOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/SetIgpOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIgpOwner(ctx, req.(*MsgSetIgpOwner))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetIgpOwner) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetDestinationGasConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDestinationGasConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetDestinationGasConfig(ctx, in)
 	}
-	l = m.IgpId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/SetDestinationGasConfig",
 	}
-	if m.RenounceOwnership {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDestinationGasConfig(ctx, req.(*MsgSetDestinationGasConfig))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetIgpOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PayForGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayForGas)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetDestinationGasConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).PayForGas(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/PayForGas",
 	}
-	l = m.IgpId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DestinationGasConfig != nil {
-		l = m.DestinationGasConfig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayForGas(ctx, req.(*MsgPayForGas))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetDestinationGasConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaim)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgPayForGas) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/Claim",
 	}
-	l = m.IgpId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MessageId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DestinationDomain != 0 {
-		n += 1 + sovTx(uint64(m.DestinationDomain))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
 	}
-	l = m.GasLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPayForGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateMerkleTreeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMerkleTreeHook)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMerkleTreeHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateMerkleTreeHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMerkleTreeHook(ctx, req.(*MsgCreateMerkleTreeHook))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateNoopHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNoopHook)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNoopHook(ctx, in)
 	}
-	l = m.IgpId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateNoopHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNoopHook(ctx, req.(*MsgCreateNoopHook))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateAggregationHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAggregationHook)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAggregationHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateAggregationHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAggregationHook(ctx, req.(*MsgCreateAggregationHook))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateMerkleTreeHook) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateDomainRoutingHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDomainRoutingHook)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDomainRoutingHook(ctx, in)
 	}
-	l = m.MailboxId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateDomainRoutingHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDomainRoutingHook(ctx, req.(*MsgCreateDomainRoutingHook))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateMerkleTreeHookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetDomainRoutingHookRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDomainRoutingHookRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDomainRoutingHookRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/SetDomainRoutingHookRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDomainRoutingHookRoute(ctx, req.(*MsgSetDomainRoutingHookRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDomainRoutingHookRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDomainRoutingHookRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDomainRoutingHookRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/RemoveDomainRoutingHookRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDomainRoutingHookRoute(ctx, req.(*MsgRemoveDomainRoutingHookRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateProtocolFeeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateProtocolFeeHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateProtocolFeeHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/CreateProtocolFeeHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateProtocolFeeHook(ctx, req.(*MsgCreateProtocolFeeHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProtocolFeeHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProtocolFeeHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProtocolFeeHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/SetProtocolFeeHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProtocolFeeHook(ctx, req.(*MsgSetProtocolFeeHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimProtocolFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.post_dispatch.v1.Msg/ClaimProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimProtocolFees(ctx, req.(*MsgClaimProtocolFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperlane.core.post_dispatch.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateIgp",
+			Handler:    _Msg_CreateIgp_Handler,
+		},
+		{
+			MethodName: "SetIgpOwner",
+			Handler:    _Msg_SetIgpOwner_Handler,
+		},
+		{
+			MethodName: "SetDestinationGasConfig",
+			Handler:    _Msg_SetDestinationGasConfig_Handler,
+		},
+		{
+			MethodName: "PayForGas",
+			Handler:    _Msg_PayForGas_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
+		{
+			MethodName: "CreateMerkleTreeHook",
+			Handler:    _Msg_CreateMerkleTreeHook_Handler,
+		},
+		{
+			MethodName: "CreateNoopHook",
+			Handler:    _Msg_CreateNoopHook_Handler,
+		},
+		{
+			MethodName: "CreateAggregationHook",
+			Handler:    _Msg_CreateAggregationHook_Handler,
+		},
+		{
+			MethodName: "CreateDomainRoutingHook",
+			Handler:    _Msg_CreateDomainRoutingHook_Handler,
+		},
+		{
+			MethodName: "SetDomainRoutingHookRoute",
+			Handler:    _Msg_SetDomainRoutingHookRoute_Handler,
+		},
+		{
+			MethodName: "RemoveDomainRoutingHookRoute",
+			Handler:    _Msg_RemoveDomainRoutingHookRoute_Handler,
+		},
+		{
+			MethodName: "CreateProtocolFeeHook",
+			Handler:    _Msg_CreateProtocolFeeHook_Handler,
+		},
+		{
+			MethodName: "SetProtocolFeeHook",
+			Handler:    _Msg_SetProtocolFeeHook_Handler,
+		},
+		{
+			MethodName: "ClaimProtocolFees",
+			Handler:    _Msg_ClaimProtocolFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyperlane/core/post_dispatch/v1/tx.proto",
+}
+
+func (m *MsgCreateIgp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIgp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIgp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateNoopHook) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateIgpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIgpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIgpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIgpOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIgpOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIgpOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenounceOwnership {
+		i--
+		if m.RenounceOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.IgpId.Size()
+		i -= size
+		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIgpOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIgpOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIgpOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDestinationGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDestinationGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDestinationGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationGasConfig != nil {
+		{
+			size, err := m.DestinationGasConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.IgpId.Size()
+		i -= size
+		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDestinationGasConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDestinationGasConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDestinationGasConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayForGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayForGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayForGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GasLimit.Size()
+		i -= size
+		if _, err := m.GasLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.IgpId.Size()
+		i -= size
+		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayForGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayForGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayForGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.IgpId.Size()
+		i -= size
+		if _, err := m.IgpId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleTreeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleTreeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleTreeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MailboxId.Size()
+		i -= size
+		if _, err := m.MailboxId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleTreeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleTreeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleTreeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNoopHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNoopHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNoopHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNoopHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNoopHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAggregationHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAggregationHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAggregationHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookIds) > 0 {
+		for iNdEx := len(m.HookIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.HookIds[iNdEx].Size()
+				i -= size
+				if _, err := m.HookIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAggregationHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAggregationHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAggregationHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDomainRoutingHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDomainRoutingHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDomainRoutingHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FallbackHookId != nil {
+		{
+			size := m.FallbackHookId.Size()
+			i -= size
+			if _, err := m.FallbackHookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDomainRoutingHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDomainRoutingHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDomainRoutingHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainRoutingHookRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainRoutingHookRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainRoutingHookRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDomainRoutingHookRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDomainRoutingHookRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDomainRoutingHookRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDomainRoutingHookRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDomainRoutingHookRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDomainRoutingHookRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDomainRoutingHookRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDomainRoutingHookRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDomainRoutingHookRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateProtocolFeeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateProtocolFeeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateProtocolFeeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateProtocolFeeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateProtocolFeeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateProtocolFeeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProtocolFeeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProtocolFeeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProtocolFeeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenounceOwnership {
+		i--
+		if m.RenounceOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProtocolFee != nil {
+		{
+			size, err := m.ProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.HookId.Size()
+		i -= size
+		if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProtocolFeeHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProtocolFeeHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProtocolFeeHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.HookId.Size()
+		i -= size
+		if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateIgp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateIgpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIgpOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IgpId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetIgpOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDestinationGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IgpId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DestinationGasConfig != nil {
+		l = m.DestinationGasConfig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDestinationGasConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayForGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IgpId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MessageId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = m.GasLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayForGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IgpId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMerkleTreeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MailboxId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMerkleTreeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateNoopHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateNoopHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateAggregationHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.HookIds) > 0 {
+		for _, e := range m.HookIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateAggregationHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateDomainRoutingHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FallbackHookId != nil {
+		l = m.FallbackHookId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDomainRoutingHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDomainRoutingHookRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Route.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDomainRoutingHookRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDomainRoutingHookRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Destination != 0 {
+		n += 1 + sovTx(uint64(m.Destination))
+	}
+	return n
+}
+
+func (m *MsgRemoveDomainRoutingHookRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateProtocolFeeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProtocolFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxProtocolFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateProtocolFeeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetProtocolFeeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HookId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ProtocolFee != nil {
+		l = m.ProtocolFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetProtocolFeeHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HookId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateIgp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIgp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIgp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIgpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIgpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIgpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIgpOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIgpOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenounceOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIgpOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIgpOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIgpOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDestinationGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDestinationGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationGasConfig == nil {
+				m.DestinationGasConfig = &DestinationGasConfig{}
+			}
+			if err := m.DestinationGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDestinationGasConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDestinationGasConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDestinationGasConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayForGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleTreeHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleTreeHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleTreeHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleTreeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCreateNoopHookResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateIgp) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNoopHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,10 +4854,10 @@ func (m *MsgCreateIgp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIgp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNoopHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIgp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNoopHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1876,9 +4892,59 @@ func (m *MsgCreateIgp) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNoopHookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNoopHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNoopHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1906,7 +4972,9 @@ func (m *MsgCreateIgp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1929,7 +4997,7 @@ func (m *MsgCreateIgp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIgpResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAggregationHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1952,15 +5020,15 @@ func (m *MsgCreateIgpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIgpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAggregationHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIgpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAggregationHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1988,7 +5056,41 @@ func (m *MsgCreateIgpResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.HookIds = append(m.HookIds, v)
+			if err := m.HookIds[len(m.HookIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2013,7 +5115,7 @@ func (m *MsgCreateIgpResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateAggregationHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2036,15 +5138,15 @@ func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetIgpOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateAggregationHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetIgpOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateAggregationHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2072,11 +5174,63 @@ func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDomainRoutingHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDomainRoutingHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDomainRoutingHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2104,13 +5258,11 @@ func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackHookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2138,28 +5290,12 @@ func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.FallbackHookId = &v
+			if err := m.FallbackHookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RenounceOwnership = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2181,7 +5317,7 @@ func (m *MsgSetIgpOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetIgpOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDomainRoutingHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2200,16 +5336,50 @@ func (m *MsgSetIgpOwnerResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetIgpOwnerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetIgpOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDomainRoutingHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDomainRoutingHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2231,7 +5401,7 @@ func (m *MsgSetIgpOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDomainRoutingHookRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2254,10 +5424,10 @@ func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDestinationGasConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDomainRoutingHookRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDestinationGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDomainRoutingHookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2294,7 +5464,7 @@ func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2322,13 +5492,13 @@ func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationGasConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2355,10 +5525,7 @@ func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationGasConfig == nil {
-				m.DestinationGasConfig = &DestinationGasConfig{}
-			}
-			if err := m.DestinationGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2383,7 +5550,7 @@ func (m *MsgSetDestinationGasConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDestinationGasConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDomainRoutingHookRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2406,10 +5573,10 @@ func (m *MsgSetDestinationGasConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDestinationGasConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDomainRoutingHookRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDestinationGasConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDomainRoutingHookRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2433,7 +5600,7 @@ func (m *MsgSetDestinationGasConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDomainRoutingHookRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2456,15 +5623,15 @@ func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForGas: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDomainRoutingHookRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForGas: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDomainRoutingHookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2492,11 +5659,11 @@ func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2524,102 +5691,15 @@ func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
-			}
-			m.DestinationDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GasLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2629,25 +5709,11 @@ func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Destination |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2669,7 +5735,7 @@ func (m *MsgPayForGas) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayForGasResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDomainRoutingHookRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +5758,10 @@ func (m *MsgPayForGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDomainRoutingHookRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDomainRoutingHookRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +5785,7 @@ func (m *MsgPayForGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateProtocolFeeHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +5808,15 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateProtocolFeeHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateProtocolFeeHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,11 +5844,11 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2810,7 +5876,71 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgpId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProtocolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2835,7 +5965,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateProtocolFeeHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,25 +5975,59 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateProtocolFeeHookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateProtocolFeeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2885,7 +6049,7 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProtocolFeeHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2908,10 +6072,10 @@ func (m *MsgCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMerkleTreeHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProtocolFeeHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMerkleTreeHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProtocolFeeHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2948,7 +6112,7 @@ func (m *MsgCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2976,10 +6140,130 @@ func (m *MsgCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProtocolFee == nil {
+				m.ProtocolFee = &types.Coin{}
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenounceOwnership = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3001,7 +6285,7 @@ func (m *MsgCreateMerkleTreeHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProtocolFeeHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3024,46 +6308,12 @@ func (m *MsgCreateMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMerkleTreeHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProtocolFeeHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMerkleTreeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProtocolFeeHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3085,7 +6335,7 @@ func (m *MsgCreateMerkleTreeHookResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNoopHook) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimProtocolFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3108,15 +6358,15 @@ func (m *MsgCreateNoopHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNoopHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimProtocolFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNoopHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3144,7 +6394,41 @@ func (m *MsgCreateNoopHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3167,7 +6451,7 @@ func (m *MsgCreateNoopHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNoopHookResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimProtocolFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3190,46 +6474,12 @@ func (m *MsgCreateNoopHookResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNoopHookResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimProtocolFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNoopHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9,6 +9,14 @@ var (
 	InterchainGasPaymasterConfigsKey = []byte{SubModuleId, 3}
 	MerkleTreeHooksKey               = []byte{SubModuleId, 4}
 	NoopHooksKey                     = []byte{SubModuleId, 5}
+	AggregationHooksKey              = []byte{SubModuleId, 6}
+	DomainRoutingHooksKey            = []byte{SubModuleId, 7}
+	DomainRoutingHookRoutesKey       = []byte{SubModuleId, 8}
+	ProtocolFeeHooksKey              = []byte{SubModuleId, 9}
+	MerkleTreeNodesKey               = []byte{SubModuleId, 10}
+	MerkleTreeCheckpointsKey         = []byte{SubModuleId, 11}
+	MerkleTreeBranchesKey            = []byte{SubModuleId, 12}
+	MerkleTreeCountsKey              = []byte{SubModuleId, 13}
 )
 
 const (
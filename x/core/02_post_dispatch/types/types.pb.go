@@ -199,7 +199,9 @@ type MerkleTreeHook struct {
 	MailboxId string                                                      `protobuf:"bytes,2,opt,name=mailbox_id,json=mailboxId,proto3" json:"mailbox_id,omitempty"`
 	// owner ...
 	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	// tree ...
+	// tree is only used in the genesis state and by hooks created before
+	// consensus version 3. Otherwise the branch and count of a hook are stored
+	// as separate entries.
 	Tree *Tree `protobuf:"bytes,4,opt,name=tree,proto3" json:"tree,omitempty"`
 }
 
@@ -313,6 +315,64 @@ func (m *Tree) GetCount() uint32 {
 	return 0
 }
 
+// MerkleTreeCheckpoint is the state of a merkle tree after a message was
+// inserted.
+type MerkleTreeCheckpoint struct {
+	// root of the tree after the insertion.
+	Root []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// index of the inserted leaf.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// message_id of the inserted leaf.
+	MessageId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"message_id"`
+}
+
+func (m *MerkleTreeCheckpoint) Reset()         { *m = MerkleTreeCheckpoint{} }
+func (m *MerkleTreeCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*MerkleTreeCheckpoint) ProtoMessage()    {}
+func (*MerkleTreeCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f5bab7d9705187, []int{5}
+}
+func (m *MerkleTreeCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleTreeCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleTreeCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleTreeCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleTreeCheckpoint.Merge(m, src)
+}
+func (m *MerkleTreeCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleTreeCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleTreeCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleTreeCheckpoint proto.InternalMessageInfo
+
+func (m *MerkleTreeCheckpoint) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *MerkleTreeCheckpoint) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 // NoopHook ...
 type NoopHook struct {
 	// id ...
@@ -325,7 +385,7 @@ func (m *NoopHook) Reset()         { *m = NoopHook{} }
 func (m *NoopHook) String() string { return proto.CompactTextString(m) }
 func (*NoopHook) ProtoMessage()    {}
 func (*NoopHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8f5bab7d9705187, []int{5}
+	return fileDescriptor_d8f5bab7d9705187, []int{6}
 }
 func (m *NoopHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,13 +421,257 @@ func (m *NoopHook) GetOwner() string {
 	return ""
 }
 
+// AggregationHook calls each of its hooks in order. The charged coins of all
+// hooks are summed.
+type AggregationHook struct {
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// hook_ids are the ordered ids of the aggregated hooks.
+	HookIds []github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,3,rep,name=hook_ids,json=hookIds,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_ids"`
+}
+
+func (m *AggregationHook) Reset()         { *m = AggregationHook{} }
+func (m *AggregationHook) String() string { return proto.CompactTextString(m) }
+func (*AggregationHook) ProtoMessage()    {}
+func (*AggregationHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f5bab7d9705187, []int{7}
+}
+func (m *AggregationHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregationHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregationHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregationHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationHook.Merge(m, src)
+}
+func (m *AggregationHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregationHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregationHook proto.InternalMessageInfo
+
+func (m *AggregationHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// DomainRoutingHook calls the hook which is configured for the destination
+// domain of a message. Its routes are stored separately. If a fallback hook is
+// set, the hook is a fallback routing hook and calls the fallback hook for
+// domains without a route.
+type DomainRoutingHook struct {
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// fallback_hook_id is only set for fallback routing hooks.
+	FallbackHookId *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,3,opt,name=fallback_hook_id,json=fallbackHookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"fallback_hook_id,omitempty"`
+}
+
+func (m *DomainRoutingHook) Reset()         { *m = DomainRoutingHook{} }
+func (m *DomainRoutingHook) String() string { return proto.CompactTextString(m) }
+func (*DomainRoutingHook) ProtoMessage()    {}
+func (*DomainRoutingHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f5bab7d9705187, []int{8}
+}
+func (m *DomainRoutingHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainRoutingHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainRoutingHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainRoutingHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainRoutingHook.Merge(m, src)
+}
+func (m *DomainRoutingHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainRoutingHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainRoutingHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainRoutingHook proto.InternalMessageInfo
+
+func (m *DomainRoutingHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// HookRoute ...
+type HookRoute struct {
+	// destination ...
+	Destination uint32 `protobuf:"varint,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// hook_id ...
+	HookId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id"`
+}
+
+func (m *HookRoute) Reset()         { *m = HookRoute{} }
+func (m *HookRoute) String() string { return proto.CompactTextString(m) }
+func (*HookRoute) ProtoMessage()    {}
+func (*HookRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f5bab7d9705187, []int{9}
+}
+func (m *HookRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookRoute.Merge(m, src)
+}
+func (m *HookRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookRoute proto.InternalMessageInfo
+
+func (m *HookRoute) GetDestination() uint32 {
+	if m != nil {
+		return m.Destination
+	}
+	return 0
+}
+
+// ProtocolFeeHook charges a fixed protocol fee from the message sender on
+// each dispatch. The fees are collected until they are claimed for the
+// beneficiary.
+type ProtocolFeeHook struct {
+	// id ...
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	// owner ...
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// beneficiary receives the claimed fees.
+	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// protocol_fee is charged on each dispatch.
+	ProtocolFee types.Coin `protobuf:"bytes,4,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee"`
+	// max_protocol_fee is the upper bound of the protocol fee. It is set on
+	// creation and cannot be changed.
+	MaxProtocolFee types.Coin `protobuf:"bytes,5,opt,name=max_protocol_fee,json=maxProtocolFee,proto3" json:"max_protocol_fee"`
+	// claimable_fees ...
+	ClaimableFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=claimable_fees,json=claimableFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable_fees"`
+}
+
+func (m *ProtocolFeeHook) Reset()         { *m = ProtocolFeeHook{} }
+func (m *ProtocolFeeHook) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFeeHook) ProtoMessage()    {}
+func (*ProtocolFeeHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f5bab7d9705187, []int{10}
+}
+func (m *ProtocolFeeHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFeeHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFeeHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFeeHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFeeHook.Merge(m, src)
+}
+func (m *ProtocolFeeHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFeeHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFeeHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFeeHook proto.InternalMessageInfo
+
+func (m *ProtocolFeeHook) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ProtocolFeeHook) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *ProtocolFeeHook) GetProtocolFee() types.Coin {
+	if m != nil {
+		return m.ProtocolFee
+	}
+	return types.Coin{}
+}
+
+func (m *ProtocolFeeHook) GetMaxProtocolFee() types.Coin {
+	if m != nil {
+		return m.MaxProtocolFee
+	}
+	return types.Coin{}
+}
+
+func (m *ProtocolFeeHook) GetClaimableFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimableFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InterchainGasPaymaster)(nil), "hyperlane.core.post_dispatch.v1.InterchainGasPaymaster")
 	proto.RegisterType((*DestinationGasConfig)(nil), "hyperlane.core.post_dispatch.v1.DestinationGasConfig")
 	proto.RegisterType((*GasOracle)(nil), "hyperlane.core.post_dispatch.v1.GasOracle")
 	proto.RegisterType((*MerkleTreeHook)(nil), "hyperlane.core.post_dispatch.v1.MerkleTreeHook")
 	proto.RegisterType((*Tree)(nil), "hyperlane.core.post_dispatch.v1.Tree")
+	proto.RegisterType((*MerkleTreeCheckpoint)(nil), "hyperlane.core.post_dispatch.v1.MerkleTreeCheckpoint")
 	proto.RegisterType((*NoopHook)(nil), "hyperlane.core.post_dispatch.v1.NoopHook")
+	proto.RegisterType((*AggregationHook)(nil), "hyperlane.core.post_dispatch.v1.AggregationHook")
+	proto.RegisterType((*DomainRoutingHook)(nil), "hyperlane.core.post_dispatch.v1.DomainRoutingHook")
+	proto.RegisterType((*HookRoute)(nil), "hyperlane.core.post_dispatch.v1.HookRoute")
+	proto.RegisterType((*ProtocolFeeHook)(nil), "hyperlane.core.post_dispatch.v1.ProtocolFeeHook")
 }
 
 func init() {
@@ -375,50 +679,65 @@ func init() {
 }
 
 var fileDescriptor_d8f5bab7d9705187 = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xb1, 0x6e, 0x13, 0x4d,
-	0x10, 0xf6, 0xd9, 0x4e, 0x14, 0x6f, 0xe2, 0x48, 0xb9, 0xdf, 0x7f, 0xe4, 0x44, 0x8a, 0x1d, 0xf9,
-	0xd7, 0x2f, 0x59, 0x41, 0xbe, 0xc3, 0x01, 0x0a, 0xa0, 0x01, 0x27, 0x90, 0xb8, 0x08, 0x44, 0x17,
-	0x2a, 0x9a, 0xd3, 0xde, 0xdd, 0xe4, 0x6e, 0x65, 0xdf, 0xee, 0x69, 0x77, 0xe3, 0x38, 0x4f, 0x01,
-	0x15, 0xcf, 0x80, 0x10, 0x05, 0x05, 0x0f, 0x91, 0x32, 0xa2, 0x42, 0x14, 0x01, 0x25, 0x05, 0x25,
-	0xaf, 0x80, 0x6e, 0x77, 0xb1, 0x92, 0xca, 0xa4, 0x48, 0x73, 0xb7, 0x33, 0xfb, 0x7d, 0xb3, 0xf3,
-	0xcd, 0xcc, 0x2e, 0xba, 0x93, 0x9c, 0x64, 0xc0, 0x87, 0x98, 0x82, 0x1b, 0x32, 0x0e, 0x6e, 0xc6,
-	0x84, 0xf4, 0x23, 0x22, 0x32, 0x2c, 0xc3, 0xc4, 0x1d, 0x75, 0x5d, 0x79, 0x92, 0x81, 0x70, 0x32,
-	0xce, 0x24, 0xb3, 0x9b, 0x13, 0xb0, 0x93, 0x83, 0x9d, 0x6b, 0x60, 0x67, 0xd4, 0x5d, 0x5d, 0xc2,
-	0x29, 0xa1, 0xcc, 0x55, 0x5f, 0xcd, 0x59, 0x5d, 0x09, 0x99, 0x48, 0x99, 0xf0, 0x95, 0xe5, 0x6a,
-	0xc3, 0x6c, 0xd5, 0x62, 0x16, 0x33, 0xed, 0xcf, 0x57, 0xc6, 0xdb, 0xd0, 0x18, 0x37, 0xc0, 0x02,
-	0xdc, 0x51, 0x37, 0x00, 0x89, 0xbb, 0x6e, 0xc8, 0x08, 0xd5, 0xfb, 0xad, 0x8f, 0x45, 0xb4, 0xdc,
-	0xa7, 0x12, 0x78, 0x98, 0x60, 0x42, 0x77, 0xb0, 0xd8, 0xc7, 0x27, 0x29, 0x16, 0x12, 0xb8, 0x7d,
-	0x80, 0x8a, 0x24, 0xaa, 0x5b, 0xeb, 0x56, 0xbb, 0xd2, 0xdb, 0x3a, 0x3d, 0x6f, 0x16, 0xbe, 0x9d,
-	0x37, 0x1f, 0xc7, 0x44, 0x26, 0x47, 0x81, 0x13, 0xb2, 0xd4, 0x0d, 0xc2, 0xac, 0x43, 0x28, 0x65,
-	0x23, 0x2c, 0x09, 0xa3, 0xc2, 0x9d, 0xc8, 0xe9, 0x98, 0x33, 0x8f, 0x24, 0x19, 0x3a, 0xbb, 0x30,
-	0x7e, 0x1a, 0x45, 0x1c, 0x84, 0xf0, 0x8a, 0x24, 0xb2, 0x1d, 0x34, 0xc3, 0x8e, 0x29, 0xf0, 0x7a,
-	0x51, 0xc5, 0xad, 0x7f, 0xf9, 0xdc, 0xa9, 0x19, 0x19, 0x06, 0x76, 0x20, 0x39, 0xa1, 0xb1, 0xa7,
-	0x61, 0x76, 0x0d, 0xcd, 0x44, 0x40, 0x59, 0x5a, 0x2f, 0xe5, 0x78, 0x4f, 0x1b, 0xf6, 0x31, 0x5a,
-	0x0c, 0x87, 0x98, 0xa4, 0x38, 0x18, 0x82, 0x7f, 0x08, 0x20, 0xea, 0xe5, 0xf5, 0x52, 0x7b, 0x7e,
-	0x73, 0xc5, 0x31, 0xb1, 0x72, 0xb9, 0x8e, 0x91, 0xeb, 0x6c, 0x31, 0x42, 0x7b, 0x0f, 0x72, 0x05,
-	0x1f, 0xbe, 0x37, 0xdb, 0x57, 0x14, 0x98, 0x3c, 0xf5, 0xaf, 0x23, 0xa2, 0x81, 0xe9, 0x4f, 0x4e,
-	0x10, 0xef, 0x7f, 0x7e, 0xda, 0xb0, 0xbc, 0xea, 0xe4, 0x9c, 0xe7, 0x00, 0xa2, 0x75, 0x6a, 0xa1,
-	0xda, 0x36, 0x08, 0x49, 0xa8, 0x12, 0xbd, 0x83, 0xc5, 0x16, 0xa3, 0x87, 0x24, 0xb6, 0xff, 0x43,
-	0x55, 0x0e, 0x29, 0x93, 0xe0, 0x47, 0x2c, 0xc5, 0x84, 0xaa, 0xba, 0x55, 0xbd, 0x05, 0xed, 0xdc,
-	0x56, 0x3e, 0xbb, 0x8f, 0x50, 0x8c, 0x85, 0xcf, 0x38, 0x0e, 0x87, 0xa0, 0x2a, 0x30, 0xbf, 0xb9,
-	0xe1, 0x4c, 0x19, 0x03, 0x67, 0x07, 0x8b, 0x97, 0x8a, 0xe1, 0x55, 0xe2, 0x3f, 0x4b, 0xfb, 0x09,
-	0x5a, 0x50, 0xa1, 0x46, 0xc0, 0x13, 0xc0, 0x91, 0x2e, 0x4f, 0x6f, 0xcd, 0xb4, 0xe9, 0x5f, 0x2d,
-	0x49, 0x44, 0x03, 0x87, 0x30, 0x37, 0xc5, 0x32, 0x71, 0xfa, 0x54, 0x7a, 0xf3, 0x39, 0xdf, 0x30,
-	0x5a, 0xef, 0x2c, 0x54, 0x99, 0x84, 0xb6, 0xf7, 0xd0, 0x3f, 0x92, 0x0d, 0x80, 0xfa, 0x30, 0x0e,
-	0x13, 0x4c, 0x63, 0xf0, 0x39, 0x96, 0x60, 0xba, 0x3f, 0x25, 0xec, 0x92, 0x62, 0x3e, 0x33, 0x44,
-	0x0f, 0x4b, 0xb0, 0x1f, 0xa1, 0x3c, 0x57, 0x3f, 0xe3, 0x24, 0x04, 0xd3, 0xea, 0x29, 0x41, 0xe6,
-	0x62, 0x2c, 0xf6, 0x73, 0x78, 0xeb, 0x97, 0x85, 0x16, 0xf7, 0x80, 0x0f, 0x86, 0xf0, 0x8a, 0x03,
-	0xec, 0x32, 0x36, 0xb8, 0x9d, 0x51, 0x5c, 0x43, 0x28, 0xc5, 0x64, 0x18, 0xb0, 0xb1, 0x4f, 0x22,
-	0x9d, 0xa4, 0x57, 0x31, 0x9e, 0xfe, 0x95, 0x49, 0x2d, 0xfd, 0xdd, 0xa4, 0x3e, 0x44, 0x65, 0xc9,
-	0x01, 0xea, 0x65, 0xd5, 0xd6, 0xff, 0xa7, 0xb6, 0x35, 0x17, 0xe7, 0x29, 0x4a, 0xeb, 0x3e, 0x2a,
-	0xe7, 0x96, 0xbd, 0x8c, 0x66, 0x03, 0x8e, 0x69, 0x98, 0xd4, 0xad, 0xf5, 0x52, 0x7b, 0xc1, 0x33,
-	0x56, 0x7e, 0x09, 0x42, 0x76, 0x44, 0xa5, 0x4a, 0xb2, 0xea, 0x69, 0xa3, 0xf5, 0xc6, 0x42, 0x73,
-	0x2f, 0x18, 0xcb, 0x6e, 0xaf, 0x42, 0x37, 0xbc, 0xac, 0xbd, 0xf0, 0xf4, 0xa2, 0x61, 0x9d, 0x5d,
-	0x34, 0xac, 0x1f, 0x17, 0x0d, 0xeb, 0xed, 0x65, 0xa3, 0x70, 0x76, 0xd9, 0x28, 0x7c, 0xbd, 0x6c,
-	0x14, 0x5e, 0xf7, 0x6f, 0x92, 0xca, 0x58, 0x3f, 0x9e, 0x77, 0x37, 0xfd, 0xeb, 0xef, 0xa7, 0xba,
-	0x9c, 0xc1, 0xac, 0x7a, 0xb8, 0xee, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x31, 0x5a, 0xe3,
-	0x6c, 0x05, 0x00, 0x00,
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x4e, 0x1a, 0x8f, 0x9d, 0xb4, 0x19, 0x4c, 0xb5, 0xad, 0x54, 0xc7, 0x5a, 0x84,
+	0x14, 0x15, 0x65, 0x97, 0x04, 0x38, 0x50, 0x2e, 0x34, 0x29, 0x4d, 0x7c, 0x68, 0x89, 0xb6, 0x9c,
+	0x10, 0x62, 0x35, 0xbb, 0xfb, 0xbc, 0x3b, 0xf2, 0xee, 0xcc, 0x6a, 0x66, 0xe2, 0x3a, 0xbf, 0x80,
+	0x03, 0x07, 0x38, 0xf1, 0x0b, 0x38, 0x20, 0xc4, 0x81, 0x03, 0x3f, 0x22, 0xc7, 0x8a, 0x13, 0xe2,
+	0x50, 0x50, 0x82, 0xc4, 0x91, 0x1b, 0x67, 0x34, 0xb3, 0x6b, 0xc7, 0x91, 0x10, 0x49, 0x44, 0x52,
+	0xe5, 0x92, 0xcc, 0x7b, 0xfb, 0xde, 0x37, 0xef, 0xfb, 0xde, 0xf8, 0xcd, 0xa0, 0xb7, 0xd2, 0x83,
+	0x02, 0x44, 0x46, 0x18, 0x78, 0x11, 0x17, 0xe0, 0x15, 0x5c, 0xaa, 0x20, 0xa6, 0xb2, 0x20, 0x2a,
+	0x4a, 0xbd, 0xd1, 0x86, 0xa7, 0x0e, 0x0a, 0x90, 0x6e, 0x21, 0xb8, 0xe2, 0x78, 0x75, 0x1a, 0xec,
+	0xea, 0x60, 0xf7, 0x54, 0xb0, 0x3b, 0xda, 0xb8, 0xbb, 0x42, 0x72, 0xca, 0xb8, 0x67, 0xfe, 0x96,
+	0x39, 0x77, 0xef, 0x44, 0x5c, 0xe6, 0x5c, 0x06, 0xc6, 0xf2, 0x4a, 0xa3, 0xfa, 0xd4, 0x49, 0x78,
+	0xc2, 0x4b, 0xbf, 0x5e, 0x55, 0xde, 0x6e, 0x19, 0xe3, 0x85, 0x44, 0x82, 0x37, 0xda, 0x08, 0x41,
+	0x91, 0x0d, 0x2f, 0xe2, 0x94, 0x95, 0xdf, 0x9d, 0x1f, 0x6a, 0xe8, 0x76, 0x9f, 0x29, 0x10, 0x51,
+	0x4a, 0x28, 0xdb, 0x21, 0x72, 0x8f, 0x1c, 0xe4, 0x44, 0x2a, 0x10, 0xf8, 0x19, 0xaa, 0xd1, 0xd8,
+	0xb6, 0x7a, 0xd6, 0x5a, 0x73, 0x6b, 0xfb, 0xf0, 0xe5, 0xea, 0xdc, 0xaf, 0x2f, 0x57, 0x3f, 0x48,
+	0xa8, 0x4a, 0xf7, 0x43, 0x37, 0xe2, 0xb9, 0x17, 0x46, 0xc5, 0x3a, 0x65, 0x8c, 0x8f, 0x88, 0xa2,
+	0x9c, 0x49, 0x6f, 0x4a, 0x67, 0xbd, 0xda, 0x73, 0x5f, 0xd1, 0xcc, 0xdd, 0x85, 0xf1, 0xc3, 0x38,
+	0x16, 0x20, 0xa5, 0x5f, 0xa3, 0x31, 0x76, 0xd1, 0x3c, 0x7f, 0xce, 0x40, 0xd8, 0x35, 0x83, 0x6b,
+	0xff, 0xfc, 0xd3, 0x7a, 0xa7, 0xa2, 0x51, 0x85, 0x3d, 0x53, 0x82, 0xb2, 0xc4, 0x2f, 0xc3, 0x70,
+	0x07, 0xcd, 0xc7, 0xc0, 0x78, 0x6e, 0xd7, 0x75, 0xbc, 0x5f, 0x1a, 0xf8, 0x39, 0x5a, 0x8e, 0x32,
+	0x42, 0x73, 0x12, 0x66, 0x10, 0x0c, 0x00, 0xa4, 0xdd, 0xe8, 0xd5, 0xd7, 0x5a, 0x9b, 0x77, 0xdc,
+	0x0a, 0x4b, 0xd3, 0x75, 0x2b, 0xba, 0xee, 0x36, 0xa7, 0x6c, 0xeb, 0x3d, 0xcd, 0xe0, 0xfb, 0xdf,
+	0x56, 0xd7, 0x66, 0x18, 0x54, 0x75, 0x96, 0xff, 0xd6, 0x65, 0x3c, 0xac, 0xfa, 0xa3, 0x13, 0xe4,
+	0x77, 0x7f, 0xfe, 0x78, 0xdf, 0xf2, 0x97, 0xa6, 0xfb, 0x3c, 0x06, 0x90, 0xce, 0xa1, 0x85, 0x3a,
+	0x8f, 0x40, 0x2a, 0xca, 0x0c, 0xe9, 0x1d, 0x22, 0xb7, 0x39, 0x1b, 0xd0, 0x04, 0xbf, 0x81, 0x96,
+	0x04, 0xe4, 0x5c, 0x41, 0x10, 0xf3, 0x9c, 0x50, 0x66, 0x74, 0x5b, 0xf2, 0xdb, 0xa5, 0xf3, 0x91,
+	0xf1, 0xe1, 0x3e, 0x42, 0x09, 0x91, 0x01, 0x17, 0x24, 0xca, 0xc0, 0x28, 0xd0, 0xda, 0xbc, 0xef,
+	0x9e, 0x71, 0x0c, 0xdc, 0x1d, 0x22, 0x3f, 0x36, 0x19, 0x7e, 0x33, 0x99, 0x2c, 0xf1, 0x87, 0xa8,
+	0x6d, 0xa0, 0x46, 0x20, 0x52, 0x20, 0x71, 0x29, 0xcf, 0xd6, 0xbd, 0xaa, 0x4d, 0xaf, 0x97, 0x94,
+	0x64, 0x3c, 0x74, 0x29, 0xf7, 0x72, 0xa2, 0x52, 0xb7, 0xcf, 0x94, 0xdf, 0xd2, 0xf9, 0x55, 0x86,
+	0xf3, 0x8d, 0x85, 0x9a, 0x53, 0x68, 0xfc, 0x04, 0xbd, 0xa6, 0xf8, 0x10, 0x58, 0x00, 0xe3, 0x28,
+	0x25, 0x2c, 0x81, 0x40, 0x10, 0x05, 0xb6, 0x75, 0x1e, 0xd8, 0x15, 0x93, 0xf9, 0x51, 0x95, 0xe8,
+	0x13, 0x05, 0xf8, 0x01, 0xd2, 0xb5, 0x06, 0x85, 0xa0, 0x11, 0xd8, 0xb5, 0xf3, 0x80, 0x2c, 0x26,
+	0x44, 0xee, 0xe9, 0x70, 0xe7, 0x2f, 0x0b, 0x2d, 0x3f, 0x01, 0x31, 0xcc, 0xe0, 0x13, 0x01, 0xb0,
+	0xcb, 0xf9, 0xf0, 0x6a, 0x8e, 0xe2, 0x3d, 0x84, 0x72, 0x42, 0xb3, 0x90, 0x8f, 0x03, 0x1a, 0x97,
+	0x45, 0xfa, 0xcd, 0xca, 0xd3, 0x9f, 0x39, 0xa9, 0xf5, 0xf3, 0x9d, 0xd4, 0xf7, 0x51, 0x43, 0x09,
+	0x00, 0xbb, 0x61, 0xda, 0xfa, 0xe6, 0x99, 0x6d, 0xd5, 0xe4, 0x7c, 0x93, 0xe2, 0xbc, 0x8b, 0x1a,
+	0xda, 0xc2, 0xb7, 0xd1, 0x42, 0x28, 0x08, 0x8b, 0x52, 0xdb, 0xea, 0xd5, 0xd7, 0xda, 0x7e, 0x65,
+	0xe9, 0x1f, 0x41, 0xc4, 0xf7, 0x99, 0x32, 0x45, 0x2e, 0xf9, 0xa5, 0xe1, 0x7c, 0x6b, 0xa1, 0xce,
+	0x89, 0x4e, 0xdb, 0x29, 0x44, 0xc3, 0x82, 0x53, 0xa6, 0x30, 0x46, 0x0d, 0xc1, 0xb9, 0x32, 0x7a,
+	0xb5, 0x7d, 0xb3, 0xd6, 0x10, 0x94, 0xc5, 0x30, 0x9e, 0x40, 0x18, 0x03, 0x87, 0x08, 0xe5, 0x20,
+	0x25, 0x49, 0x40, 0x4b, 0x50, 0xbf, 0x3c, 0x7d, 0x9b, 0x15, 0x6c, 0x3f, 0x76, 0xbe, 0xb2, 0xd0,
+	0xe2, 0x53, 0xce, 0x8b, 0xab, 0x6b, 0xe4, 0x05, 0x67, 0x8a, 0xf3, 0xb7, 0x85, 0x6e, 0x3e, 0x4c,
+	0x12, 0x01, 0x89, 0xd9, 0xe5, 0xda, 0x14, 0x86, 0x3f, 0x47, 0x8b, 0x29, 0xe7, 0xc3, 0x80, 0xc6,
+	0xd2, 0xae, 0xf7, 0xea, 0x97, 0x55, 0xca, 0x0d, 0x0d, 0xda, 0x8f, 0xa5, 0xf3, 0x45, 0x0d, 0xad,
+	0x94, 0xa3, 0xc8, 0xe7, 0xfb, 0x8a, 0xb2, 0xe4, 0xfa, 0x50, 0xcf, 0xd1, 0xad, 0x01, 0xc9, 0xb2,
+	0x90, 0x44, 0xc3, 0xa0, 0xd2, 0x60, 0xe6, 0x3c, 0x5a, 0xff, 0xb7, 0xa4, 0xe5, 0x09, 0xf8, 0xae,
+	0x91, 0xc2, 0xf9, 0xd2, 0x42, 0x4d, 0xbd, 0xd4, 0x3a, 0x00, 0xee, 0xa1, 0x56, 0x7c, 0x32, 0xd4,
+	0xab, 0xd1, 0x3d, 0xeb, 0xc2, 0x9f, 0xa1, 0x1b, 0x93, 0xaa, 0x6a, 0x97, 0x27, 0xd4, 0x42, 0xd9,
+	0x18, 0xe7, 0x8f, 0x3a, 0xba, 0xb9, 0xa7, 0xaf, 0xe3, 0x88, 0x67, 0x8f, 0x01, 0xae, 0x4f, 0x57,
+	0x1e, 0xa0, 0x56, 0x08, 0x0c, 0x06, 0x34, 0xa2, 0x44, 0x1c, 0x9c, 0x39, 0x09, 0x67, 0x83, 0xf1,
+	0x0e, 0x6a, 0x17, 0x15, 0xa7, 0x60, 0x30, 0x9d, 0x8b, 0xff, 0x71, 0x43, 0x37, 0x35, 0xcb, 0xf2,
+	0xd6, 0x6d, 0x15, 0x27, 0x6a, 0xe0, 0xa7, 0xe8, 0x56, 0x4e, 0xc6, 0xc1, 0x29, 0xb0, 0xf9, 0x0b,
+	0x80, 0x2d, 0xe7, 0x64, 0x3c, 0xa3, 0xee, 0xbf, 0x3c, 0x1e, 0x16, 0x5e, 0xc9, 0xe3, 0x61, 0x2b,
+	0x3a, 0x3c, 0xea, 0x5a, 0x2f, 0x8e, 0xba, 0xd6, 0xef, 0x47, 0x5d, 0xeb, 0xeb, 0xe3, 0xee, 0xdc,
+	0x8b, 0xe3, 0xee, 0xdc, 0x2f, 0xc7, 0xdd, 0xb9, 0x4f, 0xfb, 0x17, 0x69, 0xec, 0xb8, 0x7c, 0x5b,
+	0xbe, 0xbd, 0x19, 0x9c, 0x7e, 0x5e, 0x9a, 0xed, 0xc3, 0x05, 0xa3, 0xd3, 0x3b, 0xff, 0x0c, 0x00,
+	0xea, 0x1e, 0xb1, 0xfb, 0x8b, 0x0a, 0x00, 0x00,
 }
 
 func (m *InterchainGasPaymaster) Marshal() (dAtA []byte, err error) {
@@ -671,6 +990,51 @@ func (m *Tree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MerkleTreeCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleTreeCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleTreeCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NoopHook) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -711,52 +1075,277 @@ func (m *NoopHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AggregationHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InterchainGasPaymaster) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.ClaimableFees) > 0 {
-		for _, e := range m.ClaimableFees {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	return n
+
+func (m *AggregationHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DestinationGasConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AggregationHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RemoteDomain != 0 {
-		n += 1 + sovTypes(uint64(m.RemoteDomain))
-	}
-	if m.GasOracle != nil {
+	if len(m.HookIds) > 0 {
+		for iNdEx := len(m.HookIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.HookIds[iNdEx].Size()
+				i -= size
+				if _, err := m.HookIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainRoutingHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainRoutingHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainRoutingHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FallbackHookId != nil {
+		{
+			size := m.FallbackHookId.Size()
+			i -= size
+			if _, err := m.FallbackHookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HookRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.HookId.Size()
+		i -= size
+		if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Destination != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolFeeHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFeeHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFeeHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimableFees) > 0 {
+		for iNdEx := len(m.ClaimableFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.MaxProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainGasPaymaster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.ClaimableFees) > 0 {
+		for _, e := range m.ClaimableFees {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DestinationGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoteDomain != 0 {
+		n += 1 + sovTypes(uint64(m.RemoteDomain))
+	}
+	if m.GasOracle != nil {
 		l = m.GasOracle.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
@@ -819,6 +1408,24 @@ func (m *Tree) Size() (n int) {
 	return n
 }
 
+func (m *MerkleTreeCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = m.MessageId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *NoopHook) Size() (n int) {
 	if m == nil {
 		return 0
@@ -834,13 +1441,96 @@ func (m *NoopHook) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *AggregationHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.HookIds) > 0 {
+		for _, e := range m.HookIds {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
 }
-func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
+
+func (m *DomainRoutingHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FallbackHookId != nil {
+		l = m.FallbackHookId.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *HookRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovTypes(uint64(m.Destination))
+	}
+	l = m.HookId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ProtocolFeeHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.ProtocolFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxProtocolFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ClaimableFees) > 0 {
+		for _, e := range m.ClaimableFees {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -866,7 +1556,446 @@ func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: InterchainGasPaymaster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterchainGasPaymaster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterchainGasPaymaster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableFees = append(m.ClaimableFees, types.Coin{})
+			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestinationGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestinationGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			}
+			m.RemoteDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasOracle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasOracle == nil {
+				m.GasOracle = &GasOracle{}
+			}
+			if err := m.GasOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasOverhead", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasOverhead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleTreeHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleTreeHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -905,7 +2034,7 @@ func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -933,11 +2062,11 @@ func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.MailboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -965,11 +2094,11 @@ func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -996,8 +2125,10 @@ func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableFees = append(m.ClaimableFees, types.Coin{})
-			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tree == nil {
+				m.Tree = &Tree{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1022,7 +2153,7 @@ func (m *InterchainGasPaymaster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
+func (m *Tree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1045,17 +2176,17 @@ func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestinationGasConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestinationGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			m.RemoteDomain = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1065,16 +2196,98 @@ func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = append(m.Branch, make([]byte, postIndex-iNdEx))
+			copy(m.Branch[len(m.Branch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleTreeCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleTreeCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleTreeCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasOracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1084,31 +2297,132 @@ func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasOracle == nil {
-				m.GasOracle = &GasOracle{}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
-			if err := m.GasOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoopHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoopHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoopHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasOverhead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1136,10 +2450,42 @@ func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasOverhead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1161,7 +2507,7 @@ func (m *DestinationGasConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasOracle) Unmarshal(dAtA []byte) error {
+func (m *AggregationHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1184,15 +2530,15 @@ func (m *GasOracle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasOracle: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregationHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregationHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1220,13 +2566,13 @@ func (m *GasOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1254,7 +2600,41 @@ func (m *GasOracle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.HookIds = append(m.HookIds, v)
+			if err := m.HookIds[len(m.HookIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1279,7 +2659,7 @@ func (m *GasOracle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
+func (m *DomainRoutingHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1302,10 +2682,10 @@ func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MerkleTreeHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainRoutingHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MerkleTreeHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainRoutingHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1344,7 +2724,7 @@ func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1372,11 +2752,11 @@ func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailboxId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackHookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1404,41 +2784,9 @@ func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tree == nil {
-				m.Tree = &Tree{}
-			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.FallbackHookId = &v
+			if err := m.FallbackHookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1463,7 +2811,7 @@ func (m *MerkleTreeHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tree) Unmarshal(dAtA []byte) error {
+func (m *HookRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,17 +2834,17 @@ func (m *Tree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
+			return fmt.Errorf("proto: HookRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HookRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var byteLen int
+			m.Destination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1506,29 +2854,16 @@ func (m *Tree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Destination |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = append(m.Branch, make([]byte, postIndex-iNdEx))
-			copy(m.Branch[len(m.Branch)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1538,11 +2873,26 @@ func (m *Tree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1564,7 +2914,7 @@ func (m *Tree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoopHook) Unmarshal(dAtA []byte) error {
+func (m *ProtocolFeeHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1587,10 +2937,10 @@ func (m *NoopHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoopHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtocolFeeHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoopHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtocolFeeHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1659,6 +3009,138 @@ func (m *NoopHook) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProtocolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableFees = append(m.ClaimableFees, types.Coin{})
+			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
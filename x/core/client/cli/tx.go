@@ -19,6 +19,12 @@ var (
 	newOwner          string
 	renounceOwnership bool
 	requiredHook      string
+
+	// DispatchMessage
+	customHookId       string
+	customHookMetadata string
+	gasLimit           string
+	maxFee             string
 )
 
 func GetTxCmd() *cobra.Command {
@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -24,6 +27,7 @@ func NewMailboxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdCreateMailbox(),
 		CmdProcessMessage(),
+		CmdDispatchMessage(),
 		CmdSetMailbox(),
 	)
 
@@ -105,6 +109,96 @@ func CmdProcessMessage() *cobra.Command {
 	return cmd
 }
 
+func CmdDispatchMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispatch [mailbox-id] [destination-domain] [recipient] [body]",
+		Short: "Dispatch a Hyperlane message",
+		Long: `Dispatch an arbitrary hex encoded message body to a remote recipient.
+If no --max-hyperlane-fee is provided, the fee is quoted from the mailbox.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			mailboxId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse mailbox id: %v", err)
+			}
+
+			destinationDomain, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("failed to parse destination domain: %v", err)
+			}
+
+			recipient, err := util.DecodeHexAddress(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to parse recipient: %v", err)
+			}
+
+			body := args[3]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasLimitInt, ok := math.NewIntFromString(gasLimit)
+			if !ok {
+				return errors.New("failed to convert `gasLimit` into math.Int")
+			}
+
+			customHookIdPtr, err := parseNullableAddress(customHookId)
+			if err != nil {
+				return err
+			}
+
+			var maxFeeCoins sdk.Coins
+			if maxFee != "" {
+				maxFeeCoins, err = sdk.ParseCoinsNormalized(maxFee)
+				if err != nil {
+					return err
+				}
+			} else {
+				queryClient := types.NewQueryClient(clientCtx)
+				quote, err := queryClient.QuoteDispatch(cmd.Context(), &types.QueryQuoteDispatchRequest{
+					Id:                 mailboxId.String(),
+					DestinationDomain:  args[1],
+					Sender:             clientCtx.GetFromAddress().String(),
+					Recipient:          recipient.String(),
+					Body:               body,
+					CustomHookId:       customHookId,
+					CustomHookMetadata: customHookMetadata,
+					GasLimit:           gasLimit,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to quote dispatch: %v", err)
+				}
+				maxFeeCoins = quote.Total
+			}
+
+			msg := types.MsgDispatchMessage{
+				Sender:             clientCtx.GetFromAddress().String(),
+				MailboxId:          mailboxId,
+				DestinationDomain:  uint32(destinationDomain),
+				Recipient:          recipient,
+				Body:               body,
+				CustomHookId:       customHookIdPtr,
+				CustomHookMetadata: customHookMetadata,
+				GasLimit:           gasLimitInt,
+				MaxFee:             maxFeeCoins,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringVar(&customHookId, "custom-hook-id", "", "custom DefaultHookId")
+	cmd.Flags().StringVar(&customHookMetadata, "custom-hook-metadata", "", "custom hook metadata")
+	cmd.Flags().StringVar(&gasLimit, "gas-limit", "0", "InterchainGasPayment gas limit")
+	cmd.Flags().StringVar(&maxFee, "max-hyperlane-fee", "", "maximum Hyperlane fee; quoted from the mailbox if not set")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseNullableAddress(address string) (*util.HexAddress, error) {
 	if address != "" {
 		parsed, err := util.DecodeHexAddress(address)
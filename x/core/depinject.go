@@ -59,9 +59,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, authority.String(), in.BankKeeper)
-	m := NewAppModule(in.Cdc, &k)
+	m := NewAppModule(in.Cdc, k)
 
-	return ModuleOutputs{Module: m, Keeper: &k}
+	return ModuleOutputs{Module: m, Keeper: k}
 }
 
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
@@ -11,6 +11,10 @@ import (
 
 // InitGenesis initializes the module state from a genesis state.
 func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
 	if err := k.ismRouter.SetInternalSequence(ctx, data.IsmSequence); err != nil {
 		return err
 	}
@@ -34,7 +38,20 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 	}
 
 	for _, message := range data.Messages {
-		if err := k.Messages.Set(ctx, collections.Join(message.MailboxId, message.MessageId.Bytes())); err != nil {
+		if err := k.DeliveredMessages.Set(ctx, collections.Join(message.MailboxId, message.MessageId.Bytes()), message.Delivery); err != nil {
+			return err
+		}
+	}
+
+	for _, dispatched := range data.DispatchedMessages {
+		message, err := util.ParseHyperlaneMessage(dispatched.Message)
+		if err != nil {
+			return err
+		}
+		if err = k.DispatchedMessages.Set(ctx, collections.Join(dispatched.MailboxId, message.Nonce), dispatched.Message); err != nil {
+			return err
+		}
+		if err = k.DispatchedIds.Set(ctx, collections.Join(dispatched.MailboxId, message.Id().Bytes()), message.Nonce); err != nil {
 			return err
 		}
 	}
@@ -58,10 +75,11 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}
 
 	messages := make([]types.GenesisMailboxMessageWrapper, 0)
-	err = k.Messages.Walk(ctx, nil, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+	err = k.DeliveredMessages.Walk(ctx, nil, func(key collections.Pair[uint64, []byte], value types.DeliveredMessage) (stop bool, err error) {
 		messages = append(messages, types.GenesisMailboxMessageWrapper{
 			MailboxId: key.K1(),
 			MessageId: util.HexAddress(key.K2()),
+			Delivery:  value,
 		})
 		return false, nil
 	})
@@ -69,6 +87,23 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	dispatchedMessages := make([]types.GenesisDispatchedMessageWrapper, 0)
+	err = k.DispatchedMessages.Walk(ctx, nil, func(key collections.Pair[uint64, uint32], value []byte) (stop bool, err error) {
+		dispatchedMessages = append(dispatchedMessages, types.GenesisDispatchedMessageWrapper{
+			MailboxId: key.K1(),
+			Message:   value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	mailboxes := make([]types.Mailbox, 0)
 	err = k.Mailboxes.Walk(ctx, nil, func(key uint64, value types.Mailbox) (stop bool, err error) {
 		mailboxes = append(mailboxes, value)
@@ -79,8 +114,10 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}
 
 	return &types.GenesisState{
-		Mailboxes: mailboxes,
-		Messages:  messages,
+		Params:             params,
+		Mailboxes:          mailboxes,
+		Messages:           messages,
+		DispatchedMessages: dispatchedMessages,
 
 		IsmSequence:          ismSequence,
 		PostDispatchSequence: postDispatchSequence,
@@ -19,15 +19,22 @@ import (
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	addressCodec address.Codec
+	storeService storetypes.KVStoreService
 
 	// authority is the address capable of executing a MsgUpdateParams and other authority-gated messages.
 	// Typically this should be the x/gov module account.
 	authority string
 
+	// Params are the governance-configurable module parameters
+	Params collections.Item[types.Params]
 	// Mailboxes is a map of mailbox IDs to mailboxes
 	Mailboxes collections.Map[uint64, types.Mailbox]
-	// Messages is a set of tuples. The first key is the mailbox ID, second key is the message ID.
-	Messages collections.KeySet[collections.Pair[uint64, []byte]]
+	// DeliveredMessages is a map from (mailbox ID, message ID) to the delivery record of a processed message.
+	DeliveredMessages collections.Map[collections.Pair[uint64, []byte], types.DeliveredMessage]
+	// DispatchedMessages is a map from (mailbox ID, nonce) to the raw dispatched message.
+	DispatchedMessages collections.Map[collections.Pair[uint64, uint32], []byte]
+	// DispatchedIds is a map from (mailbox ID, message ID) to the nonce of the dispatched message.
+	DispatchedIds collections.Map[collections.Pair[uint64, []byte], uint32]
 	// MailboxesSequence is a monotonically increasing number of mailboxes. The
 	// internal ID for a mailbox is the sequence number when it was created.
 	MailboxesSequence collections.Sequence
@@ -44,8 +51,9 @@ type Keeper struct {
 	appRouter          *util.Router[util.HyperlaneApp]
 }
 
-// NewKeeper creates a new Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, bankKeeper types.BankKeeper) Keeper {
+// NewKeeper creates a new Keeper instance. The keeper is returned by pointer, as the ISM and
+// post dispatch keepers keep a reference to it.
+func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, bankKeeper types.BankKeeper) *Keeper {
 	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid authority address: %w", err))
 	}
@@ -54,11 +62,15 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 	k := Keeper{
 		cdc:          cdc,
 		addressCodec: addressCodec,
+		storeService: storeService,
 		authority:    authority,
 
-		Mailboxes:         collections.NewMap(sb, types.MailboxesKey, "mailboxes", collections.Uint64Key, codec.CollValue[types.Mailbox](cdc)),
-		Messages:          collections.NewKeySet(sb, types.MessagesKey, "messages", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
-		MailboxesSequence: collections.NewSequence(sb, types.MailboxesSequenceKey, "mailboxes_sequence"),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Mailboxes:          collections.NewMap(sb, types.MailboxesKey, "mailboxes", collections.Uint64Key, codec.CollValue[types.Mailbox](cdc)),
+		DeliveredMessages:  collections.NewMap(sb, types.DeliveredMessagesKey, "delivered_messages", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[types.DeliveredMessage](cdc)),
+		DispatchedMessages: collections.NewMap(sb, types.DispatchedMessagesKey, "dispatched_messages", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.BytesValue),
+		DispatchedIds:      collections.NewMap(sb, types.DispatchedIdsKey, "dispatched_ids", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Uint32Value),
+		MailboxesSequence:  collections.NewSequence(sb, types.MailboxesSequenceKey, "mailboxes_sequence"),
 
 		bankKeeper: bankKeeper,
 
@@ -70,8 +82,8 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 		appRouter:          util.NewRouter[util.HyperlaneApp](types.AppRouterKey, "router_app", sb),
 	}
 
-	k.IsmKeeper.SetCoreKeeper(k)
-	k.PostDispatchKeeper.SetCoreKeeper(k)
+	k.IsmKeeper.SetCoreKeeper(&k)
+	k.PostDispatchKeeper.SetCoreKeeper(&k)
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -80,7 +92,7 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 
 	k.Schema = schema
 
-	return k
+	return &k
 }
 
 func (k Keeper) AppRouter() *util.Router[util.HyperlaneApp] {
@@ -111,7 +123,7 @@ func (k Keeper) IsmRouter() *util.Router[util.InterchainSecurityModule] {
 	return k.ismRouter
 }
 
-func (k Keeper) Verify(ctx context.Context, ismId util.HexAddress, metadata []byte, message util.HyperlaneMessage) (bool, error) {
+func (k *Keeper) Verify(ctx context.Context, ismId util.HexAddress, metadata []byte, message util.HyperlaneMessage) (bool, error) {
 	// Consume a fixed amount of gas to prevent DoS attacks and limit recursive calls.
 	// This ensures a maximum number of approximately 2100 recursive verify calls.
 	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(10000, "ism verification")
@@ -145,6 +157,9 @@ func (k Keeper) PostDispatchRouter() *util.Router[util.PostDispatchModule] {
 }
 
 func (k *Keeper) PostDispatch(ctx context.Context, mailboxId, hookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage, maxFee sdk.Coins) (sdk.Coins, error) {
+	// Consume a fixed amount of gas to limit nested calls of aggregation and routing hooks.
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(10000, "post dispatch hook")
+
 	handler, err := k.postDispatchRouter.GetModule(hookId)
 	if err != nil {
 		return sdk.NewCoins(), err
@@ -161,31 +176,47 @@ func (k *Keeper) PostDispatchHookExists(ctx context.Context, hookId util.HexAddr
 }
 
 func (k *Keeper) QuoteDispatch(ctx context.Context, mailboxId, overwriteHookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage) (sdk.Coins, error) {
+	requiredQuote, defaultQuote, err := k.QuoteDispatchBreakdown(ctx, mailboxId, overwriteHookId, metadata, message)
+	if err != nil {
+		return sdk.NewCoins(), err
+	}
+
+	return sdk.Coins.Add(requiredQuote.Amount, defaultQuote.Amount...), nil
+}
+
+// QuoteDispatchBreakdown quotes the required hook and the default hook of a mailbox separately.
+// If overwriteHookId is not the zero address, it is quoted instead of the default hook.
+func (k *Keeper) QuoteDispatchBreakdown(ctx context.Context, mailboxId, overwriteHookId util.HexAddress, metadata util.StandardHookMetadata, message util.HyperlaneMessage) (requiredQuote, defaultQuote types.HookQuote, err error) {
 	mailbox, err := k.Mailboxes.Get(ctx, mailboxId.GetInternalId())
 	if err != nil {
-		return sdk.NewCoins(), fmt.Errorf("failed to find mailbox with id %s", mailboxId.String())
+		return types.HookQuote{}, types.HookQuote{}, fmt.Errorf("failed to find mailbox with id %s", mailboxId.String())
 	}
 
 	// check for valid mailbox state
 	if mailbox.RequiredHook == nil {
-		return sdk.NewCoins(), types.ErrRequiredHookNotSet
+		return types.HookQuote{}, types.HookQuote{}, types.ErrRequiredHookNotSet
 	}
 	if mailbox.DefaultHook == nil {
-		return sdk.NewCoins(), types.ErrDefaultHookNotSet
+		return types.HookQuote{}, types.HookQuote{}, types.ErrDefaultHookNotSet
 	}
 
-	calculateGasPayment := func(hookId util.HexAddress) (sdk.Coins, error) {
+	calculateGasPayment := func(hookId util.HexAddress) (types.HookQuote, error) {
 		handler, err := k.postDispatchRouter.GetModule(hookId)
 		if err != nil {
-			return sdk.NewCoins(), err
+			return types.HookQuote{}, err
+		}
+
+		amount, err := (*handler).QuoteDispatch(ctx, mailboxId, hookId, metadata, message)
+		if err != nil {
+			return types.HookQuote{}, err
 		}
 
-		return (*handler).QuoteDispatch(ctx, mailboxId, hookId, metadata, message)
+		return types.HookQuote{HookId: hookId, Amount: amount}, nil
 	}
 
-	requiredGasPayment, err := calculateGasPayment(*mailbox.RequiredHook)
+	requiredQuote, err = calculateGasPayment(*mailbox.RequiredHook)
 	if err != nil {
-		return sdk.NewCoins(), err
+		return types.HookQuote{}, types.HookQuote{}, err
 	}
 
 	var defaultHookId util.HexAddress
@@ -195,12 +226,12 @@ func (k *Keeper) QuoteDispatch(ctx context.Context, mailboxId, overwriteHookId u
 		defaultHookId = overwriteHookId
 	}
 
-	defaultGasPayment, err := calculateGasPayment(defaultHookId)
+	defaultQuote, err = calculateGasPayment(defaultHookId)
 	if err != nil {
-		return sdk.NewCoins(), err
+		return types.HookQuote{}, types.HookQuote{}, err
 	}
 
-	return sdk.Coins.Add(requiredGasPayment, defaultGasPayment...), nil
+	return requiredQuote, defaultQuote, nil
 }
 
 func (k *Keeper) AssertPostDispatchHookExists(ctx context.Context, id util.HexAddress) error {
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+)
+
+// GetParams returns the module parameters. If no parameters are stored,
+// the default parameters are returned.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// storeDispatchedMessage persists a dispatched message by its nonce and indexes it by its ID.
+// Afterward, messages which fall outside the retention window are pruned.
+func (k Keeper) storeDispatchedMessage(ctx context.Context, mailboxId uint64, message util.HyperlaneMessage) error {
+	if err := k.DispatchedMessages.Set(ctx, collections.Join(mailboxId, message.Nonce), message.Bytes()); err != nil {
+		return err
+	}
+
+	if err := k.DispatchedIds.Set(ctx, collections.Join(mailboxId, message.Id().Bytes()), message.Nonce); err != nil {
+		return err
+	}
+
+	return k.pruneDispatchedMessages(ctx, mailboxId, message.Nonce)
+}
+
+// pruneDispatchedMessages removes all messages of the given mailbox which are older than
+// the configured retention, measured from the latest nonce.
+// At most MAX_PRUNED_MESSAGES_PER_DISPATCH messages are removed per call.
+func (k Keeper) pruneDispatchedMessages(ctx context.Context, mailboxId uint64, latestNonce uint32) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	retention := params.DispatchedMessageRetention
+	if retention == 0 || latestNonce < retention {
+		return nil
+	}
+
+	// all nonces strictly below the cutoff are pruned
+	cutoff := latestNonce - retention + 1
+
+	rng := collections.NewPrefixedPairRange[uint64, uint32](mailboxId).EndExclusive(cutoff)
+	iter, err := k.DispatchedMessages.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var pruned []collections.KeyValue[collections.Pair[uint64, uint32], []byte]
+	for ; iter.Valid() && len(pruned) < types.MAX_PRUNED_MESSAGES_PER_DISPATCH; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			_ = iter.Close()
+			return err
+		}
+		pruned = append(pruned, kv)
+	}
+	if err = iter.Close(); err != nil {
+		return err
+	}
+
+	for _, kv := range pruned {
+		message, err := util.ParseHyperlaneMessage(kv.Value)
+		if err != nil {
+			return err
+		}
+
+		if err = k.DispatchedMessages.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+
+		if err = k.DispatchedIds.Remove(ctx, collections.Join(mailboxId, message.Id().Bytes())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDispatchedMessage returns the dispatched message with the given nonce.
+func (k Keeper) GetDispatchedMessage(ctx context.Context, mailboxId util.HexAddress, nonce uint32) (types.DispatchedMessage, error) {
+	rawMessage, err := k.DispatchedMessages.Get(ctx, collections.Join(mailboxId.GetInternalId(), nonce))
+	if err != nil {
+		return types.DispatchedMessage{}, fmt.Errorf("failed to find dispatched message with nonce %d for mailbox %s", nonce, mailboxId.String())
+	}
+
+	return toDispatchedMessage(rawMessage)
+}
+
+func toDispatchedMessage(rawMessage []byte) (types.DispatchedMessage, error) {
+	message, err := util.ParseHyperlaneMessage(rawMessage)
+	if err != nil {
+		return types.DispatchedMessage{}, err
+	}
+
+	return types.DispatchedMessage{
+		Nonce:     message.Nonce,
+		MessageId: message.Id(),
+		Message:   message.String(),
+	}, nil
+}
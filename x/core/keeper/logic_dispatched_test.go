@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_dispatched.go
+
+* UpdateParams (invalid) with non-authority address
+* UpdateParams (invalid) with retention below minimum
+* UpdateParams (valid)
+* LatestDispatchedId (invalid) without dispatched messages
+* DispatchedMessage (invalid) with unknown message id
+* DispatchMessage (valid) stores message by nonce and id
+* DispatchedMessages (valid) paginated
+* DispatchMessage (valid) prunes messages outside of retention
+
+*/
+
+var _ = Describe("logic_dispatched.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var creator i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var authority string
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		creator = i.GenerateTestValidatorAddress("Creator")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		authority = authtypes.NewModuleAddress("gov").String()
+		err := s.MintBaseCoins(creator.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	dispatch := func(mailboxId util.HexAddress, body string) util.HexAddress {
+		hexSender, _ := util.DecodeHexAddress(sender.Address)
+		recipient, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+
+		messageId, err := s.App().HyperlaneKeeper.DispatchMessage(
+			s.Ctx(),
+			mailboxId,
+			hexSender,
+			sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(1000000))),
+			1,
+			recipient,
+			[]byte(body),
+			util.StandardHookMetadata{
+				GasLimit: math.NewInt(50000),
+				Address:  sender.AccAddress,
+			},
+			nil,
+		)
+		Expect(err).To(BeNil())
+
+		return messageId
+	}
+
+	It("UpdateParams (invalid) with non-authority address", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: creator.Address,
+			Params:    types.Params{DispatchedMessageRetention: 500},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("invalid authority; expected %s, got %s", authority, creator.Address)))
+
+		params, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).Params(s.Ctx(), &types.QueryParamsRequest{})
+		Expect(err).To(BeNil())
+		Expect(params.Params).To(Equal(types.DefaultParams()))
+	})
+
+	It("UpdateParams (invalid) with retention below minimum", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authority,
+			Params:    types.Params{DispatchedMessageRetention: types.MIN_DISPATCHED_MESSAGE_RETENTION - 1},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("dispatched message retention must be zero or at least %d", types.MIN_DISPATCHED_MESSAGE_RETENTION)))
+
+		params, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).Params(s.Ctx(), &types.QueryParamsRequest{})
+		Expect(err).To(BeNil())
+		Expect(params.Params).To(Equal(types.DefaultParams()))
+	})
+
+	It("UpdateParams (valid)", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authority,
+			Params:    types.Params{DispatchedMessageRetention: 500},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		params, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).Params(s.Ctx(), &types.QueryParamsRequest{})
+		Expect(err).To(BeNil())
+		Expect(params.Params.DispatchedMessageRetention).To(Equal(uint32(500)))
+	})
+
+	It("LatestDispatchedId (invalid) without dispatched messages", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+
+		// Act
+		_, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).LatestDispatchedId(s.Ctx(), &types.QueryLatestDispatchedIdRequest{
+			Id: mailboxId.String(),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("no messages dispatched from mailbox %s", mailboxId)))
+	})
+
+	It("DispatchedMessage (invalid) with unknown message id", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		dispatch(mailboxId, "hello")
+		unknownId := util.CreateMockHexAddress("unknown", 1)
+
+		// Act
+		_, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).DispatchedMessage(s.Ctx(), &types.QueryDispatchedMessageRequest{
+			Id:        mailboxId.String(),
+			MessageId: unknownId.String(),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("failed to find dispatched message with id %s for mailbox %s", unknownId, mailboxId)))
+	})
+
+	It("DispatchMessage (valid) stores message by nonce and id", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		queryServer := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper)
+
+		// Act
+		firstId := dispatch(mailboxId, "hello")
+		secondId := dispatch(mailboxId, "world")
+
+		// Assert
+		verifyDispatch(s, mailboxId, 2)
+
+		byId, err := queryServer.DispatchedMessage(s.Ctx(), &types.QueryDispatchedMessageRequest{
+			Id:        mailboxId.String(),
+			MessageId: firstId.String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(byId.Message.Nonce).To(Equal(uint32(0)))
+		Expect(byId.Message.MessageId).To(Equal(firstId))
+
+		rawMessage, err := util.DecodeEthHex(byId.Message.Message)
+		Expect(err).To(BeNil())
+		message, err := util.ParseHyperlaneMessage(rawMessage)
+		Expect(err).To(BeNil())
+		Expect(message.Body).To(Equal([]byte("hello")))
+
+		byNonce, err := queryServer.DispatchedMessageByNonce(s.Ctx(), &types.QueryDispatchedMessageByNonceRequest{
+			Id:    mailboxId.String(),
+			Nonce: 1,
+		})
+		Expect(err).To(BeNil())
+		Expect(byNonce.Message.MessageId).To(Equal(secondId))
+
+		latest, err := queryServer.LatestDispatchedId(s.Ctx(), &types.QueryLatestDispatchedIdRequest{
+			Id: mailboxId.String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(latest.MessageId).To(Equal(secondId.String()))
+	})
+
+	It("DispatchedMessages (valid) paginated", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		for n := 0; n < 3; n++ {
+			dispatch(mailboxId, fmt.Sprintf("message %d", n))
+		}
+
+		// Act
+		firstPage, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).DispatchedMessages(s.Ctx(), &types.QueryDispatchedMessagesRequest{
+			Id:         mailboxId.String(),
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		Expect(err).To(BeNil())
+
+		secondPage, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).DispatchedMessages(s.Ctx(), &types.QueryDispatchedMessagesRequest{
+			Id:         mailboxId.String(),
+			Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey},
+		})
+		Expect(err).To(BeNil())
+
+		// Assert
+		Expect(firstPage.Messages).To(HaveLen(2))
+		Expect(firstPage.Messages[0].Nonce).To(Equal(uint32(0)))
+		Expect(firstPage.Messages[1].Nonce).To(Equal(uint32(1)))
+		Expect(secondPage.Messages).To(HaveLen(1))
+		Expect(secondPage.Messages[0].Nonce).To(Equal(uint32(2)))
+	})
+
+	It("DispatchMessage (valid) prunes messages outside of retention", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authority,
+			Params:    types.Params{DispatchedMessageRetention: types.MIN_DISPATCHED_MESSAGE_RETENTION},
+		})
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 250_000*(types.MIN_DISPATCHED_MESSAGE_RETENTION+1))
+		Expect(err).To(BeNil())
+
+		firstId := dispatch(mailboxId, "first")
+		for range types.MIN_DISPATCHED_MESSAGE_RETENTION - 1 {
+			dispatch(mailboxId, "next")
+		}
+
+		// Act
+		dispatch(mailboxId, "last")
+
+		// Assert
+		verifyDispatch(s, mailboxId, types.MIN_DISPATCHED_MESSAGE_RETENTION+1)
+
+		queryServer := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper)
+		_, err = queryServer.DispatchedMessageByNonce(s.Ctx(), &types.QueryDispatchedMessageByNonceRequest{
+			Id:    mailboxId.String(),
+			Nonce: 0,
+		})
+		Expect(err.Error()).To(Equal(fmt.Sprintf("failed to find dispatched message with nonce 0 for mailbox %s", mailboxId)))
+
+		hasId, err := s.App().HyperlaneKeeper.DispatchedIds.Has(s.Ctx(), collections.Join(mailboxId.GetInternalId(), firstId.Bytes()))
+		Expect(err).To(BeNil())
+		Expect(hasId).To(BeFalse())
+
+		messages, err := queryServer.DispatchedMessages(s.Ctx(), &types.QueryDispatchedMessagesRequest{
+			Id: mailboxId.String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(messages.Messages).To(HaveLen(types.MIN_DISPATCHED_MESSAGE_RETENTION))
+		Expect(messages.Messages[0].Nonce).To(Equal(uint32(1)))
+		Expect(messages.Messages[types.MIN_DISPATCHED_MESSAGE_RETENTION-1].Nonce).To(Equal(uint32(types.MIN_DISPATCHED_MESSAGE_RETENTION)))
+	})
+})
@@ -17,6 +17,8 @@ import (
 func (k Keeper) ProcessMessage(
 	ctx sdk.Context,
 	mailboxId util.HexAddress,
+	// relayer is the address which submitted the message
+	relayer string,
 	rawMessage []byte,
 	metadata []byte,
 ) error {
@@ -48,19 +50,14 @@ func (k Keeper) ProcessMessage(
 
 	// Check replay protection
 	key := collections.Join(mailboxId.GetInternalId(), message.Id().Bytes())
-	received, err := k.Messages.Has(ctx, key)
+	received, err := k.DeliveredMessages.Has(ctx, key)
 	if err != nil {
 		return err
 	}
 	if received {
 		return fmt.Errorf("already received messsage with id %s", message.Id().String())
 	}
-	err = k.Messages.Set(ctx, key)
-	if err != nil {
-		return err
-	}
 
-	// Verify message
 	ismId, err := k.ReceiverIsmId(ctx, message.Recipient)
 	if err != nil {
 		if errors.IsOf(err, types.ErrNoReceiverISM) {
@@ -70,6 +67,17 @@ func (k Keeper) ProcessMessage(
 		}
 	}
 
+	err = k.DeliveredMessages.Set(ctx, key, types.DeliveredMessage{
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime(),
+		Relayer:     relayer,
+		IsmId:       ismId,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Verify message
 	verified, err := k.Verify(ctx, ismId, metadata, message)
 	if err != nil {
 		return err
@@ -138,7 +146,7 @@ func (k Keeper) DispatchMessage(
 	}
 	mailbox.MessageSent++
 
-	err = k.Messages.Set(ctx, collections.Join(originMailboxId.GetInternalId(), hypMsg.Id().Bytes()))
+	err = k.storeDispatchedMessage(ctx, originMailboxId.GetInternalId(), hypMsg)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
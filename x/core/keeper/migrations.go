@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/bcp-innovations/hyperlane-cosmos/x/core/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// It moves delivered message IDs out of the shared messages store.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.DeliveredMessages)
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3.
+// It splits the tree of merkle tree hooks into separate entries per branch level.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.PostDispatchKeeper.MigrateMerkleTreeHooks(ctx)
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	pdkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/keeper"
+	pdtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - migrations.go
+
+* Migrate1to2 (valid) moves legacy messages to delivered messages
+* Migrate2to3 (valid) splits the tree of merkle tree hooks
+
+*/
+
+var _ = Describe("migrations.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+	})
+
+	It("Migrate1to2 (valid) moves legacy messages to delivered messages", func() {
+		// Arrange
+		storeKey := s.App().UnsafeFindStoreKey(types.ModuleName).(*storetypes.KVStoreKey)
+		sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+		legacyMessages := collections.NewKeySet(sb, types.MessagesKey, "messages", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey))
+
+		deliveredId := util.CreateMockHexAddress("delivered", 1)
+		dispatchedId := util.CreateMockHexAddress("dispatched", 1)
+
+		Expect(legacyMessages.Set(s.Ctx(), collections.Join(uint64(0), deliveredId.Bytes()))).To(Succeed())
+		Expect(legacyMessages.Set(s.Ctx(), collections.Join(uint64(0), dispatchedId.Bytes()))).To(Succeed())
+
+		// Act
+		err := keeper.NewMigrator(s.App().HyperlaneKeeper).Migrate1to2(s.Ctx())
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		delivered, err := s.App().HyperlaneKeeper.DeliveredMessages.Has(s.Ctx(), collections.Join(uint64(0), deliveredId.Bytes()))
+		Expect(err).To(BeNil())
+		Expect(delivered).To(BeTrue())
+
+		// the direction of legacy messages is unknown, dispatched ones are kept as delivered as well
+		delivered, err = s.App().HyperlaneKeeper.DeliveredMessages.Has(s.Ctx(), collections.Join(uint64(0), dispatchedId.Bytes()))
+		Expect(err).To(BeNil())
+		Expect(delivered).To(BeTrue())
+
+		iter, err := legacyMessages.Iterate(s.Ctx(), nil)
+		Expect(err).To(BeNil())
+		keys, err := iter.Keys()
+		Expect(err).To(BeNil())
+		Expect(keys).To(BeEmpty())
+	})
+
+	It("Migrate2to3 (valid) splits the tree of merkle tree hooks", func() {
+		// Arrange
+		storeKey := s.App().UnsafeFindStoreKey(types.ModuleName).(*storetypes.KVStoreKey)
+		sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+		legacyMerkleTreeHooks := collections.NewMap(sb, pdtypes.MerkleTreeHooksKey, "merkle_tree_hooks_key", collections.Uint64Key, codec.CollValue[pdtypes.MerkleTreeHook](s.App().AppCodec()))
+
+		hookId, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetNextSequence(s.Ctx(), pdtypes.POST_DISPATCH_HOOK_TYPE_MERKLE_TREE)
+		Expect(err).To(BeNil())
+		mailboxId := util.CreateMockHexAddress("mailbox", 1)
+
+		tree := util.NewTree(util.ZeroHashes, 0)
+		for k := int64(0); k < 5; k++ {
+			Expect(tree.Insert(util.CreateMockHexAddress("leaf", k))).To(Succeed())
+		}
+
+		Expect(legacyMerkleTreeHooks.Set(s.Ctx(), hookId.GetInternalId(), pdtypes.MerkleTreeHook{
+			Id:        hookId,
+			MailboxId: mailboxId.String(),
+			Owner:     "owner",
+			Tree:      pdtypes.ProtoFromTree(tree),
+		})).To(Succeed())
+
+		// Act
+		err = keeper.NewMigrator(s.App().HyperlaneKeeper).Migrate2to3(s.Ctx())
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		legacyHook, err := legacyMerkleTreeHooks.Get(s.Ctx(), hookId.GetInternalId())
+		Expect(err).To(BeNil())
+		Expect(legacyHook.Tree).To(BeNil())
+
+		qs := pdkeeper.NewQueryServerImpl(&s.App().HyperlaneKeeper.PostDispatchKeeper)
+		res, err := qs.MerkleTreeHook(s.Ctx(), &pdtypes.QueryMerkleTreeHookRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+		root := tree.GetRoot()
+		Expect(res.MerkleTreeHook.MerkleTree.Count).To(Equal(uint32(5)))
+		Expect(res.MerkleTreeHook.MerkleTree.Root).To(Equal(root[:]))
+		Expect(res.MerkleTreeHook.MerkleTree.Leafs).To(Equal(pdtypes.ProtoFromTree(tree).Branch))
+
+		// the migrated tree continues with the legacy state
+		message := util.HyperlaneMessage{Body: []byte("after migration")}
+		handler, err := s.App().HyperlaneKeeper.PostDispatchRouter().GetModule(hookId)
+		Expect(err).To(BeNil())
+		_, err = (*handler).PostDispatch(s.Ctx(), mailboxId, hookId, util.StandardHookMetadata{}, message, sdk.NewCoins())
+		Expect(err).To(BeNil())
+		Expect(tree.Insert(message.Id())).To(Succeed())
+
+		rootRes, err := qs.MerkleTreeRoot(s.Ctx(), &pdtypes.QueryMerkleTreeRootRequest{Id: hookId.String()})
+		Expect(err).To(BeNil())
+		root = tree.GetRoot()
+		Expect(rootRes.Count).To(Equal(uint32(6)))
+		Expect(rootRes.Root).To(Equal(root[:]))
+
+		// leaves inserted after the migration can be proven
+		proofRes, err := qs.MerkleTreeProof(s.Ctx(), &pdtypes.QueryMerkleTreeProofRequest{Id: hookId.String(), Index: 5})
+		Expect(err).To(BeNil())
+		Expect(proofRes.Root).To(Equal(root[:]))
+		var proof [util.TreeDepth][32]byte
+		for level := range proofRes.Proof {
+			proof[level] = [32]byte(proofRes.Proof[level])
+		}
+		Expect(util.BranchRoot(message.Id(), proof, 5)).To(Equal(root))
+
+		checkpointRes, err := qs.MerkleTreeCheckpoint(s.Ctx(), &pdtypes.QueryMerkleTreeCheckpointRequest{Id: hookId.String(), Height: s.Ctx().BlockHeight()})
+		Expect(err).To(BeNil())
+		Expect(checkpointRes.Checkpoint.Root).To(Equal(root[:]))
+		Expect(checkpointRes.Checkpoint.Index).To(Equal(uint32(5)))
+		Expect(checkpointRes.Checkpoint.MessageId).To(Equal(message.Id()))
+	})
+})
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -87,13 +89,63 @@ func (ms msgServer) ProcessMessage(ctx context.Context, req *types.MsgProcessMes
 		return nil, fmt.Errorf("failed to decode metadata")
 	}
 
-	if err = ms.k.ProcessMessage(goCtx, req.MailboxId, messageBytes, metadataBytes); err != nil {
+	if err = ms.k.ProcessMessage(goCtx, req.MailboxId, req.Relayer, messageBytes, metadataBytes); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgProcessMessageResponse{}, nil
 }
 
+func (ms msgServer) DispatchMessage(ctx context.Context, req *types.MsgDispatchMessage) (*types.MsgDispatchMessageResponse, error) {
+	goCtx := sdk.UnwrapSDKContext(ctx)
+
+	senderAcc, err := ms.k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address (%s)", req.Sender)
+	}
+
+	sender, err := util.ParseFromCosmosAcc(senderAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := util.DecodeEthHex(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode body")
+	}
+
+	customHookMetadata, err := util.DecodeEthHex(req.CustomHookMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom hook metadata")
+	}
+
+	gasLimit := req.GasLimit
+	if gasLimit.IsNil() {
+		gasLimit = math.ZeroInt()
+	}
+
+	messageId, err := ms.k.DispatchMessage(
+		goCtx,
+		req.MailboxId,
+		sender,
+		req.MaxFee,
+		req.DestinationDomain,
+		req.Recipient,
+		body,
+		util.StandardHookMetadata{
+			GasLimit:           gasLimit,
+			Address:            senderAcc,
+			CustomHookMetadata: customHookMetadata,
+		},
+		req.CustomHookId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDispatchMessageResponse{MessageId: messageId}, nil
+}
+
 func (ms msgServer) SetMailbox(ctx context.Context, req *types.MsgSetMailbox) (*types.MsgSetMailboxResponse, error) {
 	mailboxId := req.MailboxId
 	mailbox, err := ms.k.Mailboxes.Get(ctx, mailboxId.GetInternalId())
@@ -34,12 +34,17 @@ TEST CASES - msg_mailbox.go
 * DispatchMessage (valid) with MultisigISM
 * DispatchMessage (valid) with custom hook
 * DispatchMessage (valid)
+* MsgDispatchMessage (invalid) with invalid body
+* MsgDispatchMessage (invalid) with max fee below quote
+* MsgDispatchMessage (valid) with quoted fee
 * ProcessMessage (invalid) (unkown recipient)
 * ProcessMessage (invalid) with empty message
 * ProcessMessage (invalid) with invalid non-hex message
 * ProcessMessage (invalid) with invalid metadata (Noop ISM)
 * ProcessMessage (valid) (Noop ISM)
 * ProcessMessage (valid) (Multisig ISM)
+* ProcessMessage (valid) records delivery
+* DispatchMessage (valid) is not marked as delivered
 * SetMailbox (invalid) with invalid new owner
 * SetMailbox (invalid) with non-owner address
 * SetMailbox (valid) renounce ownership
@@ -272,6 +277,112 @@ var _ = Describe("msg_mailbox.go", Ordered, func() {
 		verifyDispatch(s, mailboxId, 1)
 	})
 
+	It("MsgDispatchMessage (invalid) with invalid body", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		recipient := util.CreateMockHexAddress("recipient", 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgDispatchMessage{
+			Sender:            creator.Address,
+			MailboxId:         mailboxId,
+			DestinationDomain: 1,
+			Recipient:         recipient,
+			Body:              "0xzz",
+			GasLimit:          math.NewInt(50000),
+			MaxFee:            sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(1000000))),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("failed to decode body"))
+
+		verifyDispatch(s, mailboxId, 0)
+	})
+
+	It("MsgDispatchMessage (invalid) with max fee below quote", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		recipient := util.CreateMockHexAddress("recipient", 1)
+
+		quote, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).QuoteDispatch(s.Ctx(), &types.QueryQuoteDispatchRequest{
+			Id:                mailboxId.String(),
+			DestinationDomain: "1",
+			GasLimit:          "50000",
+		})
+		Expect(err).To(BeNil())
+		Expect(quote.Total).To(Equal(sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(250000)))))
+
+		// Act
+		_, err = s.RunTx(&types.MsgDispatchMessage{
+			Sender:            creator.Address,
+			MailboxId:         mailboxId,
+			DestinationDomain: 1,
+			Recipient:         recipient,
+			Body:              "0x68656c6c6f",
+			GasLimit:          math.NewInt(50000),
+			MaxFee:            sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(249999))),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("required payment exceeds max hyperlane fee: 250000acoin"))
+
+		verifyDispatch(s, mailboxId, 0)
+	})
+
+	It("MsgDispatchMessage (valid) with quoted fee", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+		recipient := util.CreateMockHexAddress("recipient", 1)
+		expectedSender, err := util.ParseFromCosmosAcc(creator.AccAddress)
+		Expect(err).To(BeNil())
+
+		quote, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).QuoteDispatch(s.Ctx(), &types.QueryQuoteDispatchRequest{
+			Id:                mailboxId.String(),
+			DestinationDomain: "1",
+			Sender:            creator.Address,
+			Recipient:         recipient.String(),
+			Body:              "0x68656c6c6f",
+			GasLimit:          "50000",
+		})
+		Expect(err).To(BeNil())
+
+		creatorBalance := s.App().BankKeeper.GetBalance(s.Ctx(), creator.AccAddress, "acoin")
+
+		// Act
+		res, err := s.RunTx(&types.MsgDispatchMessage{
+			Sender:            creator.Address,
+			MailboxId:         mailboxId,
+			DestinationDomain: 1,
+			Recipient:         recipient,
+			Body:              "0x68656c6c6f",
+			GasLimit:          math.NewInt(50000),
+			MaxFee:            quote.Total,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var response types.MsgDispatchMessageResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		verifyDispatch(s, mailboxId, 1)
+
+		dispatched, err := s.App().HyperlaneKeeper.GetDispatchedMessage(s.Ctx(), mailboxId, 0)
+		Expect(err).To(BeNil())
+		Expect(dispatched.MessageId).To(Equal(response.MessageId))
+
+		rawMessage, err := util.DecodeEthHex(dispatched.Message)
+		Expect(err).To(BeNil())
+		message, err := util.ParseHyperlaneMessage(rawMessage)
+		Expect(err).To(BeNil())
+		Expect(message.Sender).To(Equal(expectedSender))
+		Expect(message.Recipient).To(Equal(recipient))
+		Expect(message.Body).To(Equal([]byte("hello")))
+
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), creator.AccAddress, "acoin").Amount).To(Equal(creatorBalance.Amount.Sub(quote.Total.AmountOf("acoin"))))
+	})
+
 	It("ProcessMessage (invalid) with empty message", func() {
 		// Arrange
 		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
@@ -400,6 +511,99 @@ var _ = Describe("msg_mailbox.go", Ordered, func() {
 		Expect(mailboxId.String()).To(Equal(mailboxId.String()))
 	})
 
+	It("ProcessMessage (valid) records delivery", func() {
+		// Arrange
+		mailboxId, _, _, ismId := createValidMailbox(s, creator.Address, "noop", 1)
+
+		mockApp := i.CreateMockApp(s.App().HyperlaneKeeper.AppRouter())
+		recipient, err := mockApp.RegisterApp(s.Ctx(), ismId)
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      0,
+			Sender:      util.CreateMockHexAddress("sender", 0),
+			Destination: 1,
+			Recipient:   recipient,
+			Body:        nil,
+		}
+
+		// Act
+		_, err = s.RunTx(&types.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		queryServer := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper)
+		delivered, err := queryServer.Delivered(s.Ctx(), &types.QueryDeliveredRequest{
+			Id:        mailboxId.String(),
+			MessageId: message.Id().String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(delivered.Delivered).To(BeTrue())
+		Expect(delivered.Delivery.Relayer).To(Equal(sender.Address))
+
+		details, err := queryServer.DeliveredMessage(s.Ctx(), &types.QueryDeliveredMessageRequest{
+			Id:        mailboxId.String(),
+			MessageId: message.Id().String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(details.Delivery.BlockHeight).To(Equal(s.Ctx().BlockHeight()))
+		Expect(details.Delivery.Timestamp.Equal(s.Ctx().BlockTime())).To(BeTrue())
+		Expect(details.Delivery.Relayer).To(Equal(sender.Address))
+		Expect(details.Delivery.IsmId).To(Equal(ismId))
+	})
+
+	It("DispatchMessage (valid) is not marked as delivered", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+
+		err := s.MintBaseCoins(sender.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		hexSender, _ := util.DecodeHexAddress(sender.Address)
+		recipient, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+
+		// Act
+		messageId, err := s.App().HyperlaneKeeper.DispatchMessage(
+			s.Ctx(),
+			mailboxId,
+			hexSender,
+			sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(1000000))),
+			1,
+			recipient,
+			[]byte("hello"),
+			util.StandardHookMetadata{
+				GasLimit: math.NewInt(50000),
+				Address:  sender.AccAddress,
+			},
+			nil,
+		)
+		Expect(err).To(BeNil())
+
+		// Assert
+		queryServer := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper)
+		delivered, err := queryServer.Delivered(s.Ctx(), &types.QueryDeliveredRequest{
+			Id:        mailboxId.String(),
+			MessageId: messageId.String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(delivered.Delivered).To(BeFalse())
+		Expect(delivered.Delivery).To(BeNil())
+
+		_, err = queryServer.DeliveredMessage(s.Ctx(), &types.QueryDeliveredMessageRequest{
+			Id:        mailboxId.String(),
+			MessageId: messageId.String(),
+		})
+		Expect(err.Error()).To(Equal(fmt.Sprintf("failed to find delivered message with id %s for mailbox %s", messageId, mailboxId)))
+	})
+
 	It("SetMailbox (invalid) with invalid new owner", func() {
 		// Arrange
 		mailboxId, requiredHook, defaultHook, ism := createValidMailbox(s, creator.Address, "noop", 1)
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
 )
 
@@ -14,3 +17,20 @@ var _ types.MsgServer = msgServer{}
 func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 	return &msgServer{k: keeper}
 }
+
+// UpdateParams updates the module parameters. Only the authority is allowed to do so.
+func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Params.Set(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -36,12 +38,215 @@ func (qs queryServer) Delivered(ctx context.Context, req *types.QueryDeliveredRe
 		return nil, err
 	}
 
-	delivered, err := qs.k.Messages.Has(ctx, collections.Join(mailboxId.GetInternalId(), messageId))
+	delivery, err := qs.k.DeliveredMessages.Get(ctx, collections.Join(mailboxId.GetInternalId(), messageId))
+	if errors.Is(err, collections.ErrNotFound) {
+		return &types.QueryDeliveredResponse{Delivered: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeliveredResponse{Delivered: true, Delivery: &delivery}, nil
+}
+
+func (qs queryServer) DeliveredMessage(ctx context.Context, req *types.QueryDeliveredMessageRequest) (*types.QueryDeliveredMessageResponse, error) {
+	messageId, err := util.DecodeEthHex(req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	mailboxId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := qs.k.DeliveredMessages.Get(ctx, collections.Join(mailboxId.GetInternalId(), messageId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find delivered message with id %s for mailbox %s", req.MessageId, mailboxId.String())
+	}
+
+	return &types.QueryDeliveredMessageResponse{Delivery: delivery}, nil
+}
+
+func (qs queryServer) DispatchedMessages(ctx context.Context, req *types.QueryDispatchedMessagesRequest) (*types.QueryDispatchedMessagesResponse, error) {
+	mailboxId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	values, pagination, err := util.GetPaginatedPrefixFromMap(ctx, qs.k.DispatchedMessages, req.Pagination, mailboxId.GetInternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]types.DispatchedMessage, len(values))
+	for i, value := range values {
+		messages[i], err = toDispatchedMessage(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryDispatchedMessagesResponse{
+		Messages:   messages,
+		Pagination: pagination,
+	}, nil
+}
+
+func (qs queryServer) DispatchedMessage(ctx context.Context, req *types.QueryDispatchedMessageRequest) (*types.QueryDispatchedMessageResponse, error) {
+	messageId, err := util.DecodeEthHex(req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	mailboxId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := qs.k.DispatchedIds.Get(ctx, collections.Join(mailboxId.GetInternalId(), messageId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dispatched message with id %s for mailbox %s", req.MessageId, mailboxId.String())
+	}
+
+	message, err := qs.k.GetDispatchedMessage(ctx, mailboxId, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDispatchedMessageResponse{Message: message}, nil
+}
+
+func (qs queryServer) DispatchedMessageByNonce(ctx context.Context, req *types.QueryDispatchedMessageByNonceRequest) (*types.QueryDispatchedMessageResponse, error) {
+	mailboxId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := qs.k.GetDispatchedMessage(ctx, mailboxId, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDispatchedMessageResponse{Message: message}, nil
+}
+
+func (qs queryServer) LatestDispatchedId(ctx context.Context, req *types.QueryLatestDispatchedIdRequest) (*types.QueryLatestDispatchedIdResponse, error) {
+	mailboxId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	mailbox, err := qs.k.Mailboxes.Get(ctx, mailboxId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find mailbox with id: %v", mailboxId.String())
+	}
+
+	if mailbox.MessageSent == 0 {
+		return nil, fmt.Errorf("no messages dispatched from mailbox %s", mailboxId.String())
+	}
+
+	message, err := qs.k.GetDispatchedMessage(ctx, mailboxId, mailbox.MessageSent-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLatestDispatchedIdResponse{MessageId: message.MessageId.String()}, nil
+}
+
+func (qs queryServer) QuoteDispatch(ctx context.Context, req *types.QueryQuoteDispatchRequest) (*types.QueryQuoteDispatchResponse, error) {
+	mailboxId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	mailbox, err := qs.k.Mailboxes.Get(ctx, mailboxId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find mailbox with id: %v", mailboxId.String())
+	}
+
+	destinationDomain, err := strconv.ParseUint(req.DestinationDomain, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	message := util.HyperlaneMessage{
+		Version:     types.MESSAGE_VERSION,
+		Nonce:       mailbox.MessageSent,
+		Origin:      mailbox.LocalDomain,
+		Destination: uint32(destinationDomain),
+	}
+
+	metadata := util.StandardHookMetadata{
+		GasLimit:           math.ZeroInt(),
+		Address:            sdk.AccAddress{},
+		CustomHookMetadata: []byte{},
+	}
+
+	if req.Sender != "" {
+		senderAcc, err := qs.k.addressCodec.StringToBytes(req.Sender)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sender address (%s)", req.Sender)
+		}
+		message.Sender, err = util.ParseFromCosmosAcc(senderAcc)
+		if err != nil {
+			return nil, err
+		}
+		metadata.Address = senderAcc
+	}
+
+	if req.Recipient != "" {
+		message.Recipient, err = util.DecodeHexAddress(req.Recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	message.Body, err = util.DecodeEthHex(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode body")
+	}
+
+	metadata.CustomHookMetadata, err = util.DecodeEthHex(req.CustomHookMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom hook metadata")
+	}
+
+	if req.GasLimit != "" {
+		gasLimit, ok := math.NewIntFromString(req.GasLimit)
+		if !ok {
+			return nil, fmt.Errorf("invalid gas limit %s", req.GasLimit)
+		}
+		metadata.GasLimit = gasLimit
+	}
+
+	customHookId := util.NewZeroAddress()
+	if req.CustomHookId != "" {
+		customHookId, err = util.DecodeHexAddress(req.CustomHookId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	requiredQuote, defaultQuote, err := qs.k.QuoteDispatchBreakdown(ctx, mailboxId, customHookId, metadata, message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQuoteDispatchResponse{
+		Total:        sdk.Coins.Add(requiredQuote.Amount, defaultQuote.Amount...),
+		RequiredHook: requiredQuote,
+		DefaultHook:  defaultQuote,
+	}, nil
+}
+
+func (qs queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := qs.k.GetParams(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryDeliveredResponse{Delivered: delivered}, nil
+	return &types.QueryParamsResponse{Params: params}, nil
 }
 
 func (qs queryServer) RecipientIsm(ctx context.Context, req *types.QueryRecipientIsmRequest) (*types.QueryRecipientIsmResponse, error) {
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - query_server.go
+
+* QuoteDispatch (invalid) with non-existing mailbox
+* QuoteDispatch (invalid) with invalid gas limit
+* QuoteDispatch (valid) with default hook
+* QuoteDispatch (valid) with custom hook
+
+*/
+
+var _ = Describe("query_server.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var creator i.TestValidatorAddress
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		creator = i.GenerateTestValidatorAddress("Creator")
+		err := s.MintBaseCoins(creator.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	It("QuoteDispatch (invalid) with non-existing mailbox", func() {
+		// Arrange
+		createValidMailbox(s, creator.Address, "noop", 1)
+		nonExistingMailboxId := util.CreateMockHexAddress("mailbox", 1)
+
+		// Act
+		_, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).QuoteDispatch(s.Ctx(), &types.QueryQuoteDispatchRequest{
+			Id:                nonExistingMailboxId.String(),
+			DestinationDomain: "1",
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("failed to find mailbox with id: %s", nonExistingMailboxId)))
+	})
+
+	It("QuoteDispatch (invalid) with invalid gas limit", func() {
+		// Arrange
+		mailboxId, _, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+
+		// Act
+		_, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).QuoteDispatch(s.Ctx(), &types.QueryQuoteDispatchRequest{
+			Id:                mailboxId.String(),
+			DestinationDomain: "1",
+			GasLimit:          "abc",
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid gas limit abc"))
+	})
+
+	It("QuoteDispatch (valid) with default hook", func() {
+		// Arrange
+		mailboxId, igpId, noopId, _ := createValidMailbox(s, creator.Address, "noop", 1)
+
+		// Act
+		quote, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).QuoteDispatch(s.Ctx(), &types.QueryQuoteDispatchRequest{
+			Id:                mailboxId.String(),
+			DestinationDomain: "1",
+			Recipient:         util.CreateMockHexAddress("recipient", 1).String(),
+			Body:              "0x68656c6c6f",
+			GasLimit:          "50000",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(quote.RequiredHook.HookId).To(Equal(igpId))
+		Expect(quote.RequiredHook.Amount).To(Equal(sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(250000)))))
+		Expect(quote.DefaultHook.HookId).To(Equal(noopId))
+		Expect(quote.DefaultHook.Amount).To(BeEmpty())
+		Expect(quote.Total).To(Equal(sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(250000)))))
+	})
+
+	It("QuoteDispatch (valid) with custom hook", func() {
+		// Arrange
+		mailboxId, igpId, _, _ := createValidMailbox(s, creator.Address, "noop", 1)
+
+		customIgpId := createIgp(s, creator.Address)
+		err := setDestinationGasConfig(s, creator.Address, customIgpId, 1)
+		Expect(err).To(BeNil())
+
+		// Act
+		quote, err := keeper.NewQueryServerImpl(s.App().HyperlaneKeeper).QuoteDispatch(s.Ctx(), &types.QueryQuoteDispatchRequest{
+			Id:                mailboxId.String(),
+			DestinationDomain: "1",
+			CustomHookId:      customIgpId.String(),
+			GasLimit:          "0",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(quote.RequiredHook.HookId).To(Equal(igpId))
+		Expect(quote.RequiredHook.Amount).To(Equal(sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(200000)))))
+		Expect(quote.DefaultHook.HookId).To(Equal(customIgpId))
+		Expect(quote.DefaultHook.Amount).To(Equal(sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(200000)))))
+		Expect(quote.Total).To(Equal(sdk.NewCoins(sdk.NewCoin("acoin", math.NewInt(400000)))))
+	})
+})
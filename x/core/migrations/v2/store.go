@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+//
+// In v1, the IDs of dispatched and delivered messages were stored in a single key set.
+// v2 keeps delivered messages in a separate map which holds the delivery record.
+// The legacy key set does not reveal in which direction a message travelled, and no other v1
+// state records the IDs of dispatched messages. Therefore, all entries are migrated as delivered
+// messages without delivery metadata, so that the replay protection stays intact. As in v1, the
+// Delivered query keeps reporting messages dispatched before the upgrade as delivered.
+func MigrateStore(
+	ctx context.Context,
+	storeService storetypes.KVStoreService,
+	deliveredMessages collections.Map[collections.Pair[uint64, []byte], types.DeliveredMessage],
+) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	legacyMessages := collections.NewKeySet(sb, types.MessagesKey, "messages", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey))
+
+	iter, err := legacyMessages.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err = deliveredMessages.Set(ctx, key, types.DeliveredMessage{}); err != nil {
+			return err
+		}
+
+		if err = legacyMessages.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateMailbox{},
 		&MsgSetMailbox{},
 		&MsgProcessMessage{},
+		&MsgDispatchMessage{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,3 +1,11 @@
 package types
 
 const MESSAGE_VERSION uint8 = 3
+
+// MAX_PRUNED_MESSAGES_PER_DISPATCH limits the number of dispatched messages which are
+// pruned within a single dispatch. This bounds the gas costs after the retention was lowered.
+const MAX_PRUNED_MESSAGES_PER_DISPATCH = 100
+
+// MIN_DISPATCHED_MESSAGE_RETENTION is the smallest non-zero retention window. Smaller windows
+// would prune messages before relayers had a chance to pick them up.
+const MIN_DISPATCHED_MESSAGE_RETENTION = 100
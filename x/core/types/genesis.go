@@ -18,11 +18,34 @@ func NewGenesisState() *GenesisState {
 		IsmSequence:          0,
 		PostDispatchSequence: 0,
 		AppSequence:          0,
+		Params:               DefaultParams(),
+		DispatchedMessages:   []GenesisDispatchedMessageWrapper{},
 	}
 }
 
+// DefaultParams returns the default module parameters.
+// By default, dispatched messages are retained forever.
+func DefaultParams() Params {
+	return Params{
+		DispatchedMessageRetention: 0,
+	}
+}
+
+// Validate checks that the parameters have valid values.
+// A retention of zero disables pruning.
+func (p Params) Validate() error {
+	if p.DispatchedMessageRetention != 0 && p.DispatchedMessageRetention < MIN_DISPATCHED_MESSAGE_RETENTION {
+		return fmt.Errorf("dispatched message retention must be zero or at least %d", MIN_DISPATCHED_MESSAGE_RETENTION)
+	}
+	return nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	if err := gs.PostDispatchGenesis.Validate(); err != nil {
 		return err
 	}
@@ -33,12 +56,30 @@ func (gs *GenesisState) Validate() error {
 
 	messages := make(map[uint64]map[util.HexAddress]struct{})
 	for _, m := range gs.Messages {
+		if _, ok := messages[m.MailboxId]; !ok {
+			messages[m.MailboxId] = make(map[util.HexAddress]struct{})
+		}
 		if _, ok := messages[m.MailboxId][m.MessageId]; ok {
 			return fmt.Errorf("duplicated message (%s) for mailbox %d, ", m.MessageId, m.MailboxId)
 		}
 		messages[m.MailboxId][m.MessageId] = struct{}{}
 	}
 
+	dispatched := make(map[uint64]map[uint32]struct{})
+	for _, m := range gs.DispatchedMessages {
+		message, err := util.ParseHyperlaneMessage(m.Message)
+		if err != nil {
+			return fmt.Errorf("invalid dispatched message for mailbox %d: %w", m.MailboxId, err)
+		}
+		if _, ok := dispatched[m.MailboxId]; !ok {
+			dispatched[m.MailboxId] = make(map[uint32]struct{})
+		}
+		if _, ok := dispatched[m.MailboxId][message.Nonce]; ok {
+			return fmt.Errorf("duplicated dispatched message nonce (%d) for mailbox %d", message.Nonce, m.MailboxId)
+		}
+		dispatched[m.MailboxId][message.Nonce] = struct{}{}
+	}
+
 	for i, mailbox := range gs.Mailboxes {
 		if mailbox.Id.GetInternalId() != uint64(i) {
 			return fmt.Errorf("duplicated mailbox id %d, %d", mailbox.Id.GetInternalId(), i)
@@ -31,12 +31,14 @@ type GenesisState struct {
 	// ism_genesis
 	IsmGenesis *types.GenesisState `protobuf:"bytes,1,opt,name=ism_genesis,json=ismGenesis,proto3" json:"ism_genesis,omitempty"`
 	// post_dispatch_genesis
-	PostDispatchGenesis  *types1.GenesisState           `protobuf:"bytes,2,opt,name=post_dispatch_genesis,json=postDispatchGenesis,proto3" json:"post_dispatch_genesis,omitempty"`
-	Mailboxes            []Mailbox                      `protobuf:"bytes,3,rep,name=mailboxes,proto3" json:"mailboxes"`
-	Messages             []GenesisMailboxMessageWrapper `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages"`
-	IsmSequence          uint64                         `protobuf:"varint,5,opt,name=ism_sequence,json=ismSequence,proto3" json:"ism_sequence,omitempty"`
-	PostDispatchSequence uint64                         `protobuf:"varint,6,opt,name=post_dispatch_sequence,json=postDispatchSequence,proto3" json:"post_dispatch_sequence,omitempty"`
-	AppSequence          uint64                         `protobuf:"varint,7,opt,name=app_sequence,json=appSequence,proto3" json:"app_sequence,omitempty"`
+	PostDispatchGenesis  *types1.GenesisState              `protobuf:"bytes,2,opt,name=post_dispatch_genesis,json=postDispatchGenesis,proto3" json:"post_dispatch_genesis,omitempty"`
+	Mailboxes            []Mailbox                         `protobuf:"bytes,3,rep,name=mailboxes,proto3" json:"mailboxes"`
+	Messages             []GenesisMailboxMessageWrapper    `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages"`
+	IsmSequence          uint64                            `protobuf:"varint,5,opt,name=ism_sequence,json=ismSequence,proto3" json:"ism_sequence,omitempty"`
+	PostDispatchSequence uint64                            `protobuf:"varint,6,opt,name=post_dispatch_sequence,json=postDispatchSequence,proto3" json:"post_dispatch_sequence,omitempty"`
+	AppSequence          uint64                            `protobuf:"varint,7,opt,name=app_sequence,json=appSequence,proto3" json:"app_sequence,omitempty"`
+	Params               Params                            `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	DispatchedMessages   []GenesisDispatchedMessageWrapper `protobuf:"bytes,9,rep,name=dispatched_messages,json=dispatchedMessages,proto3" json:"dispatched_messages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -121,10 +123,26 @@ func (m *GenesisState) GetAppSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDispatchedMessages() []GenesisDispatchedMessageWrapper {
+	if m != nil {
+		return m.DispatchedMessages
+	}
+	return nil
+}
+
 // GenesisMailboxMessageWrapper ...
 type GenesisMailboxMessageWrapper struct {
 	MailboxId uint64                                                      `protobuf:"varint,1,opt,name=mailbox_id,json=mailboxId,proto3" json:"mailbox_id,omitempty"`
 	MessageId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"message_id"`
+	// delivery is the delivery record of the message.
+	Delivery DeliveredMessage `protobuf:"bytes,3,opt,name=delivery,proto3" json:"delivery"`
 }
 
 func (m *GenesisMailboxMessageWrapper) Reset()         { *m = GenesisMailboxMessageWrapper{} }
@@ -167,45 +185,112 @@ func (m *GenesisMailboxMessageWrapper) GetMailboxId() uint64 {
 	return 0
 }
 
+func (m *GenesisMailboxMessageWrapper) GetDelivery() DeliveredMessage {
+	if m != nil {
+		return m.Delivery
+	}
+	return DeliveredMessage{}
+}
+
+// GenesisDispatchedMessageWrapper ...
+type GenesisDispatchedMessageWrapper struct {
+	MailboxId uint64 `protobuf:"varint,1,opt,name=mailbox_id,json=mailboxId,proto3" json:"mailbox_id,omitempty"`
+	// message is the raw Hyperlane message.
+	Message []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *GenesisDispatchedMessageWrapper) Reset()         { *m = GenesisDispatchedMessageWrapper{} }
+func (m *GenesisDispatchedMessageWrapper) String() string { return proto.CompactTextString(m) }
+func (*GenesisDispatchedMessageWrapper) ProtoMessage()    {}
+func (*GenesisDispatchedMessageWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9329350a78ea2d1f, []int{2}
+}
+func (m *GenesisDispatchedMessageWrapper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisDispatchedMessageWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisDispatchedMessageWrapper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisDispatchedMessageWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisDispatchedMessageWrapper.Merge(m, src)
+}
+func (m *GenesisDispatchedMessageWrapper) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisDispatchedMessageWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisDispatchedMessageWrapper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisDispatchedMessageWrapper proto.InternalMessageInfo
+
+func (m *GenesisDispatchedMessageWrapper) GetMailboxId() uint64 {
+	if m != nil {
+		return m.MailboxId
+	}
+	return 0
+}
+
+func (m *GenesisDispatchedMessageWrapper) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hyperlane.core.v1.GenesisState")
 	proto.RegisterType((*GenesisMailboxMessageWrapper)(nil), "hyperlane.core.v1.GenesisMailboxMessageWrapper")
+	proto.RegisterType((*GenesisDispatchedMessageWrapper)(nil), "hyperlane.core.v1.GenesisDispatchedMessageWrapper")
 }
 
 func init() { proto.RegisterFile("hyperlane/core/v1/genesis.proto", fileDescriptor_9329350a78ea2d1f) }
 
 var fileDescriptor_9329350a78ea2d1f = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x1b, 0x5b, 0x57, 0x3b, 0xed, 0xc5, 0xb8, 0x4a, 0x29, 0x6e, 0xda, 0xdd, 0x53, 0x2f,
-	0x9d, 0xd0, 0xad, 0x07, 0x41, 0x10, 0xac, 0x82, 0xee, 0x61, 0x0f, 0x66, 0x05, 0xc1, 0x4b, 0x99,
-	0x24, 0x2f, 0xe9, 0x40, 0x93, 0x19, 0xe7, 0x9d, 0x96, 0xf6, 0x5b, 0xf8, 0x15, 0xfc, 0x36, 0x7b,
-	0xdc, 0x93, 0x88, 0x87, 0x45, 0xda, 0x2f, 0x22, 0x93, 0xcc, 0x66, 0xb7, 0xcd, 0x22, 0x78, 0x0b,
-	0xef, 0xfb, 0x3c, 0xbf, 0xf7, 0xdf, 0x84, 0xf4, 0x66, 0x6b, 0x09, 0x6a, 0xce, 0x32, 0xf0, 0x23,
-	0xa1, 0xc0, 0x5f, 0x8e, 0xfc, 0x04, 0x32, 0x40, 0x8e, 0x54, 0x2a, 0xa1, 0x85, 0xfb, 0xa4, 0x14,
-	0x50, 0x23, 0xa0, 0xcb, 0x51, 0xf7, 0xa8, 0xea, 0xd1, 0x6b, 0x09, 0xd6, 0xd1, 0x1d, 0xef, 0xa5,
-	0x79, 0xa6, 0x41, 0x45, 0x33, 0xc6, 0xb3, 0x29, 0x42, 0xb4, 0x50, 0x5c, 0xaf, 0x2b, 0x65, 0xba,
-	0xc3, 0x3d, 0x93, 0x14, 0xa8, 0xa7, 0x31, 0x47, 0xc9, 0x74, 0x34, 0xab, 0xca, 0x0f, 0x13, 0x91,
-	0x88, 0xfc, 0xd3, 0x37, 0x5f, 0x45, 0xf4, 0xe4, 0x67, 0x9d, 0xb4, 0x3f, 0x14, 0xba, 0x0b, 0xcd,
-	0x34, 0xb8, 0x9f, 0x49, 0x8b, 0x63, 0x3a, 0xb5, 0xde, 0x8e, 0xd3, 0x77, 0x06, 0xad, 0xd3, 0x31,
-	0xdd, 0x1b, 0xe9, 0x9e, 0x06, 0xe9, 0x72, 0x44, 0xef, 0x92, 0x02, 0xc2, 0x31, 0xb5, 0x01, 0x97,
-	0x91, 0x67, 0x3b, 0xed, 0x95, 0xfc, 0x07, 0x39, 0x7f, 0xb8, 0xcf, 0xdf, 0x11, 0x57, 0xc8, 0x4f,
-	0x4d, 0xfa, 0xbd, 0xcd, 0xde, 0x94, 0x78, 0x43, 0x9a, 0x29, 0xe3, 0xf3, 0x50, 0xac, 0x00, 0x3b,
-	0xf5, 0x7e, 0x7d, 0xd0, 0x3a, 0xed, 0xd2, 0xca, 0x25, 0xe8, 0x79, 0xa1, 0x99, 0x34, 0x2e, 0xaf,
-	0x7b, 0xb5, 0xe0, 0xd6, 0xe2, 0x7e, 0x22, 0x8f, 0x53, 0x40, 0x64, 0x09, 0x60, 0xa7, 0x91, 0xdb,
-	0xfd, 0x7b, 0xec, 0xb6, 0x9a, 0xa5, 0x9c, 0x17, 0x86, 0x2f, 0x8a, 0x49, 0x09, 0xca, 0x32, 0x4b,
-	0x8c, 0x7b, 0x4c, 0xda, 0x66, 0x97, 0x08, 0xdf, 0x16, 0x90, 0x45, 0xd0, 0x79, 0xd8, 0x77, 0x06,
-	0x8d, 0xc0, 0xec, 0xf7, 0xc2, 0x86, 0xdc, 0x97, 0xe4, 0xf9, 0xee, 0x62, 0x4a, 0xf1, 0x41, 0x2e,
-	0x3e, 0xbc, 0x3b, 0x6a, 0xe9, 0x3a, 0x26, 0x6d, 0x26, 0xe5, 0xad, 0xf6, 0x51, 0x01, 0x66, 0x52,
-	0xde, 0x48, 0x4e, 0x7e, 0x38, 0xe4, 0xc5, 0xbf, 0x9a, 0x75, 0x8f, 0x08, 0xb1, 0xc3, 0x4f, 0x79,
-	0x9c, 0xdf, 0xb9, 0x51, 0xae, 0xe3, 0x2c, 0x76, 0x43, 0x42, 0xec, 0x1c, 0x26, 0x6d, 0xce, 0xd4,
-	0x9c, 0xbc, 0x33, 0xf3, 0xfd, 0xbe, 0xee, 0xbd, 0x4e, 0xb8, 0x9e, 0x2d, 0x42, 0x1a, 0x89, 0xd4,
-	0x0f, 0x23, 0x39, 0xe4, 0x59, 0x26, 0x96, 0x4c, 0x73, 0x91, 0xa1, 0x5f, 0xae, 0x6c, 0x18, 0x09,
-	0x4c, 0x05, 0xfa, 0x0b, 0xcd, 0xe7, 0xf4, 0x23, 0xac, 0xde, 0xc6, 0xb1, 0x02, 0xc4, 0xa0, 0x69,
-	0xb1, 0x67, 0xf1, 0x24, 0xb8, 0xdc, 0x78, 0xce, 0xd5, 0xc6, 0x73, 0xfe, 0x6c, 0x3c, 0xe7, 0xfb,
-	0xd6, 0xab, 0x5d, 0x6d, 0xbd, 0xda, 0xaf, 0xad, 0x57, 0xfb, 0xfa, 0xea, 0x7f, 0x2a, 0xac, 0x8a,
-	0xf7, 0x9f, 0xff, 0x50, 0xe1, 0x41, 0xfe, 0xae, 0xc7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x25,
-	0x25, 0xf6, 0x63, 0xa6, 0x03, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0x12, 0x4f,
+	0x18, 0x66, 0x7f, 0xe5, 0x47, 0x61, 0xe0, 0xe2, 0xb4, 0x9a, 0x95, 0xd8, 0x85, 0xe2, 0x85, 0x0b,
+	0xbb, 0x01, 0x4c, 0x34, 0x31, 0x31, 0x11, 0x31, 0xda, 0x43, 0x13, 0xdd, 0x9a, 0x98, 0xf4, 0x42,
+	0x86, 0xdd, 0x37, 0x30, 0x09, 0xbb, 0x33, 0xee, 0x0c, 0x04, 0xbe, 0x85, 0x1f, 0xab, 0xc7, 0x1e,
+	0x8d, 0x87, 0xc6, 0xc0, 0xc5, 0xcf, 0xe0, 0xc9, 0xec, 0xec, 0x30, 0x2d, 0x7f, 0xb4, 0xf1, 0xb6,
+	0x3b, 0xef, 0xf3, 0x3c, 0xef, 0xfb, 0x3c, 0x33, 0x79, 0x51, 0x6d, 0xbc, 0xe0, 0x90, 0x4c, 0x48,
+	0x0c, 0x5e, 0xc0, 0x12, 0xf0, 0x66, 0x6d, 0x6f, 0x04, 0x31, 0x08, 0x2a, 0x5c, 0x9e, 0x30, 0xc9,
+	0xf0, 0x03, 0x03, 0x70, 0x53, 0x80, 0x3b, 0x6b, 0x57, 0x4f, 0x76, 0x39, 0x72, 0xc1, 0x41, 0x33,
+	0xaa, 0xdd, 0xad, 0x32, 0x8d, 0x25, 0x24, 0xc1, 0x98, 0xd0, 0x78, 0x20, 0x20, 0x98, 0x26, 0x54,
+	0x2e, 0x76, 0xda, 0x54, 0x5b, 0x5b, 0x24, 0xce, 0x84, 0x1c, 0x84, 0x54, 0x70, 0x22, 0x83, 0xf1,
+	0x2e, 0xfc, 0x78, 0xc4, 0x46, 0x4c, 0x7d, 0x7a, 0xe9, 0x57, 0x76, 0xda, 0xf8, 0x95, 0x47, 0x95,
+	0x77, 0x19, 0xee, 0x42, 0x12, 0x09, 0xf8, 0x13, 0x2a, 0x53, 0x11, 0x0d, 0x34, 0xd7, 0xb6, 0xea,
+	0x56, 0xb3, 0xdc, 0xe9, 0xba, 0x5b, 0x96, 0xf6, 0x0c, 0xe8, 0xce, 0xda, 0xee, 0x5d, 0x25, 0x1f,
+	0x51, 0x11, 0xe9, 0x03, 0x4c, 0xd0, 0xc3, 0x8d, 0xf1, 0x8c, 0xfe, 0x7f, 0x4a, 0xbf, 0xb5, 0xad,
+	0xbf, 0x01, 0xde, 0x51, 0x3e, 0x4a, 0xcb, 0x7d, 0x5d, 0x5d, 0xb7, 0x78, 0x85, 0x4a, 0x11, 0xa1,
+	0x93, 0x21, 0x9b, 0x83, 0xb0, 0x0f, 0xea, 0x07, 0xcd, 0x72, 0xa7, 0xea, 0xee, 0xdc, 0x84, 0x7b,
+	0x9e, 0x61, 0x7a, 0xf9, 0xab, 0x9b, 0x5a, 0xce, 0xbf, 0xa5, 0xe0, 0x8f, 0xa8, 0x18, 0x81, 0x10,
+	0x64, 0x04, 0xc2, 0xce, 0x2b, 0xba, 0xb7, 0x87, 0xae, 0xbb, 0x69, 0x95, 0xf3, 0x8c, 0xf0, 0x39,
+	0x21, 0x9c, 0x43, 0xa2, 0x35, 0x8d, 0x0c, 0x3e, 0x45, 0x95, 0x34, 0x4b, 0x01, 0x5f, 0xa6, 0x10,
+	0x07, 0x60, 0xff, 0x5f, 0xb7, 0x9a, 0x79, 0x3f, 0xcd, 0xf7, 0x42, 0x1f, 0xe1, 0x67, 0xe8, 0xd1,
+	0x66, 0x30, 0x06, 0x5c, 0x50, 0xe0, 0xe3, 0xbb, 0x56, 0x0d, 0xeb, 0x14, 0x55, 0x08, 0xe7, 0xb7,
+	0xd8, 0xc3, 0x4c, 0x98, 0x70, 0x6e, 0x20, 0xcf, 0x51, 0x81, 0x93, 0x84, 0x44, 0xc2, 0x2e, 0xaa,
+	0x88, 0x1f, 0xef, 0x31, 0xf3, 0x41, 0x01, 0xf4, 0xd8, 0x1a, 0x8e, 0x29, 0x3a, 0x5a, 0x0f, 0x03,
+	0xe1, 0xc0, 0x44, 0x52, 0x52, 0x91, 0x74, 0xfe, 0x1c, 0x49, 0xdf, 0x90, 0xf6, 0xa6, 0x82, 0xc3,
+	0xed, 0xba, 0x68, 0xfc, 0xb4, 0xd0, 0x93, 0xbf, 0x05, 0x8a, 0x4f, 0x10, 0xd2, 0x17, 0x34, 0xa0,
+	0xa1, 0x7a, 0x8b, 0x79, 0x73, 0x65, 0x67, 0x21, 0x1e, 0x22, 0xa4, 0xe7, 0x4b, 0xcb, 0xe9, 0x53,
+	0x2a, 0xf5, 0xde, 0xa4, 0xdd, 0xbe, 0xdf, 0xd4, 0x5e, 0x8e, 0xa8, 0x1c, 0x4f, 0x87, 0x6e, 0xc0,
+	0x22, 0x6f, 0x18, 0xf0, 0x16, 0x8d, 0x63, 0x36, 0x23, 0x92, 0xb2, 0x58, 0x78, 0xc6, 0x43, 0x2b,
+	0x60, 0x22, 0x62, 0xc2, 0x9b, 0x4a, 0x3a, 0x71, 0xdf, 0xc3, 0xfc, 0x75, 0x18, 0x26, 0x20, 0x84,
+	0x5f, 0xd2, 0xb2, 0x67, 0x21, 0x7e, 0x8b, 0x8a, 0x21, 0x4c, 0xe8, 0x0c, 0x92, 0x85, 0x7d, 0xa0,
+	0x92, 0x7c, 0xba, 0x27, 0x83, 0x7e, 0x06, 0x31, 0xde, 0xd6, 0x4f, 0x61, 0x4d, 0x6d, 0x5c, 0xa2,
+	0xda, 0x3d, 0x39, 0xdd, 0x67, 0xd6, 0x46, 0x87, 0x7a, 0x2a, 0xe5, 0xb4, 0xe2, 0xaf, 0x7f, 0x7b,
+	0xfe, 0xd5, 0xd2, 0xb1, 0xae, 0x97, 0x8e, 0xf5, 0x63, 0xe9, 0x58, 0x5f, 0x57, 0x4e, 0xee, 0x7a,
+	0xe5, 0xe4, 0xbe, 0xad, 0x9c, 0xdc, 0xe5, 0x8b, 0x7f, 0x09, 0x61, 0x9e, 0xad, 0x11, 0xb5, 0x97,
+	0x86, 0x05, 0xb5, 0x1e, 0xba, 0xbf, 0x07, 0x00, 0x50, 0xa4, 0xf5, 0x19, 0xed, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -228,6 +313,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DispatchedMessages) > 0 {
+		for iNdEx := len(m.DispatchedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DispatchedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.AppSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AppSequence))
 		i--
@@ -318,6 +427,16 @@ func (m *GenesisMailboxMessageWrapper) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Delivery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.MessageId.Size()
 		i -= size
@@ -336,6 +455,41 @@ func (m *GenesisMailboxMessageWrapper) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisDispatchedMessageWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisDispatchedMessageWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisDispatchedMessageWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MailboxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MailboxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -382,6 +536,14 @@ func (m *GenesisState) Size() (n int) {
 	if m.AppSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.AppSequence))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DispatchedMessages) > 0 {
+		for _, e := range m.DispatchedMessages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -396,6 +558,24 @@ func (m *GenesisMailboxMessageWrapper) Size() (n int) {
 	}
 	l = m.MessageId.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Delivery.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisDispatchedMessageWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MailboxId != 0 {
+		n += 1 + sovGenesis(uint64(m.MailboxId))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -631,6 +811,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DispatchedMessages = append(m.DispatchedMessages, GenesisDispatchedMessageWrapper{})
+			if err := m.DispatchedMessages[len(m.DispatchedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -734,6 +981,142 @@ func (m *GenesisMailboxMessageWrapper) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisDispatchedMessageWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisDispatchedMessageWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisDispatchedMessageWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			m.MailboxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MailboxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
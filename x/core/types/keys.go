@@ -6,12 +6,14 @@ const (
 )
 
 var (
+	ParamsKey             = []byte{ModuleId, 0}
 	MailboxesKey          = []byte{ModuleId, 1}
 	MailboxesSequenceKey  = []byte{ModuleId, 2}
-	MessagesKey           = []byte{ModuleId, 3}
+	MessagesKey           = []byte{ModuleId, 3} // legacy, only read by the v1 to v2 store migration
 	IsmRouterKey          = []byte{ModuleId, 4}
 	PostDispatchRouterKey = []byte{ModuleId, 5}
 	AppRouterKey          = []byte{ModuleId, 6}
-
-	// Leave 0 in case we add params in the future.
+	DispatchedMessagesKey = []byte{ModuleId, 7}
+	DispatchedIdsKey      = []byte{ModuleId, 8}
+	DeliveredMessagesKey  = []byte{ModuleId, 9}
 )
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -277,6 +280,8 @@ func (m *QueryDeliveredRequest) GetMessageId() string {
 // QueryDeliveredResponse ...
 type QueryDeliveredResponse struct {
 	Delivered bool `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// delivery is only set if the message was delivered.
+	Delivery *DeliveredMessage `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
 }
 
 func (m *QueryDeliveredResponse) Reset()         { *m = QueryDeliveredResponse{} }
@@ -319,6 +324,111 @@ func (m *QueryDeliveredResponse) GetDelivered() bool {
 	return false
 }
 
+func (m *QueryDeliveredResponse) GetDelivery() *DeliveredMessage {
+	if m != nil {
+		return m.Delivery
+	}
+	return nil
+}
+
+// QueryDeliveredMessageRequest ...
+type QueryDeliveredMessageRequest struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (m *QueryDeliveredMessageRequest) Reset()         { *m = QueryDeliveredMessageRequest{} }
+func (m *QueryDeliveredMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeliveredMessageRequest) ProtoMessage()    {}
+func (*QueryDeliveredMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{6}
+}
+func (m *QueryDeliveredMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeliveredMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeliveredMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeliveredMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeliveredMessageRequest.Merge(m, src)
+}
+func (m *QueryDeliveredMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeliveredMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeliveredMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeliveredMessageRequest proto.InternalMessageInfo
+
+func (m *QueryDeliveredMessageRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryDeliveredMessageRequest) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+// QueryDeliveredMessageResponse ...
+type QueryDeliveredMessageResponse struct {
+	Delivery DeliveredMessage `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery"`
+}
+
+func (m *QueryDeliveredMessageResponse) Reset()         { *m = QueryDeliveredMessageResponse{} }
+func (m *QueryDeliveredMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeliveredMessageResponse) ProtoMessage()    {}
+func (*QueryDeliveredMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{7}
+}
+func (m *QueryDeliveredMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeliveredMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeliveredMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeliveredMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeliveredMessageResponse.Merge(m, src)
+}
+func (m *QueryDeliveredMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeliveredMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeliveredMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeliveredMessageResponse proto.InternalMessageInfo
+
+func (m *QueryDeliveredMessageResponse) GetDelivery() DeliveredMessage {
+	if m != nil {
+		return m.Delivery
+	}
+	return DeliveredMessage{}
+}
+
 // QueryRecipientIsmRequest ...
 type QueryRecipientIsmRequest struct {
 	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
@@ -328,7 +438,7 @@ func (m *QueryRecipientIsmRequest) Reset()         { *m = QueryRecipientIsmReque
 func (m *QueryRecipientIsmRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRecipientIsmRequest) ProtoMessage()    {}
 func (*QueryRecipientIsmRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{6}
+	return fileDescriptor_312c522f209452f6, []int{8}
 }
 func (m *QueryRecipientIsmRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +483,7 @@ func (m *QueryRecipientIsmResponse) Reset()         { *m = QueryRecipientIsmResp
 func (m *QueryRecipientIsmResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRecipientIsmResponse) ProtoMessage()    {}
 func (*QueryRecipientIsmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{7}
+	return fileDescriptor_312c522f209452f6, []int{9}
 }
 func (m *QueryRecipientIsmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +531,7 @@ func (m *QueryVerifyDryRunRequest) Reset()         { *m = QueryVerifyDryRunReque
 func (m *QueryVerifyDryRunRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyDryRunRequest) ProtoMessage()    {}
 func (*QueryVerifyDryRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{8}
+	return fileDescriptor_312c522f209452f6, []int{10}
 }
 func (m *QueryVerifyDryRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +597,7 @@ func (m *QueryVerifyDryRunResponse) Reset()         { *m = QueryVerifyDryRunResp
 func (m *QueryVerifyDryRunResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyDryRunResponse) ProtoMessage()    {}
 func (*QueryVerifyDryRunResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{9}
+	return fileDescriptor_312c522f209452f6, []int{11}
 }
 func (m *QueryVerifyDryRunResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +641,7 @@ func (m *QueryRegisteredISMs) Reset()         { *m = QueryRegisteredISMs{} }
 func (m *QueryRegisteredISMs) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredISMs) ProtoMessage()    {}
 func (*QueryRegisteredISMs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{10}
+	return fileDescriptor_312c522f209452f6, []int{12}
 }
 func (m *QueryRegisteredISMs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +679,7 @@ func (m *QueryRegisteredISMsResponse) Reset()         { *m = QueryRegisteredISMs
 func (m *QueryRegisteredISMsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredISMsResponse) ProtoMessage()    {}
 func (*QueryRegisteredISMsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{11}
+	return fileDescriptor_312c522f209452f6, []int{13}
 }
 func (m *QueryRegisteredISMsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +723,7 @@ func (m *QueryRegisteredHooks) Reset()         { *m = QueryRegisteredHooks{} }
 func (m *QueryRegisteredHooks) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredHooks) ProtoMessage()    {}
 func (*QueryRegisteredHooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{12}
+	return fileDescriptor_312c522f209452f6, []int{14}
 }
 func (m *QueryRegisteredHooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +761,7 @@ func (m *QueryRegisteredHooksResponse) Reset()         { *m = QueryRegisteredHoo
 func (m *QueryRegisteredHooksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredHooksResponse) ProtoMessage()    {}
 func (*QueryRegisteredHooksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{13}
+	return fileDescriptor_312c522f209452f6, []int{15}
 }
 func (m *QueryRegisteredHooksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +805,7 @@ func (m *QueryRegisteredApps) Reset()         { *m = QueryRegisteredApps{} }
 func (m *QueryRegisteredApps) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredApps) ProtoMessage()    {}
 func (*QueryRegisteredApps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{14}
+	return fileDescriptor_312c522f209452f6, []int{16}
 }
 func (m *QueryRegisteredApps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +843,7 @@ func (m *QueryRegisteredAppsResponse) Reset()         { *m = QueryRegisteredApps
 func (m *QueryRegisteredAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredAppsResponse) ProtoMessage()    {}
 func (*QueryRegisteredAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_312c522f209452f6, []int{15}
+	return fileDescriptor_312c522f209452f6, []int{17}
 }
 func (m *QueryRegisteredAppsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,1238 +879,4748 @@ func (m *QueryRegisteredAppsResponse) GetIds() []uint32 {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryMailboxesRequest)(nil), "hyperlane.core.v1.QueryMailboxesRequest")
-	proto.RegisterType((*QueryMailboxesResponse)(nil), "hyperlane.core.v1.QueryMailboxesResponse")
-	proto.RegisterType((*QueryMailboxRequest)(nil), "hyperlane.core.v1.QueryMailboxRequest")
-	proto.RegisterType((*QueryMailboxResponse)(nil), "hyperlane.core.v1.QueryMailboxResponse")
-	proto.RegisterType((*QueryDeliveredRequest)(nil), "hyperlane.core.v1.QueryDeliveredRequest")
-	proto.RegisterType((*QueryDeliveredResponse)(nil), "hyperlane.core.v1.QueryDeliveredResponse")
-	proto.RegisterType((*QueryRecipientIsmRequest)(nil), "hyperlane.core.v1.QueryRecipientIsmRequest")
-	proto.RegisterType((*QueryRecipientIsmResponse)(nil), "hyperlane.core.v1.QueryRecipientIsmResponse")
-	proto.RegisterType((*QueryVerifyDryRunRequest)(nil), "hyperlane.core.v1.QueryVerifyDryRunRequest")
-	proto.RegisterType((*QueryVerifyDryRunResponse)(nil), "hyperlane.core.v1.QueryVerifyDryRunResponse")
-	proto.RegisterType((*QueryRegisteredISMs)(nil), "hyperlane.core.v1.QueryRegisteredISMs")
-	proto.RegisterType((*QueryRegisteredISMsResponse)(nil), "hyperlane.core.v1.QueryRegisteredISMsResponse")
-	proto.RegisterType((*QueryRegisteredHooks)(nil), "hyperlane.core.v1.QueryRegisteredHooks")
-	proto.RegisterType((*QueryRegisteredHooksResponse)(nil), "hyperlane.core.v1.QueryRegisteredHooksResponse")
-	proto.RegisterType((*QueryRegisteredApps)(nil), "hyperlane.core.v1.QueryRegisteredApps")
-	proto.RegisterType((*QueryRegisteredAppsResponse)(nil), "hyperlane.core.v1.QueryRegisteredAppsResponse")
+// QueryDispatchedMessagesRequest ...
+type QueryDispatchedMessagesRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("hyperlane/core/v1/query.proto", fileDescriptor_312c522f209452f6) }
-
-var fileDescriptor_312c522f209452f6 = []byte{
-	// 881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x94, 0xdd, 0xd6, 0xb3, 0xcb, 0xc2, 0x0e, 0xdb, 0x25, 0xeb, 0x4d, 0xdd, 0xca,
-	0xa2, 0x4d, 0x5b, 0xa8, 0x87, 0xa4, 0x02, 0x2a, 0x71, 0x40, 0x94, 0xaa, 0x10, 0x89, 0x4a, 0x60,
-	0x24, 0x90, 0xb8, 0x44, 0x93, 0x78, 0x70, 0x47, 0xc4, 0x1e, 0xd7, 0xe3, 0x44, 0x8d, 0xaa, 0x4a,
-	0x80, 0xb8, 0x83, 0xd4, 0x03, 0x12, 0x12, 0x57, 0xc4, 0x91, 0x3f, 0xa3, 0xc7, 0x4a, 0x5c, 0x38,
-	0x21, 0xd4, 0x22, 0xf1, 0x6f, 0xac, 0x3c, 0x1e, 0xdb, 0x71, 0x6a, 0x37, 0xbe, 0x44, 0x9e, 0x37,
-	0xef, 0xc7, 0xe7, 0x7d, 0x33, 0x6f, 0x34, 0x60, 0xe5, 0x78, 0xe2, 0x93, 0x60, 0x88, 0x3d, 0x82,
-	0x06, 0x2c, 0x20, 0x68, 0xdc, 0x46, 0x27, 0x23, 0x12, 0x4c, 0x4c, 0x3f, 0x60, 0x21, 0x83, 0x8f,
-	0xd3, 0x6d, 0x33, 0xda, 0x36, 0xc7, 0x6d, 0x6d, 0x7b, 0xc0, 0xb8, 0xcb, 0x38, 0xea, 0x63, 0x4e,
-	0x62, 0x5f, 0x34, 0x6e, 0xf7, 0x49, 0x88, 0xdb, 0xc8, 0xc7, 0x0e, 0xf5, 0x70, 0x48, 0x99, 0x17,
-	0x87, 0x6b, 0x05, 0xd9, 0xc3, 0x89, 0x4f, 0xb8, 0xdc, 0x6e, 0x3a, 0x8c, 0x39, 0x43, 0x82, 0xb0,
-	0x4f, 0x11, 0xf6, 0x3c, 0x16, 0x8a, 0xd8, 0x64, 0xf7, 0x31, 0x76, 0xa9, 0xc7, 0x90, 0xf8, 0x95,
-	0xa6, 0x27, 0x0e, 0x73, 0x98, 0xf8, 0x44, 0xd1, 0x57, 0x6c, 0x35, 0x7a, 0x60, 0xf9, 0xf3, 0x88,
-	0xe3, 0x08, 0xd3, 0x61, 0x9f, 0x9d, 0x12, 0x6e, 0x91, 0x93, 0x11, 0xe1, 0x21, 0x3c, 0x04, 0x20,
-	0x43, 0x6a, 0x28, 0x6b, 0xca, 0xe6, 0x83, 0xce, 0x86, 0x19, 0xf3, 0x9b, 0x11, 0xbf, 0x19, 0xf7,
-	0x2a, 0xf9, 0xcd, 0xcf, 0xb0, 0x43, 0x64, 0xac, 0x35, 0x15, 0x69, 0xfc, 0xae, 0x80, 0xa7, 0xb3,
-	0x15, 0xb8, 0xcf, 0x3c, 0x4e, 0xe0, 0x47, 0x40, 0x75, 0x13, 0x63, 0x43, 0x59, 0x5b, 0xd8, 0x7c,
-	0xd0, 0xd1, 0xcc, 0x5b, 0xa2, 0x99, 0x32, 0x70, 0x5f, 0xbd, 0xfc, 0x67, 0xb5, 0xf6, 0xc7, 0xff,
-	0x7f, 0x6e, 0x2b, 0x56, 0x16, 0x07, 0x3f, 0xce, 0x71, 0xd6, 0x05, 0x67, 0x6b, 0x2e, 0x67, 0x4c,
-	0x90, 0x03, 0x5d, 0x07, 0xaf, 0x4d, 0x73, 0x26, 0x3a, 0x3c, 0x02, 0x75, 0x6a, 0x8b, 0xfe, 0x55,
-	0xab, 0x4e, 0x6d, 0xe3, 0x2b, 0xf0, 0x24, 0xef, 0x26, 0x9b, 0xf9, 0x00, 0x2c, 0x4a, 0x28, 0x29,
-	0x56, 0xc5, 0x56, 0x92, 0x28, 0xe3, 0x50, 0xfe, 0x13, 0x07, 0x64, 0x48, 0xc7, 0x24, 0x20, 0x76,
-	0x09, 0x01, 0x5c, 0x01, 0xc0, 0x25, 0x9c, 0x63, 0x87, 0xf4, 0xa8, 0x2d, 0x3a, 0x56, 0x2d, 0x55,
-	0x5a, 0xba, 0xb6, 0xf1, 0xae, 0xd4, 0x7b, 0x2a, 0x8f, 0x44, 0x6c, 0x02, 0xd5, 0x4e, 0x8c, 0x22,
-	0xdf, 0x92, 0x95, 0x19, 0x8c, 0x3d, 0xd0, 0x10, 0x71, 0x16, 0x19, 0x50, 0x9f, 0x12, 0x2f, 0xec,
-	0x72, 0x37, 0x41, 0x68, 0x02, 0x35, 0x48, 0xcc, 0x92, 0x24, 0x33, 0x18, 0x1d, 0xf0, 0xac, 0x20,
-	0x52, 0x16, 0x5d, 0x06, 0xf7, 0x29, 0x77, 0x7b, 0x69, 0x07, 0xf7, 0x28, 0x77, 0xbb, 0xb6, 0xf1,
-	0xa3, 0x22, 0xcb, 0x7d, 0x49, 0x02, 0xfa, 0xcd, 0xe4, 0x20, 0x98, 0x58, 0x23, 0x2f, 0x29, 0x57,
-	0x1c, 0x03, 0x1b, 0x60, 0x51, 0xb6, 0x29, 0xbb, 0x4e, 0x96, 0x50, 0x03, 0x4b, 0x2e, 0x09, 0xb1,
-	0x8d, 0x43, 0xdc, 0x58, 0x10, 0x5b, 0xe9, 0x1a, 0x3e, 0x07, 0xaa, 0x83, 0x79, 0x6f, 0x48, 0x5d,
-	0x1a, 0x36, 0x5e, 0x8a, 0x37, 0x1d, 0xcc, 0x3f, 0x8d, 0xd6, 0xc6, 0x7b, 0x12, 0x3d, 0x4f, 0x21,
-	0xd1, 0x35, 0xb0, 0x34, 0x8e, 0xec, 0x34, 0x95, 0x2b, 0x5d, 0x1b, 0xcb, 0xf2, 0xb4, 0x58, 0xc4,
-	0xa1, 0x3c, 0x8c, 0x04, 0xec, 0x7e, 0x71, 0xc4, 0x0d, 0x04, 0x9e, 0x17, 0x98, 0xd3, 0x8c, 0xaf,
-	0x82, 0x05, 0x6a, 0xc7, 0x67, 0xfd, 0x65, 0x2b, 0xfa, 0x34, 0x9e, 0xca, 0xe3, 0x94, 0x05, 0x7c,
-	0xc2, 0xd8, 0xb7, 0xdc, 0x78, 0x1b, 0x34, 0x8b, 0xec, 0x77, 0x64, 0xba, 0x4d, 0xf4, 0xa1, 0xef,
-	0x17, 0x11, 0x45, 0xe6, 0xf2, 0x3c, 0x9d, 0x5f, 0x54, 0x70, 0x4f, 0x44, 0xc0, 0xef, 0x15, 0xa0,
-	0xa6, 0x53, 0x0b, 0x37, 0x0b, 0xce, 0x73, 0xe1, 0xd5, 0xa1, 0x6d, 0x55, 0xf0, 0x8c, 0xcb, 0x1b,
-	0xab, 0x3f, 0xfc, 0xf5, 0xdf, 0x45, 0xfd, 0x19, 0x7c, 0x1d, 0x65, 0xb7, 0xdd, 0xb8, 0x8d, 0xb2,
-	0xf1, 0xfe, 0x4e, 0x01, 0x8b, 0x32, 0x0c, 0x6e, 0xcc, 0xc9, 0x9b, 0xd4, 0x6f, 0xcd, 0xf5, 0x93,
-	0xd5, 0xdf, 0x10, 0xd5, 0x75, 0xd8, 0x2c, 0xa9, 0x8e, 0xce, 0xa8, 0x7d, 0x0e, 0x7f, 0x53, 0x80,
-	0x9a, 0x0e, 0x53, 0xb9, 0x0c, 0xb3, 0x73, 0x5b, 0x2e, 0xc3, 0xad, 0xc9, 0x34, 0xde, 0x17, 0x20,
-	0xef, 0xc0, 0xdd, 0xbb, 0x40, 0x50, 0x3a, 0xab, 0xe8, 0x2c, 0xbb, 0x00, 0xce, 0xe1, 0xaf, 0x0a,
-	0x78, 0x38, 0x3d, 0x7a, 0xf0, 0xcd, 0xb2, 0xc2, 0x05, 0xa3, 0xad, 0xbd, 0x55, 0xcd, 0x59, 0x82,
-	0x22, 0x01, 0xba, 0x05, 0x5b, 0x79, 0xd0, 0xf4, 0x2e, 0xe8, 0x51, 0xee, 0xa2, 0xb3, 0x74, 0x79,
-	0x0e, 0x7f, 0x52, 0xc0, 0xc3, 0xe9, 0xe1, 0x2a, 0x87, 0x2b, 0xb8, 0x08, 0xca, 0xe1, 0x8a, 0xe6,
-	0xb5, 0xec, 0xef, 0x14, 0x33, 0x3b, 0xe9, 0xd9, 0xc1, 0xa4, 0x17, 0x8c, 0xbc, 0x88, 0xe8, 0x51,
-	0x7e, 0x3c, 0xcb, 0x0f, 0x56, 0xde, 0x4f, 0x33, 0xab, 0xf9, 0xa5, 0x40, 0xeb, 0x02, 0x68, 0x15,
-	0xae, 0xcc, 0xaa, 0x95, 0x78, 0x47, 0x72, 0x71, 0x78, 0xa1, 0x80, 0x57, 0x66, 0xe6, 0x1c, 0xb6,
-	0xe6, 0x97, 0x12, 0x8e, 0x1a, 0xaa, 0xe8, 0x98, 0x42, 0x6d, 0x08, 0xa8, 0x35, 0xa8, 0x97, 0x42,
-	0x1d, 0x0b, 0x82, 0xbc, 0x4e, 0xd1, 0xa5, 0x51, 0x45, 0xa7, 0xc8, 0xaf, 0x8a, 0x4e, 0xd3, 0x97,
-	0x50, 0x05, 0x9d, 0xb0, 0xef, 0xf3, 0x7d, 0xeb, 0xf2, 0x5a, 0x57, 0xae, 0xae, 0x75, 0xe5, 0xdf,
-	0x6b, 0x5d, 0xf9, 0xf9, 0x46, 0xaf, 0x5d, 0xdd, 0xe8, 0xb5, 0xbf, 0x6f, 0xf4, 0xda, 0xd7, 0x7b,
-	0x0e, 0x0d, 0x8f, 0x47, 0x7d, 0x73, 0xc0, 0x5c, 0xd4, 0x1f, 0xf8, 0x3b, 0xd4, 0xf3, 0xd8, 0x38,
-	0x7e, 0x10, 0x65, 0x29, 0x77, 0xe4, 0xe3, 0xeb, 0x34, 0x7e, 0x4f, 0x89, 0xc7, 0x54, 0xff, 0xbe,
-	0x78, 0x06, 0xed, 0xbe, 0x08, 0x00, 0x00, 0xff, 0xff, 0x95, 0x24, 0xbb, 0x53, 0xcc, 0x09, 0x00,
-	0x00,
+func (m *QueryDispatchedMessagesRequest) Reset()         { *m = QueryDispatchedMessagesRequest{} }
+func (m *QueryDispatchedMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDispatchedMessagesRequest) ProtoMessage()    {}
+func (*QueryDispatchedMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{18}
+}
+func (m *QueryDispatchedMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDispatchedMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDispatchedMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDispatchedMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDispatchedMessagesRequest.Merge(m, src)
+}
+func (m *QueryDispatchedMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDispatchedMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDispatchedMessagesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDispatchedMessagesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Mailboxes ...
-	Mailboxes(ctx context.Context, in *QueryMailboxesRequest, opts ...grpc.CallOption) (*QueryMailboxesResponse, error)
-	// Mailbox ...
-	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
-	// Delivered ...
-	Delivered(ctx context.Context, in *QueryDeliveredRequest, opts ...grpc.CallOption) (*QueryDeliveredResponse, error)
-	// RecipientIsm returns the recipient ISM ID for a registered application.
-	//
-	// The recipient is globally unique as every application ID registered on the
-	// core module is unique. This means that one application cannot be registered
-	// to two mailboxes, resulting in a mailbox-independent lookup.
-	RecipientIsm(ctx context.Context, in *QueryRecipientIsmRequest, opts ...grpc.CallOption) (*QueryRecipientIsmResponse, error)
-	// VerifyDryRun ...
-	VerifyDryRun(ctx context.Context, in *QueryVerifyDryRunRequest, opts ...grpc.CallOption) (*QueryVerifyDryRunResponse, error)
-	// RegisteredISMs ...
-	RegisteredISMs(ctx context.Context, in *QueryRegisteredISMs, opts ...grpc.CallOption) (*QueryRegisteredISMsResponse, error)
-	// RegisteredHooks ...
-	RegisteredHooks(ctx context.Context, in *QueryRegisteredHooks, opts ...grpc.CallOption) (*QueryRegisteredHooksResponse, error)
-	// RegisteredApps ...
-	RegisteredApps(ctx context.Context, in *QueryRegisteredApps, opts ...grpc.CallOption) (*QueryRegisteredAppsResponse, error)
+func (m *QueryDispatchedMessagesRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryDispatchedMessagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryDispatchedMessagesResponse ...
+type QueryDispatchedMessagesResponse struct {
+	Messages []DispatchedMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Mailboxes(ctx context.Context, in *QueryMailboxesRequest, opts ...grpc.CallOption) (*QueryMailboxesResponse, error) {
-	out := new(QueryMailboxesResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Mailboxes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDispatchedMessagesResponse) Reset()         { *m = QueryDispatchedMessagesResponse{} }
+func (m *QueryDispatchedMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDispatchedMessagesResponse) ProtoMessage()    {}
+func (*QueryDispatchedMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{19}
+}
+func (m *QueryDispatchedMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDispatchedMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDispatchedMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDispatchedMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDispatchedMessagesResponse.Merge(m, src)
+}
+func (m *QueryDispatchedMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDispatchedMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDispatchedMessagesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error) {
-	out := new(QueryMailboxResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Mailbox", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDispatchedMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryDispatchedMessagesResponse) GetMessages() []DispatchedMessage {
+	if m != nil {
+		return m.Messages
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Delivered(ctx context.Context, in *QueryDeliveredRequest, opts ...grpc.CallOption) (*QueryDeliveredResponse, error) {
-	out := new(QueryDeliveredResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Delivered", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDispatchedMessagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RecipientIsm(ctx context.Context, in *QueryRecipientIsmRequest, opts ...grpc.CallOption) (*QueryRecipientIsmResponse, error) {
-	out := new(QueryRecipientIsmResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RecipientIsm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDispatchedMessageRequest ...
+type QueryDispatchedMessageRequest struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 }
 
-func (c *queryClient) VerifyDryRun(ctx context.Context, in *QueryVerifyDryRunRequest, opts ...grpc.CallOption) (*QueryVerifyDryRunResponse, error) {
-	out := new(QueryVerifyDryRunResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/VerifyDryRun", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDispatchedMessageRequest) Reset()         { *m = QueryDispatchedMessageRequest{} }
+func (m *QueryDispatchedMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDispatchedMessageRequest) ProtoMessage()    {}
+func (*QueryDispatchedMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{20}
+}
+func (m *QueryDispatchedMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDispatchedMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDispatchedMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDispatchedMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDispatchedMessageRequest.Merge(m, src)
+}
+func (m *QueryDispatchedMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDispatchedMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDispatchedMessageRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) RegisteredISMs(ctx context.Context, in *QueryRegisteredISMs, opts ...grpc.CallOption) (*QueryRegisteredISMsResponse, error) {
-	out := new(QueryRegisteredISMsResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RegisteredISMs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDispatchedMessageRequest proto.InternalMessageInfo
+
+func (m *QueryDispatchedMessageRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RegisteredHooks(ctx context.Context, in *QueryRegisteredHooks, opts ...grpc.CallOption) (*QueryRegisteredHooksResponse, error) {
-	out := new(QueryRegisteredHooksResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RegisteredHooks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDispatchedMessageRequest) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RegisteredApps(ctx context.Context, in *QueryRegisteredApps, opts ...grpc.CallOption) (*QueryRegisteredAppsResponse, error) {
-	out := new(QueryRegisteredAppsResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RegisteredApps", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryDispatchedMessageByNonceRequest ...
+type QueryDispatchedMessageByNonceRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nonce uint32 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryDispatchedMessageByNonceRequest) Reset()         { *m = QueryDispatchedMessageByNonceRequest{} }
+func (m *QueryDispatchedMessageByNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDispatchedMessageByNonceRequest) ProtoMessage()    {}
+func (*QueryDispatchedMessageByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{21}
+}
+func (m *QueryDispatchedMessageByNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDispatchedMessageByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDispatchedMessageByNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDispatchedMessageByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDispatchedMessageByNonceRequest.Merge(m, src)
+}
+func (m *QueryDispatchedMessageByNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDispatchedMessageByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDispatchedMessageByNonceRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Mailboxes ...
-	Mailboxes(context.Context, *QueryMailboxesRequest) (*QueryMailboxesResponse, error)
-	// Mailbox ...
-	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
-	// Delivered ...
-	Delivered(context.Context, *QueryDeliveredRequest) (*QueryDeliveredResponse, error)
-	// RecipientIsm returns the recipient ISM ID for a registered application.
-	//
-	// The recipient is globally unique as every application ID registered on the
-	// core module is unique. This means that one application cannot be registered
-	// to two mailboxes, resulting in a mailbox-independent lookup.
-	RecipientIsm(context.Context, *QueryRecipientIsmRequest) (*QueryRecipientIsmResponse, error)
-	// VerifyDryRun ...
-	VerifyDryRun(context.Context, *QueryVerifyDryRunRequest) (*QueryVerifyDryRunResponse, error)
-	// RegisteredISMs ...
-	RegisteredISMs(context.Context, *QueryRegisteredISMs) (*QueryRegisteredISMsResponse, error)
-	// RegisteredHooks ...
-	RegisteredHooks(context.Context, *QueryRegisteredHooks) (*QueryRegisteredHooksResponse, error)
-	// RegisteredApps ...
-	RegisteredApps(context.Context, *QueryRegisteredApps) (*QueryRegisteredAppsResponse, error)
+var xxx_messageInfo_QueryDispatchedMessageByNonceRequest proto.InternalMessageInfo
+
+func (m *QueryDispatchedMessageByNonceRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDispatchedMessageByNonceRequest) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Mailboxes(ctx context.Context, req *QueryMailboxesRequest) (*QueryMailboxesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mailboxes not implemented")
+// QueryDispatchedMessageResponse ...
+type QueryDispatchedMessageResponse struct {
+	Message DispatchedMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 }
-func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
+
+func (m *QueryDispatchedMessageResponse) Reset()         { *m = QueryDispatchedMessageResponse{} }
+func (m *QueryDispatchedMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDispatchedMessageResponse) ProtoMessage()    {}
+func (*QueryDispatchedMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{22}
 }
-func (*UnimplementedQueryServer) Delivered(ctx context.Context, req *QueryDeliveredRequest) (*QueryDeliveredResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delivered not implemented")
+func (m *QueryDispatchedMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RecipientIsm(ctx context.Context, req *QueryRecipientIsmRequest) (*QueryRecipientIsmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecipientIsm not implemented")
+func (m *QueryDispatchedMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDispatchedMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VerifyDryRun(ctx context.Context, req *QueryVerifyDryRunRequest) (*QueryVerifyDryRunResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyDryRun not implemented")
+func (m *QueryDispatchedMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDispatchedMessageResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RegisteredISMs(ctx context.Context, req *QueryRegisteredISMs) (*QueryRegisteredISMsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredISMs not implemented")
+func (m *QueryDispatchedMessageResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RegisteredHooks(ctx context.Context, req *QueryRegisteredHooks) (*QueryRegisteredHooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredHooks not implemented")
+func (m *QueryDispatchedMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDispatchedMessageResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) RegisteredApps(ctx context.Context, req *QueryRegisteredApps) (*QueryRegisteredAppsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredApps not implemented")
+
+var xxx_messageInfo_QueryDispatchedMessageResponse proto.InternalMessageInfo
+
+func (m *QueryDispatchedMessageResponse) GetMessage() DispatchedMessage {
+	if m != nil {
+		return m.Message
+	}
+	return DispatchedMessage{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryLatestDispatchedIdRequest ...
+type QueryLatestDispatchedIdRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_Mailboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mailboxes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/Mailboxes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mailboxes(ctx, req.(*QueryMailboxesRequest))
+func (m *QueryLatestDispatchedIdRequest) Reset()         { *m = QueryLatestDispatchedIdRequest{} }
+func (m *QueryLatestDispatchedIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDispatchedIdRequest) ProtoMessage()    {}
+func (*QueryLatestDispatchedIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{23}
+}
+func (m *QueryLatestDispatchedIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestDispatchedIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDispatchedIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLatestDispatchedIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDispatchedIdRequest.Merge(m, src)
+}
+func (m *QueryLatestDispatchedIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestDispatchedIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDispatchedIdRequest.DiscardUnknown(m)
 }
 
-func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMailboxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mailbox(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/Mailbox",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mailbox(ctx, req.(*QueryMailboxRequest))
+var xxx_messageInfo_QueryLatestDispatchedIdRequest proto.InternalMessageInfo
+
+func (m *QueryLatestDispatchedIdRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Delivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDeliveredRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Delivered(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/Delivered",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Delivered(ctx, req.(*QueryDeliveredRequest))
+// QueryLatestDispatchedIdResponse ...
+type QueryLatestDispatchedIdResponse struct {
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (m *QueryLatestDispatchedIdResponse) Reset()         { *m = QueryLatestDispatchedIdResponse{} }
+func (m *QueryLatestDispatchedIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDispatchedIdResponse) ProtoMessage()    {}
+func (*QueryLatestDispatchedIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{24}
+}
+func (m *QueryLatestDispatchedIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestDispatchedIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDispatchedIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLatestDispatchedIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDispatchedIdResponse.Merge(m, src)
+}
+func (m *QueryLatestDispatchedIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestDispatchedIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDispatchedIdResponse.DiscardUnknown(m)
 }
 
-func _Query_RecipientIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecipientIsmRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestDispatchedIdResponse proto.InternalMessageInfo
+
+func (m *QueryLatestDispatchedIdResponse) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecipientIsm(ctx, in)
+	return ""
+}
+
+// QueryQuoteDispatchRequest ...
+type QueryQuoteDispatchRequest struct {
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DestinationDomain string `protobuf:"bytes,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Sender            string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient         string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// body is the hex encoded message body.
+	Body         string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CustomHookId string `protobuf:"bytes,6,opt,name=custom_hook_id,json=customHookId,proto3" json:"custom_hook_id,omitempty"`
+	// custom_hook_metadata is the hex encoded custom hook metadata.
+	CustomHookMetadata string `protobuf:"bytes,7,opt,name=custom_hook_metadata,json=customHookMetadata,proto3" json:"custom_hook_metadata,omitempty"`
+	GasLimit           string `protobuf:"bytes,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *QueryQuoteDispatchRequest) Reset()         { *m = QueryQuoteDispatchRequest{} }
+func (m *QueryQuoteDispatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteDispatchRequest) ProtoMessage()    {}
+func (*QueryQuoteDispatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{25}
+}
+func (m *QueryQuoteDispatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteDispatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteDispatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/RecipientIsm",
+}
+func (m *QueryQuoteDispatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteDispatchRequest.Merge(m, src)
+}
+func (m *QueryQuoteDispatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteDispatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteDispatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteDispatchRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteDispatchRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecipientIsm(ctx, req.(*QueryRecipientIsmRequest))
+	return ""
+}
+
+func (m *QueryQuoteDispatchRequest) GetDestinationDomain() string {
+	if m != nil {
+		return m.DestinationDomain
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_VerifyDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerifyDryRunRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryQuoteDispatchRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerifyDryRun(ctx, in)
+	return ""
+}
+
+func (m *QueryQuoteDispatchRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/VerifyDryRun",
+	return ""
+}
+
+func (m *QueryQuoteDispatchRequest) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerifyDryRun(ctx, req.(*QueryVerifyDryRunRequest))
+	return ""
+}
+
+func (m *QueryQuoteDispatchRequest) GetCustomHookId() string {
+	if m != nil {
+		return m.CustomHookId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RegisteredISMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredISMs)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryQuoteDispatchRequest) GetCustomHookMetadata() string {
+	if m != nil {
+		return m.CustomHookMetadata
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredISMs(ctx, in)
+	return ""
+}
+
+func (m *QueryQuoteDispatchRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/RegisteredISMs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredISMs(ctx, req.(*QueryRegisteredISMs))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_RegisteredHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredHooks)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredHooks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/RegisteredHooks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredHooks(ctx, req.(*QueryRegisteredHooks))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryQuoteDispatchResponse ...
+type QueryQuoteDispatchResponse struct {
+	// total is the sum of all hook quotes.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// required_hook is the quote of the required hook of the mailbox.
+	RequiredHook HookQuote `protobuf:"bytes,2,opt,name=required_hook,json=requiredHook,proto3" json:"required_hook"`
+	// default_hook is the quote of the default hook of the mailbox or, if set,
+	// of the custom hook which overrides it.
+	DefaultHook HookQuote `protobuf:"bytes,3,opt,name=default_hook,json=defaultHook,proto3" json:"default_hook"`
 }
 
-func _Query_RegisteredApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredApps)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredApps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.core.v1.Query/RegisteredApps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredApps(ctx, req.(*QueryRegisteredApps))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryQuoteDispatchResponse) Reset()         { *m = QueryQuoteDispatchResponse{} }
+func (m *QueryQuoteDispatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteDispatchResponse) ProtoMessage()    {}
+func (*QueryQuoteDispatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{26}
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hyperlane.core.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Mailboxes",
-			Handler:    _Query_Mailboxes_Handler,
-		},
-		{
-			MethodName: "Mailbox",
-			Handler:    _Query_Mailbox_Handler,
-		},
-		{
-			MethodName: "Delivered",
-			Handler:    _Query_Delivered_Handler,
-		},
-		{
-			MethodName: "RecipientIsm",
-			Handler:    _Query_RecipientIsm_Handler,
-		},
-		{
-			MethodName: "VerifyDryRun",
-			Handler:    _Query_VerifyDryRun_Handler,
-		},
-		{
-			MethodName: "RegisteredISMs",
-			Handler:    _Query_RegisteredISMs_Handler,
-		},
-		{
-			MethodName: "RegisteredHooks",
-			Handler:    _Query_RegisteredHooks_Handler,
-		},
-		{
-			MethodName: "RegisteredApps",
-			Handler:    _Query_RegisteredApps_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hyperlane/core/v1/query.proto",
+func (m *QueryQuoteDispatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryMailboxesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuoteDispatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteDispatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryQuoteDispatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteDispatchResponse.Merge(m, src)
+}
+func (m *QueryQuoteDispatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteDispatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteDispatchResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryQuoteDispatchResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteDispatchResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
 }
 
-func (m *QueryMailboxesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryQuoteDispatchResponse) GetRequiredHook() HookQuote {
+	if m != nil {
+		return m.RequiredHook
 	}
-	return len(dAtA) - i, nil
+	return HookQuote{}
 }
 
-func (m *QueryMailboxesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuoteDispatchResponse) GetDefaultHook() HookQuote {
+	if m != nil {
+		return m.DefaultHook
 	}
-	return dAtA[:n], nil
+	return HookQuote{}
 }
 
-func (m *QueryMailboxesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HookQuote is the fee quoted by a single post dispatch hook.
+type HookQuote struct {
+	HookId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins                    `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *QueryMailboxesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Mailboxes) > 0 {
-		for iNdEx := len(m.Mailboxes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mailboxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *HookQuote) Reset()         { *m = HookQuote{} }
+func (m *HookQuote) String() string { return proto.CompactTextString(m) }
+func (*HookQuote) ProtoMessage()    {}
+func (*HookQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{27}
+}
+func (m *HookQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMailboxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *HookQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookQuote.Merge(m, src)
 }
-
-func (m *QueryMailboxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HookQuote) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMailboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *HookQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookQuote.DiscardUnknown(m)
 }
 
-func (m *QueryMailboxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HookQuote proto.InternalMessageInfo
+
+func (m *HookQuote) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMailboxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest ...
+type QueryParamsRequest struct {
 }
 
-func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Mailbox.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{28}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDeliveredRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryDeliveredRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDeliveredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MessageId) > 0 {
-		i -= len(m.MessageId)
-		copy(dAtA[i:], m.MessageId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse ...
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c522f209452f6, []int{29}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDeliveredResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryDeliveredResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryMailboxesRequest)(nil), "hyperlane.core.v1.QueryMailboxesRequest")
+	proto.RegisterType((*QueryMailboxesResponse)(nil), "hyperlane.core.v1.QueryMailboxesResponse")
+	proto.RegisterType((*QueryMailboxRequest)(nil), "hyperlane.core.v1.QueryMailboxRequest")
+	proto.RegisterType((*QueryMailboxResponse)(nil), "hyperlane.core.v1.QueryMailboxResponse")
+	proto.RegisterType((*QueryDeliveredRequest)(nil), "hyperlane.core.v1.QueryDeliveredRequest")
+	proto.RegisterType((*QueryDeliveredResponse)(nil), "hyperlane.core.v1.QueryDeliveredResponse")
+	proto.RegisterType((*QueryDeliveredMessageRequest)(nil), "hyperlane.core.v1.QueryDeliveredMessageRequest")
+	proto.RegisterType((*QueryDeliveredMessageResponse)(nil), "hyperlane.core.v1.QueryDeliveredMessageResponse")
+	proto.RegisterType((*QueryRecipientIsmRequest)(nil), "hyperlane.core.v1.QueryRecipientIsmRequest")
+	proto.RegisterType((*QueryRecipientIsmResponse)(nil), "hyperlane.core.v1.QueryRecipientIsmResponse")
+	proto.RegisterType((*QueryVerifyDryRunRequest)(nil), "hyperlane.core.v1.QueryVerifyDryRunRequest")
+	proto.RegisterType((*QueryVerifyDryRunResponse)(nil), "hyperlane.core.v1.QueryVerifyDryRunResponse")
+	proto.RegisterType((*QueryRegisteredISMs)(nil), "hyperlane.core.v1.QueryRegisteredISMs")
+	proto.RegisterType((*QueryRegisteredISMsResponse)(nil), "hyperlane.core.v1.QueryRegisteredISMsResponse")
+	proto.RegisterType((*QueryRegisteredHooks)(nil), "hyperlane.core.v1.QueryRegisteredHooks")
+	proto.RegisterType((*QueryRegisteredHooksResponse)(nil), "hyperlane.core.v1.QueryRegisteredHooksResponse")
+	proto.RegisterType((*QueryRegisteredApps)(nil), "hyperlane.core.v1.QueryRegisteredApps")
+	proto.RegisterType((*QueryRegisteredAppsResponse)(nil), "hyperlane.core.v1.QueryRegisteredAppsResponse")
+	proto.RegisterType((*QueryDispatchedMessagesRequest)(nil), "hyperlane.core.v1.QueryDispatchedMessagesRequest")
+	proto.RegisterType((*QueryDispatchedMessagesResponse)(nil), "hyperlane.core.v1.QueryDispatchedMessagesResponse")
+	proto.RegisterType((*QueryDispatchedMessageRequest)(nil), "hyperlane.core.v1.QueryDispatchedMessageRequest")
+	proto.RegisterType((*QueryDispatchedMessageByNonceRequest)(nil), "hyperlane.core.v1.QueryDispatchedMessageByNonceRequest")
+	proto.RegisterType((*QueryDispatchedMessageResponse)(nil), "hyperlane.core.v1.QueryDispatchedMessageResponse")
+	proto.RegisterType((*QueryLatestDispatchedIdRequest)(nil), "hyperlane.core.v1.QueryLatestDispatchedIdRequest")
+	proto.RegisterType((*QueryLatestDispatchedIdResponse)(nil), "hyperlane.core.v1.QueryLatestDispatchedIdResponse")
+	proto.RegisterType((*QueryQuoteDispatchRequest)(nil), "hyperlane.core.v1.QueryQuoteDispatchRequest")
+	proto.RegisterType((*QueryQuoteDispatchResponse)(nil), "hyperlane.core.v1.QueryQuoteDispatchResponse")
+	proto.RegisterType((*HookQuote)(nil), "hyperlane.core.v1.HookQuote")
+	proto.RegisterType((*QueryParamsRequest)(nil), "hyperlane.core.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hyperlane.core.v1.QueryParamsResponse")
 }
 
-func (m *QueryDeliveredResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Delivered {
-		i--
-		if m.Delivered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("hyperlane/core/v1/query.proto", fileDescriptor_312c522f209452f6) }
+
+var fileDescriptor_312c522f209452f6 = []byte{
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x5f, 0x6f, 0xd4, 0xc6,
+	0x16, 0xc0, 0xe3, 0x84, 0x6c, 0xb2, 0x87, 0x84, 0x4b, 0x86, 0x90, 0xbb, 0x31, 0x9b, 0x0d, 0xf2,
+	0x0d, 0x49, 0xe0, 0x92, 0x75, 0x12, 0x2e, 0x17, 0x2a, 0x40, 0x40, 0x82, 0x28, 0x4b, 0x13, 0x04,
+	0x46, 0x6a, 0xa5, 0xaa, 0xd2, 0xca, 0xbb, 0x1e, 0x76, 0x47, 0x59, 0x7b, 0x1c, 0x8f, 0x37, 0xcd,
+	0x2a, 0x8a, 0xd4, 0x56, 0x7d, 0x6f, 0x25, 0x9e, 0x5a, 0xa9, 0x7d, 0xac, 0xaa, 0xaa, 0x0f, 0x7d,
+	0x6a, 0xa5, 0xbe, 0x57, 0xe2, 0x11, 0x89, 0x97, 0xaa, 0x0f, 0xb4, 0x82, 0x4a, 0x7d, 0xea, 0x77,
+	0xa8, 0x3c, 0x1e, 0x7b, 0xd7, 0x1b, 0x7b, 0xe3, 0x8d, 0xda, 0x97, 0xc4, 0x9e, 0x39, 0x7f, 0x7e,
+	0xe7, 0xcc, 0x99, 0xf1, 0x99, 0x85, 0x99, 0x7a, 0xcb, 0xc6, 0x4e, 0x43, 0xb7, 0xb0, 0x5a, 0xa5,
+	0x0e, 0x56, 0x77, 0x56, 0xd4, 0xed, 0x26, 0x76, 0x5a, 0x45, 0xdb, 0xa1, 0x2e, 0x45, 0x13, 0xe1,
+	0x74, 0xd1, 0x9b, 0x2e, 0xee, 0xac, 0xc8, 0x17, 0xaa, 0x94, 0x99, 0x94, 0xa9, 0x15, 0x9d, 0x61,
+	0x5f, 0x56, 0xdd, 0x59, 0xa9, 0x60, 0x57, 0x5f, 0x51, 0x6d, 0xbd, 0x46, 0x2c, 0xdd, 0x25, 0xd4,
+	0xf2, 0xd5, 0xe5, 0x42, 0xa7, 0x6c, 0x20, 0x55, 0xa5, 0x24, 0x98, 0x8f, 0xf1, 0xee, 0xb6, 0x6c,
+	0xcc, 0xc4, 0x74, 0xbe, 0x46, 0x69, 0xad, 0x81, 0x55, 0xdd, 0x26, 0xaa, 0x6e, 0x59, 0xd4, 0xe5,
+	0xb6, 0x83, 0xd9, 0x09, 0xdd, 0x24, 0x16, 0x55, 0xf9, 0x5f, 0x31, 0x34, 0x59, 0xa3, 0x35, 0xca,
+	0x1f, 0x55, 0xef, 0xc9, 0x1f, 0x55, 0xca, 0x70, 0xfa, 0x91, 0xc7, 0xb9, 0xa9, 0x93, 0x46, 0x85,
+	0xee, 0x62, 0xa6, 0xe1, 0xed, 0x26, 0x66, 0x2e, 0xba, 0x0b, 0xd0, 0x46, 0xce, 0x49, 0x67, 0xa5,
+	0xc5, 0xe3, 0xab, 0xf3, 0x45, 0x9f, 0xb9, 0xe8, 0x31, 0x17, 0xfd, 0x5c, 0x08, 0xf2, 0xe2, 0x43,
+	0xbd, 0x86, 0x85, 0xae, 0xd6, 0xa1, 0xa9, 0x7c, 0x25, 0xc1, 0x54, 0xb7, 0x07, 0x66, 0x53, 0x8b,
+	0x61, 0xb4, 0x0e, 0x59, 0x33, 0x18, 0xcc, 0x49, 0x67, 0x87, 0x16, 0x8f, 0xaf, 0xca, 0xc5, 0x03,
+	0x49, 0x2d, 0x0a, 0xc5, 0xb5, 0xec, 0xb3, 0x97, 0xb3, 0x03, 0x5f, 0xff, 0xf1, 0xdd, 0x05, 0x49,
+	0x6b, 0xeb, 0xa1, 0x37, 0x23, 0x9c, 0x83, 0x9c, 0x73, 0xe1, 0x50, 0x4e, 0x9f, 0x20, 0x02, 0x7a,
+	0x0e, 0x4e, 0x75, 0x72, 0x06, 0x79, 0x38, 0x01, 0x83, 0xc4, 0xe0, 0xf1, 0x67, 0xb5, 0x41, 0x62,
+	0x28, 0xef, 0xc0, 0x64, 0x54, 0x4c, 0x04, 0x73, 0x13, 0x46, 0x04, 0x94, 0x48, 0x56, 0xca, 0x50,
+	0x02, 0x2d, 0xe5, 0xae, 0x58, 0x89, 0x3b, 0xb8, 0x41, 0x76, 0xb0, 0x83, 0x8d, 0x04, 0x02, 0x34,
+	0x03, 0x60, 0x62, 0xc6, 0xf4, 0x1a, 0x2e, 0x13, 0x83, 0x47, 0x9c, 0xd5, 0xb2, 0x62, 0xa4, 0x64,
+	0x28, 0xef, 0xc3, 0x54, 0xb7, 0x1d, 0x81, 0x98, 0x87, 0xac, 0x11, 0x0c, 0x72, 0x7b, 0xa3, 0x5a,
+	0x7b, 0x00, 0xdd, 0x84, 0x51, 0xf1, 0xd2, 0x12, 0x69, 0xfc, 0x4f, 0x4c, 0x04, 0xa1, 0xd5, 0x4d,
+	0xdf, 0xa1, 0x16, 0x2a, 0x29, 0x9b, 0x90, 0x8f, 0x3a, 0x0e, 0x44, 0x8e, 0x16, 0xc7, 0x16, 0xcc,
+	0x24, 0x98, 0x13, 0xe1, 0xdc, 0xef, 0x00, 0x96, 0x52, 0x03, 0x77, 0xe6, 0xbe, 0xcd, 0x7e, 0x15,
+	0x72, 0xdc, 0x99, 0x86, 0xab, 0xc4, 0x26, 0xd8, 0x72, 0x4b, 0xcc, 0x0c, 0xb8, 0xf3, 0x90, 0x75,
+	0x82, 0x61, 0x81, 0xdf, 0x1e, 0x50, 0x56, 0x61, 0x3a, 0x46, 0x53, 0x20, 0x9e, 0x86, 0x0c, 0x61,
+	0x66, 0x39, 0x0c, 0x7b, 0x98, 0x30, 0xb3, 0x64, 0x28, 0x1f, 0x4b, 0xc2, 0xdd, 0xdb, 0xd8, 0x21,
+	0x4f, 0x5a, 0x77, 0x9c, 0x96, 0xd6, 0xb4, 0x02, 0x77, 0xf1, 0x3a, 0x28, 0x07, 0x23, 0x22, 0x37,
+	0x22, 0x55, 0xc1, 0x2b, 0x92, 0x61, 0xd4, 0xc4, 0xae, 0x6e, 0xe8, 0xae, 0x9e, 0x1b, 0xe2, 0x53,
+	0xe1, 0x3b, 0x3a, 0x03, 0xd9, 0x9a, 0xce, 0xca, 0x0d, 0x62, 0x12, 0x37, 0x77, 0xcc, 0x9f, 0xac,
+	0xe9, 0x6c, 0xc3, 0x7b, 0x57, 0xae, 0xc0, 0x74, 0x0c, 0x85, 0x40, 0x97, 0x61, 0x74, 0xc7, 0x1b,
+	0x27, 0x61, 0xad, 0x84, 0xef, 0xca, 0x69, 0xb1, 0x55, 0x34, 0x5c, 0x23, 0xcc, 0xf5, 0x92, 0x5b,
+	0x7a, 0xbc, 0xc9, 0x14, 0x15, 0xce, 0xc4, 0x0c, 0x87, 0x16, 0x4f, 0xc2, 0x10, 0x31, 0xfc, 0x8d,
+	0x3e, 0xae, 0x79, 0x8f, 0xca, 0x14, 0x4c, 0x76, 0x29, 0xdc, 0xa3, 0x74, 0x8b, 0x29, 0xcb, 0x90,
+	0x8f, 0x1b, 0xef, 0x61, 0xe9, 0x20, 0xd1, 0x6d, 0xdb, 0x8e, 0x23, 0xf2, 0x86, 0x7b, 0xd8, 0xd9,
+	0x85, 0x82, 0x5f, 0x74, 0x84, 0xd9, 0xba, 0x5b, 0xad, 0x87, 0x65, 0xc3, 0x92, 0xaa, 0xf8, 0x6e,
+	0xcc, 0xf9, 0x73, 0x94, 0x73, 0xf2, 0x07, 0x09, 0x66, 0x13, 0x5d, 0x0b, 0xde, 0xb7, 0xbc, 0x95,
+	0xf6, 0xc7, 0xc4, 0x79, 0x39, 0x17, 0x57, 0xf1, 0xdd, 0x06, 0x22, 0x25, 0x1f, 0x18, 0xf8, 0xfb,
+	0x0e, 0xce, 0x07, 0xc1, 0x46, 0xed, 0xf6, 0x7b, 0xc4, 0x8d, 0xbf, 0x01, 0x73, 0xf1, 0xf6, 0xd6,
+	0x5a, 0x0f, 0xa8, 0x55, 0x4d, 0x34, 0x3b, 0x09, 0xc3, 0x96, 0x37, 0xcf, 0x2d, 0x8e, 0x6b, 0xfe,
+	0x8b, 0xb2, 0x95, 0xb4, 0xa2, 0x61, 0x56, 0x4b, 0xed, 0x9d, 0xe5, 0x1f, 0x23, 0x7d, 0x27, 0x35,
+	0xd0, 0x57, 0x96, 0x85, 0xb3, 0x0d, 0xdd, 0xc5, 0xcc, 0x6d, 0xeb, 0x94, 0x92, 0x0e, 0x73, 0xe5,
+	0x16, 0xcc, 0x26, 0x6a, 0x08, 0xbe, 0x68, 0xba, 0xa4, 0xee, 0x74, 0x7d, 0x36, 0x28, 0xb6, 0xf1,
+	0xa3, 0x26, 0x75, 0x71, 0x60, 0x21, 0x29, 0x49, 0x4b, 0x80, 0x0c, 0xcc, 0x5c, 0xb1, 0x76, 0x65,
+	0x83, 0x9a, 0x3a, 0xb1, 0xc4, 0x1a, 0x4c, 0x74, 0xcc, 0xdc, 0xe1, 0x13, 0x68, 0x0a, 0x32, 0x0c,
+	0x5b, 0x06, 0x76, 0xc4, 0xc9, 0x22, 0xde, 0xa2, 0x67, 0xe2, 0xb1, 0xae, 0x33, 0x11, 0x21, 0x38,
+	0x56, 0xa1, 0x46, 0x2b, 0x37, 0xcc, 0x27, 0xf8, 0x33, 0x9a, 0x83, 0x13, 0xd5, 0x26, 0x73, 0xa9,
+	0x59, 0xae, 0x53, 0xba, 0xe5, 0x45, 0x92, 0xe1, 0xb3, 0x63, 0xfe, 0xa8, 0xb7, 0xc1, 0x4b, 0x06,
+	0x5a, 0x86, 0xc9, 0x4e, 0xa9, 0xf0, 0x5c, 0x1b, 0xe1, 0xb2, 0xa8, 0x2d, 0xbb, 0x19, 0x7b, 0xc2,
+	0x8d, 0x76, 0x9d, 0x70, 0x5f, 0x0e, 0x82, 0x1c, 0x97, 0x1b, 0x91, 0xd9, 0x27, 0x30, 0xec, 0x52,
+	0x57, 0x6f, 0x88, 0xcd, 0x34, 0x1d, 0xa9, 0xfe, 0xa0, 0xee, 0xd7, 0x29, 0xb1, 0xd6, 0x2e, 0x7b,
+	0x8b, 0xfd, 0xcd, 0xaf, 0xb3, 0x8b, 0x35, 0xe2, 0xd6, 0x9b, 0x95, 0x62, 0x95, 0x9a, 0xaa, 0x2f,
+	0x2c, 0xfe, 0x2d, 0x31, 0x63, 0x4b, 0xf4, 0x67, 0x9e, 0x02, 0xf3, 0x0b, 0xc3, 0x37, 0x8f, 0x36,
+	0x60, 0xdc, 0xc1, 0xdb, 0x4d, 0xe2, 0x60, 0x83, 0xc7, 0x25, 0x76, 0x5b, 0x3e, 0xa6, 0xce, 0xbc,
+	0xd8, 0x38, 0x6c, 0x67, 0x7d, 0x8d, 0x05, 0xda, 0xde, 0x2c, 0xba, 0x0f, 0x63, 0x06, 0x7e, 0xa2,
+	0x37, 0x1b, 0xae, 0x6f, 0x6c, 0xa8, 0x3f, 0x63, 0xc7, 0x85, 0xb2, 0x37, 0xa9, 0xbc, 0x90, 0x20,
+	0x1b, 0x4a, 0xa1, 0xf7, 0x60, 0x24, 0x58, 0x1c, 0x5e, 0x31, 0x6b, 0xeb, 0x9e, 0xda, 0x2f, 0x2f,
+	0x67, 0xaf, 0x75, 0x84, 0x5d, 0xa9, 0xda, 0x4b, 0xc4, 0xb2, 0xe8, 0x8e, 0xdf, 0x70, 0xaa, 0xa1,
+	0xdb, 0x25, 0x91, 0x90, 0xa6, 0x4b, 0x1a, 0xc5, 0x7b, 0x78, 0xf7, 0xb6, 0x61, 0x38, 0x98, 0x31,
+	0x2d, 0x53, 0xf7, 0xd7, 0xb6, 0x0e, 0x19, 0xdd, 0xa4, 0x4d, 0xcb, 0xcd, 0x0d, 0xfe, 0x43, 0xe9,
+	0x16, 0xf6, 0x95, 0x49, 0x40, 0x7c, 0xd5, 0x1f, 0xea, 0x8e, 0x6e, 0x06, 0x27, 0xb7, 0xf2, 0x18,
+	0x4e, 0x45, 0x46, 0x45, 0x11, 0x5c, 0x87, 0x8c, 0xcd, 0x47, 0xc4, 0xee, 0x9f, 0x8e, 0x49, 0xa4,
+	0xaf, 0xd2, 0x99, 0x45, 0xa1, 0xb3, 0xfa, 0x27, 0x82, 0x61, 0x6e, 0x15, 0x7d, 0x28, 0x41, 0x36,
+	0xec, 0x71, 0xd1, 0x62, 0x8c, 0x95, 0xd8, 0x46, 0x5b, 0x3e, 0x9f, 0x42, 0xd2, 0x47, 0x55, 0x66,
+	0x3f, 0x7a, 0xf1, 0xfb, 0xd3, 0xc1, 0x69, 0xf4, 0xef, 0x76, 0xd2, 0xbd, 0x6b, 0x41, 0xbb, 0x19,
+	0xfe, 0x40, 0x82, 0x11, 0xa1, 0x86, 0xe6, 0x0f, 0xb1, 0x1b, 0xf8, 0x5f, 0x38, 0x54, 0x4e, 0x78,
+	0x9f, 0xe3, 0xde, 0x0b, 0x28, 0x9f, 0xe0, 0x5d, 0xdd, 0x23, 0xc6, 0x3e, 0xfa, 0x42, 0x82, 0x6c,
+	0xd8, 0x73, 0x25, 0xa7, 0xa1, 0xbb, 0xcb, 0x95, 0xcf, 0xa7, 0x90, 0x14, 0x20, 0xd7, 0x38, 0xc8,
+	0x65, 0x74, 0xa9, 0x17, 0x88, 0x1a, 0x76, 0xb6, 0xea, 0x5e, 0xfb, 0xf8, 0xdc, 0x47, 0x3f, 0x4a,
+	0x70, 0xb2, 0xbb, 0x27, 0x44, 0xea, 0xa1, 0xce, 0xa3, 0x9f, 0x34, 0x79, 0x39, 0xbd, 0x82, 0x80,
+	0x5e, 0xe7, 0xd0, 0x37, 0xd0, 0xb5, 0x23, 0x40, 0xab, 0x06, 0x76, 0x75, 0xd2, 0x60, 0xe8, 0x5b,
+	0x09, 0xd0, 0xc1, 0xfe, 0x00, 0xad, 0x24, 0xd2, 0x24, 0xb5, 0x31, 0xf2, 0x6a, 0x3f, 0x2a, 0x22,
+	0x04, 0x95, 0x87, 0x70, 0x1e, 0x2d, 0xf4, 0x0e, 0x21, 0x34, 0x80, 0xbe, 0x97, 0x60, 0xe2, 0x80,
+	0x3d, 0xb4, 0x9c, 0xda, 0x75, 0x00, 0xbb, 0xd2, 0x87, 0x86, 0x60, 0xbd, 0xce, 0x59, 0xff, 0x8f,
+	0xfe, 0x97, 0x92, 0x35, 0x5a, 0x24, 0x3f, 0x49, 0x90, 0x4b, 0x6a, 0x3f, 0xd0, 0x95, 0xd4, 0x34,
+	0xd1, 0x86, 0xe5, 0x28, 0x61, 0xdc, 0xe0, 0x61, 0x5c, 0x41, 0x97, 0x53, 0x86, 0x51, 0xe6, 0x4d,
+	0x8f, 0xba, 0xc7, 0xff, 0xed, 0x7b, 0x0b, 0x80, 0x0e, 0x76, 0x16, 0xc9, 0xf5, 0x92, 0xd8, 0xb7,
+	0xc8, 0xab, 0xfd, 0xa8, 0x08, 0xf8, 0x37, 0x38, 0xfc, 0x25, 0xb4, 0xd2, 0x13, 0xbe, 0xc1, 0x0d,
+	0x94, 0x3b, 0x62, 0x20, 0xbc, 0x72, 0xc6, 0x23, 0xdf, 0x6c, 0x74, 0x31, 0x09, 0x20, 0xae, 0xed,
+	0x91, 0x97, 0x52, 0x4a, 0x0b, 0xd2, 0x7b, 0x9c, 0x74, 0x0d, 0xdd, 0xea, 0x49, 0xba, 0xed, 0xe9,
+	0x86, 0xa0, 0xea, 0xde, 0xc1, 0x46, 0x6a, 0x1f, 0x35, 0x21, 0xe3, 0x7f, 0x2c, 0xd0, 0xb9, 0x24,
+	0x84, 0xc8, 0x57, 0x49, 0x9e, 0x3f, 0x4c, 0x4c, 0x20, 0xe6, 0x39, 0xe2, 0x14, 0x9a, 0x8c, 0x22,
+	0xfa, 0x9f, 0x21, 0xf4, 0xb9, 0x04, 0x63, 0x9d, 0x37, 0x50, 0xf4, 0xdf, 0x24, 0xb3, 0x31, 0x37,
+	0x5c, 0xf9, 0x62, 0x3a, 0xe1, 0xde, 0xc7, 0x40, 0xd8, 0xfe, 0x95, 0x09, 0x33, 0xd5, 0xbd, 0xf0,
+	0x75, 0x1f, 0x7d, 0x22, 0xc1, 0x58, 0xe7, 0x1d, 0x33, 0x19, 0x2e, 0xe6, 0x3e, 0x2c, 0x5f, 0x4c,
+	0x27, 0xdc, 0xfb, 0x23, 0xc5, 0xaf, 0xae, 0xad, 0xb2, 0xe1, 0xb4, 0xca, 0x4e, 0xd3, 0xf2, 0x88,
+	0x4e, 0x44, 0x6f, 0xa9, 0xc9, 0x9f, 0xcb, 0xa8, 0x9c, 0x5c, 0x4c, 0x27, 0x17, 0x02, 0x9d, 0xe3,
+	0x40, 0xb3, 0x68, 0xa6, 0x3b, 0x5b, 0x81, 0xb4, 0x97, 0x2e, 0x86, 0x9e, 0x4a, 0xf0, 0xaf, 0xae,
+	0xeb, 0x2e, 0x5a, 0x38, 0xdc, 0x15, 0x17, 0x94, 0xd5, 0x94, 0x82, 0x21, 0xd4, 0x3c, 0x87, 0x3a,
+	0x8b, 0x0a, 0x89, 0x50, 0x75, 0x4e, 0x10, 0xcd, 0x93, 0x77, 0x77, 0x4e, 0x93, 0x27, 0x4f, 0x4e,
+	0x2e, 0xa6, 0x93, 0xeb, 0x23, 0x4f, 0xba, 0x6d, 0xb3, 0x35, 0xed, 0xd9, 0xab, 0x82, 0xf4, 0xfc,
+	0x55, 0x41, 0xfa, 0xed, 0x55, 0x41, 0xfa, 0xf4, 0x75, 0x61, 0xe0, 0xf9, 0xeb, 0xc2, 0xc0, 0xcf,
+	0xaf, 0x0b, 0x03, 0xef, 0x5e, 0xed, 0xa7, 0x47, 0xdd, 0xf5, 0x7f, 0x53, 0xe5, 0x1d, 0x64, 0x25,
+	0xc3, 0x7f, 0x0a, 0xbd, 0xf4, 0xd7, 0x00, 0xe1, 0xe8, 0x18, 0x0b, 0xf0, 0x15, 0x00, 0x00,
 }
 
-func (m *QueryRecipientIsmRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Mailboxes ...
+	Mailboxes(ctx context.Context, in *QueryMailboxesRequest, opts ...grpc.CallOption) (*QueryMailboxesResponse, error)
+	// Mailbox ...
+	Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error)
+	// Delivered ...
+	Delivered(ctx context.Context, in *QueryDeliveredRequest, opts ...grpc.CallOption) (*QueryDeliveredResponse, error)
+	// DeliveredMessage returns the delivery record of a processed message.
+	DeliveredMessage(ctx context.Context, in *QueryDeliveredMessageRequest, opts ...grpc.CallOption) (*QueryDeliveredMessageResponse, error)
+	// DispatchedMessages returns all retained messages dispatched by a mailbox,
+	// ordered by nonce.
+	DispatchedMessages(ctx context.Context, in *QueryDispatchedMessagesRequest, opts ...grpc.CallOption) (*QueryDispatchedMessagesResponse, error)
+	// DispatchedMessage returns a dispatched message by its ID.
+	DispatchedMessage(ctx context.Context, in *QueryDispatchedMessageRequest, opts ...grpc.CallOption) (*QueryDispatchedMessageResponse, error)
+	// DispatchedMessageByNonce returns a dispatched message by its nonce.
+	DispatchedMessageByNonce(ctx context.Context, in *QueryDispatchedMessageByNonceRequest, opts ...grpc.CallOption) (*QueryDispatchedMessageResponse, error)
+	// LatestDispatchedId returns the ID of the latest dispatched message.
+	LatestDispatchedId(ctx context.Context, in *QueryLatestDispatchedIdRequest, opts ...grpc.CallOption) (*QueryLatestDispatchedIdResponse, error)
+	// QuoteDispatch returns the fees required to dispatch a message.
+	QuoteDispatch(ctx context.Context, in *QueryQuoteDispatchRequest, opts ...grpc.CallOption) (*QueryQuoteDispatchResponse, error)
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RecipientIsm returns the recipient ISM ID for a registered application.
+	//
+	// The recipient is globally unique as every application ID registered on the
+	// core module is unique. This means that one application cannot be registered
+	// to two mailboxes, resulting in a mailbox-independent lookup.
+	RecipientIsm(ctx context.Context, in *QueryRecipientIsmRequest, opts ...grpc.CallOption) (*QueryRecipientIsmResponse, error)
+	// VerifyDryRun ...
+	VerifyDryRun(ctx context.Context, in *QueryVerifyDryRunRequest, opts ...grpc.CallOption) (*QueryVerifyDryRunResponse, error)
+	// RegisteredISMs ...
+	RegisteredISMs(ctx context.Context, in *QueryRegisteredISMs, opts ...grpc.CallOption) (*QueryRegisteredISMsResponse, error)
+	// RegisteredHooks ...
+	RegisteredHooks(ctx context.Context, in *QueryRegisteredHooks, opts ...grpc.CallOption) (*QueryRegisteredHooksResponse, error)
+	// RegisteredApps ...
+	RegisteredApps(ctx context.Context, in *QueryRegisteredApps, opts ...grpc.CallOption) (*QueryRegisteredAppsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Mailboxes(ctx context.Context, in *QueryMailboxesRequest, opts ...grpc.CallOption) (*QueryMailboxesResponse, error) {
+	out := new(QueryMailboxesResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Mailboxes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRecipientIsmRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Mailbox(ctx context.Context, in *QueryMailboxRequest, opts ...grpc.CallOption) (*QueryMailboxResponse, error) {
+	out := new(QueryMailboxResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Mailbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRecipientIsmRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Delivered(ctx context.Context, in *QueryDeliveredRequest, opts ...grpc.CallOption) (*QueryDeliveredResponse, error) {
+	out := new(QueryDeliveredResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Delivered", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRecipientIsmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DeliveredMessage(ctx context.Context, in *QueryDeliveredMessageRequest, opts ...grpc.CallOption) (*QueryDeliveredMessageResponse, error) {
+	out := new(QueryDeliveredMessageResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/DeliveredMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRecipientIsmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DispatchedMessages(ctx context.Context, in *QueryDispatchedMessagesRequest, opts ...grpc.CallOption) (*QueryDispatchedMessagesResponse, error) {
+	out := new(QueryDispatchedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/DispatchedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRecipientIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IsmId) > 0 {
-		i -= len(m.IsmId)
-		copy(dAtA[i:], m.IsmId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsmId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DispatchedMessage(ctx context.Context, in *QueryDispatchedMessageRequest, opts ...grpc.CallOption) (*QueryDispatchedMessageResponse, error) {
+	out := new(QueryDispatchedMessageResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/DispatchedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVerifyDryRunRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DispatchedMessageByNonce(ctx context.Context, in *QueryDispatchedMessageByNonceRequest, opts ...grpc.CallOption) (*QueryDispatchedMessageResponse, error) {
+	out := new(QueryDispatchedMessageResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/DispatchedMessageByNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVerifyDryRunRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestDispatchedId(ctx context.Context, in *QueryLatestDispatchedIdRequest, opts ...grpc.CallOption) (*QueryLatestDispatchedIdResponse, error) {
+	out := new(QueryLatestDispatchedIdResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/LatestDispatchedId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVerifyDryRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GasLimit) > 0 {
-		i -= len(m.GasLimit)
-		copy(dAtA[i:], m.GasLimit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) QuoteDispatch(ctx context.Context, in *QueryQuoteDispatchRequest, opts ...grpc.CallOption) (*QueryQuoteDispatchResponse, error) {
+	out := new(QueryQuoteDispatchResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/QuoteDispatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.IsmId) > 0 {
-		i -= len(m.IsmId)
-		copy(dAtA[i:], m.IsmId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsmId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryVerifyDryRunResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecipientIsm(ctx context.Context, in *QueryRecipientIsmRequest, opts ...grpc.CallOption) (*QueryRecipientIsmResponse, error) {
+	out := new(QueryRecipientIsmResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RecipientIsm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVerifyDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryVerifyDryRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) VerifyDryRun(ctx context.Context, in *QueryVerifyDryRunRequest, opts ...grpc.CallOption) (*QueryVerifyDryRunResponse, error) {
+	out := new(QueryVerifyDryRunResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/VerifyDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRegisteredISMs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RegisteredISMs(ctx context.Context, in *QueryRegisteredISMs, opts ...grpc.CallOption) (*QueryRegisteredISMsResponse, error) {
+	out := new(QueryRegisteredISMsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RegisteredISMs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRegisteredISMs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRegisteredISMs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) RegisteredHooks(ctx context.Context, in *QueryRegisteredHooks, opts ...grpc.CallOption) (*QueryRegisteredHooksResponse, error) {
+	out := new(QueryRegisteredHooksResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RegisteredHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRegisteredISMsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RegisteredApps(ctx context.Context, in *QueryRegisteredApps, opts ...grpc.CallOption) (*QueryRegisteredAppsResponse, error) {
+	out := new(QueryRegisteredAppsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Query/RegisteredApps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRegisteredISMsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Mailboxes ...
+	Mailboxes(context.Context, *QueryMailboxesRequest) (*QueryMailboxesResponse, error)
+	// Mailbox ...
+	Mailbox(context.Context, *QueryMailboxRequest) (*QueryMailboxResponse, error)
+	// Delivered ...
+	Delivered(context.Context, *QueryDeliveredRequest) (*QueryDeliveredResponse, error)
+	// DeliveredMessage returns the delivery record of a processed message.
+	DeliveredMessage(context.Context, *QueryDeliveredMessageRequest) (*QueryDeliveredMessageResponse, error)
+	// DispatchedMessages returns all retained messages dispatched by a mailbox,
+	// ordered by nonce.
+	DispatchedMessages(context.Context, *QueryDispatchedMessagesRequest) (*QueryDispatchedMessagesResponse, error)
+	// DispatchedMessage returns a dispatched message by its ID.
+	DispatchedMessage(context.Context, *QueryDispatchedMessageRequest) (*QueryDispatchedMessageResponse, error)
+	// DispatchedMessageByNonce returns a dispatched message by its nonce.
+	DispatchedMessageByNonce(context.Context, *QueryDispatchedMessageByNonceRequest) (*QueryDispatchedMessageResponse, error)
+	// LatestDispatchedId returns the ID of the latest dispatched message.
+	LatestDispatchedId(context.Context, *QueryLatestDispatchedIdRequest) (*QueryLatestDispatchedIdResponse, error)
+	// QuoteDispatch returns the fees required to dispatch a message.
+	QuoteDispatch(context.Context, *QueryQuoteDispatchRequest) (*QueryQuoteDispatchResponse, error)
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RecipientIsm returns the recipient ISM ID for a registered application.
+	//
+	// The recipient is globally unique as every application ID registered on the
+	// core module is unique. This means that one application cannot be registered
+	// to two mailboxes, resulting in a mailbox-independent lookup.
+	RecipientIsm(context.Context, *QueryRecipientIsmRequest) (*QueryRecipientIsmResponse, error)
+	// VerifyDryRun ...
+	VerifyDryRun(context.Context, *QueryVerifyDryRunRequest) (*QueryVerifyDryRunResponse, error)
+	// RegisteredISMs ...
+	RegisteredISMs(context.Context, *QueryRegisteredISMs) (*QueryRegisteredISMsResponse, error)
+	// RegisteredHooks ...
+	RegisteredHooks(context.Context, *QueryRegisteredHooks) (*QueryRegisteredHooksResponse, error)
+	// RegisteredApps ...
+	RegisteredApps(context.Context, *QueryRegisteredApps) (*QueryRegisteredAppsResponse, error)
 }
 
-func (m *QueryRegisteredISMsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintQuery(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryRegisteredHooks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Mailboxes(ctx context.Context, req *QueryMailboxesRequest) (*QueryMailboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mailboxes not implemented")
+}
+func (*UnimplementedQueryServer) Mailbox(ctx context.Context, req *QueryMailboxRequest) (*QueryMailboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mailbox not implemented")
+}
+func (*UnimplementedQueryServer) Delivered(ctx context.Context, req *QueryDeliveredRequest) (*QueryDeliveredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delivered not implemented")
+}
+func (*UnimplementedQueryServer) DeliveredMessage(ctx context.Context, req *QueryDeliveredMessageRequest) (*QueryDeliveredMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliveredMessage not implemented")
+}
+func (*UnimplementedQueryServer) DispatchedMessages(ctx context.Context, req *QueryDispatchedMessagesRequest) (*QueryDispatchedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchedMessages not implemented")
+}
+func (*UnimplementedQueryServer) DispatchedMessage(ctx context.Context, req *QueryDispatchedMessageRequest) (*QueryDispatchedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchedMessage not implemented")
+}
+func (*UnimplementedQueryServer) DispatchedMessageByNonce(ctx context.Context, req *QueryDispatchedMessageByNonceRequest) (*QueryDispatchedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchedMessageByNonce not implemented")
+}
+func (*UnimplementedQueryServer) LatestDispatchedId(ctx context.Context, req *QueryLatestDispatchedIdRequest) (*QueryLatestDispatchedIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestDispatchedId not implemented")
+}
+func (*UnimplementedQueryServer) QuoteDispatch(ctx context.Context, req *QueryQuoteDispatchRequest) (*QueryQuoteDispatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteDispatch not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RecipientIsm(ctx context.Context, req *QueryRecipientIsmRequest) (*QueryRecipientIsmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecipientIsm not implemented")
+}
+func (*UnimplementedQueryServer) VerifyDryRun(ctx context.Context, req *QueryVerifyDryRunRequest) (*QueryVerifyDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDryRun not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredISMs(ctx context.Context, req *QueryRegisteredISMs) (*QueryRegisteredISMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredISMs not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredHooks(ctx context.Context, req *QueryRegisteredHooks) (*QueryRegisteredHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredHooks not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredApps(ctx context.Context, req *QueryRegisteredApps) (*QueryRegisteredAppsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredApps not implemented")
 }
 
-func (m *QueryRegisteredHooks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryRegisteredHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_Mailboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mailboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/Mailboxes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mailboxes(ctx, req.(*QueryMailboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRegisteredHooksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Mailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMailboxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Mailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/Mailbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mailbox(ctx, req.(*QueryMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRegisteredHooksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Delivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeliveredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Delivered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/Delivered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delivered(ctx, req.(*QueryDeliveredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRegisteredHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA7 := make([]byte, len(m.Ids)*10)
-		var j6 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintQuery(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0xa
+func _Query_DeliveredMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeliveredMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DeliveredMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/DeliveredMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeliveredMessage(ctx, req.(*QueryDeliveredMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRegisteredApps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DispatchedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDispatchedMessagesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).DispatchedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/DispatchedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DispatchedMessages(ctx, req.(*QueryDispatchedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRegisteredApps) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRegisteredApps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryRegisteredAppsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DispatchedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDispatchedMessageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRegisteredAppsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRegisteredAppsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA9 := make([]byte, len(m.Ids)*10)
-		var j8 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintQuery(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if interceptor == nil {
+		return srv.(QueryServer).DispatchedMessage(ctx, in)
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryMailboxesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/DispatchedMessage",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DispatchedMessage(ctx, req.(*QueryDispatchedMessageRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMailboxesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DispatchedMessageByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDispatchedMessageByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Mailboxes) > 0 {
-		for _, e := range m.Mailboxes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).DispatchedMessageByNonce(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/DispatchedMessageByNonce",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DispatchedMessageByNonce(ctx, req.(*QueryDispatchedMessageByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMailboxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestDispatchedId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestDispatchedIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LatestDispatchedId(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryMailboxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/LatestDispatchedId",
 	}
-	var l int
-	_ = l
-	l = m.Mailbox.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestDispatchedId(ctx, req.(*QueryLatestDispatchedIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDeliveredRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuoteDispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteDispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteDispatch(ctx, in)
 	}
-	l = len(m.MessageId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/QuoteDispatch",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteDispatch(ctx, req.(*QueryQuoteDispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDeliveredResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Delivered {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryRecipientIsmRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/Params",
 	}
-	var l int
-	_ = l
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRecipientIsmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RecipientIsm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecipientIsmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.IsmId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RecipientIsm(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/RecipientIsm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecipientIsm(ctx, req.(*QueryRecipientIsmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyDryRunRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IsmId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_VerifyDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyDryRun(ctx, in)
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/VerifyDryRun",
 	}
-	l = len(m.GasLimit)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyDryRun(ctx, req.(*QueryVerifyDryRunRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyDryRunResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RegisteredISMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredISMs)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Verified {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredISMs(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryRegisteredISMs) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/RegisteredISMs",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredISMs(ctx, req.(*QueryRegisteredISMs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRegisteredISMsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RegisteredHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/RegisteredHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredHooks(ctx, req.(*QueryRegisteredHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredApps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Query/RegisteredApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredApps(ctx, req.(*QueryRegisteredApps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperlane.core.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Mailboxes",
+			Handler:    _Query_Mailboxes_Handler,
+		},
+		{
+			MethodName: "Mailbox",
+			Handler:    _Query_Mailbox_Handler,
+		},
+		{
+			MethodName: "Delivered",
+			Handler:    _Query_Delivered_Handler,
+		},
+		{
+			MethodName: "DeliveredMessage",
+			Handler:    _Query_DeliveredMessage_Handler,
+		},
+		{
+			MethodName: "DispatchedMessages",
+			Handler:    _Query_DispatchedMessages_Handler,
+		},
+		{
+			MethodName: "DispatchedMessage",
+			Handler:    _Query_DispatchedMessage_Handler,
+		},
+		{
+			MethodName: "DispatchedMessageByNonce",
+			Handler:    _Query_DispatchedMessageByNonce_Handler,
+		},
+		{
+			MethodName: "LatestDispatchedId",
+			Handler:    _Query_LatestDispatchedId_Handler,
+		},
+		{
+			MethodName: "QuoteDispatch",
+			Handler:    _Query_QuoteDispatch_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RecipientIsm",
+			Handler:    _Query_RecipientIsm_Handler,
+		},
+		{
+			MethodName: "VerifyDryRun",
+			Handler:    _Query_VerifyDryRun_Handler,
+		},
+		{
+			MethodName: "RegisteredISMs",
+			Handler:    _Query_RegisteredISMs_Handler,
+		},
+		{
+			MethodName: "RegisteredHooks",
+			Handler:    _Query_RegisteredHooks_Handler,
+		},
+		{
+			MethodName: "RegisteredApps",
+			Handler:    _Query_RegisteredApps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyperlane/core/v1/query.proto",
+}
+
+func (m *QueryMailboxesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovQuery(uint64(e))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mailboxes) > 0 {
+		for iNdEx := len(m.Mailboxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mailboxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMailboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMailboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMailboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mailbox.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeliveredRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeliveredRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeliveredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeliveredResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeliveredResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeliveredResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delivery != nil {
+		{
+			size, err := m.Delivery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Delivered {
+		i--
+		if m.Delivered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeliveredMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeliveredMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeliveredMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeliveredMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeliveredMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeliveredMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delivery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientIsmRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientIsmRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientIsmRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientIsmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientIsmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientIsmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IsmId) > 0 {
+		i -= len(m.IsmId)
+		copy(dAtA[i:], m.IsmId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsmId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDryRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDryRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDryRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IsmId) > 0 {
+		i -= len(m.IsmId)
+		copy(dAtA[i:], m.IsmId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IsmId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDryRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDryRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredISMs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredISMs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredISMs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredISMsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredISMsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredISMsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA9 := make([]byte, len(m.Ids)*10)
+		var j8 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredApps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredApps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredApps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredAppsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredAppsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredAppsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDispatchedMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDispatchedMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDispatchedMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDispatchedMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDispatchedMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDispatchedMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDispatchedMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDispatchedMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDispatchedMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDispatchedMessageByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDispatchedMessageByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDispatchedMessageByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDispatchedMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDispatchedMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDispatchedMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDispatchedIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDispatchedIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDispatchedIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDispatchedIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDispatchedIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDispatchedIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteDispatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteDispatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteDispatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CustomHookMetadata) > 0 {
+		i -= len(m.CustomHookMetadata)
+		copy(dAtA[i:], m.CustomHookMetadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CustomHookMetadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CustomHookId) > 0 {
+		i -= len(m.CustomHookId)
+		copy(dAtA[i:], m.CustomHookId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CustomHookId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationDomain) > 0 {
+		i -= len(m.DestinationDomain)
+		copy(dAtA[i:], m.DestinationDomain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationDomain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteDispatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteDispatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteDispatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DefaultHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RequiredHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.HookId.Size()
+		i -= size
+		if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMailboxesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mailboxes) > 0 {
+		for _, e := range m.Mailboxes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMailboxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mailbox.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeliveredRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeliveredResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delivered {
+		n += 2
+	}
+	if m.Delivery != nil {
+		l = m.Delivery.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeliveredMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeliveredMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delivery.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecipientIsmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecipientIsmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IsmId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDryRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IsmId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDryRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRegisteredISMs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRegisteredISMsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryRegisteredHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRegisteredHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryRegisteredApps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRegisteredAppsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryDispatchedMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDispatchedMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDispatchedMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDispatchedMessageByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryDispatchedMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Message.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestDispatchedIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestDispatchedIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteDispatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationDomain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CustomHookId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CustomHookMetadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteDispatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.RequiredHook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DefaultHook.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *HookQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HookId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMailboxesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mailboxes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mailboxes = append(m.Mailboxes, Mailbox{})
+			if err := m.Mailboxes[len(m.Mailboxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMailboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mailbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeliveredRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeliveredRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeliveredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeliveredResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeliveredResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeliveredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delivered = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delivery == nil {
+				m.Delivery = &DeliveredMessage{}
+			}
+			if err := m.Delivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeliveredMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeliveredMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeliveredMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeliveredMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeliveredMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeliveredMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientIsmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientIsmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientIsmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientIsmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientIsmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsmId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyDryRunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyDryRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsmId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyDryRunResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyDryRunResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredISMs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredISMs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredISMs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredISMsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredISMsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredISMsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *QueryRegisteredHooks) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryRegisteredHooksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovQuery(uint64(e))
+func (m *QueryRegisteredApps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredApps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredApps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *QueryRegisteredApps) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryRegisteredAppsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovQuery(uint64(e))
+func (m *QueryRegisteredAppsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredAppsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredAppsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryMailboxesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDispatchedMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2023,13 +5643,45 @@ func (m *QueryMailboxesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDispatchedMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDispatchedMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2086,7 +5738,7 @@ func (m *QueryMailboxesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDispatchedMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2109,15 +5761,15 @@ func (m *QueryMailboxesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDispatchedMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDispatchedMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mailboxes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2144,8 +5796,8 @@ func (m *QueryMailboxesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mailboxes = append(m.Mailboxes, Mailbox{})
-			if err := m.Mailboxes[len(m.Mailboxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, DispatchedMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2206,7 +5858,7 @@ func (m *QueryMailboxesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDispatchedMessageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,10 +5881,10 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDispatchedMessageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDispatchedMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2267,61 +5919,11 @@ func (m *QueryMailboxRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMailboxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mailbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2331,24 +5933,23 @@ func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Mailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2371,7 +5972,7 @@ func (m *QueryMailboxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeliveredRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDispatchedMessageByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2394,10 +5995,10 @@ func (m *QueryDeliveredRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeliveredRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDispatchedMessageByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeliveredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDispatchedMessageByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2433,10 +6034,10 @@ func (m *QueryDeliveredRequest) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2446,24 +6047,11 @@ func (m *QueryDeliveredRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2485,7 +6073,7 @@ func (m *QueryDeliveredRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeliveredResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDispatchedMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2508,17 +6096,17 @@ func (m *QueryDeliveredResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeliveredResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDispatchedMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeliveredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDispatchedMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delivered", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2528,12 +6116,25 @@ func (m *QueryDeliveredResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Delivered = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2555,7 +6156,7 @@ func (m *QueryDeliveredResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecipientIsmRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestDispatchedIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2578,15 +6179,15 @@ func (m *QueryRecipientIsmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecipientIsmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestDispatchedIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecipientIsmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestDispatchedIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2614,7 +6215,7 @@ func (m *QueryRecipientIsmRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2637,7 +6238,7 @@ func (m *QueryRecipientIsmRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecipientIsmResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestDispatchedIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2660,15 +6261,15 @@ func (m *QueryRecipientIsmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecipientIsmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestDispatchedIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecipientIsmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestDispatchedIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2696,7 +6297,7 @@ func (m *QueryRecipientIsmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsmId = string(dAtA[iNdEx:postIndex])
+			m.MessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2719,7 +6320,7 @@ func (m *QueryRecipientIsmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteDispatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +6343,143 @@ func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyDryRunRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteDispatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyDryRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteDispatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,11 +6507,11 @@ func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsmId = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2810,11 +6539,11 @@ func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.CustomHookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookMetadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2842,9 +6571,9 @@ func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.CustomHookMetadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -2897,7 +6626,7 @@ func (m *QueryVerifyDryRunRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyDryRunResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteDispatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2920,17 +6649,17 @@ func (m *QueryVerifyDryRunResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyDryRunResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteDispatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteDispatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2940,238 +6669,92 @@ func (m *QueryVerifyDryRunResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Verified = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRegisteredISMs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredISMs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredISMs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRegisteredISMsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredHook", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredISMsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredISMsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRegisteredHooks) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.RequiredHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DefaultHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredHooks: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredHooks: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3193,7 +6776,7 @@ func (m *QueryRegisteredHooks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredHooksResponse) Unmarshal(dAtA []byte) error {
+func (m *HookQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,88 +6799,80 @@ func (m *QueryRegisteredHooksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredHooksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HookQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HookQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3319,7 +6894,7 @@ func (m *QueryRegisteredHooksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredApps) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3342,10 +6917,10 @@ func (m *QueryRegisteredApps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredApps: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredApps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3369,7 +6944,7 @@ func (m *QueryRegisteredApps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredAppsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3392,88 +6967,45 @@ func (m *QueryRegisteredAppsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredAppsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredAppsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5,9 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -342,6 +345,229 @@ func (m *MsgProcessMessageResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProcessMessageResponse proto.InternalMessageInfo
 
+// MsgDispatchMessage ...
+type MsgDispatchMessage struct {
+	// sender is the account which dispatches the message. It is used as the
+	// message sender and pays for the post dispatch hooks.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// mailbox_id ...
+	MailboxId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=mailbox_id,json=mailboxId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"mailbox_id"`
+	// destination_domain ...
+	DestinationDomain uint32 `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// recipient ...
+	Recipient github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,4,opt,name=recipient,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"recipient"`
+	// body is the hex encoded message body.
+	Body string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// custom_hook_id overwrites the default hook of the mailbox.
+	CustomHookId *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,6,opt,name=custom_hook_id,json=customHookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"custom_hook_id,omitempty"`
+	// custom_hook_metadata is the hex encoded custom hook metadata.
+	CustomHookMetadata string `protobuf:"bytes,7,opt,name=custom_hook_metadata,json=customHookMetadata,proto3" json:"custom_hook_metadata,omitempty"`
+	// gas_limit ...
+	GasLimit cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=gas_limit,json=gasLimit,proto3,customtype=cosmossdk.io/math.Int" json:"gas_limit"`
+	// max_fee is the maximum amount the sender is willing to pay for the post
+	// dispatch hooks.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=max_fee,json=maxFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee"`
+}
+
+func (m *MsgDispatchMessage) Reset()         { *m = MsgDispatchMessage{} }
+func (m *MsgDispatchMessage) String() string { return proto.CompactTextString(m) }
+func (*MsgDispatchMessage) ProtoMessage()    {}
+func (*MsgDispatchMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbb8ebe75a427476, []int{6}
+}
+func (m *MsgDispatchMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDispatchMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDispatchMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDispatchMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDispatchMessage.Merge(m, src)
+}
+func (m *MsgDispatchMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDispatchMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDispatchMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDispatchMessage proto.InternalMessageInfo
+
+func (m *MsgDispatchMessage) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgDispatchMessage) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *MsgDispatchMessage) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *MsgDispatchMessage) GetCustomHookMetadata() string {
+	if m != nil {
+		return m.CustomHookMetadata
+	}
+	return ""
+}
+
+func (m *MsgDispatchMessage) GetMaxFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFee
+	}
+	return nil
+}
+
+// MsgDispatchMessageResponse ...
+type MsgDispatchMessageResponse struct {
+	MessageId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"message_id"`
+}
+
+func (m *MsgDispatchMessageResponse) Reset()         { *m = MsgDispatchMessageResponse{} }
+func (m *MsgDispatchMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDispatchMessageResponse) ProtoMessage()    {}
+func (*MsgDispatchMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbb8ebe75a427476, []int{7}
+}
+func (m *MsgDispatchMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDispatchMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDispatchMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDispatchMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDispatchMessageResponse.Merge(m, src)
+}
+func (m *MsgDispatchMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDispatchMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDispatchMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDispatchMessageResponse proto.InternalMessageInfo
+
+// MsgUpdateParams ...
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	// All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbb8ebe75a427476, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse ...
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbb8ebe75a427476, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateMailbox)(nil), "hyperlane.core.v1.MsgCreateMailbox")
 	proto.RegisterType((*MsgCreateMailboxResponse)(nil), "hyperlane.core.v1.MsgCreateMailboxResponse")
@@ -349,55 +575,82 @@ func init() {
 	proto.RegisterType((*MsgSetMailboxResponse)(nil), "hyperlane.core.v1.MsgSetMailboxResponse")
 	proto.RegisterType((*MsgProcessMessage)(nil), "hyperlane.core.v1.MsgProcessMessage")
 	proto.RegisterType((*MsgProcessMessageResponse)(nil), "hyperlane.core.v1.MsgProcessMessageResponse")
+	proto.RegisterType((*MsgDispatchMessage)(nil), "hyperlane.core.v1.MsgDispatchMessage")
+	proto.RegisterType((*MsgDispatchMessageResponse)(nil), "hyperlane.core.v1.MsgDispatchMessageResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "hyperlane.core.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "hyperlane.core.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("hyperlane/core/v1/tx.proto", fileDescriptor_fbb8ebe75a427476) }
 
 var fileDescriptor_fbb8ebe75a427476 = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4d, 0x4f, 0xd4, 0x5c,
-	0x14, 0x9e, 0x0e, 0xdf, 0x07, 0xe6, 0xcd, 0x3b, 0x0d, 0x86, 0x52, 0x63, 0x19, 0x47, 0x17, 0x23,
-	0x32, 0x6d, 0xc0, 0x98, 0x18, 0x5c, 0x09, 0x2e, 0x60, 0x31, 0xc1, 0x94, 0x8d, 0x71, 0x33, 0xb9,
-	0xd3, 0x1e, 0x3a, 0x37, 0xb4, 0xbd, 0xe3, 0xbd, 0x9d, 0x61, 0xd8, 0x19, 0x97, 0xae, 0x8c, 0x7f,
-	0x44, 0x16, 0xfe, 0x08, 0x12, 0x37, 0xc4, 0x95, 0x71, 0x41, 0x0c, 0x2c, 0xd8, 0xf8, 0x23, 0xcc,
-	0xf4, 0xb6, 0x83, 0x53, 0x40, 0x50, 0x71, 0xe7, 0xa6, 0xb9, 0xf7, 0x9c, 0xe7, 0x7c, 0xf4, 0x39,
-	0x4f, 0xce, 0x05, 0xbd, 0xb9, 0xdb, 0x42, 0xee, 0x93, 0x10, 0x2d, 0x87, 0x71, 0xb4, 0x3a, 0x8b,
-	0x56, 0xd4, 0x35, 0x5b, 0x9c, 0x45, 0x4c, 0x2d, 0xf6, 0x7d, 0x66, 0xcf, 0x67, 0x76, 0x16, 0xf5,
-	0x19, 0x87, 0x89, 0x80, 0x09, 0x2b, 0x10, 0x5e, 0x0f, 0x1a, 0x08, 0x4f, 0x62, 0xf5, 0x69, 0x8f,
-	0x79, 0x2c, 0x3e, 0x5a, 0xbd, 0x53, 0x62, 0x2d, 0x92, 0x80, 0x86, 0xcc, 0x8a, 0xbf, 0x89, 0x69,
-	0x56, 0x66, 0xa8, 0x4b, 0xac, 0xbc, 0x48, 0x57, 0xf9, 0xe3, 0x10, 0xfc, 0x5f, 0x13, 0xde, 0x2a,
-	0x47, 0x12, 0x61, 0x8d, 0x50, 0xbf, 0xc1, 0xba, 0xaa, 0x09, 0x23, 0x6c, 0x27, 0x44, 0xae, 0x29,
-	0x25, 0xa5, 0x32, 0xb1, 0xa2, 0x7d, 0xfa, 0x50, 0x9d, 0x4e, 0xa2, 0x9e, 0xb8, 0x2e, 0x47, 0x21,
-	0x36, 0x23, 0x4e, 0x43, 0xcf, 0x96, 0x30, 0xf5, 0x36, 0x4c, 0xf9, 0xcc, 0x21, 0x7e, 0xdd, 0x65,
-	0x01, 0xa1, 0xa1, 0x96, 0x2f, 0x29, 0x95, 0x82, 0x3d, 0x19, 0xdb, 0x9e, 0xc6, 0x26, 0xd5, 0x85,
-	0x49, 0x17, 0xb7, 0x48, 0xdb, 0x8f, 0xea, 0x54, 0x04, 0xda, 0x50, 0x9c, 0x78, 0x75, 0xff, 0x70,
-	0x2e, 0xf7, 0xe5, 0x70, 0xee, 0xb1, 0x47, 0xa3, 0x66, 0xbb, 0x61, 0x3a, 0x2c, 0xb0, 0x1a, 0x4e,
-	0xab, 0x4a, 0xc3, 0x90, 0x75, 0x48, 0x44, 0x59, 0x28, 0xac, 0x3e, 0x1f, 0xd5, 0x84, 0x86, 0x76,
-	0x44, 0x7d, 0x73, 0x0d, 0xbb, 0x49, 0x27, 0x36, 0x24, 0x79, 0xd7, 0x45, 0xa0, 0x6e, 0xc1, 0x54,
-	0x5a, 0xa5, 0xc9, 0xd8, 0xb6, 0x36, 0xdc, 0x2f, 0xa3, 0xfc, 0x69, 0x99, 0xb4, 0xfd, 0x35, 0xc6,
-	0xb6, 0xd5, 0x26, 0x14, 0x38, 0xbe, 0x6c, 0x53, 0x8e, 0xae, 0x2c, 0x34, 0x72, 0x7d, 0x85, 0xa6,
-	0xd2, 0xcc, 0xbd, 0x4a, 0xcb, 0x0b, 0xaf, 0x4f, 0xf6, 0xe6, 0x25, 0xcd, 0x6f, 0x4e, 0xf6, 0xe6,
-	0x6f, 0x9d, 0x4a, 0xa7, 0xb3, 0x68, 0x65, 0x07, 0x57, 0x66, 0xa0, 0x65, 0x6d, 0x36, 0x8a, 0x16,
-	0x0b, 0x05, 0xaa, 0x9b, 0x90, 0xa7, 0x6e, 0x32, 0xd1, 0x6b, 0x21, 0x3e, 0x4f, 0xdd, 0xf2, 0xb7,
-	0x61, 0x28, 0xd4, 0x84, 0xb7, 0x89, 0xd1, 0xef, 0x6a, 0xa7, 0x01, 0x10, 0xc8, 0xd0, 0x3a, 0x75,
-	0x63, 0xe5, 0x5c, 0x53, 0x7b, 0x13, 0x49, 0xda, 0x75, 0xf7, 0x62, 0xf1, 0x29, 0xff, 0xc4, 0xf7,
-	0x13, 0xf1, 0xa9, 0x0f, 0x61, 0x22, 0xc4, 0x9d, 0xba, 0x9c, 0xe7, 0xe8, 0x25, 0xf3, 0x1c, 0x0f,
-	0x71, 0x67, 0x23, 0x1e, 0x69, 0x15, 0x54, 0x8e, 0x21, 0x6b, 0x87, 0x0e, 0xca, 0x58, 0xd1, 0xa4,
-	0x2d, 0x6d, 0xac, 0xa4, 0x54, 0xc6, 0xed, 0x62, 0xea, 0xd9, 0x48, 0x1d, 0xcb, 0xf7, 0x06, 0x25,
-	0xae, 0x67, 0x25, 0x7e, 0x2a, 0xae, 0xf2, 0x0c, 0xdc, 0x18, 0x30, 0xa4, 0xe2, 0x2e, 0xbf, 0xcb,
-	0x43, 0xb1, 0x26, 0xbc, 0x67, 0x9c, 0x39, 0x28, 0x44, 0x0d, 0x85, 0x20, 0x1e, 0x66, 0xb4, 0xa5,
-	0xfc, 0x15, 0x6d, 0x2d, 0xc1, 0x18, 0x47, 0x9f, 0xec, 0x22, 0x4f, 0xc4, 0x7b, 0x31, 0x43, 0x29,
-	0x50, 0xd5, 0x61, 0x3c, 0xc0, 0x88, 0xb8, 0x24, 0x22, 0x52, 0x8c, 0x76, 0xff, 0xae, 0x6a, 0x30,
-	0x16, 0xc8, 0xf6, 0xa5, 0x80, 0xec, 0xf4, 0xba, 0x6c, 0xf5, 0x78, 0x4a, 0x73, 0xf4, 0x98, 0x32,
-	0xb2, 0x4c, 0x0d, 0xfe, 0x7e, 0xf9, 0x26, 0xcc, 0x9e, 0x31, 0xa6, 0x8c, 0x2d, 0xbd, 0xcf, 0xc3,
-	0x50, 0x4d, 0x78, 0x2a, 0x81, 0xc2, 0xe0, 0xf2, 0xbf, 0x63, 0x9e, 0x79, 0x82, 0xcc, 0xec, 0x52,
-	0xd1, 0xef, 0x5f, 0x01, 0xd4, 0xdf, 0x3c, 0xcf, 0x01, 0x7e, 0x58, 0x10, 0xa5, 0xf3, 0x43, 0x4f,
-	0x11, 0x7a, 0xe5, 0x32, 0x44, 0x3f, 0xb3, 0x0b, 0xff, 0x65, 0x46, 0x7e, 0xf7, 0xfc, 0xd8, 0x41,
-	0x94, 0xbe, 0x70, 0x15, 0x54, 0x5a, 0x45, 0x1f, 0x79, 0x75, 0xb2, 0x37, 0xaf, 0xac, 0xd8, 0xfb,
-	0x47, 0x86, 0x72, 0x70, 0x64, 0x28, 0x5f, 0x8f, 0x0c, 0xe5, 0xed, 0xb1, 0x91, 0x3b, 0x38, 0x36,
-	0x72, 0x9f, 0x8f, 0x8d, 0xdc, 0x8b, 0x47, 0xbf, 0xa2, 0xa5, 0xae, 0x7c, 0xf4, 0xa3, 0xdd, 0x16,
-	0x8a, 0xc6, 0x68, 0xfc, 0x0a, 0x3f, 0xf8, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x81, 0x32, 0xb0, 0x59,
-	0x13, 0x08, 0x00, 0x00,
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0x36, 0x6d, 0x5e, 0xdb, 0x5d, 0x3a, 0xea, 0xaa, 0xae, 0x61, 0xd3, 0x12, 0x40,
+	0x0a, 0x65, 0x63, 0x6f, 0x8b, 0x16, 0xa1, 0xc2, 0x85, 0x76, 0x85, 0xb6, 0x12, 0xd1, 0xae, 0x5c,
+	0x21, 0x21, 0x0e, 0x44, 0x13, 0xcf, 0xd4, 0x19, 0x35, 0xf6, 0x18, 0xcf, 0xa4, 0x4d, 0x6f, 0x2b,
+	0x8e, 0x9c, 0x10, 0xbf, 0x02, 0x71, 0xea, 0x81, 0x03, 0x7f, 0x00, 0x69, 0x25, 0x2e, 0x2b, 0x4e,
+	0x88, 0xc3, 0x82, 0xda, 0x43, 0x2f, 0xfc, 0x00, 0x8e, 0xc8, 0x9e, 0xb1, 0x9b, 0xb8, 0x81, 0x16,
+	0xb6, 0x70, 0xda, 0x4b, 0x1b, 0xbf, 0xf7, 0xcd, 0xf7, 0x9e, 0xbf, 0xf9, 0x5e, 0x66, 0x02, 0x56,
+	0xf7, 0x28, 0xa2, 0x71, 0x0f, 0x87, 0xd4, 0xf1, 0x78, 0x4c, 0x9d, 0x83, 0x75, 0x47, 0x0e, 0xec,
+	0x28, 0xe6, 0x92, 0xa3, 0x85, 0x3c, 0x67, 0x27, 0x39, 0xfb, 0x60, 0xdd, 0x5a, 0xf2, 0xb8, 0x08,
+	0xb8, 0x70, 0x02, 0xe1, 0x27, 0xd0, 0x40, 0xf8, 0x0a, 0x6b, 0xd5, 0x74, 0xa2, 0x83, 0x45, 0x42,
+	0xd2, 0xa1, 0x12, 0xaf, 0x3b, 0x1e, 0x67, 0xa1, 0xce, 0x2f, 0xfa, 0xdc, 0xe7, 0xe9, 0x47, 0x27,
+	0xf9, 0xa4, 0xa3, 0x0b, 0x38, 0x60, 0x21, 0x77, 0xd2, 0xbf, 0x3a, 0xb4, 0xac, 0x88, 0xda, 0x0a,
+	0xab, 0x1e, 0x74, 0xea, 0xf6, 0x98, 0x5e, 0x8f, 0x22, 0xaa, 0xd3, 0xf5, 0x1f, 0xcb, 0xf0, 0x52,
+	0x4b, 0xf8, 0xdb, 0x31, 0xc5, 0x92, 0xb6, 0x30, 0xeb, 0x75, 0xf8, 0x00, 0xd9, 0x30, 0xc5, 0x0f,
+	0x43, 0x1a, 0x9b, 0xc6, 0xaa, 0xd1, 0xa8, 0x6e, 0x99, 0x3f, 0x7d, 0xd7, 0x5c, 0xd4, 0xa4, 0x1f,
+	0x10, 0x12, 0x53, 0x21, 0x76, 0x65, 0xcc, 0x42, 0xdf, 0x55, 0x30, 0xf4, 0x2a, 0xcc, 0xf5, 0xb8,
+	0x87, 0x7b, 0x6d, 0xc2, 0x03, 0xcc, 0x42, 0xb3, 0xb4, 0x6a, 0x34, 0xe6, 0xdd, 0xd9, 0x34, 0x76,
+	0x3f, 0x0d, 0x21, 0x02, 0xb3, 0x84, 0xee, 0xe1, 0x7e, 0x4f, 0xb6, 0x99, 0x08, 0xcc, 0x72, 0x4a,
+	0xbc, 0xfd, 0xe4, 0xd9, 0xca, 0xc4, 0x2f, 0xcf, 0x56, 0xde, 0xf3, 0x99, 0xec, 0xf6, 0x3b, 0xb6,
+	0xc7, 0x03, 0xa7, 0xe3, 0x45, 0x4d, 0x16, 0x86, 0xfc, 0x00, 0x4b, 0xc6, 0x43, 0xe1, 0xe4, 0xed,
+	0x37, 0xb5, 0x58, 0x7d, 0xc9, 0x7a, 0xf6, 0x03, 0x3a, 0xd0, 0x9d, 0xb8, 0xa0, 0x79, 0x77, 0x44,
+	0x80, 0xf6, 0x60, 0x2e, 0xab, 0xd2, 0xe5, 0x7c, 0xdf, 0x9c, 0xcc, 0xcb, 0x18, 0xcf, 0x5b, 0x26,
+	0x6b, 0xff, 0x01, 0xe7, 0xfb, 0xa8, 0x0b, 0xf3, 0x31, 0xfd, 0xbc, 0xcf, 0x62, 0x4a, 0x54, 0xa1,
+	0xa9, 0xeb, 0x2b, 0x34, 0x97, 0x31, 0x27, 0x95, 0x36, 0xef, 0x7c, 0x71, 0x76, 0xbc, 0xa6, 0x64,
+	0xfe, 0xf2, 0xec, 0x78, 0x6d, 0x68, 0x37, 0x0f, 0xd6, 0x9d, 0xe2, 0xc6, 0xd5, 0x39, 0x98, 0xc5,
+	0x98, 0x4b, 0x45, 0xc4, 0x43, 0x41, 0xd1, 0x2e, 0x94, 0x18, 0x31, 0x8d, 0xbc, 0xd1, 0xe7, 0x16,
+	0xbe, 0xc4, 0x48, 0xfd, 0xf7, 0x49, 0x98, 0x6f, 0x09, 0x7f, 0x97, 0xca, 0x7f, 0xeb, 0x9d, 0x0e,
+	0x40, 0xa0, 0x96, 0xb6, 0x19, 0x31, 0x4b, 0xd7, 0xd7, 0x5e, 0x55, 0xd3, 0xee, 0x90, 0xbf, 0x36,
+	0x9f, 0xf1, 0xc2, 0x7c, 0x7f, 0x63, 0x3e, 0x74, 0x0f, 0xaa, 0x21, 0x3d, 0x6c, 0xab, 0xfd, 0xac,
+	0x5c, 0xb2, 0x9f, 0x33, 0x21, 0x3d, 0x7c, 0x98, 0x6e, 0x69, 0x13, 0x50, 0x4c, 0x43, 0xde, 0x0f,
+	0x3d, 0xaa, 0xd6, 0x8a, 0x2e, 0x8b, 0xcc, 0xe9, 0x55, 0xa3, 0x31, 0xe3, 0x2e, 0x64, 0x99, 0x87,
+	0x59, 0x62, 0xf3, 0xcd, 0x51, 0x8b, 0x5b, 0x45, 0x8b, 0x9f, 0x9b, 0xab, 0xbe, 0x04, 0xb7, 0x46,
+	0x02, 0x99, 0xb9, 0xeb, 0x5f, 0x97, 0x60, 0xa1, 0x25, 0xfc, 0x47, 0x31, 0xf7, 0xa8, 0x10, 0x2d,
+	0x2a, 0x04, 0xf6, 0x69, 0xc1, 0x5b, 0xc6, 0x7f, 0xe2, 0xad, 0x0d, 0x98, 0x8e, 0x69, 0x0f, 0x1f,
+	0xd1, 0xd8, 0x2c, 0x5d, 0xa2, 0x50, 0x06, 0x44, 0x16, 0xcc, 0x04, 0x54, 0x62, 0x82, 0x25, 0x56,
+	0x66, 0x74, 0xf3, 0x67, 0x64, 0xc2, 0x74, 0xa0, 0xda, 0x57, 0x06, 0x72, 0xb3, 0xc7, 0x4d, 0x27,
+	0xd1, 0x29, 0xe3, 0x48, 0x94, 0xaa, 0x15, 0x95, 0x1a, 0x7d, 0xfd, 0xfa, 0xcb, 0xb0, 0x7c, 0x21,
+	0x98, 0x2b, 0xf6, 0xc3, 0x14, 0xa0, 0x96, 0xf0, 0xef, 0x33, 0x11, 0x61, 0xe9, 0x75, 0x33, 0xc9,
+	0xee, 0x42, 0x45, 0xd0, 0x90, 0x5c, 0x61, 0x7e, 0x35, 0xee, 0x7f, 0x19, 0xe0, 0x26, 0x20, 0x42,
+	0x85, 0x64, 0x61, 0xba, 0x36, 0x3b, 0x66, 0xca, 0xe9, 0x31, 0xb3, 0x30, 0x94, 0xd1, 0x87, 0x0d,
+	0x86, 0x6a, 0x4c, 0x3d, 0x16, 0x31, 0x1a, 0x4a, 0x73, 0xf2, 0x1a, 0x3b, 0xca, 0x59, 0x11, 0x82,
+	0xc9, 0x0e, 0x27, 0x47, 0x6a, 0xf6, 0xdc, 0xf4, 0x33, 0x62, 0x70, 0xc3, 0xeb, 0x0b, 0xc9, 0x83,
+	0x74, 0x2c, 0x13, 0x35, 0x2a, 0xd7, 0x38, 0x99, 0x8a, 0x3a, 0x99, 0xcb, 0x1d, 0x82, 0xee, 0xc2,
+	0xe2, 0x70, 0xa9, 0xdc, 0x4d, 0xd3, 0x69, 0x3b, 0xe8, 0x1c, 0xdb, 0xca, 0x7c, 0xb5, 0x09, 0x55,
+	0x1f, 0x8b, 0x76, 0x8f, 0x05, 0x4c, 0x9a, 0x33, 0x69, 0x5f, 0xb7, 0xb5, 0x26, 0xb7, 0x54, 0x49,
+	0x41, 0xf6, 0x6d, 0xc6, 0x9d, 0x00, 0xcb, 0xae, 0xbd, 0x13, 0x4a, 0x77, 0xc6, 0xc7, 0xe2, 0xa3,
+	0x04, 0x8e, 0x18, 0x4c, 0x07, 0x78, 0xd0, 0xde, 0xa3, 0xd4, 0xac, 0xae, 0x96, 0x1b, 0xb3, 0x1b,
+	0xcb, 0xb6, 0xb6, 0x44, 0x72, 0x73, 0xb1, 0xf5, 0xcd, 0xc5, 0xde, 0xe6, 0x2c, 0xdc, 0xba, 0x97,
+	0x90, 0x7e, 0xfb, 0xeb, 0x4a, 0x63, 0xe8, 0x65, 0xf5, 0x2b, 0xa9, 0x7f, 0x4d, 0x41, 0xf6, 0xf5,
+	0x15, 0x24, 0x59, 0x20, 0xbe, 0x39, 0x3b, 0x5e, 0x33, 0xdc, 0x4a, 0x80, 0x07, 0x1f, 0x52, 0x6d,
+	0x72, 0x6d, 0xad, 0xc4, 0xe3, 0x2b, 0x45, 0x8f, 0x17, 0x0c, 0x5b, 0x7f, 0x6c, 0x80, 0x75, 0x31,
+	0x9c, 0x9f, 0x7a, 0x89, 0x3b, 0x55, 0xe8, 0xda, 0xbf, 0x02, 0x14, 0xed, 0x0e, 0xa9, 0x7f, 0x6f,
+	0xc0, 0xcd, 0x96, 0xf0, 0x3f, 0x8e, 0x08, 0x96, 0xf4, 0x11, 0x8e, 0x71, 0x20, 0xd0, 0x3b, 0x50,
+	0xc5, 0x7d, 0xd9, 0xe5, 0x31, 0x93, 0x47, 0x97, 0x8e, 0xd2, 0x39, 0x14, 0xbd, 0x0f, 0x95, 0x28,
+	0x65, 0x48, 0x27, 0x29, 0x51, 0xfa, 0xc2, 0x7d, 0xd2, 0x56, 0x25, 0xb6, 0xaa, 0xc9, 0x6b, 0x68,
+	0xf5, 0xd4, 0x1a, 0xa5, 0xde, 0x39, 0x5b, 0x22, 0xe0, 0x2b, 0x45, 0x01, 0x87, 0xdb, 0xac, 0x2f,
+	0xc3, 0x52, 0x21, 0x94, 0x29, 0xb7, 0xf1, 0x47, 0x19, 0xca, 0x2d, 0xe1, 0x23, 0x0c, 0xf3, 0xa3,
+	0xb7, 0xc3, 0xd7, 0xc6, 0xb4, 0x54, 0xbc, 0x75, 0x58, 0x6f, 0x5d, 0x01, 0x94, 0x6f, 0xd2, 0x27,
+	0x00, 0x43, 0x37, 0x88, 0xd5, 0xf1, 0x4b, 0xcf, 0x11, 0x56, 0xe3, 0x32, 0x44, 0xce, 0x4c, 0xe0,
+	0x46, 0xe1, 0x4c, 0x78, 0x7d, 0xfc, 0xda, 0x51, 0x94, 0x75, 0xe7, 0x2a, 0xa8, 0xbc, 0x8a, 0x0f,
+	0x37, 0x8b, 0xdf, 0xa3, 0x6f, 0x8c, 0x27, 0x28, 0xc0, 0xac, 0xe6, 0x95, 0x60, 0x79, 0xa1, 0xcf,
+	0x60, 0x6e, 0xc4, 0x65, 0xf5, 0xf1, 0xcb, 0x87, 0x31, 0xd6, 0xda, 0xe5, 0x98, 0x8c, 0xdf, 0x9a,
+	0x7a, 0x9c, 0xd8, 0x69, 0xcb, 0x7d, 0x72, 0x52, 0x33, 0x9e, 0x9e, 0xd4, 0x8c, 0xdf, 0x4e, 0x6a,
+	0xc6, 0x57, 0xa7, 0xb5, 0x89, 0xa7, 0xa7, 0xb5, 0x89, 0x9f, 0x4f, 0x6b, 0x13, 0x9f, 0xbe, 0xfb,
+	0x4f, 0x46, 0x66, 0xa0, 0x7e, 0x71, 0xa4, 0xb3, 0xde, 0xa9, 0xa4, 0xbf, 0x37, 0xde, 0xfe, 0x73,
+	0x00, 0xa9, 0xfb, 0x0a, 0x18, 0x3c, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,6 +671,12 @@ type MsgClient interface {
 	SetMailbox(ctx context.Context, in *MsgSetMailbox, opts ...grpc.CallOption) (*MsgSetMailboxResponse, error)
 	// ProcessMessage ...
 	ProcessMessage(ctx context.Context, in *MsgProcessMessage, opts ...grpc.CallOption) (*MsgProcessMessageResponse, error)
+	// DispatchMessage sends an arbitrary message body from the signer to a
+	// remote recipient.
+	DispatchMessage(ctx context.Context, in *MsgDispatchMessage, opts ...grpc.CallOption) (*MsgDispatchMessageResponse, error)
+	// UpdateParams updates the module parameters. Only callable by the
+	// authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -455,6 +714,24 @@ func (c *msgClient) ProcessMessage(ctx context.Context, in *MsgProcessMessage, o
 	return out, nil
 }
 
+func (c *msgClient) DispatchMessage(ctx context.Context, in *MsgDispatchMessage, opts ...grpc.CallOption) (*MsgDispatchMessageResponse, error) {
+	out := new(MsgDispatchMessageResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Msg/DispatchMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.core.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateMailbox ...
@@ -463,6 +740,12 @@ type MsgServer interface {
 	SetMailbox(context.Context, *MsgSetMailbox) (*MsgSetMailboxResponse, error)
 	// ProcessMessage ...
 	ProcessMessage(context.Context, *MsgProcessMessage) (*MsgProcessMessageResponse, error)
+	// DispatchMessage sends an arbitrary message body from the signer to a
+	// remote recipient.
+	DispatchMessage(context.Context, *MsgDispatchMessage) (*MsgDispatchMessageResponse, error)
+	// UpdateParams updates the module parameters. Only callable by the
+	// authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -478,6 +761,12 @@ func (*UnimplementedMsgServer) SetMailbox(ctx context.Context, req *MsgSetMailbo
 func (*UnimplementedMsgServer) ProcessMessage(ctx context.Context, req *MsgProcessMessage) (*MsgProcessMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessMessage not implemented")
 }
+func (*UnimplementedMsgServer) DispatchMessage(ctx context.Context, req *MsgDispatchMessage) (*MsgDispatchMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchMessage not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -537,6 +826,42 @@ func _Msg_ProcessMessage_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DispatchMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDispatchMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DispatchMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Msg/DispatchMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DispatchMessage(ctx, req.(*MsgDispatchMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.core.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hyperlane.core.v1.Msg",
@@ -554,6 +879,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessMessage",
 			Handler:    _Msg_ProcessMessage_Handler,
 		},
+		{
+			MethodName: "DispatchMessage",
+			Handler:    _Msg_DispatchMessage_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hyperlane/core/v1/tx.proto",
@@ -854,83 +1187,284 @@ func (m *MsgProcessMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateMailbox) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LocalDomain != 0 {
-		n += 1 + sovTx(uint64(m.LocalDomain))
-	}
-	l = m.DefaultIsm.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DefaultHook != nil {
-		l = m.DefaultHook.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequiredHook != nil {
-		l = m.RequiredHook.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDispatchMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateMailboxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgDispatchMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetMailbox) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDispatchMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MaxFee) > 0 {
+		for iNdEx := len(m.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = m.MailboxId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DefaultIsm != nil {
-		l = m.DefaultIsm.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.GasLimit.Size()
+		i -= size
+		if _, err := m.GasLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.DefaultHook != nil {
-		l = m.DefaultHook.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	if len(m.CustomHookMetadata) > 0 {
+		i -= len(m.CustomHookMetadata)
+		copy(dAtA[i:], m.CustomHookMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomHookMetadata)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.RequiredHook != nil {
-		l = m.RequiredHook.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.CustomHookId != nil {
+		{
+			size := m.CustomHookId.Size()
+			i -= size
+			if _, err := m.CustomHookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.RenounceOwnership {
+	{
+		size := m.Recipient.Size()
+		i -= size
+		if _, err := m.Recipient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MailboxId.Size()
+		i -= size
+		if _, err := m.MailboxId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDispatchMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDispatchMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDispatchMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateMailbox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LocalDomain != 0 {
+		n += 1 + sovTx(uint64(m.LocalDomain))
+	}
+	l = m.DefaultIsm.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DefaultHook != nil {
+		l = m.DefaultHook.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequiredHook != nil {
+		l = m.RequiredHook.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateMailboxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMailbox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MailboxId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DefaultIsm != nil {
+		l = m.DefaultIsm.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DefaultHook != nil {
+		l = m.DefaultHook.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequiredHook != nil {
+		l = m.RequiredHook.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
 		n += 2
 	}
 	return n
@@ -977,6 +1511,81 @@ func (m *MsgProcessMessageResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDispatchMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MailboxId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = m.Recipient.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CustomHookId != nil {
+		l = m.CustomHookId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CustomHookMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.MaxFee) > 0 {
+		for _, e := range m.MaxFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDispatchMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MessageId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1135,7 +1744,601 @@ func (m *MsgCreateMailbox) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.RequiredHook = &v
+			if err := m.RequiredHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMailboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMailboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMailbox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMailbox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultIsm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.DefaultIsm = &v
+			if err := m.DefaultIsm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.DefaultHook = &v
+			if err := m.DefaultHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.RequiredHook = &v
+			if err := m.RequiredHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenounceOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMailboxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMailboxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1163,11 +2366,7 @@ func (m *MsgCreateMailbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.RequiredHook = &v
-			if err := m.RequiredHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1190,7 +2389,7 @@ func (m *MsgCreateMailbox) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMailboxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProcessMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1213,46 +2412,12 @@ func (m *MsgCreateMailboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMailboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProcessMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProcessMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1274,7 +2439,7 @@ func (m *MsgCreateMailboxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
+func (m *MsgDispatchMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1297,15 +2462,15 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMailbox: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDispatchMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMailbox: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDispatchMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1333,7 +2498,7 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1370,8 +2535,27 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultIsm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1399,15 +2583,13 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.DefaultIsm = &v
-			if err := m.DefaultIsm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,15 +2617,11 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.DefaultHook = &v
-			if err := m.DefaultHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1472,14 +2650,14 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.RequiredHook = &v
-			if err := m.RequiredHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CustomHookId = &v
+			if err := m.CustomHookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookMetadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1507,13 +2685,13 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.CustomHookMetadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1523,12 +2701,60 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RenounceOwnership = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = append(m.MaxFee, types.Coin{})
+			if err := m.MaxFee[len(m.MaxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1550,7 +2776,7 @@ func (m *MsgSetMailbox) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMailboxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDispatchMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1573,12 +2799,46 @@ func (m *MsgSetMailboxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMailboxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDispatchMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMailboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDispatchMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1600,7 +2860,7 @@ func (m *MsgSetMailboxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessMessage) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1623,15 +2883,15 @@ func (m *MsgProcessMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1659,47 +2919,13 @@ func (m *MsgProcessMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MailboxId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1709,55 +2935,24 @@ func (m *MsgProcessMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1780,7 +2975,7 @@ func (m *MsgProcessMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessMessageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1803,10 +2998,10 @@ func (m *MsgProcessMessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessMessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
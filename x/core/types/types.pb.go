@@ -7,17 +7,22 @@ import (
 	fmt "fmt"
 	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params defines the governance-configurable parameters of the core module.
+type Params struct {
+	// dispatched_message_retention is the number of most recent dispatched
+	// messages which are kept in state per mailbox. Older messages are pruned
+	// when new messages are dispatched. Zero disables pruning.
+	DispatchedMessageRetention uint32 `protobuf:"varint,1,opt,name=dispatched_message_retention,json=dispatchedMessageRetention,proto3" json:"dispatched_message_retention,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d14de0fc8fa7fd67, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDispatchedMessageRetention() uint32 {
+	if m != nil {
+		return m.DispatchedMessageRetention
+	}
+	return 0
+}
+
 // Mailbox ...
 type Mailbox struct {
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
@@ -48,7 +101,7 @@ func (m *Mailbox) Reset()         { *m = Mailbox{} }
 func (m *Mailbox) String() string { return proto.CompactTextString(m) }
 func (*Mailbox) ProtoMessage()    {}
 func (*Mailbox) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d14de0fc8fa7fd67, []int{0}
+	return fileDescriptor_d14de0fc8fa7fd67, []int{1}
 }
 func (m *Mailbox) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,39 +158,207 @@ func (m *Mailbox) GetLocalDomain() uint32 {
 	return 0
 }
 
+// DispatchedMessage is a message which was sent from a local mailbox.
+type DispatchedMessage struct {
+	// nonce is the nonce of the message within the origin mailbox.
+	Nonce uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// message_id ...
+	MessageId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"message_id"`
+	// message is the hex encoded Hyperlane message.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *DispatchedMessage) Reset()         { *m = DispatchedMessage{} }
+func (m *DispatchedMessage) String() string { return proto.CompactTextString(m) }
+func (*DispatchedMessage) ProtoMessage()    {}
+func (*DispatchedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d14de0fc8fa7fd67, []int{2}
+}
+func (m *DispatchedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DispatchedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DispatchedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DispatchedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DispatchedMessage.Merge(m, src)
+}
+func (m *DispatchedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DispatchedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DispatchedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DispatchedMessage proto.InternalMessageInfo
+
+func (m *DispatchedMessage) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *DispatchedMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// DeliveredMessage records the delivery of an incoming message.
+type DeliveredMessage struct {
+	// block_height is the height at which the message was processed.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the block time at which the message was processed.
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// relayer is the address which submitted the message.
+	Relayer string `protobuf:"bytes,3,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// ism_id is the ISM which verified the message.
+	IsmId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,4,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id"`
+}
+
+func (m *DeliveredMessage) Reset()         { *m = DeliveredMessage{} }
+func (m *DeliveredMessage) String() string { return proto.CompactTextString(m) }
+func (*DeliveredMessage) ProtoMessage()    {}
+func (*DeliveredMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d14de0fc8fa7fd67, []int{3}
+}
+func (m *DeliveredMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveredMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveredMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveredMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveredMessage.Merge(m, src)
+}
+func (m *DeliveredMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveredMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveredMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveredMessage proto.InternalMessageInfo
+
+func (m *DeliveredMessage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *DeliveredMessage) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *DeliveredMessage) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterType((*Params)(nil), "hyperlane.core.v1.Params")
 	proto.RegisterType((*Mailbox)(nil), "hyperlane.core.v1.Mailbox")
+	proto.RegisterType((*DispatchedMessage)(nil), "hyperlane.core.v1.DispatchedMessage")
+	proto.RegisterType((*DeliveredMessage)(nil), "hyperlane.core.v1.DeliveredMessage")
 }
 
 func init() { proto.RegisterFile("hyperlane/core/v1/types.proto", fileDescriptor_d14de0fc8fa7fd67) }
 
 var fileDescriptor_d14de0fc8fa7fd67 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x6e, 0xd4, 0x40,
-	0x10, 0x87, 0xed, 0x23, 0x7f, 0x60, 0xe3, 0x08, 0x58, 0xa5, 0x58, 0x22, 0xe1, 0x24, 0x54, 0xa1,
-	0x38, 0x5b, 0x11, 0x0d, 0x12, 0x15, 0x81, 0x22, 0x14, 0x34, 0xbe, 0x8e, 0xc6, 0xb2, 0xbd, 0x13,
-	0x7b, 0x15, 0x7b, 0xc7, 0xec, 0xae, 0xcd, 0xe5, 0x2d, 0x78, 0x18, 0x24, 0x5e, 0x21, 0x65, 0x44,
-	0x85, 0x28, 0x4e, 0xe8, 0xee, 0x45, 0xd0, 0xed, 0xda, 0xd7, 0xa3, 0x5c, 0xb7, 0xfb, 0xcd, 0x4f,
-	0xf3, 0xcd, 0x6a, 0x87, 0xbc, 0xac, 0x6e, 0x5b, 0x50, 0x75, 0x26, 0x21, 0x2e, 0x50, 0x41, 0xdc,
-	0x5f, 0xc4, 0xe6, 0xb6, 0x05, 0x1d, 0xb5, 0x0a, 0x0d, 0xd2, 0xe7, 0x9b, 0x72, 0xb4, 0x2e, 0x47,
-	0xfd, 0xc5, 0xf1, 0x8b, 0x02, 0x75, 0x83, 0x3a, 0xb5, 0x81, 0xd8, 0x5d, 0x5c, 0xfa, 0xf8, 0xa8,
-	0xc4, 0x12, 0x1d, 0x5f, 0x9f, 0x1c, 0x7d, 0xf5, 0x73, 0x87, 0xec, 0x7f, 0xce, 0x44, 0x9d, 0xe3,
-	0x9c, 0xce, 0xc8, 0x44, 0x70, 0xe6, 0x9f, 0xfa, 0xe7, 0x4f, 0x2e, 0x3f, 0xdc, 0x2d, 0x4e, 0xbc,
-	0x3f, 0x8b, 0x93, 0x77, 0xa5, 0x30, 0x55, 0x97, 0x47, 0x05, 0x36, 0x71, 0x5e, 0xb4, 0x53, 0x21,
-	0x25, 0xf6, 0x99, 0x11, 0x28, 0x75, 0xbc, 0xd1, 0x4f, 0x9d, 0x28, 0xee, 0x8c, 0xa8, 0xa3, 0x2b,
-	0x98, 0xbf, 0xe7, 0x5c, 0x81, 0xd6, 0xc9, 0x44, 0x70, 0x1a, 0x91, 0x5d, 0xfc, 0x26, 0x41, 0xb1,
-	0x89, 0xed, 0xcb, 0x7e, 0xfd, 0x98, 0x1e, 0x0d, 0x73, 0x0d, 0xb1, 0x99, 0x51, 0x42, 0x96, 0x89,
-	0x8b, 0xd1, 0x33, 0x12, 0x34, 0xa0, 0x75, 0x56, 0x42, 0xaa, 0x41, 0x1a, 0xf6, 0xe8, 0xd4, 0x3f,
-	0x3f, 0x4c, 0x0e, 0x06, 0x36, 0x03, 0x69, 0xe8, 0x6b, 0xf2, 0x6c, 0x8c, 0x28, 0x28, 0x40, 0xf4,
-	0xc0, 0xd9, 0x8e, 0x8d, 0x3d, 0x1d, 0x78, 0x32, 0x60, 0xca, 0xc9, 0x01, 0x87, 0xeb, 0xac, 0xab,
-	0x4d, 0x2a, 0x74, 0xc3, 0x76, 0xb7, 0xf7, 0x36, 0x32, 0xf4, 0xfd, 0xa4, 0x1b, 0x7a, 0x4d, 0x82,
-	0xd1, 0x52, 0x21, 0xde, 0xb0, 0xbd, 0x8d, 0xc6, 0x7f, 0xa8, 0x66, 0x1c, 0xff, 0x0a, 0xf1, 0x86,
-	0x56, 0xe4, 0x50, 0xc1, 0xd7, 0x4e, 0x28, 0xe0, 0x4e, 0xb4, 0xbf, 0x3d, 0x51, 0x30, 0x76, 0xb6,
-	0xa6, 0x33, 0x12, 0xd4, 0x58, 0x64, 0x75, 0xca, 0xb1, 0xc9, 0x84, 0x64, 0x8f, 0xdd, 0x2f, 0x58,
-	0xf6, 0xd1, 0xa2, 0xcb, 0xe4, 0x6e, 0x19, 0xfa, 0xf7, 0xcb, 0xd0, 0xff, 0xbb, 0x0c, 0xfd, 0xef,
-	0xab, 0xd0, 0xbb, 0x5f, 0x85, 0xde, 0xef, 0x55, 0xe8, 0x7d, 0x79, 0xfb, 0x3f, 0x73, 0xcc, 0xdd,
-	0x6a, 0xdb, 0xbd, 0xce, 0xf7, 0xec, 0x52, 0xbe, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x24,
-	0x67, 0xa6, 0xf9, 0x02, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xb1, 0x6e, 0x13, 0x4b,
+	0x14, 0xf5, 0x3a, 0xb1, 0x1d, 0x8f, 0x1d, 0xbd, 0x64, 0x5e, 0x8a, 0x7d, 0xd6, 0xc3, 0x26, 0xae,
+	0x00, 0x29, 0xbb, 0x4a, 0x68, 0x10, 0x34, 0x60, 0x52, 0x24, 0x45, 0x24, 0xb4, 0xa1, 0x4a, 0xb3,
+	0x9a, 0xdd, 0xb9, 0xd9, 0x1d, 0x65, 0x67, 0xc6, 0xcc, 0x8c, 0x4d, 0xf2, 0x09, 0x50, 0xe5, 0x2f,
+	0x68, 0x29, 0x90, 0xf8, 0x85, 0x94, 0x11, 0x15, 0xa2, 0x08, 0x28, 0x29, 0xf8, 0x0d, 0xe4, 0x99,
+	0x5d, 0x5b, 0xa2, 0x41, 0x28, 0x69, 0x2c, 0xdf, 0x33, 0x67, 0xcf, 0xbd, 0xe7, 0xce, 0xbd, 0x83,
+	0xee, 0xe5, 0x67, 0x63, 0x50, 0x05, 0x11, 0x10, 0xa6, 0x52, 0x41, 0x38, 0xdd, 0x0e, 0xcd, 0xd9,
+	0x18, 0x74, 0x30, 0x56, 0xd2, 0x48, 0xbc, 0x3e, 0x3f, 0x0e, 0x66, 0xc7, 0xc1, 0x74, 0xbb, 0xf7,
+	0x5f, 0x2a, 0x35, 0x97, 0x3a, 0xb6, 0x84, 0xd0, 0x05, 0x8e, 0xdd, 0xdb, 0xc8, 0x64, 0x26, 0x1d,
+	0x3e, 0xfb, 0x57, 0xa2, 0xeb, 0x84, 0x33, 0x21, 0x43, 0xfb, 0x5b, 0x42, 0x83, 0x4c, 0xca, 0xac,
+	0x80, 0xd0, 0x46, 0xc9, 0xe4, 0x38, 0x34, 0x8c, 0x83, 0x36, 0x84, 0x8f, 0x1d, 0x61, 0x48, 0x51,
+	0xf3, 0x15, 0x51, 0x84, 0x6b, 0xfc, 0x1c, 0xfd, 0x4f, 0x99, 0x1e, 0x13, 0x93, 0xe6, 0x40, 0x63,
+	0x0e, 0x5a, 0x93, 0x0c, 0x62, 0x05, 0x06, 0x84, 0x61, 0x52, 0xf8, 0xde, 0x7d, 0xef, 0xc1, 0x6a,
+	0xd4, 0x5b, 0x70, 0x0e, 0x1c, 0x25, 0xaa, 0x18, 0x4f, 0xfd, 0xf7, 0x3f, 0x3f, 0x3e, 0xfa, 0x77,
+	0xe1, 0x73, 0xba, 0x1d, 0x3a, 0xed, 0xe1, 0xe7, 0x65, 0xd4, 0x3a, 0x20, 0xac, 0x48, 0xe4, 0x29,
+	0x3e, 0x44, 0x75, 0x46, 0xad, 0x5a, 0x7b, 0xf4, 0xf2, 0xe2, 0x6a, 0x50, 0xfb, 0x76, 0x35, 0x78,
+	0x96, 0x31, 0x93, 0x4f, 0x92, 0x20, 0x95, 0x3c, 0x4c, 0xd2, 0xf1, 0x16, 0x13, 0x42, 0x4e, 0xc9,
+	0x4c, 0x54, 0x87, 0x73, 0xbd, 0x2d, 0xd7, 0x82, 0x70, 0x62, 0x58, 0x11, 0xec, 0xc1, 0xe9, 0x0b,
+	0x4a, 0x15, 0x68, 0x1d, 0xd5, 0x19, 0xc5, 0x01, 0x6a, 0xc8, 0xb7, 0x02, 0x94, 0x5f, 0xb7, 0xba,
+	0xfe, 0x97, 0x4f, 0x5b, 0x1b, 0x65, 0xc7, 0x4a, 0xda, 0xa1, 0x51, 0x4c, 0x64, 0x91, 0xa3, 0xe1,
+	0x4d, 0xd4, 0xad, 0x1c, 0x6a, 0x10, 0xc6, 0x5f, 0xb2, 0xe6, 0x3a, 0x25, 0x76, 0x08, 0xc2, 0xe0,
+	0x87, 0x68, 0x6d, 0xd1, 0x84, 0x14, 0xd8, 0x14, 0xa8, 0xbf, 0x6c, 0x69, 0xff, 0xf0, 0xca, 0xb9,
+	0x83, 0x31, 0x45, 0x1d, 0x0a, 0xc7, 0x64, 0x52, 0x98, 0x98, 0x69, 0xee, 0x37, 0xee, 0xce, 0x1b,
+	0x2a, 0x75, 0xf7, 0x35, 0xc7, 0xc7, 0xa8, 0x5b, 0x65, 0xc9, 0xa5, 0x3c, 0xf1, 0x9b, 0xf3, 0x34,
+	0xde, 0x6d, 0xd3, 0x54, 0xe5, 0xef, 0x49, 0x79, 0x82, 0x73, 0xb4, 0xaa, 0xe0, 0xcd, 0x84, 0x29,
+	0xa0, 0x2e, 0x51, 0xeb, 0xee, 0x12, 0x75, 0x2b, 0x65, 0x9b, 0x69, 0x13, 0x75, 0x0b, 0x99, 0x92,
+	0x22, 0xa6, 0x92, 0x13, 0x26, 0xfc, 0x15, 0x77, 0x0b, 0x16, 0xdb, 0xb5, 0xd0, 0xf0, 0x83, 0x87,
+	0xd6, 0x77, 0x7f, 0x1f, 0x39, 0xbc, 0x81, 0x1a, 0x42, 0x8a, 0x14, 0xca, 0xa1, 0x74, 0x01, 0x4e,
+	0x10, 0xaa, 0x6e, 0x8c, 0x51, 0xbf, 0x3e, 0xaf, 0xfa, 0xd6, 0xb7, 0xd0, 0x2e, 0x65, 0xf7, 0x29,
+	0xf6, 0x51, 0xab, 0x0c, 0xec, 0xcc, 0xb4, 0xa3, 0x2a, 0x1c, 0xbe, 0xab, 0xa3, 0xb5, 0x5d, 0x28,
+	0xd8, 0x14, 0xd4, 0xa2, 0xd0, 0x4d, 0xd4, 0x4d, 0x0a, 0x99, 0x9e, 0xc4, 0x39, 0xb0, 0x2c, 0x37,
+	0xb6, 0xde, 0xa5, 0xa8, 0x63, 0xb1, 0x3d, 0x0b, 0xe1, 0x11, 0x6a, 0xcf, 0x97, 0xd2, 0x16, 0xdd,
+	0xd9, 0xe9, 0x05, 0x6e, 0x6d, 0x83, 0x6a, 0x6d, 0x83, 0xd7, 0x15, 0x63, 0xb4, 0x32, 0x33, 0x74,
+	0xfe, 0x7d, 0xe0, 0x45, 0x8b, 0xcf, 0xf0, 0x0e, 0x6a, 0x29, 0x28, 0xc8, 0x19, 0x28, 0x7f, 0xe9,
+	0x0f, 0x0b, 0x50, 0x11, 0xf1, 0x11, 0x6a, 0x32, 0xcd, 0x63, 0xe6, 0xa6, 0xfa, 0x8e, 0x3a, 0xd5,
+	0x60, 0x9a, 0xef, 0xd3, 0x51, 0x74, 0x71, 0xdd, 0xf7, 0x2e, 0xaf, 0xfb, 0xde, 0x8f, 0xeb, 0xbe,
+	0x77, 0x7e, 0xd3, 0xaf, 0x5d, 0xde, 0xf4, 0x6b, 0x5f, 0x6f, 0xfa, 0xb5, 0xa3, 0x27, 0x7f, 0xa3,
+	0x7e, 0xea, 0x9e, 0x4a, 0xfb, 0x4e, 0x26, 0x4d, 0xdb, 0x8c, 0xc7, 0xbf, 0x06, 0x00, 0x0d, 0x71,
+	0x53, 0xa7, 0x49, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DispatchedMessageRetention != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DispatchedMessageRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Mailbox) Marshal() (dAtA []byte, err error) {
@@ -229,6 +450,104 @@ func (m *Mailbox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DispatchedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DispatchedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DispatchedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveredMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveredMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveredMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.IsmId.Size()
+		i -= size
+		if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -240,6 +559,18 @@ func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DispatchedMessageRetention != 0 {
+		n += 1 + sovTypes(uint64(m.DispatchedMessageRetention))
+	}
+	return n
+}
+
 func (m *Mailbox) Size() (n int) {
 	if m == nil {
 		return 0
@@ -274,13 +605,51 @@ func (m *Mailbox) Size() (n int) {
 	return n
 }
 
+func (m *DispatchedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = m.MessageId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DeliveredMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.IsmId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Mailbox) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -303,17 +672,17 @@ func (m *Mailbox) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mailbox: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mailbox: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchedMessageRetention", wireType)
 			}
-			var stringLen uint64
+			m.DispatchedMessageRetention = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -323,41 +692,110 @@ func (m *Mailbox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DispatchedMessageRetention |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mailbox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mailbox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mailbox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -559,6 +997,309 @@ func (m *Mailbox) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DispatchedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DispatchedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DispatchedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveredMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveredMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveredMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
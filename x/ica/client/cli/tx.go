@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gasLimit           string
+	customHookId       string
+	customHookMetadata string
+	ismId              string
+	maxFee             string
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "hyperlane-ica",
+		Short:                      "hyperlane-ica transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		CmdCallRemote(),
+		CmdCreateRouter(),
+		CmdEnrollRemoteRouter(),
+		CmdUnrollRemoteRouter(),
+	)
+
+	return txCmd
+}
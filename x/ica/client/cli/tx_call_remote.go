@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+)
+
+func CmdCallRemote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call-remote [router-id] [destination-domain] [calls]",
+		Short: "Execute calls with the interchain account of the sender on a remote chain",
+		Long:  "Execute calls with the interchain account of the sender on a remote chain. The calls are hex encoded in the format of the destination router.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			routerId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			destinationDomain, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasLimitInt, ok := math.NewIntFromString(gasLimit)
+			if !ok {
+				return errors.New("failed to convert `gasLimit` into math.Int")
+			}
+
+			maxFeeCoin, err := sdk.ParseCoinNormalized(maxFee)
+			if err != nil {
+				return err
+			}
+
+			var parsedHookId *util.HexAddress = nil
+			if customHookId != "" {
+				parsed, err := util.DecodeHexAddress(customHookId)
+				if err != nil {
+					return err
+				}
+				parsedHookId = &parsed
+			}
+
+			msg := types.MsgCallRemote{
+				Sender:             clientCtx.GetFromAddress().String(),
+				RouterId:           routerId,
+				DestinationDomain:  uint32(destinationDomain),
+				Calls:              args[2],
+				CustomHookId:       parsedHookId,
+				GasLimit:           gasLimitInt,
+				MaxFee:             maxFeeCoin,
+				CustomHookMetadata: customHookMetadata,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	cmd.Flags().StringVar(&customHookId, "custom-hook-id", "", "custom DefaultHookId")
+	cmd.Flags().StringVar(&customHookMetadata, "custom-hook-metadata", "", "custom hook metadata")
+
+	cmd.Flags().StringVar(&gasLimit, "gas-limit", "0", "Overwrite InterchainGasPayment gas limit")
+
+	cmd.Flags().StringVar(&maxFee, "max-hyperlane-fee", "0", "maximum Hyperlane InterchainGasPayment")
+
+	return cmd
+}
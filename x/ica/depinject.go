@@ -0,0 +1,62 @@
+package ica
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	modulev1 "github.com/bcp-innovations/hyperlane-cosmos/api/ica/module/v1"
+	coreKeeper "github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ appmodule.AppModule = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (am AppModule) IsAppModule() {}
+
+func init() {
+	appmodule.Register(
+		&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+	AddressCodec address.Codec
+
+	Config *modulev1.Module
+
+	AccountKeeper    types.AccountKeeper
+	MailboxKeeper    *coreKeeper.Keeper
+	MsgServiceRouter baseapp.MessageRouter
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Module appmodule.AppModule
+	Keeper keeper.Keeper
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.AddressCodec, in.StoreService, in.AccountKeeper, in.MailboxKeeper, in.MsgServiceRouter)
+	m := NewAppModule(in.Cdc, k)
+	return ModuleOutputs{Module: m, Keeper: k}
+}
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, router := range data.Routers {
+		if err := k.Routers.Set(ctx, router.Id.GetInternalId(), router); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range data.RemoteRouters {
+		if err := k.EnrolledRouters.Set(ctx, collections.Join(r.RouterId, r.RemoteRouter.ReceiverDomain), r.RemoteRouter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	routerIterator, err := k.Routers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	routers, err := routerIterator.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	genesisRouters := make([]types.GenesisRemoteRouterWrapper, 0)
+	err = k.EnrolledRouters.Walk(ctx, nil, func(key collections.Pair[uint64, uint32], value types.RemoteRouter) (stop bool, err error) {
+		genesisRouters = append(genesisRouters, types.GenesisRemoteRouterWrapper{
+			RouterId:     key.K1(),
+			RemoteRouter: value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Routers:       routers,
+		RemoteRouters: genesisRouters,
+	}, nil
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+)
+
+type Keeper struct {
+	cdc          codec.Codec
+	addressCodec address.Codec
+
+	// state management
+	Schema collections.Schema
+	// <routerId> -> Router
+	Routers collections.Map[uint64, types.InterchainAccountRouter]
+	// <routerId> <domain> -> Router
+	EnrolledRouters collections.Map[collections.Pair[uint64, uint32], types.RemoteRouter]
+
+	accountKeeper types.AccountKeeper
+	coreKeeper    types.CoreKeeper
+	msgRouter     baseapp.MessageRouter
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	addressCodec address.Codec,
+	storeService storetypes.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	coreKeeper types.CoreKeeper,
+	msgRouter baseapp.MessageRouter,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:             cdc,
+		addressCodec:    addressCodec,
+		Routers:         collections.NewMap(sb, types.RoutersKey, "routers", collections.Uint64Key, codec.CollValue[types.InterchainAccountRouter](cdc)),
+		EnrolledRouters: collections.NewMap(sb, types.EnrolledRoutersKey, "enrolled_routers", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.RemoteRouter](cdc)),
+		accountKeeper:   accountKeeper,
+		coreKeeper:      coreKeeper,
+		msgRouter:       msgRouter,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	coreKeeper.AppRouter().RegisterModule(types.APP_TYPE_INTERCHAIN_ACCOUNT_ROUTER, &k)
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k *Keeper) Exists(ctx context.Context, routerId util.HexAddress) (bool, error) {
+	return k.Routers.Has(ctx, routerId.GetInternalId())
+}
+
+func (k *Keeper) ReceiverIsmId(ctx context.Context, recipient util.HexAddress) (*util.HexAddress, error) {
+	router, err := k.Routers.Get(ctx, recipient.GetInternalId())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrRouterNotFound, "%v", recipient.String())
+	}
+
+	if router.IsmId == nil {
+		mailbox, err := k.coreKeeper.GetMailbox(ctx, router.OriginMailbox)
+		if err != nil {
+			return nil, err
+		}
+		return &mailbox.DefaultIsm, nil
+	}
+
+	return router.IsmId, nil
+}
+
+// Handle executes the calls of an incoming message with the interchain account of the remote owner.
+func (k *Keeper) Handle(ctx context.Context, mailboxId util.HexAddress, message util.HyperlaneMessage) error {
+	router, err := k.Routers.Get(ctx, message.Recipient.GetInternalId())
+	if err != nil {
+		return err
+	}
+
+	if router.OriginMailbox != mailboxId {
+		return fmt.Errorf("invalid origin mailbox address")
+	}
+
+	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(message.Recipient.GetInternalId(), message.Origin))
+	if err != nil {
+		return fmt.Errorf("no enrolled router found for origin %d", message.Origin)
+	}
+
+	if message.Sender.String() != strings.ToLower(remoteRouter.ReceiverContract) {
+		return fmt.Errorf("invalid receiver contract")
+	}
+
+	payload, err := types.ParseIcaPayload(message.Body)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidCallPayload, err.Error())
+	}
+
+	var calls types.InterchainAccountCalls
+	if err := k.cdc.Unmarshal(payload.Calls, &calls); err != nil {
+		return errors.Wrap(types.ErrInvalidCallPayload, err.Error())
+	}
+	if err := calls.UnpackInterfaces(k.cdc); err != nil {
+		return errors.Wrap(types.ErrInvalidCallPayload, err.Error())
+	}
+
+	account := k.InterchainAccountAddress(router.Id, message.Origin, payload.Owner, message.Sender, accountIsmId(router))
+	if !k.accountKeeper.HasAccount(ctx, account) {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, account))
+	}
+
+	return k.executeCalls(sdk.UnwrapSDKContext(ctx), account, calls)
+}
+
+// accountIsmId returns the ISM which is part of the interchain account addresses of a router.
+// It is the ISM configured on the router, or the zero address if the router uses the default ISM of
+// its mailbox, so that the addresses do not change when the default ISM of the mailbox changes.
+func accountIsmId(router types.InterchainAccountRouter) util.HexAddress {
+	if router.IsmId == nil {
+		return util.HexAddress{}
+	}
+	return *router.IsmId
+}
+
+// InterchainAccountAddress derives the deterministic address of the interchain account which is
+// controlled by the owner on the origin domain through the given remote router and ISM.
+func (k *Keeper) InterchainAccountAddress(routerId util.HexAddress, origin uint32, owner, remoteRouter, ismId util.HexAddress) sdk.AccAddress {
+	key := make([]byte, 0, 4*32+4)
+	key = append(key, routerId.Bytes()...)
+	key = binary.BigEndian.AppendUint32(key, origin)
+	key = append(key, owner.Bytes()...)
+	key = append(key, remoteRouter.Bytes()...)
+	key = append(key, ismId.Bytes()...)
+
+	return sdkaddress.Module(types.ModuleName, key)
+}
+
+// executeCalls runs every call through the message router. Each call must be signed by the
+// interchain account only. If any call fails, the whole message is rejected.
+func (k *Keeper) executeCalls(ctx sdk.Context, account sdk.AccAddress, calls types.InterchainAccountCalls) error {
+	msgs := make([]sdk.Msg, len(calls.Messages))
+	for i, call := range calls.Messages {
+		msg, ok := call.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return errors.Wrapf(types.ErrInvalidCall, "call %d is not a message", i)
+		}
+
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidCall, "failed to get signers of call %d: %s", i, err)
+		}
+		if len(signers) != 1 || !account.Equals(sdk.AccAddress(signers[0])) {
+			return errors.Wrapf(types.ErrInvalidCall, "call %d must be signed by interchain account %s", i, account)
+		}
+
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return errors.Wrapf(types.ErrInvalidCall, "call %d: %s", i, err)
+			}
+		}
+
+		msgs[i] = msg
+	}
+
+	for i, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errors.Wrapf(types.ErrUnroutableCall, "call %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return errors.Wrapf(err, "failed to execute call %d", i)
+		}
+
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
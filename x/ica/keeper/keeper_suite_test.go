@@ -0,0 +1,16 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIcaKeeper(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, fmt.Sprintf("x/%s Keeper Test Suite", types.ModuleName))
+}
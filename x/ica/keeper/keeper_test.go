@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	ismTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - keeper.go
+
+* Handle (invalid) sender is not the enrolled router
+* Handle (invalid) call is not signed by the interchain account
+* Handle (invalid) malformed calls
+* Handle (valid) executes bank send from the interchain account
+* InterchainAccount (valid) address depends on owner, origin and ISM
+* InterchainAccount (valid) address does not change with the default ISM of the mailbox
+
+*/
+
+var _ = Describe("keeper.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var recipient i.TestValidatorAddress
+	var remoteOwner util.HexAddress
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		recipient = i.GenerateTestValidatorAddress("Recipient")
+		remoteOwner = util.CreateMockHexAddress("remote-owner", 1)
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	encodeCalls := func(msgs ...sdk.Msg) []byte {
+		calls := types.InterchainAccountCalls{}
+		for _, msg := range msgs {
+			call, err := codectypes.NewAnyWithValue(msg)
+			Expect(err).To(BeNil())
+			calls.Messages = append(calls.Messages, call)
+		}
+
+		bz, err := s.App().AppCodec().Marshal(&calls)
+		Expect(err).To(BeNil())
+		return bz
+	}
+
+	interchainAccount := func(routerId util.HexAddress, owner util.HexAddress) string {
+		res, err := keeper.NewQueryServerImpl(s.App().IcaKeeper).InterchainAccount(s.Ctx(), &types.QueryInterchainAccountRequest{
+			Id:           routerId.String(),
+			OriginDomain: fmt.Sprintf("%d", remoteRouter.ReceiverDomain),
+			Owner:        owner.String(),
+		})
+		Expect(err).To(BeNil())
+		return res.Address
+	}
+
+	processMessage := func(mailboxId, routerId, sender util.HexAddress, calls []byte) error {
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      sender,
+			Destination: 0,
+			Recipient:   routerId,
+			Body:        types.NewIcaPayload(remoteOwner, calls).Bytes(),
+		}
+
+		_, err := s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   owner.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+		return err
+	}
+
+	It("Handle (invalid) sender is not the enrolled router", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+
+		// Act
+		err := processMessage(mailboxId, routerId, util.CreateMockHexAddress("attacker", 1), encodeCalls())
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid receiver contract"))
+	})
+
+	It("Handle (invalid) call is not signed by the interchain account", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		sender, _ := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		account := interchainAccount(routerId, remoteOwner)
+
+		calls := encodeCalls(&banktypes.MsgSend{
+			FromAddress: owner.Address,
+			ToAddress:   recipient.Address,
+			Amount:      sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))),
+		})
+
+		// Act
+		err := processMessage(mailboxId, routerId, sender, calls)
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("call 0 must be signed by interchain account %s: %s", account, types.ErrInvalidCall)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient.AccAddress, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("Handle (invalid) malformed calls", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		sender, _ := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+
+		// Act
+		err := processMessage(mailboxId, routerId, sender, []byte{0xff, 0xff})
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring(types.ErrInvalidCallPayload.Error()))
+	})
+
+	It("Handle (valid) executes bank send from the interchain account", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		sender, _ := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+
+		account := interchainAccount(routerId, remoteOwner)
+		err := s.MintBaseCoins(account, 1_000)
+		Expect(err).To(BeNil())
+
+		calls := encodeCalls(&banktypes.MsgSend{
+			FromAddress: account,
+			ToAddress:   recipient.Address,
+			Amount:      sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))),
+		})
+
+		// Act
+		err = processMessage(mailboxId, routerId, sender, calls)
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		accountAddress, err := sdk.AccAddressFromBech32(account)
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), accountAddress, denom).Amount).To(Equal(math.NewInt(900)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient.AccAddress, denom).Amount).To(Equal(math.NewInt(100)))
+	})
+
+	It("InterchainAccount (valid) address depends on owner, origin and ISM", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		sender, _ := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		// the router does not configure an ISM
+		ismId := util.HexAddress{}
+
+		// Act
+		res, err := keeper.NewQueryServerImpl(s.App().IcaKeeper).InterchainAccount(s.Ctx(), &types.QueryInterchainAccountRequest{
+			Id:           routerId.String(),
+			OriginDomain: fmt.Sprintf("%d", remoteRouter.ReceiverDomain),
+			Owner:        remoteOwner.String(),
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.RemoteRouter).To(Equal(remoteRouter.ReceiverContract))
+		Expect(res.IsmId).To(Equal(ismId.String()))
+
+		expected := s.App().IcaKeeper.InterchainAccountAddress(routerId, remoteRouter.ReceiverDomain, remoteOwner, sender, ismId)
+		Expect(res.Address).To(Equal(expected.String()))
+
+		Expect(interchainAccount(routerId, util.CreateMockHexAddress("remote-owner", 2))).NotTo(Equal(res.Address))
+		Expect(s.App().IcaKeeper.InterchainAccountAddress(routerId, 2, remoteOwner, sender, ismId)).NotTo(Equal(expected))
+		Expect(s.App().IcaKeeper.InterchainAccountAddress(routerId, remoteRouter.ReceiverDomain, remoteOwner, sender, util.CreateMockHexAddress("ism", 1))).NotTo(Equal(expected))
+	})
+
+	It("InterchainAccount (valid) address does not change with the default ISM of the mailbox", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		sender, _ := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		account := interchainAccount(routerId, remoteOwner)
+
+		res, err := s.RunTx(&ismTypes.MsgCreateNoopIsm{
+			Creator: owner.Address,
+		})
+		Expect(err).To(BeNil())
+		var ismResponse ismTypes.MsgCreateNoopIsmResponse
+		Expect(proto.Unmarshal(res.MsgResponses[0].Value, &ismResponse)).To(Succeed())
+
+		// Act
+		_, err = s.RunTx(&coreTypes.MsgSetMailbox{
+			Owner:      owner.Address,
+			MailboxId:  mailboxId,
+			DefaultIsm: &ismResponse.Id,
+		})
+		Expect(err).To(BeNil())
+
+		// Assert
+		Expect(interchainAccount(routerId, remoteOwner)).To(Equal(account))
+
+		err = s.MintBaseCoins(account, 1_000)
+		Expect(err).To(BeNil())
+		calls := encodeCalls(&banktypes.MsgSend{
+			FromAddress: account,
+			ToAddress:   recipient.Address,
+			Amount:      sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))),
+		})
+		Expect(processMessage(mailboxId, routerId, sender, calls)).To(Succeed())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient.AccAddress, denom).Amount).To(Equal(math.NewInt(100)))
+	})
+})
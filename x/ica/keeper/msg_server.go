@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+// CreateRouter creates a new interchain account router on the given mailbox.
+func (ms msgServer) CreateRouter(ctx context.Context, msg *types.MsgCreateRouter) (*types.MsgCreateRouterResponse, error) {
+	has, err := ms.k.coreKeeper.MailboxIdExists(ctx, msg.OriginMailbox)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("failed to find mailbox with id: %s", msg.OriginMailbox.String())
+	}
+
+	if msg.IsmId != nil {
+		if err := ms.k.coreKeeper.AssertIsmExists(ctx, *msg.IsmId); err != nil {
+			return nil, err
+		}
+	}
+
+	routerId, err := ms.k.coreKeeper.AppRouter().GetNextSequence(ctx, types.APP_TYPE_INTERCHAIN_ACCOUNT_ROUTER)
+	if err != nil {
+		return nil, err
+	}
+
+	newRouter := types.InterchainAccountRouter{
+		Id:            routerId,
+		Owner:         msg.Owner,
+		OriginMailbox: msg.OriginMailbox,
+		IsmId:         msg.IsmId,
+	}
+
+	if err = ms.k.Routers.Set(ctx, routerId.GetInternalId(), newRouter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateRouterResponse{Id: routerId}, nil
+}
+
+// EnrollRemoteRouter enrolls the interchain account router of a remote domain.
+func (ms msgServer) EnrollRemoteRouter(ctx context.Context, msg *types.MsgEnrollRemoteRouter) (*types.MsgEnrollRemoteRouterResponse, error) {
+	routerId := msg.RouterId
+	router, err := ms.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("router with id %s not found", routerId.String())
+	}
+
+	if router.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own router with id %s", msg.Owner, routerId.String())
+	}
+
+	if msg.RemoteRouter == nil {
+		return nil, fmt.Errorf("invalid remote router")
+	}
+
+	if _, err := util.DecodeHexAddress(msg.RemoteRouter.ReceiverContract); err != nil {
+		return nil, fmt.Errorf("invalid receiver contract")
+	}
+
+	if msg.RemoteRouter.Gas.IsNil() || msg.RemoteRouter.Gas.IsNegative() {
+		return nil, fmt.Errorf("invalid gas")
+	}
+
+	if err = ms.k.EnrolledRouters.Set(ctx, collections.Join(routerId.GetInternalId(), msg.RemoteRouter.ReceiverDomain), *msg.RemoteRouter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnrollRemoteRouterResponse{}, nil
+}
+
+// UnrollRemoteRouter removes the enrolled router of a remote domain.
+func (ms msgServer) UnrollRemoteRouter(ctx context.Context, msg *types.MsgUnrollRemoteRouter) (*types.MsgUnrollRemoteRouterResponse, error) {
+	routerId := msg.RouterId
+	router, err := ms.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("router with id %s not found", routerId.String())
+	}
+
+	if router.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own router with id %s", msg.Owner, routerId.String())
+	}
+
+	exists, err := ms.k.EnrolledRouters.Has(ctx, collections.Join(routerId.GetInternalId(), msg.ReceiverDomain))
+	if err != nil || !exists {
+		return nil, fmt.Errorf("failed to find remote router for domain %v", msg.ReceiverDomain)
+	}
+
+	if err = ms.k.EnrolledRouters.Remove(ctx, collections.Join(routerId.GetInternalId(), msg.ReceiverDomain)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnrollRemoteRouterResponse{}, nil
+}
+
+// CallRemote dispatches calls to the interchain account of the sender on the destination domain.
+func (ms msgServer) CallRemote(ctx context.Context, msg *types.MsgCallRemote) (*types.MsgCallRemoteResponse, error) {
+	router, err := ms.k.Routers.Get(ctx, msg.RouterId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find router with id: %s", msg.RouterId.String())
+	}
+
+	senderAcc, err := ms.k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address (%s)", msg.Sender)
+	}
+
+	owner, err := util.ParseFromCosmosAcc(senderAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	calls, err := util.DecodeEthHex(msg.Calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode calls")
+	}
+
+	customHookMetadata, err := util.DecodeEthHex(msg.CustomHookMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom hook metadata")
+	}
+
+	remoteRouter, err := ms.k.EnrolledRouters.Get(ctx, collections.Join(router.Id.GetInternalId(), msg.DestinationDomain))
+	if err != nil {
+		return nil, fmt.Errorf("no enrolled router found for destination domain %d", msg.DestinationDomain)
+	}
+
+	receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode receiver contract address %s", remoteRouter.ReceiverContract)
+	}
+
+	gas := remoteRouter.Gas
+	if !msg.GasLimit.IsNil() && !msg.GasLimit.IsZero() {
+		gas = msg.GasLimit
+	}
+
+	messageId, err := ms.k.coreKeeper.DispatchMessage(
+		sdk.UnwrapSDKContext(ctx),
+		router.OriginMailbox,
+		router.Id, // sender
+		sdk.NewCoins(msg.MaxFee),
+
+		remoteRouter.ReceiverDomain,
+		receiverContract,
+
+		types.NewIcaPayload(owner, calls).Bytes(), // message body
+
+		util.StandardHookMetadata{
+			GasLimit:           gas,
+			Address:            senderAcc,
+			CustomHookMetadata: customHookMetadata,
+		},
+		msg.CustomHookId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCallRemoteResponse{MessageId: messageId}, nil
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
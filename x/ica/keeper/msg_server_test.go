@@ -0,0 +1,307 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	ismTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	pdTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	coreKeeper "github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server.go
+
+* CreateRouter (invalid) non-existing mailbox
+* CreateRouter (valid)
+* EnrollRemoteRouter (invalid) non-owner
+* EnrollRemoteRouter (invalid) invalid receiver contract
+* EnrollRemoteRouter & UnrollRemoteRouter (valid)
+* CallRemote (invalid) no enrolled router for destination
+* CallRemote (valid) dispatches owner and calls
+
+*/
+
+const denom = "acoin"
+
+var _ = Describe("msg_server.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	It("CreateRouter (invalid) non-existing mailbox", func() {
+		// Arrange
+		mailboxId := util.CreateMockHexAddress("mailbox", 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateRouter{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("failed to find mailbox with id: %s", mailboxId)))
+	})
+
+	It("CreateRouter (valid)", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+
+		// Act
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+
+		// Assert
+		routers, err := keeper.NewQueryServerImpl(s.App().IcaKeeper).Routers(s.Ctx(), &types.QueryRoutersRequest{})
+		Expect(err).To(BeNil())
+		Expect(routers.Routers).To(HaveLen(1))
+		Expect(routers.Routers[0].Id).To(Equal(routerId))
+		Expect(routers.Routers[0].Owner).To(Equal(owner.Address))
+		Expect(routers.Routers[0].OriginMailbox).To(Equal(mailboxId))
+		Expect(routers.Routers[0].IsmId).To(BeNil())
+
+		exists, err := s.App().HyperlaneKeeper.AppRouter().GetModule(routerId)
+		Expect(err).To(BeNil())
+		Expect(exists).NotTo(BeNil())
+	})
+
+	It("EnrollRemoteRouter (invalid) non-owner", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+
+		// Act
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        sender.Address,
+			RouterId:     routerId,
+			RemoteRouter: &remoteRouter,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("%s does not own router with id %s", sender.Address, routerId)))
+	})
+
+	It("EnrollRemoteRouter (invalid) invalid receiver contract", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+
+		// Act
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:    owner.Address,
+			RouterId: routerId,
+			RemoteRouter: &types.RemoteRouter{
+				ReceiverDomain:   1,
+				ReceiverContract: "0x1234",
+				Gas:              math.NewInt(50000),
+			},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid receiver contract"))
+	})
+
+	It("EnrollRemoteRouter & UnrollRemoteRouter (valid)", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+		queryServer := keeper.NewQueryServerImpl(s.App().IcaKeeper)
+
+		// Act
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner.Address,
+			RouterId:     routerId,
+			RemoteRouter: &remoteRouter,
+		})
+		Expect(err).To(BeNil())
+
+		enrolled, err := queryServer.RemoteRouters(s.Ctx(), &types.QueryRemoteRoutersRequest{Id: routerId.String()})
+		Expect(err).To(BeNil())
+
+		_, err = s.RunTx(&types.MsgUnrollRemoteRouter{
+			Owner:          owner.Address,
+			RouterId:       routerId,
+			ReceiverDomain: remoteRouter.ReceiverDomain,
+		})
+		Expect(err).To(BeNil())
+
+		// Assert
+		Expect(enrolled.RemoteRouters).To(HaveLen(1))
+		Expect(*enrolled.RemoteRouters[0]).To(Equal(remoteRouter))
+
+		unrolled, err := queryServer.RemoteRouters(s.Ctx(), &types.QueryRemoteRoutersRequest{Id: routerId.String()})
+		Expect(err).To(BeNil())
+		Expect(unrolled.RemoteRouters).To(BeEmpty())
+	})
+
+	It("CallRemote (invalid) no enrolled router for destination", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCallRemote{
+			Sender:            owner.Address,
+			RouterId:          routerId,
+			DestinationDomain: 2,
+			Calls:             "0x1234",
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            sdk.NewCoin(denom, math.NewInt(1_000_000)),
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("no enrolled router found for destination domain 2"))
+	})
+
+	It("CallRemote (valid) dispatches owner and calls", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+
+		// Act
+		res, err := s.RunTx(&types.MsgCallRemote{
+			Sender:            owner.Address,
+			RouterId:          routerId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Calls:             "0x1234",
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            sdk.NewCoin(denom, math.NewInt(1_000_000)),
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		var response types.MsgCallRemoteResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		dispatched, err := coreKeeper.NewQueryServerImpl(s.App().HyperlaneKeeper).DispatchedMessage(s.Ctx(), &coreTypes.QueryDispatchedMessageRequest{
+			Id:        mailboxId.String(),
+			MessageId: response.MessageId.String(),
+		})
+		Expect(err).To(BeNil())
+
+		rawMessage, err := util.DecodeEthHex(dispatched.Message.Message)
+		Expect(err).To(BeNil())
+		message, err := util.ParseHyperlaneMessage(rawMessage)
+		Expect(err).To(BeNil())
+		Expect(message.Sender).To(Equal(routerId))
+		Expect(message.Recipient.String()).To(Equal(remoteRouter.ReceiverContract))
+		Expect(message.Destination).To(Equal(remoteRouter.ReceiverDomain))
+
+		payload, err := types.ParseIcaPayload(message.Body)
+		Expect(err).To(BeNil())
+		expectedOwner, err := util.ParseFromCosmosAcc(owner.AccAddress)
+		Expect(err).To(BeNil())
+		Expect(payload.Owner).To(Equal(expectedOwner))
+		Expect(payload.Calls).To(Equal([]byte{0x12, 0x34}))
+	})
+})
+
+var remoteRouter = types.RemoteRouter{
+	ReceiverDomain:   1,
+	ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+	Gas:              math.NewInt(50000),
+}
+
+// Utils
+func createValidMailbox(s *i.KeeperTestSuite, creator string) (util.HexAddress, util.HexAddress, util.HexAddress) {
+	res, err := s.RunTx(&ismTypes.MsgCreateNoopIsm{
+		Creator: creator,
+	})
+	Expect(err).To(BeNil())
+
+	var ismResponse ismTypes.MsgCreateNoopIsmResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &ismResponse)
+	Expect(err).To(BeNil())
+	ismId := ismResponse.Id
+
+	res, err = s.RunTx(&pdTypes.MsgCreateIgp{
+		Owner: creator,
+		Denom: denom,
+	})
+	Expect(err).To(BeNil())
+
+	var igpResponse pdTypes.MsgCreateIgpResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &igpResponse)
+	Expect(err).To(BeNil())
+	igpId := igpResponse.Id
+
+	_, err = s.RunTx(&pdTypes.MsgSetDestinationGasConfig{
+		Owner: creator,
+		IgpId: igpId,
+		DestinationGasConfig: &pdTypes.DestinationGasConfig{
+			RemoteDomain: remoteRouter.ReceiverDomain,
+			GasOracle: &pdTypes.GasOracle{
+				TokenExchangeRate: math.NewInt(1e10),
+				GasPrice:          math.NewInt(1),
+			},
+			GasOverhead: math.NewInt(200000),
+		},
+	})
+	Expect(err).To(BeNil())
+
+	res, err = s.RunTx(&pdTypes.MsgCreateNoopHook{
+		Owner: creator,
+	})
+	Expect(err).To(BeNil())
+
+	var noopResponse pdTypes.MsgCreateNoopHookResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &noopResponse)
+	Expect(err).To(BeNil())
+
+	res, err = s.RunTx(&coreTypes.MsgCreateMailbox{
+		Owner:        creator,
+		DefaultIsm:   ismId,
+		DefaultHook:  &igpId,
+		RequiredHook: &noopResponse.Id,
+	})
+	Expect(err).To(BeNil())
+
+	var mailboxResponse coreTypes.MsgCreateMailboxResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &mailboxResponse)
+	Expect(err).To(BeNil())
+
+	return mailboxResponse.Id, igpId, ismId
+}
+
+func createRouter(s *i.KeeperTestSuite, owner string, mailboxId util.HexAddress, remoteRouter *types.RemoteRouter) util.HexAddress {
+	res, err := s.RunTx(&types.MsgCreateRouter{
+		Owner:         owner,
+		OriginMailbox: mailboxId,
+	})
+	Expect(err).To(BeNil())
+
+	var response types.MsgCreateRouterResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	if remoteRouter != nil {
+		_, err = s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner,
+			RouterId:     response.Id,
+			RemoteRouter: remoteRouter,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	return response.Id
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/ica/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the module QueryServer.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+func (qs queryServer) Routers(ctx context.Context, req *types.QueryRoutersRequest) (*types.QueryRoutersResponse, error) {
+	routers, page, err := util.GetPaginatedFromMap(ctx, qs.k.Routers, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRoutersResponse{
+		Routers:    routers,
+		Pagination: page,
+	}, nil
+}
+
+func (qs queryServer) Router(ctx context.Context, req *types.QueryRouterRequest) (*types.QueryRouterResponse, error) {
+	routerId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	router, err := qs.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find router with id: %s", routerId.String())
+	}
+
+	return &types.QueryRouterResponse{Router: router}, nil
+}
+
+func (qs queryServer) RemoteRouters(ctx context.Context, req *types.QueryRemoteRoutersRequest) (*types.QueryRemoteRoutersResponse, error) {
+	routerId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	routers, page, err := util.GetPaginatedPrefixFromMap(ctx, qs.k.EnrolledRouters, req.Pagination, routerId.GetInternalId())
+	if err != nil {
+		return &types.QueryRemoteRoutersResponse{}, err
+	}
+
+	remoteRouters := make([]*types.RemoteRouter, len(routers))
+	for i := range routers {
+		remoteRouters[i] = &routers[i]
+	}
+
+	return &types.QueryRemoteRoutersResponse{
+		RemoteRouters: remoteRouters,
+		Pagination:    page,
+	}, nil
+}
+
+func (qs queryServer) InterchainAccount(ctx context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	routerId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	originDomain, err := strconv.ParseUint(req.OriginDomain, 10, 32)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := util.DecodeHexAddress(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	remoteRouter, err := qs.k.EnrolledRouters.Get(ctx, collections.Join(routerId.GetInternalId(), uint32(originDomain)))
+	if err != nil {
+		return nil, fmt.Errorf("no enrolled router found for origin %d", originDomain)
+	}
+
+	remoteRouterAddress, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode receiver contract address %s", remoteRouter.ReceiverContract)
+	}
+
+	router, err := qs.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find router with id: %s", routerId.String())
+	}
+	ismId := accountIsmId(router)
+
+	account := qs.k.InterchainAccountAddress(routerId, uint32(originDomain), owner, remoteRouterAddress, ismId)
+
+	address, err := qs.k.addressCodec.BytesToString(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address:      address,
+		RemoteRouter: remoteRouterAddress.String(),
+		IsmId:        ismId.String(),
+	}, nil
+}
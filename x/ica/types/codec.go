@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateRouter{},
+		&MsgEnrollRemoteRouter{},
+		&MsgUnrollRemoteRouter{},
+		&MsgCallRemote{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var _ types.UnpackInterfacesMessage = InterchainAccountCalls{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c InterchainAccountCalls) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, call := range c.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(call, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrRouterNotFound     = errors.Register(ModuleName, 1, "router not found")
+	ErrInvalidCall        = errors.Register(ModuleName, 2, "invalid interchain account call")
+	ErrUnroutableCall     = errors.Register(ModuleName, 3, "no handler found for interchain account call")
+	ErrInvalidCallPayload = errors.Register(ModuleName, 4, "invalid interchain account payload")
+)
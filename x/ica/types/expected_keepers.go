@@ -0,0 +1,37 @@
+package types
+
+import (
+	"context"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type AccountKeeper interface {
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+type CoreKeeper interface {
+	MailboxIdExists(ctx context.Context, mailboxId util.HexAddress) (bool, error)
+	GetMailbox(ctx context.Context, mailboxId util.HexAddress) (coreTypes.Mailbox, error)
+	AppRouter() *util.Router[util.HyperlaneApp]
+	DispatchMessage(
+		ctx sdk.Context,
+		originMailboxId util.HexAddress,
+		// sender address on the origin chain (e.g. router id)
+		sender util.HexAddress,
+		// the maximum amount of tokens the dispatch is allowed to cost
+		maxFee sdk.Coins,
+		destinationDomain uint32,
+		// Recipient address on the destination chain (e.g. smart contract)
+		recipient util.HexAddress,
+		body []byte,
+		// Metadata for postDispatch Hook
+		metadata util.StandardHookMetadata,
+		postDispatchHookId *util.HexAddress,
+	) (messageId util.HexAddress, error error)
+	AssertIsmExists(ctx context.Context, id util.HexAddress) error
+}
@@ -0,0 +1,30 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{
+		Routers:       []InterchainAccountRouter{},
+		RemoteRouters: []GenesisRemoteRouterWrapper{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+func (gs *GenesisState) Validate() error {
+	routers := make(map[uint64]struct{}, len(gs.Routers))
+	for _, router := range gs.Routers {
+		if _, ok := routers[router.Id.GetInternalId()]; ok {
+			return fmt.Errorf("duplicate router id %s", router.Id)
+		}
+		routers[router.Id.GetInternalId()] = struct{}{}
+	}
+
+	for _, r := range gs.RemoteRouters {
+		if _, ok := routers[r.RouterId]; !ok {
+			return fmt.Errorf("remote router for unknown router %d", r.RouterId)
+		}
+	}
+
+	return nil
+}
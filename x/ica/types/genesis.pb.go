@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hyperlane/ica/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	Routers       []InterchainAccountRouter    `protobuf:"bytes,1,rep,name=routers,proto3" json:"routers"`
+	RemoteRouters []GenesisRemoteRouterWrapper `protobuf:"bytes,2,rep,name=remote_routers,json=remoteRouters,proto3" json:"remote_routers"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_705801b45a0f831d, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetRouters() []InterchainAccountRouter {
+	if m != nil {
+		return m.Routers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemoteRouters() []GenesisRemoteRouterWrapper {
+	if m != nil {
+		return m.RemoteRouters
+	}
+	return nil
+}
+
+// GenesisRemoteRouterWrapper ...
+type GenesisRemoteRouterWrapper struct {
+	RouterId     uint64       `protobuf:"varint,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	RemoteRouter RemoteRouter `protobuf:"bytes,2,opt,name=remote_router,json=remoteRouter,proto3" json:"remote_router"`
+}
+
+func (m *GenesisRemoteRouterWrapper) Reset()         { *m = GenesisRemoteRouterWrapper{} }
+func (m *GenesisRemoteRouterWrapper) String() string { return proto.CompactTextString(m) }
+func (*GenesisRemoteRouterWrapper) ProtoMessage()    {}
+func (*GenesisRemoteRouterWrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_705801b45a0f831d, []int{1}
+}
+func (m *GenesisRemoteRouterWrapper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRemoteRouterWrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRemoteRouterWrapper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRemoteRouterWrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRemoteRouterWrapper.Merge(m, src)
+}
+func (m *GenesisRemoteRouterWrapper) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRemoteRouterWrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRemoteRouterWrapper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRemoteRouterWrapper proto.InternalMessageInfo
+
+func (m *GenesisRemoteRouterWrapper) GetRouterId() uint64 {
+	if m != nil {
+		return m.RouterId
+	}
+	return 0
+}
+
+func (m *GenesisRemoteRouterWrapper) GetRemoteRouter() RemoteRouter {
+	if m != nil {
+		return m.RemoteRouter
+	}
+	return RemoteRouter{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "hyperlane.ica.v1.GenesisState")
+	proto.RegisterType((*GenesisRemoteRouterWrapper)(nil), "hyperlane.ica.v1.GenesisRemoteRouterWrapper")
+}
+
+func init() { proto.RegisterFile("hyperlane/ica/v1/genesis.proto", fileDescriptor_705801b45a0f831d) }
+
+var fileDescriptor_705801b45a0f831d = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0x7a, 0x31,
+	0x14, 0xc6, 0x6f, 0xf9, 0x93, 0xbf, 0x5a, 0xc0, 0x98, 0x1b, 0x07, 0x82, 0xa6, 0x12, 0x26, 0x4c,
+	0xa4, 0x0d, 0x38, 0x38, 0xcb, 0x62, 0xee, 0xe8, 0x75, 0x30, 0xba, 0x90, 0x52, 0x9a, 0x4b, 0x13,
+	0xe9, 0x69, 0xda, 0x42, 0x64, 0xf7, 0x01, 0x7c, 0x18, 0x1f, 0x82, 0x91, 0xd1, 0xc9, 0x18, 0x78,
+	0x11, 0x63, 0x2f, 0x10, 0x94, 0xb8, 0x9d, 0x9c, 0x73, 0xbe, 0xdf, 0xf7, 0x25, 0x1f, 0x26, 0xc3,
+	0xa9, 0x91, 0xf6, 0x89, 0x6b, 0xc9, 0x94, 0xe0, 0x6c, 0xd2, 0x66, 0x99, 0xd4, 0xd2, 0x29, 0x47,
+	0x8d, 0x05, 0x0f, 0xf1, 0xd1, 0xe6, 0x4e, 0x95, 0xe0, 0x74, 0xd2, 0xae, 0x9d, 0xee, 0x28, 0xfc,
+	0xd4, 0xc8, 0xd5, 0x7f, 0xed, 0x38, 0x83, 0x0c, 0xc2, 0xc8, 0xbe, 0xa7, 0x7c, 0xdb, 0x78, 0x43,
+	0xb8, 0x7c, 0x93, 0x73, 0xef, 0x3c, 0xf7, 0x32, 0x4e, 0xf0, 0x9e, 0x85, 0xb1, 0x97, 0xd6, 0x55,
+	0x51, 0xfd, 0x5f, 0xb3, 0xd4, 0x39, 0xa7, 0xbf, 0x8d, 0x68, 0xa2, 0xbd, 0xb4, 0x62, 0xc8, 0x95,
+	0xbe, 0x16, 0x02, 0xc6, 0xda, 0xa7, 0x41, 0xd1, 0x2d, 0xce, 0x3e, 0xce, 0xa2, 0x74, 0xad, 0x8f,
+	0x1f, 0xf0, 0xa1, 0x95, 0x23, 0xf0, 0xb2, 0xb7, 0x26, 0x16, 0x02, 0xf1, 0x62, 0x97, 0xb8, 0x8a,
+	0x90, 0x86, 0xf7, 0x9c, 0x76, 0x6f, 0xb9, 0x31, 0x1b, 0x68, 0xc5, 0x6e, 0x9d, 0x5c, 0xe3, 0x05,
+	0xe1, 0xda, 0xdf, 0x9a, 0xf8, 0x04, 0x1f, 0xe4, 0x96, 0x3d, 0x35, 0xa8, 0xa2, 0x3a, 0x6a, 0x16,
+	0xd3, 0xfd, 0x7c, 0x91, 0x0c, 0xe2, 0x04, 0x57, 0x7e, 0xc4, 0xaa, 0x16, 0xea, 0xa8, 0x59, 0xea,
+	0x90, 0xdd, 0x54, 0xdb, 0xe8, 0x55, 0x8e, 0xf2, 0x76, 0x8e, 0xee, 0xed, 0x6c, 0x41, 0xd0, 0x7c,
+	0x41, 0xd0, 0xe7, 0x82, 0xa0, 0xd7, 0x25, 0x89, 0xe6, 0x4b, 0x12, 0xbd, 0x2f, 0x49, 0xf4, 0x78,
+	0x95, 0x29, 0x3f, 0x1c, 0xf7, 0xa9, 0x80, 0x11, 0xeb, 0x0b, 0xd3, 0x52, 0x5a, 0xc3, 0x84, 0x7b,
+	0x05, 0xda, 0xb1, 0x8d, 0x4f, 0x4b, 0x80, 0x1b, 0x81, 0x63, 0xcf, 0xa1, 0xaf, 0x50, 0x56, 0xff,
+	0x7f, 0xe8, 0xe5, 0xf2, 0x6b, 0x00, 0x50, 0xb0, 0x77, 0x8c, 0xff, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteRouters) > 0 {
+		for iNdEx := len(m.RemoteRouters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteRouters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Routers) > 0 {
+		for iNdEx := len(m.Routers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisRemoteRouterWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRemoteRouterWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisRemoteRouterWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemoteRouter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RouterId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RouterId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routers) > 0 {
+		for _, e := range m.Routers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemoteRouters) > 0 {
+		for _, e := range m.RemoteRouters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisRemoteRouterWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RouterId != 0 {
+		n += 1 + sovGenesis(uint64(m.RouterId))
+	}
+	l = m.RemoteRouter.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routers = append(m.Routers, InterchainAccountRouter{})
+			if err := m.Routers[len(m.Routers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteRouters = append(m.RemoteRouters, GenesisRemoteRouterWrapper{})
+			if err := m.RemoteRouters[len(m.RemoteRouters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisRemoteRouterWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRemoteRouterWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRemoteRouterWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterId", wireType)
+			}
+			m.RouterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RouterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoteRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
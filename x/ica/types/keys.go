@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const ModuleName = "ica"
+
+// APP_TYPE_INTERCHAIN_ACCOUNT_ROUTER is the module id of interchain account routers in the core app router.
+const APP_TYPE_INTERCHAIN_ACCOUNT_ROUTER uint8 = 3
+
+var (
+	RoutersKey         = collections.NewPrefix(0)
+	EnrolledRoutersKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+)
+
+const ownerLength = 32
+
+// IcaPayload is the body of a Hyperlane message sent between interchain account routers.
+// It consists of the 32 byte owner on the origin chain followed by the encoded calls.
+type IcaPayload struct {
+	Owner util.HexAddress
+	Calls []byte
+}
+
+func NewIcaPayload(owner util.HexAddress, calls []byte) IcaPayload {
+	return IcaPayload{
+		Owner: owner,
+		Calls: calls,
+	}
+}
+
+func ParseIcaPayload(payload []byte) (IcaPayload, error) {
+	if len(payload) < ownerLength {
+		return IcaPayload{}, fmt.Errorf("payload is invalid")
+	}
+
+	return IcaPayload{
+		Owner: util.HexAddress(payload[:ownerLength]),
+		Calls: payload[ownerLength:],
+	}, nil
+}
+
+func (p IcaPayload) Bytes() []byte {
+	bytes := make([]byte, 0, ownerLength+len(p.Calls))
+	bytes = append(bytes, p.Owner.Bytes()...)
+	return append(bytes, p.Calls...)
+}
@@ -0,0 +1,2288 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hyperlane/ica/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateRouter ...
+type MsgCreateRouter struct {
+	// owner is the message sender.
+	Owner         string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	OriginMailbox github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=origin_mailbox,json=originMailbox,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"origin_mailbox"`
+	// ism_id is used to verify incoming calls. If not set, the default ISM of
+	// the mailbox is used.
+	IsmId *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,3,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id,omitempty"`
+}
+
+func (m *MsgCreateRouter) Reset()         { *m = MsgCreateRouter{} }
+func (m *MsgCreateRouter) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRouter) ProtoMessage()    {}
+func (*MsgCreateRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{0}
+}
+func (m *MsgCreateRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRouter.Merge(m, src)
+}
+func (m *MsgCreateRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateRouter proto.InternalMessageInfo
+
+func (m *MsgCreateRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgCreateRouterResponse ...
+type MsgCreateRouterResponse struct {
+	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+}
+
+func (m *MsgCreateRouterResponse) Reset()         { *m = MsgCreateRouterResponse{} }
+func (m *MsgCreateRouterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRouterResponse) ProtoMessage()    {}
+func (*MsgCreateRouterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{1}
+}
+func (m *MsgCreateRouterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRouterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRouterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateRouterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRouterResponse.Merge(m, src)
+}
+func (m *MsgCreateRouterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRouterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRouterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateRouterResponse proto.InternalMessageInfo
+
+// MsgEnrollRemoteRouter ...
+type MsgEnrollRemoteRouter struct {
+	// owner is the message sender.
+	Owner        string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	RouterId     github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"router_id"`
+	RemoteRouter *RemoteRouter                                               `protobuf:"bytes,3,opt,name=remote_router,json=remoteRouter,proto3" json:"remote_router,omitempty"`
+}
+
+func (m *MsgEnrollRemoteRouter) Reset()         { *m = MsgEnrollRemoteRouter{} }
+func (m *MsgEnrollRemoteRouter) String() string { return proto.CompactTextString(m) }
+func (*MsgEnrollRemoteRouter) ProtoMessage()    {}
+func (*MsgEnrollRemoteRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{2}
+}
+func (m *MsgEnrollRemoteRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnrollRemoteRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnrollRemoteRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnrollRemoteRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnrollRemoteRouter.Merge(m, src)
+}
+func (m *MsgEnrollRemoteRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnrollRemoteRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnrollRemoteRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnrollRemoteRouter proto.InternalMessageInfo
+
+func (m *MsgEnrollRemoteRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgEnrollRemoteRouter) GetRemoteRouter() *RemoteRouter {
+	if m != nil {
+		return m.RemoteRouter
+	}
+	return nil
+}
+
+// MsgEnrollRemoteRouterResponse ...
+type MsgEnrollRemoteRouterResponse struct {
+}
+
+func (m *MsgEnrollRemoteRouterResponse) Reset()         { *m = MsgEnrollRemoteRouterResponse{} }
+func (m *MsgEnrollRemoteRouterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnrollRemoteRouterResponse) ProtoMessage()    {}
+func (*MsgEnrollRemoteRouterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{3}
+}
+func (m *MsgEnrollRemoteRouterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnrollRemoteRouterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnrollRemoteRouterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnrollRemoteRouterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnrollRemoteRouterResponse.Merge(m, src)
+}
+func (m *MsgEnrollRemoteRouterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnrollRemoteRouterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnrollRemoteRouterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnrollRemoteRouterResponse proto.InternalMessageInfo
+
+// MsgUnrollRemoteRouter ...
+type MsgUnrollRemoteRouter struct {
+	// owner is the message sender.
+	Owner          string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	RouterId       github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"router_id"`
+	ReceiverDomain uint32                                                      `protobuf:"varint,3,opt,name=receiver_domain,json=receiverDomain,proto3" json:"receiver_domain,omitempty"`
+}
+
+func (m *MsgUnrollRemoteRouter) Reset()         { *m = MsgUnrollRemoteRouter{} }
+func (m *MsgUnrollRemoteRouter) String() string { return proto.CompactTextString(m) }
+func (*MsgUnrollRemoteRouter) ProtoMessage()    {}
+func (*MsgUnrollRemoteRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{4}
+}
+func (m *MsgUnrollRemoteRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnrollRemoteRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnrollRemoteRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnrollRemoteRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnrollRemoteRouter.Merge(m, src)
+}
+func (m *MsgUnrollRemoteRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnrollRemoteRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnrollRemoteRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnrollRemoteRouter proto.InternalMessageInfo
+
+func (m *MsgUnrollRemoteRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUnrollRemoteRouter) GetReceiverDomain() uint32 {
+	if m != nil {
+		return m.ReceiverDomain
+	}
+	return 0
+}
+
+// MsgUnrollRemoteRouterResponse ...
+type MsgUnrollRemoteRouterResponse struct {
+}
+
+func (m *MsgUnrollRemoteRouterResponse) Reset()         { *m = MsgUnrollRemoteRouterResponse{} }
+func (m *MsgUnrollRemoteRouterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnrollRemoteRouterResponse) ProtoMessage()    {}
+func (*MsgUnrollRemoteRouterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{5}
+}
+func (m *MsgUnrollRemoteRouterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnrollRemoteRouterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnrollRemoteRouterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnrollRemoteRouterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnrollRemoteRouterResponse.Merge(m, src)
+}
+func (m *MsgUnrollRemoteRouterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnrollRemoteRouterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnrollRemoteRouterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnrollRemoteRouterResponse proto.InternalMessageInfo
+
+// MsgCallRemote ...
+type MsgCallRemote struct {
+	// sender is the owner of the interchain account on the destination chain.
+	Sender            string                                                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	RouterId          github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"router_id"`
+	DestinationDomain uint32                                                      `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// calls are the hex encoded calls in the format of the destination router.
+	Calls string `protobuf:"bytes,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Post Dispatch
+	CustomHookId       *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,5,opt,name=custom_hook_id,json=customHookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"custom_hook_id,omitempty"`
+	GasLimit           cosmossdk_io_math.Int                                        `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3,customtype=cosmossdk.io/math.Int" json:"gas_limit"`
+	MaxFee             types.Coin                                                   `protobuf:"bytes,7,opt,name=max_fee,json=maxFee,proto3" json:"max_fee"`
+	CustomHookMetadata string                                                       `protobuf:"bytes,8,opt,name=custom_hook_metadata,json=customHookMetadata,proto3" json:"custom_hook_metadata,omitempty"`
+}
+
+func (m *MsgCallRemote) Reset()         { *m = MsgCallRemote{} }
+func (m *MsgCallRemote) String() string { return proto.CompactTextString(m) }
+func (*MsgCallRemote) ProtoMessage()    {}
+func (*MsgCallRemote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{6}
+}
+func (m *MsgCallRemote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCallRemote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCallRemote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCallRemote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCallRemote.Merge(m, src)
+}
+func (m *MsgCallRemote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCallRemote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCallRemote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCallRemote proto.InternalMessageInfo
+
+func (m *MsgCallRemote) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCallRemote) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *MsgCallRemote) GetCalls() string {
+	if m != nil {
+		return m.Calls
+	}
+	return ""
+}
+
+func (m *MsgCallRemote) GetMaxFee() types.Coin {
+	if m != nil {
+		return m.MaxFee
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCallRemote) GetCustomHookMetadata() string {
+	if m != nil {
+		return m.CustomHookMetadata
+	}
+	return ""
+}
+
+// MsgCallRemoteResponse ...
+type MsgCallRemoteResponse struct {
+	MessageId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"message_id"`
+}
+
+func (m *MsgCallRemoteResponse) Reset()         { *m = MsgCallRemoteResponse{} }
+func (m *MsgCallRemoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCallRemoteResponse) ProtoMessage()    {}
+func (*MsgCallRemoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_610e48d9adcd8833, []int{7}
+}
+func (m *MsgCallRemoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCallRemoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCallRemoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCallRemoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCallRemoteResponse.Merge(m, src)
+}
+func (m *MsgCallRemoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCallRemoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCallRemoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCallRemoteResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateRouter)(nil), "hyperlane.ica.v1.MsgCreateRouter")
+	proto.RegisterType((*MsgCreateRouterResponse)(nil), "hyperlane.ica.v1.MsgCreateRouterResponse")
+	proto.RegisterType((*MsgEnrollRemoteRouter)(nil), "hyperlane.ica.v1.MsgEnrollRemoteRouter")
+	proto.RegisterType((*MsgEnrollRemoteRouterResponse)(nil), "hyperlane.ica.v1.MsgEnrollRemoteRouterResponse")
+	proto.RegisterType((*MsgUnrollRemoteRouter)(nil), "hyperlane.ica.v1.MsgUnrollRemoteRouter")
+	proto.RegisterType((*MsgUnrollRemoteRouterResponse)(nil), "hyperlane.ica.v1.MsgUnrollRemoteRouterResponse")
+	proto.RegisterType((*MsgCallRemote)(nil), "hyperlane.ica.v1.MsgCallRemote")
+	proto.RegisterType((*MsgCallRemoteResponse)(nil), "hyperlane.ica.v1.MsgCallRemoteResponse")
+}
+
+func init() { proto.RegisterFile("hyperlane/ica/v1/tx.proto", fileDescriptor_610e48d9adcd8833) }
+
+var fileDescriptor_610e48d9adcd8833 = []byte{
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6b, 0x3b, 0x45,
+	0x14, 0xcf, 0xa6, 0xdf, 0xe4, 0xdb, 0x8c, 0x4d, 0xbf, 0x76, 0x48, 0xe9, 0x36, 0xd8, 0x4d, 0xcd,
+	0xc1, 0x6a, 0x21, 0xbb, 0xa6, 0x22, 0x42, 0xc4, 0x83, 0x8d, 0x4a, 0x03, 0xe6, 0xe0, 0x96, 0x78,
+	0x28, 0xc2, 0x3a, 0xd9, 0x1d, 0x37, 0x63, 0x77, 0x66, 0xc2, 0xce, 0x24, 0xa6, 0x78, 0x11, 0x6f,
+	0x7a, 0xf2, 0x6f, 0x10, 0x04, 0x8f, 0x39, 0x78, 0xf2, 0x2f, 0xe8, 0xb1, 0x78, 0x12, 0x0f, 0x45,
+	0xda, 0x43, 0xff, 0x0d, 0xd9, 0xdd, 0xc9, 0x9a, 0x5f, 0xa5, 0xd1, 0x16, 0xc1, 0x4b, 0xc8, 0xbc,
+	0xf7, 0x99, 0xcf, 0x7b, 0xf3, 0x79, 0x6f, 0xde, 0x2c, 0xd8, 0xed, 0x5d, 0xf4, 0x71, 0x18, 0x20,
+	0x86, 0x2d, 0xe2, 0x22, 0x6b, 0x58, 0xb7, 0xe4, 0xc8, 0xec, 0x87, 0x5c, 0x72, 0xf8, 0x72, 0xea,
+	0x32, 0x89, 0x8b, 0xcc, 0x61, 0xbd, 0xbc, 0xe3, 0x72, 0x41, 0xb9, 0xb0, 0xa8, 0xf0, 0x23, 0x24,
+	0x15, 0x7e, 0x02, 0x2d, 0x97, 0x7c, 0xee, 0xf3, 0xf8, 0xaf, 0x15, 0xfd, 0x53, 0xd6, 0x2d, 0x44,
+	0x09, 0xe3, 0x56, 0xfc, 0xab, 0x4c, 0xbb, 0x09, 0x83, 0x93, 0x60, 0x93, 0x85, 0x72, 0x19, 0x8a,
+	0xbc, 0x8b, 0x04, 0xb6, 0x86, 0xf5, 0x2e, 0x96, 0xa8, 0x6e, 0xb9, 0x9c, 0x30, 0xe5, 0x7f, 0x65,
+	0x31, 0xd3, 0x8b, 0x3e, 0x56, 0xbb, 0xab, 0xbf, 0x66, 0xc1, 0x8b, 0xb6, 0xf0, 0x9b, 0x21, 0x46,
+	0x12, 0xdb, 0x7c, 0x20, 0x71, 0x08, 0x4d, 0x90, 0xe3, 0x5f, 0x31, 0x1c, 0xea, 0xda, 0xbe, 0xf6,
+	0x7a, 0xe1, 0x58, 0xff, 0xed, 0x97, 0x5a, 0x49, 0x85, 0x7c, 0xdf, 0xf3, 0x42, 0x2c, 0xc4, 0xa9,
+	0x0c, 0x09, 0xf3, 0xed, 0x04, 0x06, 0xbf, 0x04, 0x9b, 0x3c, 0x24, 0x3e, 0x61, 0x0e, 0x45, 0x24,
+	0xe8, 0xf2, 0x91, 0x9e, 0x8d, 0x37, 0x36, 0x2f, 0xaf, 0x2b, 0x99, 0x3f, 0xae, 0x2b, 0xef, 0xfa,
+	0x44, 0xf6, 0x06, 0x5d, 0xd3, 0xe5, 0xd4, 0xea, 0xba, 0xfd, 0x1a, 0x61, 0x8c, 0x0f, 0x91, 0x24,
+	0x9c, 0x09, 0x2b, 0x4d, 0xae, 0xa6, 0x8e, 0x31, 0x90, 0x24, 0x30, 0x4f, 0xf0, 0x48, 0x45, 0xb2,
+	0x8b, 0x09, 0x75, 0x3b, 0x61, 0x86, 0x67, 0x20, 0x4f, 0x04, 0x75, 0x88, 0xa7, 0xaf, 0xa5, 0x31,
+	0xb4, 0xc7, 0xc6, 0xc8, 0x11, 0x41, 0x5b, 0x5e, 0xc3, 0xfa, 0xf6, 0x6e, 0x7c, 0x98, 0x9c, 0xe9,
+	0xfb, 0xbb, 0xf1, 0xe1, 0xfe, 0x82, 0x70, 0x73, 0x42, 0x55, 0x19, 0xd8, 0x99, 0x33, 0xd9, 0x58,
+	0xf4, 0x39, 0x13, 0x18, 0x9e, 0x82, 0x2c, 0xf1, 0x74, 0x2d, 0xcd, 0xf1, 0xd1, 0x3a, 0x64, 0x89,
+	0x57, 0xfd, 0x29, 0x0b, 0xb6, 0xdb, 0xc2, 0xff, 0x90, 0x85, 0x3c, 0x08, 0x6c, 0x4c, 0xf9, 0xbf,
+	0x2e, 0xd9, 0xe7, 0xa0, 0x10, 0xc6, 0x3b, 0x23, 0x25, 0x9f, 0xb0, 0x5a, 0xeb, 0x09, 0x6b, 0xcb,
+	0x83, 0x4d, 0x50, 0x0c, 0xe3, 0x0c, 0x9d, 0xc4, 0x14, 0xd7, 0xeb, 0xa5, 0x23, 0xc3, 0x9c, 0xbf,
+	0x1d, 0xe6, 0xf4, 0x41, 0xec, 0x8d, 0x70, 0x6a, 0xd5, 0x78, 0x7b, 0xb6, 0x22, 0xaf, 0x2d, 0xab,
+	0xc8, 0xa2, 0x1a, 0xd5, 0x0a, 0xd8, 0x5b, 0xea, 0x98, 0x54, 0xa7, 0xfa, 0x5d, 0x22, 0x64, 0xe7,
+	0xff, 0x20, 0xe4, 0x01, 0x78, 0x11, 0x62, 0x17, 0x93, 0x21, 0x0e, 0x1d, 0x8f, 0x53, 0x44, 0x58,
+	0x2c, 0x65, 0xd1, 0xde, 0x9c, 0x98, 0x3f, 0x88, 0xad, 0x2b, 0x89, 0xd5, 0xb9, 0x4f, 0xac, 0xce,
+	0xfd, 0x62, 0x8d, 0x9f, 0x81, 0x62, 0xd4, 0xe6, 0x68, 0xe2, 0x87, 0x6f, 0x82, 0xbc, 0xc0, 0xcc,
+	0x5b, 0x41, 0x25, 0x85, 0xfb, 0x0f, 0x64, 0xaa, 0x01, 0xe8, 0x61, 0x21, 0x09, 0x8b, 0xb7, 0xce,
+	0x2a, 0xb5, 0x35, 0xe5, 0x49, 0xc4, 0x82, 0x25, 0x90, 0x73, 0x51, 0x10, 0x08, 0xfd, 0x59, 0x94,
+	0x8c, 0x9d, 0x2c, 0x20, 0x01, 0x9b, 0xee, 0x40, 0x48, 0x4e, 0x9d, 0x1e, 0xe7, 0xe7, 0x51, 0xae,
+	0xb9, 0xa7, 0x9b, 0x32, 0x1b, 0x09, 0xf5, 0x09, 0xe7, 0xe7, 0x2d, 0x0f, 0x36, 0x40, 0xc1, 0x47,
+	0xc2, 0x09, 0x08, 0x25, 0x52, 0xcf, 0xc7, 0x51, 0xf6, 0x94, 0x22, 0xdb, 0x09, 0x81, 0xf0, 0xce,
+	0x4d, 0xc2, 0x2d, 0x8a, 0x64, 0xcf, 0x6c, 0x31, 0x69, 0xaf, 0xfb, 0x48, 0x7c, 0x1c, 0xc1, 0xe1,
+	0x7b, 0xe0, 0x39, 0x45, 0x23, 0xe7, 0x0b, 0x8c, 0xf5, 0xe7, 0xf1, 0xad, 0xda, 0x35, 0x95, 0xfa,
+	0xd1, 0x23, 0x60, 0xaa, 0x47, 0xc0, 0x6c, 0x72, 0xc2, 0x8e, 0x0b, 0x11, 0xe9, 0xcf, 0x77, 0xe3,
+	0x43, 0xcd, 0xce, 0x53, 0x34, 0xfa, 0x08, 0x47, 0xe5, 0x2b, 0x4d, 0x9f, 0x92, 0x62, 0x89, 0x3c,
+	0x24, 0x91, 0xbe, 0x1e, 0x4b, 0x01, 0xff, 0x4e, 0xb3, 0xad, 0x3c, 0x0d, 0x33, 0x6a, 0x2d, 0x55,
+	0xcb, 0xa8, 0xb7, 0x8c, 0xa5, 0xa3, 0x31, 0x6d, 0x90, 0xea, 0xd7, 0x60, 0x7b, 0xc6, 0x90, 0x8e,
+	0xc5, 0x2e, 0x00, 0x14, 0x0b, 0x81, 0x7c, 0xec, 0x3c, 0xed, 0x78, 0x2c, 0x28, 0xda, 0x96, 0x77,
+	0xf4, 0xe3, 0x1a, 0x58, 0x6b, 0x0b, 0x1f, 0x7e, 0x06, 0x36, 0x66, 0x9e, 0xb5, 0x57, 0x17, 0x47,
+	0xcf, 0xdc, 0xf4, 0x2e, 0xbf, 0xf1, 0x20, 0x24, 0x3d, 0x09, 0x03, 0x70, 0xc9, 0x1c, 0x3e, 0x58,
+	0x4a, 0xb0, 0x08, 0x2c, 0x5b, 0x2b, 0x02, 0xa7, 0xe3, 0x75, 0x56, 0x8d, 0xd7, 0x59, 0x35, 0xde,
+	0xfd, 0xb7, 0x1e, 0x7e, 0x0a, 0xc0, 0xd4, 0x8d, 0xaf, 0x2c, 0x17, 0x26, 0x05, 0x94, 0x0f, 0x1e,
+	0x00, 0x4c, 0x78, 0xcb, 0xb9, 0x6f, 0xa2, 0x5e, 0x3c, 0xfe, 0xe4, 0xf2, 0xc6, 0xd0, 0xae, 0x6e,
+	0x0c, 0xed, 0xcf, 0x1b, 0x43, 0xfb, 0xe1, 0xd6, 0xc8, 0x5c, 0xdd, 0x1a, 0x99, 0xdf, 0x6f, 0x8d,
+	0xcc, 0xd9, 0x3b, 0xff, 0xa4, 0x0d, 0x46, 0x71, 0xff, 0xc5, 0x1f, 0x34, 0xdd, 0x7c, 0xfc, 0x45,
+	0xf3, 0xd6, 0x5f, 0x03, 0x00, 0x6f, 0xc0, 0xc8, 0x1f, 0x9b, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateRouter ...
+	CreateRouter(ctx context.Context, in *MsgCreateRouter, opts ...grpc.CallOption) (*MsgCreateRouterResponse, error)
+	// EnrollRemoteRouter ...
+	EnrollRemoteRouter(ctx context.Context, in *MsgEnrollRemoteRouter, opts ...grpc.CallOption) (*MsgEnrollRemoteRouterResponse, error)
+	// UnrollRemoteRouter ...
+	UnrollRemoteRouter(ctx context.Context, in *MsgUnrollRemoteRouter, opts ...grpc.CallOption) (*MsgUnrollRemoteRouterResponse, error)
+	// CallRemote dispatches calls for the interchain account of the sender on
+	// the destination chain.
+	CallRemote(ctx context.Context, in *MsgCallRemote, opts ...grpc.CallOption) (*MsgCallRemoteResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateRouter(ctx context.Context, in *MsgCreateRouter, opts ...grpc.CallOption) (*MsgCreateRouterResponse, error) {
+	out := new(MsgCreateRouterResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.ica.v1.Msg/CreateRouter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnrollRemoteRouter(ctx context.Context, in *MsgEnrollRemoteRouter, opts ...grpc.CallOption) (*MsgEnrollRemoteRouterResponse, error) {
+	out := new(MsgEnrollRemoteRouterResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.ica.v1.Msg/EnrollRemoteRouter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnrollRemoteRouter(ctx context.Context, in *MsgUnrollRemoteRouter, opts ...grpc.CallOption) (*MsgUnrollRemoteRouterResponse, error) {
+	out := new(MsgUnrollRemoteRouterResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.ica.v1.Msg/UnrollRemoteRouter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CallRemote(ctx context.Context, in *MsgCallRemote, opts ...grpc.CallOption) (*MsgCallRemoteResponse, error) {
+	out := new(MsgCallRemoteResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.ica.v1.Msg/CallRemote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateRouter ...
+	CreateRouter(context.Context, *MsgCreateRouter) (*MsgCreateRouterResponse, error)
+	// EnrollRemoteRouter ...
+	EnrollRemoteRouter(context.Context, *MsgEnrollRemoteRouter) (*MsgEnrollRemoteRouterResponse, error)
+	// UnrollRemoteRouter ...
+	UnrollRemoteRouter(context.Context, *MsgUnrollRemoteRouter) (*MsgUnrollRemoteRouterResponse, error)
+	// CallRemote dispatches calls for the interchain account of the sender on
+	// the destination chain.
+	CallRemote(context.Context, *MsgCallRemote) (*MsgCallRemoteResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateRouter(ctx context.Context, req *MsgCreateRouter) (*MsgCreateRouterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRouter not implemented")
+}
+func (*UnimplementedMsgServer) EnrollRemoteRouter(ctx context.Context, req *MsgEnrollRemoteRouter) (*MsgEnrollRemoteRouterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollRemoteRouter not implemented")
+}
+func (*UnimplementedMsgServer) UnrollRemoteRouter(ctx context.Context, req *MsgUnrollRemoteRouter) (*MsgUnrollRemoteRouterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnrollRemoteRouter not implemented")
+}
+func (*UnimplementedMsgServer) CallRemote(ctx context.Context, req *MsgCallRemote) (*MsgCallRemoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallRemote not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateRouter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRouter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRouter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.ica.v1.Msg/CreateRouter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRouter(ctx, req.(*MsgCreateRouter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnrollRemoteRouter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnrollRemoteRouter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnrollRemoteRouter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.ica.v1.Msg/EnrollRemoteRouter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnrollRemoteRouter(ctx, req.(*MsgEnrollRemoteRouter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnrollRemoteRouter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnrollRemoteRouter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnrollRemoteRouter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.ica.v1.Msg/UnrollRemoteRouter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnrollRemoteRouter(ctx, req.(*MsgUnrollRemoteRouter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CallRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCallRemote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CallRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.ica.v1.Msg/CallRemote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CallRemote(ctx, req.(*MsgCallRemote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperlane.ica.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRouter",
+			Handler:    _Msg_CreateRouter_Handler,
+		},
+		{
+			MethodName: "EnrollRemoteRouter",
+			Handler:    _Msg_EnrollRemoteRouter_Handler,
+		},
+		{
+			MethodName: "UnrollRemoteRouter",
+			Handler:    _Msg_UnrollRemoteRouter_Handler,
+		},
+		{
+			MethodName: "CallRemote",
+			Handler:    _Msg_CallRemote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyperlane/ica/v1/tx.proto",
+}
+
+func (m *MsgCreateRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsmId != nil {
+		{
+			size := m.IsmId.Size()
+			i -= size
+			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.OriginMailbox.Size()
+		i -= size
+		if _, err := m.OriginMailbox.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRouterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRouterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRouterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnrollRemoteRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnrollRemoteRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnrollRemoteRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoteRouter != nil {
+		{
+			size, err := m.RemoteRouter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.RouterId.Size()
+		i -= size
+		if _, err := m.RouterId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnrollRemoteRouterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnrollRemoteRouterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnrollRemoteRouterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnrollRemoteRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnrollRemoteRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnrollRemoteRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiverDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiverDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RouterId.Size()
+		i -= size
+		if _, err := m.RouterId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnrollRemoteRouterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnrollRemoteRouterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnrollRemoteRouterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCallRemote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCallRemote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCallRemote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomHookMetadata) > 0 {
+		i -= len(m.CustomHookMetadata)
+		copy(dAtA[i:], m.CustomHookMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomHookMetadata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.MaxFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GasLimit.Size()
+		i -= size
+		if _, err := m.GasLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CustomHookId != nil {
+		{
+			size := m.CustomHookId.Size()
+			i -= size
+			if _, err := m.CustomHookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Calls) > 0 {
+		i -= len(m.Calls)
+		copy(dAtA[i:], m.Calls)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Calls)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RouterId.Size()
+		i -= size
+		if _, err := m.RouterId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCallRemoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCallRemoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCallRemoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OriginMailbox.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.IsmId != nil {
+		l = m.IsmId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEnrollRemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RouterId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemoteRouter != nil {
+		l = m.RemoteRouter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnrollRemoteRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnrollRemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RouterId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovTx(uint64(m.ReceiverDomain))
+	}
+	return n
+}
+
+func (m *MsgUnrollRemoteRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCallRemote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RouterId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = len(m.Calls)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CustomHookId != nil {
+		l = m.CustomHookId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CustomHookMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCallRemoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MessageId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.IsmId = &v
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRouterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRouterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouterId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteRouter == nil {
+				m.RemoteRouter = &RemoteRouter{}
+			}
+			if err := m.RemoteRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouterId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			}
+			m.ReceiverDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCallRemote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCallRemote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCallRemote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouterId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.CustomHookId = &v
+			if err := m.CustomHookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomHookMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCallRemoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCallRemoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCallRemoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,924 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hyperlane/ica/v1/types.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterchainAccountRouter is the local counterpart of the remote interchain
+// account routers. It receives calls for interchain accounts and dispatches
+// calls of local accounts to remote chains.
+type InterchainAccountRouter struct {
+	Id            github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress  `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	Owner         string                                                       `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	OriginMailbox github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress  `protobuf:"bytes,3,opt,name=origin_mailbox,json=originMailbox,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"origin_mailbox"`
+	IsmId         *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,4,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id,omitempty"`
+}
+
+func (m *InterchainAccountRouter) Reset()         { *m = InterchainAccountRouter{} }
+func (m *InterchainAccountRouter) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountRouter) ProtoMessage()    {}
+func (*InterchainAccountRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c557e314d899fdc6, []int{0}
+}
+func (m *InterchainAccountRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountRouter.Merge(m, src)
+}
+func (m *InterchainAccountRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountRouter proto.InternalMessageInfo
+
+func (m *InterchainAccountRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// RemoteRouter ...
+type RemoteRouter struct {
+	ReceiverDomain   uint32                `protobuf:"varint,1,opt,name=receiver_domain,json=receiverDomain,proto3" json:"receiver_domain,omitempty"`
+	ReceiverContract string                `protobuf:"bytes,2,opt,name=receiver_contract,json=receiverContract,proto3" json:"receiver_contract,omitempty"`
+	Gas              cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=gas,proto3,customtype=cosmossdk.io/math.Int" json:"gas"`
+}
+
+func (m *RemoteRouter) Reset()         { *m = RemoteRouter{} }
+func (m *RemoteRouter) String() string { return proto.CompactTextString(m) }
+func (*RemoteRouter) ProtoMessage()    {}
+func (*RemoteRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c557e314d899fdc6, []int{1}
+}
+func (m *RemoteRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteRouter.Merge(m, src)
+}
+func (m *RemoteRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteRouter proto.InternalMessageInfo
+
+func (m *RemoteRouter) GetReceiverDomain() uint32 {
+	if m != nil {
+		return m.ReceiverDomain
+	}
+	return 0
+}
+
+func (m *RemoteRouter) GetReceiverContract() string {
+	if m != nil {
+		return m.ReceiverContract
+	}
+	return ""
+}
+
+// InterchainAccountCalls is the list of messages which is executed by an
+// interchain account on this chain.
+type InterchainAccountCalls struct {
+	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *InterchainAccountCalls) Reset()         { *m = InterchainAccountCalls{} }
+func (m *InterchainAccountCalls) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountCalls) ProtoMessage()    {}
+func (*InterchainAccountCalls) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c557e314d899fdc6, []int{2}
+}
+func (m *InterchainAccountCalls) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountCalls) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountCalls.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountCalls) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountCalls.Merge(m, src)
+}
+func (m *InterchainAccountCalls) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountCalls) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountCalls.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountCalls proto.InternalMessageInfo
+
+func (m *InterchainAccountCalls) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InterchainAccountRouter)(nil), "hyperlane.ica.v1.InterchainAccountRouter")
+	proto.RegisterType((*RemoteRouter)(nil), "hyperlane.ica.v1.RemoteRouter")
+	proto.RegisterType((*InterchainAccountCalls)(nil), "hyperlane.ica.v1.InterchainAccountCalls")
+}
+
+func init() { proto.RegisterFile("hyperlane/ica/v1/types.proto", fileDescriptor_c557e314d899fdc6) }
+
+var fileDescriptor_c557e314d899fdc6 = []byte{
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0xf5, 0xd9, 0x24, 0x82, 0x85, 0x84, 0x70, 0x32, 0x70, 0x89, 0xe0, 0x12, 0xb9, 0x21, 0x12,
+	0xf2, 0x2e, 0x81, 0x82, 0x82, 0xca, 0x31, 0x05, 0x46, 0xa2, 0xe0, 0xd2, 0xa5, 0xb1, 0xf6, 0xf6,
+	0x96, 0xf3, 0xc0, 0xed, 0x8e, 0xb5, 0xbb, 0x67, 0xec, 0xbf, 0xa0, 0xe1, 0x4f, 0xf8, 0x88, 0x94,
+	0x11, 0x15, 0xa2, 0x88, 0x90, 0x2d, 0xf1, 0x1d, 0xc8, 0xb7, 0xe7, 0x6b, 0xa8, 0x10, 0xe9, 0x6e,
+	0xde, 0x7b, 0x9a, 0x79, 0xf7, 0x76, 0x86, 0x3c, 0x9a, 0x2c, 0xa6, 0xd2, 0x14, 0x5c, 0x4b, 0x06,
+	0x82, 0xb3, 0xd9, 0x09, 0x73, 0x8b, 0xa9, 0xb4, 0x74, 0x6a, 0xd0, 0x61, 0xb8, 0xd7, 0xb0, 0x14,
+	0x04, 0xa7, 0xb3, 0x93, 0x83, 0x7d, 0x81, 0x56, 0xa1, 0x1d, 0x57, 0x3c, 0xf3, 0x85, 0x17, 0x1f,
+	0x74, 0x73, 0xcc, 0xd1, 0xe3, 0xeb, 0xaf, 0x1a, 0xdd, 0xcf, 0x11, 0xf3, 0x42, 0xb2, 0xaa, 0x4a,
+	0xcb, 0x0f, 0x8c, 0xeb, 0x85, 0xa7, 0x7a, 0xbf, 0xdb, 0xe4, 0xe1, 0x48, 0x3b, 0x69, 0xc4, 0x84,
+	0x83, 0x1e, 0x08, 0x81, 0xa5, 0x76, 0x09, 0x96, 0x4e, 0x9a, 0xf0, 0x8c, 0xb4, 0x21, 0x8b, 0x82,
+	0xa3, 0xe0, 0xf8, 0xd6, 0xe9, 0xf0, 0xe2, 0xea, 0xb0, 0xf5, 0xf3, 0xea, 0xf0, 0x55, 0x0e, 0x6e,
+	0x52, 0xa6, 0x54, 0xa0, 0x62, 0xa9, 0x98, 0xf6, 0x41, 0x6b, 0x9c, 0x71, 0x07, 0xa8, 0x2d, 0x6b,
+	0x8c, 0xf6, 0xbd, 0x27, 0x56, 0x3a, 0x28, 0xe8, 0x1b, 0x39, 0x1f, 0x64, 0x99, 0x91, 0xd6, 0x26,
+	0x6d, 0xc8, 0x42, 0x4a, 0xb6, 0xf0, 0xb3, 0x96, 0x26, 0x6a, 0x57, 0x7d, 0xa3, 0xef, 0xdf, 0xfa,
+	0xdd, 0xfa, 0x17, 0x6a, 0xd9, 0x99, 0x33, 0xa0, 0xf3, 0xc4, 0xcb, 0xc2, 0x8f, 0x64, 0x17, 0x0d,
+	0xe4, 0xa0, 0xc7, 0x8a, 0x43, 0x91, 0xe2, 0x3c, 0xea, 0x5c, 0x9f, 0xa1, 0x1d, 0xdf, 0xfa, 0x9d,
+	0xef, 0x1c, 0x9e, 0x93, 0x6d, 0xb0, 0x6a, 0x0c, 0x59, 0x74, 0xa3, 0x99, 0x11, 0xfc, 0xef, 0x8c,
+	0x2d, 0xb0, 0x6a, 0x94, 0xf5, 0xbe, 0x06, 0xe4, 0x4e, 0x22, 0x15, 0x3a, 0x59, 0xa7, 0xfb, 0x84,
+	0xdc, 0x35, 0x52, 0x48, 0x98, 0x49, 0x33, 0xce, 0x50, 0x71, 0xd0, 0x55, 0xd4, 0x3b, 0xc9, 0xee,
+	0x06, 0x7e, 0x5d, 0xa1, 0xe1, 0x53, 0x72, 0xaf, 0x11, 0x0a, 0xd4, 0xce, 0x70, 0xe1, 0x7c, 0x7a,
+	0xc9, 0xde, 0x86, 0x18, 0xd6, 0x78, 0xc8, 0x48, 0x27, 0xe7, 0xb6, 0xce, 0xe8, 0x71, 0x9d, 0xd1,
+	0x7d, 0x6f, 0xcd, 0x66, 0x9f, 0x28, 0x20, 0x53, 0xdc, 0x4d, 0xe8, 0x48, 0xbb, 0x64, 0xad, 0xec,
+	0xbd, 0x25, 0x0f, 0xfe, 0x7a, 0xff, 0x21, 0x2f, 0x0a, 0x1b, 0x3e, 0x23, 0x37, 0x95, 0xb4, 0x96,
+	0xe7, 0xd2, 0x46, 0xc1, 0x51, 0xe7, 0xf8, 0xf6, 0xf3, 0x2e, 0xf5, 0x8b, 0x44, 0x37, 0x8b, 0x44,
+	0x07, 0x7a, 0x91, 0x34, 0xaa, 0xd3, 0xf7, 0x17, 0xcb, 0x38, 0xb8, 0x5c, 0xc6, 0xc1, 0xaf, 0x65,
+	0x1c, 0x7c, 0x59, 0xc5, 0xad, 0xcb, 0x55, 0xdc, 0xfa, 0xb1, 0x8a, 0x5b, 0xe7, 0x2f, 0xff, 0x25,
+	0xc1, 0x79, 0x75, 0x06, 0xd5, 0x0d, 0xa4, 0xdb, 0xd5, 0xa8, 0x17, 0x7f, 0x06, 0x00, 0x1c, 0xc9,
+	0xe1, 0xf4, 0x24, 0x03, 0x00, 0x00,
+}
+
+func (m *InterchainAccountRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsmId != nil {
+		{
+			size := m.IsmId.Size()
+			i -= size
+			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.OriginMailbox.Size()
+		i -= size
+		if _, err := m.OriginMailbox.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Gas.Size()
+		i -= size
+		if _, err := m.Gas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReceiverContract) > 0 {
+		i -= len(m.ReceiverContract)
+		copy(dAtA[i:], m.ReceiverContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReceiverContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReceiverDomain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReceiverDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountCalls) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountCalls) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountCalls) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccountRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.OriginMailbox.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.IsmId != nil {
+		l = m.IsmId.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovTypes(uint64(m.ReceiverDomain))
+	}
+	l = len(m.ReceiverContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Gas.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *InterchainAccountCalls) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.IsmId = &v
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			}
+			m.ReceiverDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainAccountCalls) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountCalls: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountCalls: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+var ismId string
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "hyperlane-icq",
+		Short:                      "hyperlane-icq transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		CmdCreateRouter(),
+		CmdEnrollRemoteRouter(),
+		CmdUnrollRemoteRouter(),
+	)
+
+	return txCmd
+}
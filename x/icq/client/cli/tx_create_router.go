@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
+)
+
+func CmdCreateRouter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-router [origin-mailbox]",
+		Short: "Create an interchain query router",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mailboxId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			var parsedIsmId *util.HexAddress = nil
+			if ismId != "" {
+				parsed, err := util.DecodeHexAddress(ismId)
+				if err != nil {
+					return err
+				}
+				parsedIsmId = &parsed
+			}
+
+			msg := types.MsgCreateRouter{
+				Owner:         clientCtx.GetFromAddress().String(),
+				OriginMailbox: mailboxId,
+				IsmId:         parsedIsmId,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid owner address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	cmd.Flags().StringVar(&ismId, "ism-id", "", "ISM which verifies incoming responses, defaults to the mailbox ISM")
+
+	return cmd
+}
@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	addressCodec address.Codec
+
+	// authority is the address capable of executing a MsgUpdateParams and other authority-gated message.
+	// typically, this should be the x/gov module account.
+	authority string
+
+	Params collections.Item[types.Params]
+	// state management
+	Schema collections.Schema
+	// <routerId> -> Router
+	Routers collections.Map[uint64, types.InterchainQueryRouter]
+	// <routerId> <domain> -> Router
+	EnrolledRouters collections.Map[collections.Pair[uint64, uint32], types.RemoteRouter]
+	// <messageId> -> PendingQuery
+	PendingQueries collections.Map[[]byte, types.PendingQuery]
+	// <timeoutHeight> <messageId>
+	QueryTimeouts collections.KeySet[collections.Pair[int64, []byte]]
+
+	// callbacks are registered by other modules during app wiring and are not part of the state.
+	callbacks map[string]types.QueryCallbackHandler
+
+	coreKeeper types.CoreKeeper
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	addressCodec address.Codec,
+	storeService storetypes.KVStoreService,
+	authority string,
+	coreKeeper types.CoreKeeper,
+) Keeper {
+	if _, err := addressCodec.StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:             cdc,
+		addressCodec:    addressCodec,
+		authority:       authority,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Routers:         collections.NewMap(sb, types.RoutersKey, "routers", collections.Uint64Key, codec.CollValue[types.InterchainQueryRouter](cdc)),
+		EnrolledRouters: collections.NewMap(sb, types.EnrolledRoutersKey, "enrolled_routers", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.RemoteRouter](cdc)),
+		PendingQueries:  collections.NewMap(sb, types.PendingQueriesKey, "pending_queries", collections.BytesKey, codec.CollValue[types.PendingQuery](cdc)),
+		QueryTimeouts:   collections.NewKeySet(sb, types.QueryTimeoutsKey, "query_timeouts", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		callbacks:       make(map[string]types.QueryCallbackHandler),
+		coreKeeper:      coreKeeper,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	coreKeeper.AppRouter().RegisterModule(types.APP_TYPE_INTERCHAIN_QUERY_ROUTER, &k)
+
+	k.Schema = schema
+
+	return k
+}
+
+// RegisterCallbackHandler registers the handler which receives responses and timeouts of the
+// queries sent with the given callback name. It panics if the name is already taken.
+func (k *Keeper) RegisterCallbackHandler(name string, handler types.QueryCallbackHandler) {
+	if _, ok := k.callbacks[name]; ok {
+		panic(fmt.Sprintf("callback handler '%s' already registered", name))
+	}
+	k.callbacks[name] = handler
+}
+
+func (k *Keeper) Exists(ctx context.Context, routerId util.HexAddress) (bool, error) {
+	return k.Routers.Has(ctx, routerId.GetInternalId())
+}
+
+func (k *Keeper) ReceiverIsmId(ctx context.Context, recipient util.HexAddress) (*util.HexAddress, error) {
+	router, err := k.Routers.Get(ctx, recipient.GetInternalId())
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrRouterNotFound, "%v", recipient.String())
+	}
+
+	if router.IsmId == nil {
+		mailbox, err := k.coreKeeper.GetMailbox(ctx, router.OriginMailbox)
+		if err != nil {
+			return nil, err
+		}
+		return &mailbox.DefaultIsm, nil
+	}
+
+	return router.IsmId, nil
+}
+
+// Handle delivers the response of a pending query to its callback handler.
+func (k *Keeper) Handle(ctx context.Context, mailboxId util.HexAddress, message util.HyperlaneMessage) error {
+	router, err := k.Routers.Get(ctx, message.Recipient.GetInternalId())
+	if err != nil {
+		return err
+	}
+
+	if router.OriginMailbox != mailboxId {
+		return fmt.Errorf("invalid origin mailbox address")
+	}
+
+	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(message.Recipient.GetInternalId(), message.Origin))
+	if err != nil {
+		return fmt.Errorf("no enrolled router found for origin %d", message.Origin)
+	}
+
+	if message.Sender.String() != strings.ToLower(remoteRouter.ReceiverContract) {
+		return fmt.Errorf("invalid receiver contract")
+	}
+
+	payload, err := types.ParseResponsePayload(message.Body)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidPayload, err.Error())
+	}
+
+	query, err := k.PendingQueries.Get(ctx, payload.QueryId.Bytes())
+	if err != nil {
+		return errors.Wrapf(types.ErrPendingQueryNotFound, "%s", payload.QueryId)
+	}
+
+	if query.RouterId != router.Id || query.DestinationDomain != message.Origin {
+		return fmt.Errorf("response for query %s from unexpected origin %d", payload.QueryId, message.Origin)
+	}
+
+	handler, ok := k.callbacks[query.Callback]
+	if !ok {
+		return errors.Wrapf(types.ErrUnknownCallback, "%s", query.Callback)
+	}
+
+	if err := k.removePendingQuery(ctx, query); err != nil {
+		return err
+	}
+
+	if err := handler.OnQueryResponse(ctx, query, payload.Response); err != nil {
+		return err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.QueryResolved{
+		MessageId: query.MessageId.String(),
+		Callback:  query.Callback,
+	})
+
+	return nil
+}
+
+// SendQuery dispatches a query to the enrolled remote router of the destination domain. The
+// response is delivered to the callback handler registered under the given name. The payer covers
+// the fees of the post dispatch hooks. If gasLimit is zero, the gas of the remote router is used.
+func (k *Keeper) SendQuery(
+	ctx sdk.Context,
+	routerId util.HexAddress,
+	destinationDomain uint32,
+	callback string,
+	payer sdk.AccAddress,
+	query []byte,
+	gasLimit math.Int,
+	maxFee sdk.Coins,
+) (util.HexAddress, error) {
+	if _, ok := k.callbacks[callback]; !ok {
+		return util.HexAddress{}, errors.Wrapf(types.ErrUnknownCallback, "%s", callback)
+	}
+
+	router, err := k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return util.HexAddress{}, fmt.Errorf("failed to find router with id: %s", routerId.String())
+	}
+
+	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(router.Id.GetInternalId(), destinationDomain))
+	if err != nil {
+		return util.HexAddress{}, fmt.Errorf("no enrolled router found for destination domain %d", destinationDomain)
+	}
+
+	receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+	if err != nil {
+		return util.HexAddress{}, fmt.Errorf("failed to decode receiver contract address %s", remoteRouter.ReceiverContract)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	gas := remoteRouter.Gas
+	if !gasLimit.IsNil() && !gasLimit.IsZero() {
+		gas = gasLimit
+	}
+
+	messageId, err := k.coreKeeper.DispatchMessage(
+		ctx,
+		router.OriginMailbox,
+		router.Id, // sender
+		maxFee,
+
+		remoteRouter.ReceiverDomain,
+		receiverContract,
+
+		types.NewQueryPayload(query), // message body
+
+		util.StandardHookMetadata{
+			GasLimit: gas,
+			Address:  payer,
+		},
+		nil,
+	)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	pendingQuery := types.PendingQuery{
+		MessageId:         messageId,
+		RouterId:          router.Id,
+		DestinationDomain: destinationDomain,
+		Callback:          callback,
+		CreatedHeight:     ctx.BlockHeight(),
+		TimeoutHeight:     ctx.BlockHeight() + int64(params.QueryTimeoutBlocks),
+	}
+
+	if err := k.setPendingQuery(ctx, pendingQuery); err != nil {
+		return util.HexAddress{}, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.QuerySent{
+		MessageId:         messageId.String(),
+		RouterId:          router.Id.String(),
+		DestinationDomain: destinationDomain,
+		Callback:          callback,
+	})
+
+	return messageId, nil
+}
+
+// TimeoutQueries removes pending queries whose timeout height is reached and notifies their
+// callback handlers. At most MaxTimeoutsPerBlock queries are processed, the remaining ones are
+// left for the following blocks. Each handler runs in a cached context, so that a failing handler
+// does not leave partial state behind. Errors of the handlers are logged and do not stop the processing.
+func (k *Keeper) TimeoutQueries(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	rng := new(collections.Range[collections.Pair[int64, []byte]]).
+		EndExclusive(collections.Join(sdkCtx.BlockHeight()+1, []byte{}))
+
+	iter, err := k.QueryTimeouts.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var keys []collections.Pair[int64, []byte]
+	for ; iter.Valid() && uint64(len(keys)) < params.MaxTimeoutsPerBlock; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			_ = iter.Close()
+			return err
+		}
+		keys = append(keys, key)
+	}
+	if err = iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		query, err := k.PendingQueries.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		if err := k.removePendingQuery(ctx, query); err != nil {
+			return err
+		}
+
+		if handler, ok := k.callbacks[query.Callback]; ok {
+			if err := onQueryTimeout(sdkCtx, handler, query); err != nil {
+				sdkCtx.Logger().Error("interchain query timeout callback failed", "message_id", query.MessageId.String(), "callback", query.Callback, "error", err)
+			}
+		}
+
+		_ = sdkCtx.EventManager().EmitTypedEvent(&types.QueryTimedOut{
+			MessageId: query.MessageId.String(),
+			Callback:  query.Callback,
+		})
+	}
+
+	return nil
+}
+
+// onQueryTimeout calls the timeout callback of a query in a cached context. The state changes of
+// the callback are only written if it succeeds. A panic of the callback is recovered and returned as
+// error, as it would otherwise halt the chain in the end blocker.
+func onQueryTimeout(ctx sdk.Context, handler types.QueryCallbackHandler, query types.PendingQuery) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("callback panicked: %v", r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if err = handler.OnQueryTimeout(cacheCtx, query); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+func (k *Keeper) setPendingQuery(ctx context.Context, query types.PendingQuery) error {
+	if err := k.PendingQueries.Set(ctx, query.MessageId.Bytes(), query); err != nil {
+		return err
+	}
+	return k.QueryTimeouts.Set(ctx, collections.Join(query.TimeoutHeight, query.MessageId.Bytes()))
+}
+
+func (k *Keeper) removePendingQuery(ctx context.Context, query types.PendingQuery) error {
+	if err := k.PendingQueries.Remove(ctx, query.MessageId.Bytes()); err != nil {
+		return err
+	}
+	return k.QueryTimeouts.Remove(ctx, collections.Join(query.TimeoutHeight, query.MessageId.Bytes()))
+}
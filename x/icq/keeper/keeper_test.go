@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - keeper.go
+
+* SendQuery (invalid) unknown callback
+* SendQuery (invalid) no enrolled router for destination
+* SendQuery (valid) stores pending query
+* Handle (invalid) sender is not the enrolled router
+* Handle (invalid) unknown query id
+* Handle (invalid) response from unexpected origin
+* Handle (valid) delivers response to callback
+* TimeoutQueries (valid) removes stale queries and notifies callback
+* TimeoutQueries (valid) processes at most max timeouts per block
+* TimeoutQueries (valid) discards state of failing callback
+* TimeoutQueries (valid) recovers from panicking callback
+
+*/
+
+type mockCallback struct {
+	responses map[util.HexAddress][]byte
+	timeouts  []util.HexAddress
+	onTimeout func(ctx context.Context) error
+}
+
+func (m *mockCallback) OnQueryResponse(_ context.Context, query types.PendingQuery, response []byte) error {
+	m.responses[query.MessageId] = response
+	return nil
+}
+
+func (m *mockCallback) OnQueryTimeout(ctx context.Context, query types.PendingQuery) error {
+	m.timeouts = append(m.timeouts, query.MessageId)
+	if m.onTimeout != nil {
+		return m.onTimeout(ctx)
+	}
+	return nil
+}
+
+var _ = Describe("keeper.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var callback *mockCallback
+	var mailboxId util.HexAddress
+	var routerId util.HexAddress
+	var remoteSender util.HexAddress
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		callback = &mockCallback{responses: make(map[util.HexAddress][]byte)}
+		s.App().IcqKeeper.RegisterCallbackHandler("mock", callback)
+
+		mailboxId, _, _ = createValidMailbox(s, owner.Address)
+		routerId = createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		remoteSender, _ = util.DecodeHexAddress(remoteRouter.ReceiverContract)
+	})
+
+	sendQuery := func(callbackName string, destination uint32) (util.HexAddress, error) {
+		return s.App().IcqKeeper.SendQuery(
+			s.Ctx(),
+			routerId,
+			destination,
+			callbackName,
+			owner.AccAddress,
+			[]byte("query"),
+			math.ZeroInt(),
+			sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1_000_000))),
+		)
+	}
+
+	processResponse := func(origin uint32, sender, queryId util.HexAddress, response []byte) error {
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      origin,
+			Sender:      sender,
+			Destination: 0,
+			Recipient:   routerId,
+			Body:        types.NewResponsePayload(queryId, response).Bytes(),
+		}
+
+		_, err := s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   owner.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+		return err
+	}
+
+	It("SendQuery (invalid) unknown callback", func() {
+		// Act
+		_, err := sendQuery("unknown", remoteRouter.ReceiverDomain)
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("unknown: %s", types.ErrUnknownCallback)))
+	})
+
+	It("SendQuery (invalid) no enrolled router for destination", func() {
+		// Act
+		_, err := sendQuery("mock", 2)
+
+		// Assert
+		Expect(err.Error()).To(Equal("no enrolled router found for destination domain 2"))
+	})
+
+	It("SendQuery (valid) stores pending query", func() {
+		// Act
+		queryId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		pending, err := keeper.NewQueryServerImpl(s.App().IcqKeeper).PendingQuery(s.Ctx(), &types.QueryPendingQueryRequest{
+			MessageId: queryId.String(),
+		})
+		Expect(err).To(BeNil())
+		Expect(pending.Query.RouterId).To(Equal(routerId))
+		Expect(pending.Query.DestinationDomain).To(Equal(remoteRouter.ReceiverDomain))
+		Expect(pending.Query.Callback).To(Equal("mock"))
+		Expect(pending.Query.TimeoutHeight).To(Equal(s.Ctx().BlockHeight() + int64(types.DefaultQueryTimeoutBlocks)))
+	})
+
+	It("Handle (invalid) sender is not the enrolled router", func() {
+		// Arrange
+		queryId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = processResponse(remoteRouter.ReceiverDomain, util.CreateMockHexAddress("attacker", 1), queryId, []byte("result"))
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid receiver contract"))
+		Expect(callback.responses).To(BeEmpty())
+	})
+
+	It("Handle (invalid) unknown query id", func() {
+		// Arrange
+		unknownId := util.CreateMockHexAddress("unknown", 1)
+
+		// Act
+		err := processResponse(remoteRouter.ReceiverDomain, remoteSender, unknownId, []byte("result"))
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("%s: %s", unknownId, types.ErrPendingQueryNotFound)))
+	})
+
+	It("Handle (invalid) response from unexpected origin", func() {
+		// Arrange
+		otherRouter := types.RemoteRouter{
+			ReceiverDomain:   2,
+			ReceiverContract: remoteRouter.ReceiverContract,
+			Gas:              math.NewInt(50000),
+		}
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner.Address,
+			RouterId:     routerId,
+			RemoteRouter: &otherRouter,
+		})
+		Expect(err).To(BeNil())
+
+		queryId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = processResponse(otherRouter.ReceiverDomain, remoteSender, queryId, []byte("result"))
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("response for query %s from unexpected origin 2", queryId)))
+		Expect(callback.responses).To(BeEmpty())
+	})
+
+	It("Handle (valid) delivers response to callback", func() {
+		// Arrange
+		queryId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = processResponse(remoteRouter.ReceiverDomain, remoteSender, queryId, []byte("result"))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(callback.responses).To(HaveKeyWithValue(queryId, []byte("result")))
+
+		has, err := s.App().IcqKeeper.PendingQueries.Has(s.Ctx(), queryId.Bytes())
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+
+		err = processResponse(remoteRouter.ReceiverDomain, remoteSender, queryId, []byte("result"))
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("TimeoutQueries (valid) removes stale queries and notifies callback", func() {
+		// Arrange
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress("gov").String(),
+			Params:    types.Params{QueryTimeoutBlocks: 5, MaxTimeoutsPerBlock: 10},
+		})
+		Expect(err).To(BeNil())
+
+		staleId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+		staleHeight := s.Ctx().BlockHeight()
+
+		freshId, err := s.App().IcqKeeper.SendQuery(
+			s.Ctx().WithBlockHeight(staleHeight+3),
+			routerId,
+			remoteRouter.ReceiverDomain,
+			"mock",
+			owner.AccAddress,
+			[]byte("query"),
+			math.ZeroInt(),
+			sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1_000_000))),
+		)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = s.App().IcqKeeper.TimeoutQueries(s.Ctx().WithBlockHeight(staleHeight + 4))
+		Expect(err).To(BeNil())
+		Expect(callback.timeouts).To(BeEmpty())
+
+		err = s.App().IcqKeeper.TimeoutQueries(s.Ctx().WithBlockHeight(staleHeight + 5))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(callback.timeouts).To(Equal([]util.HexAddress{staleId}))
+
+		pending, err := keeper.NewQueryServerImpl(s.App().IcqKeeper).PendingQueries(s.Ctx(), &types.QueryPendingQueriesRequest{})
+		Expect(err).To(BeNil())
+		Expect(pending.Queries).To(HaveLen(1))
+		Expect(pending.Queries[0].MessageId).To(Equal(freshId))
+
+		err = processResponse(remoteRouter.ReceiverDomain, remoteSender, staleId, []byte("result"))
+		Expect(err.Error()).To(Equal(fmt.Sprintf("%s: %s", staleId, types.ErrPendingQueryNotFound)))
+	})
+
+	It("TimeoutQueries (valid) processes at most max timeouts per block", func() {
+		// Arrange
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress("gov").String(),
+			Params:    types.Params{QueryTimeoutBlocks: 5, MaxTimeoutsPerBlock: 1},
+		})
+		Expect(err).To(BeNil())
+
+		_, err = sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+		_, err = sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+		timeoutHeight := s.Ctx().BlockHeight() + 5
+
+		// Act
+		err = s.App().IcqKeeper.TimeoutQueries(s.Ctx().WithBlockHeight(timeoutHeight))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(callback.timeouts).To(HaveLen(1))
+
+		pending, err := keeper.NewQueryServerImpl(s.App().IcqKeeper).PendingQueries(s.Ctx(), &types.QueryPendingQueriesRequest{})
+		Expect(err).To(BeNil())
+		Expect(pending.Queries).To(HaveLen(1))
+
+		err = s.App().IcqKeeper.TimeoutQueries(s.Ctx().WithBlockHeight(timeoutHeight + 1))
+		Expect(err).To(BeNil())
+		Expect(callback.timeouts).To(HaveLen(2))
+
+		pending, err = keeper.NewQueryServerImpl(s.App().IcqKeeper).PendingQueries(s.Ctx(), &types.QueryPendingQueriesRequest{})
+		Expect(err).To(BeNil())
+		Expect(pending.Queries).To(BeEmpty())
+	})
+
+	It("TimeoutQueries (valid) discards state of failing callback", func() {
+		// Arrange
+		queryId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+		timeoutHeight := s.Ctx().BlockHeight() + int64(types.DefaultQueryTimeoutBlocks)
+
+		callback.onTimeout = func(ctx context.Context) error {
+			err := s.App().IcqKeeper.Params.Set(ctx, types.Params{QueryTimeoutBlocks: 1, MaxTimeoutsPerBlock: 1})
+			Expect(err).To(BeNil())
+			return fmt.Errorf("callback failed")
+		}
+
+		// Act
+		err = s.App().IcqKeeper.TimeoutQueries(s.Ctx().WithBlockHeight(timeoutHeight))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(callback.timeouts).To(Equal([]util.HexAddress{queryId}))
+
+		params, err := s.App().IcqKeeper.Params.Get(s.Ctx())
+		Expect(err).To(BeNil())
+		Expect(params).To(Equal(types.DefaultParams()))
+
+		has, err := s.App().IcqKeeper.PendingQueries.Has(s.Ctx(), queryId.Bytes())
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+	})
+
+	It("TimeoutQueries (valid) recovers from panicking callback", func() {
+		// Arrange
+		firstId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+		secondId, err := sendQuery("mock", remoteRouter.ReceiverDomain)
+		Expect(err).To(BeNil())
+		timeoutHeight := s.Ctx().BlockHeight() + int64(types.DefaultQueryTimeoutBlocks)
+
+		callback.onTimeout = func(ctx context.Context) error {
+			err := s.App().IcqKeeper.Params.Set(ctx, types.Params{QueryTimeoutBlocks: 1, MaxTimeoutsPerBlock: 1})
+			Expect(err).To(BeNil())
+			panic("callback panicked")
+		}
+
+		// Act
+		err = s.App().IcqKeeper.TimeoutQueries(s.Ctx().WithBlockHeight(timeoutHeight))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(callback.timeouts).To(ConsistOf(firstId, secondId))
+
+		params, err := s.App().IcqKeeper.Params.Get(s.Ctx())
+		Expect(err).To(BeNil())
+		Expect(params).To(Equal(types.DefaultParams()))
+
+		pending, err := keeper.NewQueryServerImpl(s.App().IcqKeeper).PendingQueries(s.Ctx(), &types.QueryPendingQueriesRequest{})
+		Expect(err).To(BeNil())
+		Expect(pending.Queries).To(BeEmpty())
+	})
+})
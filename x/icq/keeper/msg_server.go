@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+// UpdateParams updates the module parameters. Only the authority is allowed to do so.
+func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Params.Set(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// CreateRouter creates a new interchain query router on the given mailbox.
+func (ms msgServer) CreateRouter(ctx context.Context, msg *types.MsgCreateRouter) (*types.MsgCreateRouterResponse, error) {
+	has, err := ms.k.coreKeeper.MailboxIdExists(ctx, msg.OriginMailbox)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("failed to find mailbox with id: %s", msg.OriginMailbox.String())
+	}
+
+	if msg.IsmId != nil {
+		if err := ms.k.coreKeeper.AssertIsmExists(ctx, *msg.IsmId); err != nil {
+			return nil, err
+		}
+	}
+
+	routerId, err := ms.k.coreKeeper.AppRouter().GetNextSequence(ctx, types.APP_TYPE_INTERCHAIN_QUERY_ROUTER)
+	if err != nil {
+		return nil, err
+	}
+
+	newRouter := types.InterchainQueryRouter{
+		Id:            routerId,
+		Owner:         msg.Owner,
+		OriginMailbox: msg.OriginMailbox,
+		IsmId:         msg.IsmId,
+	}
+
+	if err = ms.k.Routers.Set(ctx, routerId.GetInternalId(), newRouter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateRouterResponse{Id: routerId}, nil
+}
+
+// EnrollRemoteRouter enrolls the interchain account router of a remote domain.
+func (ms msgServer) EnrollRemoteRouter(ctx context.Context, msg *types.MsgEnrollRemoteRouter) (*types.MsgEnrollRemoteRouterResponse, error) {
+	routerId := msg.RouterId
+	router, err := ms.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("router with id %s not found", routerId.String())
+	}
+
+	if router.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own router with id %s", msg.Owner, routerId.String())
+	}
+
+	if msg.RemoteRouter == nil {
+		return nil, fmt.Errorf("invalid remote router")
+	}
+
+	if _, err := util.DecodeHexAddress(msg.RemoteRouter.ReceiverContract); err != nil {
+		return nil, fmt.Errorf("invalid receiver contract")
+	}
+
+	if msg.RemoteRouter.Gas.IsNil() || msg.RemoteRouter.Gas.IsNegative() {
+		return nil, fmt.Errorf("invalid gas")
+	}
+
+	if err = ms.k.EnrolledRouters.Set(ctx, collections.Join(routerId.GetInternalId(), msg.RemoteRouter.ReceiverDomain), *msg.RemoteRouter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnrollRemoteRouterResponse{}, nil
+}
+
+// UnrollRemoteRouter removes the enrolled router of a remote domain.
+func (ms msgServer) UnrollRemoteRouter(ctx context.Context, msg *types.MsgUnrollRemoteRouter) (*types.MsgUnrollRemoteRouterResponse, error) {
+	routerId := msg.RouterId
+	router, err := ms.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("router with id %s not found", routerId.String())
+	}
+
+	if router.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own router with id %s", msg.Owner, routerId.String())
+	}
+
+	exists, err := ms.k.EnrolledRouters.Has(ctx, collections.Join(routerId.GetInternalId(), msg.ReceiverDomain))
+	if err != nil || !exists {
+		return nil, fmt.Errorf("failed to find remote router for domain %v", msg.ReceiverDomain)
+	}
+
+	if err = ms.k.EnrolledRouters.Remove(ctx, collections.Join(routerId.GetInternalId(), msg.ReceiverDomain)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnrollRemoteRouterResponse{}, nil
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
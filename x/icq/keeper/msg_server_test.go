@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	ismTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	pdTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/02_post_dispatch/types"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server.go
+
+* UpdateParams (invalid) with non-authority address
+* UpdateParams (invalid) zero query timeout
+* UpdateParams (invalid) zero max timeouts per block
+* UpdateParams (valid)
+* CreateRouter (invalid) non-existing mailbox
+* CreateRouter (valid)
+* EnrollRemoteRouter (invalid) non-owner
+* EnrollRemoteRouter & UnrollRemoteRouter (valid)
+
+*/
+
+const denom = "acoin"
+
+var _ = Describe("msg_server.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var authority string
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		authority = authtypes.NewModuleAddress("gov").String()
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	It("UpdateParams (invalid) with non-authority address", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: owner.Address,
+			Params:    types.Params{QueryTimeoutBlocks: 10, MaxTimeoutsPerBlock: 10},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("invalid authority; expected %s, got %s", authority, owner.Address)))
+	})
+
+	It("UpdateParams (invalid) zero query timeout", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authority,
+			Params:    types.Params{QueryTimeoutBlocks: 0, MaxTimeoutsPerBlock: 10},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("query timeout blocks must be greater than zero"))
+	})
+
+	It("UpdateParams (invalid) zero max timeouts per block", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authority,
+			Params:    types.Params{QueryTimeoutBlocks: 10, MaxTimeoutsPerBlock: 0},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("max timeouts per block must be greater than zero"))
+	})
+
+	It("UpdateParams (valid)", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgUpdateParams{
+			Authority: authority,
+			Params:    types.Params{QueryTimeoutBlocks: 10, MaxTimeoutsPerBlock: 10},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		params, err := keeper.NewQueryServerImpl(s.App().IcqKeeper).Params(s.Ctx(), &types.QueryParamsRequest{})
+		Expect(err).To(BeNil())
+		Expect(params.Params.QueryTimeoutBlocks).To(Equal(uint64(10)))
+		Expect(params.Params.MaxTimeoutsPerBlock).To(Equal(uint64(10)))
+	})
+
+	It("CreateRouter (invalid) non-existing mailbox", func() {
+		// Arrange
+		mailboxId := util.CreateMockHexAddress("mailbox", 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateRouter{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("failed to find mailbox with id: %s", mailboxId)))
+	})
+
+	It("CreateRouter (valid)", func() {
+		// Arrange
+		mailboxId, _, ismId := createValidMailbox(s, owner.Address)
+
+		// Act
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+
+		// Assert
+		router, err := keeper.NewQueryServerImpl(s.App().IcqKeeper).Router(s.Ctx(), &types.QueryRouterRequest{Id: routerId.String()})
+		Expect(err).To(BeNil())
+		Expect(router.Router.Owner).To(Equal(owner.Address))
+		Expect(router.Router.OriginMailbox).To(Equal(mailboxId))
+
+		receiverIsm, err := s.App().IcqKeeper.ReceiverIsmId(s.Ctx(), routerId)
+		Expect(err).To(BeNil())
+		Expect(*receiverIsm).To(Equal(ismId))
+	})
+
+	It("EnrollRemoteRouter (invalid) non-owner", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, nil)
+
+		// Act
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        sender.Address,
+			RouterId:     routerId,
+			RemoteRouter: &remoteRouter,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(fmt.Sprintf("%s does not own router with id %s", sender.Address, routerId)))
+	})
+
+	It("EnrollRemoteRouter & UnrollRemoteRouter (valid)", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address)
+		routerId := createRouter(s, owner.Address, mailboxId, &remoteRouter)
+		queryServer := keeper.NewQueryServerImpl(s.App().IcqKeeper)
+
+		enrolled, err := queryServer.RemoteRouters(s.Ctx(), &types.QueryRemoteRoutersRequest{Id: routerId.String()})
+		Expect(err).To(BeNil())
+
+		// Act
+		_, err = s.RunTx(&types.MsgUnrollRemoteRouter{
+			Owner:          owner.Address,
+			RouterId:       routerId,
+			ReceiverDomain: remoteRouter.ReceiverDomain,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(enrolled.RemoteRouters).To(HaveLen(1))
+		Expect(*enrolled.RemoteRouters[0]).To(Equal(remoteRouter))
+
+		unrolled, err := queryServer.RemoteRouters(s.Ctx(), &types.QueryRemoteRoutersRequest{Id: routerId.String()})
+		Expect(err).To(BeNil())
+		Expect(unrolled.RemoteRouters).To(BeEmpty())
+	})
+})
+
+var remoteRouter = types.RemoteRouter{
+	ReceiverDomain:   1,
+	ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+	Gas:              math.NewInt(50000),
+}
+
+// Utils
+func createValidMailbox(s *i.KeeperTestSuite, creator string) (util.HexAddress, util.HexAddress, util.HexAddress) {
+	res, err := s.RunTx(&ismTypes.MsgCreateNoopIsm{
+		Creator: creator,
+	})
+	Expect(err).To(BeNil())
+
+	var ismResponse ismTypes.MsgCreateNoopIsmResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &ismResponse)
+	Expect(err).To(BeNil())
+	ismId := ismResponse.Id
+
+	res, err = s.RunTx(&pdTypes.MsgCreateIgp{
+		Owner: creator,
+		Denom: denom,
+	})
+	Expect(err).To(BeNil())
+
+	var igpResponse pdTypes.MsgCreateIgpResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &igpResponse)
+	Expect(err).To(BeNil())
+	igpId := igpResponse.Id
+
+	_, err = s.RunTx(&pdTypes.MsgSetDestinationGasConfig{
+		Owner: creator,
+		IgpId: igpId,
+		DestinationGasConfig: &pdTypes.DestinationGasConfig{
+			RemoteDomain: remoteRouter.ReceiverDomain,
+			GasOracle: &pdTypes.GasOracle{
+				TokenExchangeRate: math.NewInt(1e10),
+				GasPrice:          math.NewInt(1),
+			},
+			GasOverhead: math.NewInt(200000),
+		},
+	})
+	Expect(err).To(BeNil())
+
+	res, err = s.RunTx(&pdTypes.MsgCreateNoopHook{
+		Owner: creator,
+	})
+	Expect(err).To(BeNil())
+
+	var noopResponse pdTypes.MsgCreateNoopHookResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &noopResponse)
+	Expect(err).To(BeNil())
+
+	res, err = s.RunTx(&coreTypes.MsgCreateMailbox{
+		Owner:        creator,
+		DefaultIsm:   ismId,
+		DefaultHook:  &igpId,
+		RequiredHook: &noopResponse.Id,
+	})
+	Expect(err).To(BeNil())
+
+	var mailboxResponse coreTypes.MsgCreateMailboxResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &mailboxResponse)
+	Expect(err).To(BeNil())
+
+	return mailboxResponse.Id, igpId, ismId
+}
+
+func createRouter(s *i.KeeperTestSuite, owner string, mailboxId util.HexAddress, remoteRouter *types.RemoteRouter) util.HexAddress {
+	res, err := s.RunTx(&types.MsgCreateRouter{
+		Owner:         owner,
+		OriginMailbox: mailboxId,
+	})
+	Expect(err).To(BeNil())
+
+	var response types.MsgCreateRouterResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	if remoteRouter != nil {
+		_, err = s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner,
+			RouterId:     response.Id,
+			RemoteRouter: remoteRouter,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	return response.Id
+}
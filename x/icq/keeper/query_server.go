@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/icq/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the module QueryServer.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+func (qs queryServer) Routers(ctx context.Context, req *types.QueryRoutersRequest) (*types.QueryRoutersResponse, error) {
+	routers, page, err := util.GetPaginatedFromMap(ctx, qs.k.Routers, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRoutersResponse{
+		Routers:    routers,
+		Pagination: page,
+	}, nil
+}
+
+func (qs queryServer) Router(ctx context.Context, req *types.QueryRouterRequest) (*types.QueryRouterResponse, error) {
+	routerId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	router, err := qs.k.Routers.Get(ctx, routerId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find router with id: %s", routerId.String())
+	}
+
+	return &types.QueryRouterResponse{Router: router}, nil
+}
+
+func (qs queryServer) RemoteRouters(ctx context.Context, req *types.QueryRemoteRoutersRequest) (*types.QueryRemoteRoutersResponse, error) {
+	routerId, err := util.DecodeHexAddress(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	routers, page, err := util.GetPaginatedPrefixFromMap(ctx, qs.k.EnrolledRouters, req.Pagination, routerId.GetInternalId())
+	if err != nil {
+		return &types.QueryRemoteRoutersResponse{}, err
+	}
+
+	remoteRouters := make([]*types.RemoteRouter, len(routers))
+	for i := range routers {
+		remoteRouters[i] = &routers[i]
+	}
+
+	return &types.QueryRemoteRoutersResponse{
+		RemoteRouters: remoteRouters,
+		Pagination:    page,
+	}, nil
+}
+
+func (qs queryServer) PendingQueries(ctx context.Context, req *types.QueryPendingQueriesRequest) (*types.QueryPendingQueriesResponse, error) {
+	queries, page, err := util.GetPaginatedFromMap(ctx, qs.k.PendingQueries, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingQueriesResponse{
+		Queries:    queries,
+		Pagination: page,
+	}, nil
+}
+
+func (qs queryServer) PendingQuery(ctx context.Context, req *types.QueryPendingQueryRequest) (*types.QueryPendingQueryResponse, error) {
+	messageId, err := util.DecodeHexAddress(req.MessageId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query, err := qs.k.PendingQueries.Get(ctx, messageId.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending query with id: %s", messageId.String())
+	}
+
+	return &types.QueryPendingQueryResponse{Query: query}, nil
+}
+
+func (qs queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
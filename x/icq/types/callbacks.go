@@ -0,0 +1,16 @@
+package types
+
+import "context"
+
+// QueryCallbackHandler is implemented by modules which send interchain queries.
+// Handlers are registered on the icq keeper by name and the name is stored with
+// every pending query, so that the response can be delivered to the sender.
+type QueryCallbackHandler interface {
+	// OnQueryResponse is called with the raw response of the remote router.
+	// Returning an error rejects the response message, the query stays pending.
+	OnQueryResponse(ctx context.Context, query PendingQuery, response []byte) error
+	// OnQueryTimeout is called once the query did not receive a response within the
+	// configured number of blocks. The query is removed regardless of the returned error or a
+	// panic, in both cases the state changes of the callback are discarded.
+	OnQueryTimeout(ctx context.Context, query PendingQuery) error
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrRouterNotFound       = errors.Register(ModuleName, 1, "router not found")
+	ErrUnknownCallback      = errors.Register(ModuleName, 2, "no callback handler registered")
+	ErrPendingQueryNotFound = errors.Register(ModuleName, 3, "pending query not found")
+	ErrInvalidPayload       = errors.Register(ModuleName, 4, "invalid interchain query payload")
+)
@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:         DefaultParams(),
+		Routers:        []InterchainQueryRouter{},
+		RemoteRouters:  []GenesisRemoteRouterWrapper{},
+		PendingQueries: []PendingQuery{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	routers := make(map[uint64]struct{}, len(gs.Routers))
+	for _, router := range gs.Routers {
+		if _, ok := routers[router.Id.GetInternalId()]; ok {
+			return fmt.Errorf("duplicate router id %s", router.Id)
+		}
+		routers[router.Id.GetInternalId()] = struct{}{}
+	}
+
+	for _, r := range gs.RemoteRouters {
+		if _, ok := routers[r.RouterId]; !ok {
+			return fmt.Errorf("remote router for unknown router %d", r.RouterId)
+		}
+	}
+
+	for _, q := range gs.PendingQueries {
+		if _, ok := routers[q.RouterId.GetInternalId()]; !ok {
+			return fmt.Errorf("pending query %s for unknown router %s", q.MessageId, q.RouterId)
+		}
+	}
+
+	return nil
+}
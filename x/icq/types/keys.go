@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const ModuleName = "icq"
+
+// APP_TYPE_INTERCHAIN_QUERY_ROUTER is the module id of interchain query routers in the core app router.
+const APP_TYPE_INTERCHAIN_QUERY_ROUTER uint8 = 4
+
+var (
+	ParamsKey          = collections.NewPrefix(0)
+	RoutersKey         = collections.NewPrefix(1)
+	EnrolledRoutersKey = collections.NewPrefix(2)
+	PendingQueriesKey  = collections.NewPrefix(3)
+	QueryTimeoutsKey   = collections.NewPrefix(4)
+)
@@ -0,0 +1,28 @@
+package types
+
+import "fmt"
+
+// DefaultQueryTimeoutBlocks is the default number of blocks a query waits for its response.
+const DefaultQueryTimeoutBlocks uint64 = 10_000
+
+// DefaultMaxTimeoutsPerBlock is the default number of expired queries processed per block.
+const DefaultMaxTimeoutsPerBlock uint64 = 100
+
+// DefaultParams returns the default module parameters.
+func DefaultParams() Params {
+	return Params{
+		QueryTimeoutBlocks:  DefaultQueryTimeoutBlocks,
+		MaxTimeoutsPerBlock: DefaultMaxTimeoutsPerBlock,
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.QueryTimeoutBlocks == 0 {
+		return fmt.Errorf("query timeout blocks must be greater than zero")
+	}
+	if p.MaxTimeoutsPerBlock == 0 {
+		return fmt.Errorf("max timeouts per block must be greater than zero")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+)
+
+// Message types of the interchain query payload, encoded as the first byte of the body.
+const (
+	MESSAGE_TYPE_QUERY    uint8 = 0
+	MESSAGE_TYPE_RESPONSE uint8 = 1
+)
+
+const queryIdLength = 32
+
+// NewQueryPayload encodes an outgoing query: type (1 byte) | query.
+func NewQueryPayload(query []byte) []byte {
+	bytes := make([]byte, 0, 1+len(query))
+	bytes = append(bytes, MESSAGE_TYPE_QUERY)
+	return append(bytes, query...)
+}
+
+// ResponsePayload is the body of a response: type (1 byte) | query message id (32 bytes) | response.
+type ResponsePayload struct {
+	QueryId  util.HexAddress
+	Response []byte
+}
+
+func NewResponsePayload(queryId util.HexAddress, response []byte) ResponsePayload {
+	return ResponsePayload{
+		QueryId:  queryId,
+		Response: response,
+	}
+}
+
+func ParseResponsePayload(payload []byte) (ResponsePayload, error) {
+	if len(payload) < 1+queryIdLength {
+		return ResponsePayload{}, fmt.Errorf("payload is invalid")
+	}
+
+	if payload[0] != MESSAGE_TYPE_RESPONSE {
+		return ResponsePayload{}, fmt.Errorf("unsupported message type %d", payload[0])
+	}
+
+	return ResponsePayload{
+		QueryId:  util.HexAddress(payload[1 : 1+queryIdLength]),
+		Response: payload[1+queryIdLength:],
+	}, nil
+}
+
+func (p ResponsePayload) Bytes() []byte {
+	bytes := make([]byte, 0, 1+queryIdLength+len(p.Response))
+	bytes = append(bytes, MESSAGE_TYPE_RESPONSE)
+	bytes = append(bytes, p.QueryId.Bytes()...)
+	return append(bytes, p.Response...)
+}
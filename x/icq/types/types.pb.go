@@ -0,0 +1,1318 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hyperlane/icq/v1/types.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the governance-configurable parameters of the icq module.
+type Params struct {
+	// query_timeout_blocks is the number of blocks after which a query without
+	// response is removed and its callback is notified about the timeout.
+	QueryTimeoutBlocks uint64 `protobuf:"varint,1,opt,name=query_timeout_blocks,json=queryTimeoutBlocks,proto3" json:"query_timeout_blocks,omitempty"`
+	// max_timeouts_per_block is the maximum number of expired queries which are
+	// processed at the end of a block. Remaining queries are processed in the
+	// following blocks.
+	MaxTimeoutsPerBlock uint64 `protobuf:"varint,2,opt,name=max_timeouts_per_block,json=maxTimeoutsPerBlock,proto3" json:"max_timeouts_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3596aed46058b497, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetQueryTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.QueryTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeoutsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTimeoutsPerBlock
+	}
+	return 0
+}
+
+// InterchainQueryRouter sends queries to the remote interchain query routers
+// and receives their responses.
+type InterchainQueryRouter struct {
+	Id            github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress  `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
+	Owner         string                                                       `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	OriginMailbox github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress  `protobuf:"bytes,3,opt,name=origin_mailbox,json=originMailbox,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"origin_mailbox"`
+	IsmId         *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,4,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id,omitempty"`
+}
+
+func (m *InterchainQueryRouter) Reset()         { *m = InterchainQueryRouter{} }
+func (m *InterchainQueryRouter) String() string { return proto.CompactTextString(m) }
+func (*InterchainQueryRouter) ProtoMessage()    {}
+func (*InterchainQueryRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3596aed46058b497, []int{1}
+}
+func (m *InterchainQueryRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainQueryRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainQueryRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainQueryRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainQueryRouter.Merge(m, src)
+}
+func (m *InterchainQueryRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainQueryRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainQueryRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainQueryRouter proto.InternalMessageInfo
+
+func (m *InterchainQueryRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// RemoteRouter ...
+type RemoteRouter struct {
+	ReceiverDomain   uint32                `protobuf:"varint,1,opt,name=receiver_domain,json=receiverDomain,proto3" json:"receiver_domain,omitempty"`
+	ReceiverContract string                `protobuf:"bytes,2,opt,name=receiver_contract,json=receiverContract,proto3" json:"receiver_contract,omitempty"`
+	Gas              cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=gas,proto3,customtype=cosmossdk.io/math.Int" json:"gas"`
+}
+
+func (m *RemoteRouter) Reset()         { *m = RemoteRouter{} }
+func (m *RemoteRouter) String() string { return proto.CompactTextString(m) }
+func (*RemoteRouter) ProtoMessage()    {}
+func (*RemoteRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3596aed46058b497, []int{2}
+}
+func (m *RemoteRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteRouter.Merge(m, src)
+}
+func (m *RemoteRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteRouter proto.InternalMessageInfo
+
+func (m *RemoteRouter) GetReceiverDomain() uint32 {
+	if m != nil {
+		return m.ReceiverDomain
+	}
+	return 0
+}
+
+func (m *RemoteRouter) GetReceiverContract() string {
+	if m != nil {
+		return m.ReceiverContract
+	}
+	return ""
+}
+
+// PendingQuery is a query which was sent to a remote router and is waiting
+// for its response.
+type PendingQuery struct {
+	// message_id is the id of the dispatched query message.
+	MessageId         github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"message_id"`
+	RouterId          github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"router_id"`
+	DestinationDomain uint32                                                      `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// callback is the name of the registered handler which receives the
+	// response.
+	Callback      string `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,5,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	TimeoutHeight int64  `protobuf:"varint,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+}
+
+func (m *PendingQuery) Reset()         { *m = PendingQuery{} }
+func (m *PendingQuery) String() string { return proto.CompactTextString(m) }
+func (*PendingQuery) ProtoMessage()    {}
+func (*PendingQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3596aed46058b497, []int{3}
+}
+func (m *PendingQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingQuery.Merge(m, src)
+}
+func (m *PendingQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingQuery proto.InternalMessageInfo
+
+func (m *PendingQuery) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
+func (m *PendingQuery) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *PendingQuery) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hyperlane.icq.v1.Params")
+	proto.RegisterType((*InterchainQueryRouter)(nil), "hyperlane.icq.v1.InterchainQueryRouter")
+	proto.RegisterType((*RemoteRouter)(nil), "hyperlane.icq.v1.RemoteRouter")
+	proto.RegisterType((*PendingQuery)(nil), "hyperlane.icq.v1.PendingQuery")
+}
+
+func init() { proto.RegisterFile("hyperlane/icq/v1/types.proto", fileDescriptor_3596aed46058b497) }
+
+var fileDescriptor_3596aed46058b497 = []byte{
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3d, 0x6f, 0x13, 0x31,
+	0x18, 0xce, 0x25, 0x6d, 0xd4, 0x58, 0x6d, 0x69, 0x4d, 0x8a, 0xd2, 0x0a, 0xd2, 0x2a, 0x12, 0xa2,
+	0x02, 0xe5, 0x8e, 0xaa, 0x03, 0x12, 0x4c, 0xa4, 0x0c, 0xcd, 0x80, 0xd4, 0x5e, 0x99, 0xba, 0x1c,
+	0x3e, 0xdb, 0xba, 0xbc, 0x34, 0xb6, 0x53, 0xdb, 0x09, 0xe9, 0x5f, 0xe8, 0xc4, 0xc2, 0xff, 0x60,
+	0xe0, 0x47, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x5a, 0x24, 0x26, 0xfe, 0x03, 0x8a, 0xed, 0x44,
+	0x30, 0x22, 0xba, 0x9c, 0xee, 0x7d, 0x9e, 0xe7, 0xfd, 0xf0, 0xf3, 0xca, 0x46, 0xf7, 0x7b, 0x67,
+	0x03, 0xae, 0xfb, 0x44, 0xf2, 0x04, 0xe8, 0x69, 0x32, 0xda, 0x49, 0xec, 0xd9, 0x80, 0x9b, 0x78,
+	0xa0, 0x95, 0x55, 0x78, 0x65, 0xc6, 0xc6, 0x40, 0x4f, 0xe3, 0xd1, 0xce, 0xc6, 0x2a, 0x11, 0x20,
+	0x55, 0xe2, 0xbe, 0x5e, 0xb4, 0xb1, 0x4e, 0x95, 0x11, 0xca, 0x64, 0x2e, 0x4a, 0x7c, 0x10, 0xa8,
+	0x7a, 0xa1, 0x0a, 0xe5, 0xf1, 0xc9, 0x9f, 0x47, 0x5b, 0xe7, 0x11, 0xaa, 0x1e, 0x10, 0x4d, 0x84,
+	0xc1, 0x4f, 0x51, 0xfd, 0x74, 0xc8, 0xf5, 0x59, 0x66, 0x41, 0x70, 0x35, 0xb4, 0x59, 0xde, 0x57,
+	0xf4, 0xc4, 0x34, 0xa2, 0xad, 0x68, 0x7b, 0x2e, 0xc5, 0x8e, 0x7b, 0xe3, 0xa9, 0x8e, 0x63, 0xf0,
+	0x2e, 0xba, 0x27, 0xc8, 0x78, 0xaa, 0x37, 0xd9, 0x80, 0x6b, 0x9f, 0xd4, 0x28, 0xbb, 0x9c, 0xbb,
+	0x82, 0x8c, 0x43, 0x86, 0x39, 0xe0, 0xda, 0x65, 0x3d, 0x5f, 0x3f, 0xff, 0xf9, 0xe9, 0x71, 0xfd,
+	0xef, 0xa3, 0xfa, 0x09, 0x5a, 0x3f, 0xca, 0x68, 0xad, 0x2b, 0x2d, 0xd7, 0xb4, 0x47, 0x40, 0x1e,
+	0x4e, 0x1a, 0xa6, 0x6a, 0x68, 0xb9, 0xc6, 0x47, 0xa8, 0x0c, 0xcc, 0x4d, 0x52, 0xeb, 0xec, 0x5d,
+	0x5c, 0x6d, 0x96, 0xbe, 0x5d, 0x6d, 0xbe, 0x28, 0xc0, 0xf6, 0x86, 0x79, 0x4c, 0x95, 0x48, 0x72,
+	0x3a, 0x68, 0x83, 0x94, 0x6a, 0x44, 0x2c, 0x28, 0x69, 0x92, 0x59, 0xf9, 0xb6, 0xf7, 0x20, 0x19,
+	0x5a, 0xe8, 0xc7, 0xfb, 0x7c, 0xfc, 0x92, 0x31, 0xcd, 0x8d, 0x49, 0xcb, 0xc0, 0x70, 0x8c, 0xe6,
+	0xd5, 0x7b, 0xc9, 0xb5, 0x9b, 0xb6, 0xd6, 0x69, 0x7c, 0xf9, 0xdc, 0xae, 0x07, 0xcb, 0x82, 0xec,
+	0xc8, 0x6a, 0x90, 0x45, 0xea, 0x65, 0xf8, 0x1d, 0x5a, 0x56, 0x1a, 0x0a, 0x90, 0x99, 0x20, 0xd0,
+	0xcf, 0xd5, 0xb8, 0x51, 0xb9, 0xbd, 0x81, 0x96, 0x7c, 0xe9, 0xd7, 0xbe, 0x32, 0x3e, 0x46, 0x55,
+	0x30, 0x22, 0x03, 0xd6, 0x98, 0x9b, 0xf5, 0x88, 0xfe, 0xb7, 0xc7, 0x3c, 0x18, 0xd1, 0x65, 0xad,
+	0x8f, 0x11, 0x5a, 0x4c, 0xb9, 0x50, 0x96, 0x07, 0x77, 0x1f, 0xa1, 0x3b, 0x9a, 0x53, 0x0e, 0x23,
+	0xae, 0x33, 0xa6, 0x04, 0x01, 0xe9, 0xac, 0x5e, 0x4a, 0x97, 0xa7, 0xf0, 0x2b, 0x87, 0xe2, 0x27,
+	0x68, 0x75, 0x26, 0xa4, 0x4a, 0x5a, 0x4d, 0xa8, 0xf5, 0xee, 0xa5, 0x2b, 0x53, 0x62, 0x2f, 0xe0,
+	0x38, 0x41, 0x95, 0x82, 0x98, 0xe0, 0xd1, 0x83, 0xe0, 0xd1, 0x9a, 0x1f, 0xcd, 0xb0, 0x93, 0x18,
+	0x54, 0x22, 0x88, 0xed, 0xc5, 0x5d, 0x69, 0xd3, 0x89, 0xb2, 0xf5, 0xab, 0x8c, 0x16, 0x0f, 0xb8,
+	0x64, 0x20, 0x0b, 0xb7, 0x7b, 0x9c, 0x23, 0x24, 0xb8, 0x31, 0xa4, 0xe0, 0xd9, 0xed, 0x6e, 0xbf,
+	0x16, 0xca, 0x76, 0x19, 0x7e, 0x8b, 0x6a, 0xda, 0xb9, 0x30, 0x69, 0x51, 0xbe, 0xbd, 0x16, 0x0b,
+	0xbe, 0x6a, 0x97, 0xe1, 0x36, 0xc2, 0x8c, 0x1b, 0x0b, 0xd2, 0xa5, 0x4e, 0x0d, 0xae, 0x38, 0x83,
+	0x57, 0xff, 0x60, 0x82, 0xc7, 0x1b, 0x68, 0x81, 0x92, 0x7e, 0x3f, 0x27, 0xf4, 0xc4, 0xef, 0x3e,
+	0x9d, 0xc5, 0xf8, 0x21, 0x5a, 0xa6, 0x9a, 0x13, 0xcb, 0x59, 0xd6, 0xe3, 0x50, 0xf4, 0x6c, 0x63,
+	0x7e, 0x2b, 0xda, 0xae, 0xa4, 0x4b, 0x01, 0xdd, 0x77, 0xe0, 0x44, 0x36, 0xbd, 0xc3, 0x41, 0x56,
+	0xf5, 0xb2, 0x80, 0x7a, 0x59, 0xe7, 0xf0, 0xe2, 0xba, 0x19, 0x5d, 0x5e, 0x37, 0xa3, 0xef, 0xd7,
+	0xcd, 0xe8, 0xc3, 0x4d, 0xb3, 0x74, 0x79, 0xd3, 0x2c, 0x7d, 0xbd, 0x69, 0x96, 0x8e, 0x9f, 0xfd,
+	0xcb, 0xc9, 0xc7, 0xee, 0x0a, 0xbb, 0xa7, 0x2a, 0xaf, 0xba, 0x57, 0x65, 0xf7, 0xf7, 0x00, 0x78,
+	0x14, 0x9a, 0xaa, 0xcb, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTimeoutsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTimeoutsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueryTimeoutBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QueryTimeoutBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainQueryRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainQueryRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainQueryRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsmId != nil {
+		{
+			size := m.IsmId.Size()
+			i -= size
+			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.OriginMailbox.Size()
+		i -= size
+		if _, err := m.OriginMailbox.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Gas.Size()
+		i -= size
+		if _, err := m.Gas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReceiverContract) > 0 {
+		i -= len(m.ReceiverContract)
+		copy(dAtA[i:], m.ReceiverContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReceiverContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReceiverDomain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReceiverDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Callback) > 0 {
+		i -= len(m.Callback)
+		copy(dAtA[i:], m.Callback)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Callback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RouterId.Size()
+		i -= size
+		if _, err := m.RouterId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryTimeoutBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.QueryTimeoutBlocks))
+	}
+	if m.MaxTimeoutsPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTimeoutsPerBlock))
+	}
+	return n
+}
+
+func (m *InterchainQueryRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.OriginMailbox.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.IsmId != nil {
+		l = m.IsmId.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovTypes(uint64(m.ReceiverDomain))
+	}
+	l = len(m.ReceiverContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Gas.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PendingQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MessageId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RouterId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTypes(uint64(m.DestinationDomain))
+	}
+	l = len(m.Callback)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedHeight))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutHeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryTimeoutBlocks", wireType)
+			}
+			m.QueryTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeoutsPerBlock", wireType)
+			}
+			m.MaxTimeoutsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeoutsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainQueryRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainQueryRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainQueryRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.IsmId = &v
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			}
+			m.ReceiverDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouterId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
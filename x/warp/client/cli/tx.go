@@ -1,20 +1,71 @@
 package cli
 
 import (
+	"fmt"
+	"math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 )
 
 var (
 	gasLimit           string
 	customHookId       string
 	customHookMetadata string
+	decimals           uint32
 	ismId              string
 	maxFee             string
 	newOwner           string
 	renounceOwnership  bool
+	newGuardian        string
+	removeGuardian     bool
+	feeRecipient       string
+	hookId             string
+	hookMetadata       string
+	removeHook         bool
+	targetRouter       string
+	domains            []uint
+
+	metadataName        string
+	metadataSymbol      string
+	metadataDescription string
+	metadataUri         string
 )
 
+// tokenMetadataFromFlags returns the token metadata if any of the metadata flags is set.
+func tokenMetadataFromFlags() *types.TokenMetadata {
+	if metadataName == "" && metadataSymbol == "" && metadataDescription == "" && metadataUri == "" {
+		return nil
+	}
+	return &types.TokenMetadata{
+		Name:        metadataName,
+		Symbol:      metadataSymbol,
+		Description: metadataDescription,
+		Uri:         metadataUri,
+	}
+}
+
+func addTokenMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&metadataName, "name", "", "display name of the synthetic token")
+	cmd.Flags().StringVar(&metadataSymbol, "symbol", "", "symbol of the synthetic token")
+	cmd.Flags().StringVar(&metadataDescription, "description", "", "description of the synthetic token")
+	cmd.Flags().StringVar(&metadataUri, "uri", "", "URI to additional information about the synthetic token")
+}
+
+// domainsFromFlags converts the domains flag into a list of uint32 domains.
+func domainsFromFlags() ([]uint32, error) {
+	result := make([]uint32, 0, len(domains))
+	for _, domain := range domains {
+		if domain > math.MaxUint32 {
+			return nil, fmt.Errorf("invalid domain %d", domain)
+		}
+		result = append(result, uint32(domain))
+	}
+	return result, nil
+}
+
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        "hyperlane-transfer",
@@ -27,9 +78,16 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		CmdCreateCollateralToken(),
 		CmdCreateSyntheticToken(),
+		CmdEnrollCollateralRouter(),
 		CmdEnrollRemoteRouter(),
+		CmdPauseToken(),
 		CmdRemoteTransfer(),
+		CmdSetBridgeFee(),
+		CmdSetRateLimit(),
 		CmdSetToken(),
+		CmdSweepCollateralSurplus(),
+		CmdUnpauseToken(),
+		CmdUnrollCollateralRouter(),
 		CmdUnrollRemoteRouter(),
 	)
 
@@ -33,6 +33,7 @@ func CmdCreateCollateralToken() *cobra.Command {
 				Owner:         clientCtx.GetFromAddress().String(),
 				OriginMailbox: mailboxId,
 				OriginDenom:   args[1],
+				Decimals:      decimals,
 			}
 
 			_, err = sdk.AccAddressFromBech32(msg.Owner)
@@ -46,6 +47,7 @@ func CmdCreateCollateralToken() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 
+	cmd.Flags().Uint32Var(&decimals, "decimals", 0, "decimals of the origin denom")
 	cmd.Flags().StringVar(&ismId, "ism-id", "", "ISM ID; if not specified, DefaultISM is used")
 
 	return cmd
@@ -32,6 +32,8 @@ func CmdCreateSyntheticToken() *cobra.Command {
 			msg := types.MsgCreateSyntheticToken{
 				Owner:         clientCtx.GetFromAddress().String(),
 				OriginMailbox: mailboxId,
+				Decimals:      decimals,
+				Metadata:      tokenMetadataFromFlags(),
 			}
 
 			_, err = sdk.AccAddressFromBech32(msg.Owner)
@@ -45,6 +47,8 @@ func CmdCreateSyntheticToken() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 
+	cmd.Flags().Uint32Var(&decimals, "decimals", 0, "decimals of the synthetic denom")
+	addTokenMetadataFlags(cmd)
 	cmd.Flags().StringVar(&ismId, "ism-id", "", "ISM ID; if not specified, DefaultISM is used")
 
 	return cmd
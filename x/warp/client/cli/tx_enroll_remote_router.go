@@ -50,6 +50,7 @@ func CmdEnrollRemoteRouter() *cobra.Command {
 					ReceiverDomain:   uint32(receiverDomain),
 					ReceiverContract: args[2],
 					Gas:              gas,
+					Decimals:         decimals,
 				},
 			}
 
@@ -64,5 +65,7 @@ func CmdEnrollRemoteRouter() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 
+	cmd.Flags().Uint32Var(&decimals, "decimals", 0, "decimals of the token on the remote chain")
+
 	return cmd
 }
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+)
+
+func CmdPauseToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-token [token-id]",
+		Short: "Pause the transfers of a Warp token",
+		Long:  "Pause all transfers of a Warp token or only the transfers from and to the given domains. Can be executed by the owner or the guardian of the token.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			parsedDomains, err := domainsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPauseToken{
+				Sender:  clientCtx.GetFromAddress().String(),
+				TokenId: tokenId,
+				Domains: parsedDomains,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Sender)
+			if err != nil {
+				panic(fmt.Errorf("invalid sender address (%s)", msg.Sender))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().UintSliceVar(&domains, "domains", []uint{}, "only pause the transfers from and to these domains")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
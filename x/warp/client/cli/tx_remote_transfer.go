@@ -67,6 +67,15 @@ func CmdRemoteTransfer() *cobra.Command {
 				parsedHookId = &parsed
 			}
 
+			var parsedTargetRouter *util.HexAddress = nil
+			if targetRouter != "" {
+				parsed, err := util.DecodeHexAddress(targetRouter)
+				if err != nil {
+					return err
+				}
+				parsedTargetRouter = &parsed
+			}
+
 			msg := types.MsgRemoteTransfer{
 				TokenId:            tokenId,
 				DestinationDomain:  uint32(destinationDomain),
@@ -77,6 +86,7 @@ func CmdRemoteTransfer() *cobra.Command {
 				GasLimit:           gasLimitInt,
 				MaxFee:             maxFeeCoin,
 				CustomHookMetadata: customHookMetadata,
+				TargetRouter:       parsedTargetRouter,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -92,5 +102,7 @@ func CmdRemoteTransfer() *cobra.Command {
 
 	cmd.Flags().StringVar(&maxFee, "max-hyperlane-fee", "0", "maximum Hyperlane InterchainGasPayment")
 
+	cmd.Flags().StringVar(&targetRouter, "target-router", "", "enrolled collateral router which receives the transfer")
+
 	return cmd
 }
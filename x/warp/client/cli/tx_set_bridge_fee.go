@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+)
+
+func CmdSetBridgeFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bridge-fee [token-id] [policy]",
+		Short: "Set the bridge fee policy of a Warp token",
+		Long: `Set the bridge fee policy of a Warp token. The fee is deducted from the transferred amount.
+The policy is one of:
+  none                          remove the fee
+  flat:<amount>                 fixed amount per transfer
+  bps:<bps>                     basis points of the transferred amount
+  tiered:<min>=<bps>,<min>=<bps> basis points of the highest tier whose min amount does not exceed the transferred amount
+
+If no domains are given, the default policy of the token is set.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseBridgeFeePolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			parsedDomains, err := domainsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetBridgeFee{
+				Owner:        clientCtx.GetFromAddress().String(),
+				TokenId:      tokenId,
+				Domains:      parsedDomains,
+				Policy:       policy,
+				FeeRecipient: feeRecipient,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid owner address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().UintSliceVar(&domains, "domains", []uint{}, "only set the policy for these destination domains")
+	cmd.Flags().StringVar(&feeRecipient, "fee-recipient", "", "set the recipient of the bridge fees")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseBridgeFeePolicy(policy string) (types.BridgeFeePolicy, error) {
+	if policy == "none" {
+		return types.BridgeFeePolicy{FlatAmount: math.ZeroInt()}, nil
+	}
+
+	feeType, value, found := strings.Cut(policy, ":")
+	if !found {
+		return types.BridgeFeePolicy{}, fmt.Errorf("invalid bridge fee policy %s", policy)
+	}
+
+	switch feeType {
+	case "flat":
+		amount, ok := math.NewIntFromString(value)
+		if !ok {
+			return types.BridgeFeePolicy{}, errors.New("failed to convert flat amount into math.Int")
+		}
+		return types.BridgeFeePolicy{FeeType: types.BRIDGE_FEE_TYPE_FLAT, FlatAmount: amount}, nil
+	case "bps":
+		bps, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return types.BridgeFeePolicy{}, err
+		}
+		return types.BridgeFeePolicy{FeeType: types.BRIDGE_FEE_TYPE_BPS, FlatAmount: math.ZeroInt(), Bps: uint32(bps)}, nil
+	case "tiered":
+		tiers := make([]types.BridgeFeeTier, 0)
+		for _, tier := range strings.Split(value, ",") {
+			minAmount, bps, found := strings.Cut(tier, "=")
+			if !found {
+				return types.BridgeFeePolicy{}, fmt.Errorf("invalid bridge fee tier %s", tier)
+			}
+			parsedMin, ok := math.NewIntFromString(minAmount)
+			if !ok {
+				return types.BridgeFeePolicy{}, errors.New("failed to convert tier min amount into math.Int")
+			}
+			parsedBps, err := strconv.ParseUint(bps, 10, 32)
+			if err != nil {
+				return types.BridgeFeePolicy{}, err
+			}
+			tiers = append(tiers, types.BridgeFeeTier{MinAmount: parsedMin, Bps: uint32(parsedBps)})
+		}
+		return types.BridgeFeePolicy{FeeType: types.BRIDGE_FEE_TYPE_TIERED, FlatAmount: math.ZeroInt(), Tiers: tiers}, nil
+	default:
+		return types.BridgeFeePolicy{}, fmt.Errorf("invalid bridge fee type %s", feeType)
+	}
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+)
+
+func CmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [token-id] [domain] [inbound-max-capacity] [inbound-window-seconds] [outbound-max-capacity] [outbound-window-seconds]",
+		Short: "Set the inbound and outbound rate limit of a token for a remote domain",
+		Long:  "Set the inbound and outbound rate limit of a token for a remote domain. A max capacity of zero disables the rate limit in that direction.",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			domain, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			inbound, err := parseRateLimitConfig(args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			outbound, err := parseRateLimitConfig(args[4], args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetRateLimit{
+				Owner:    clientCtx.GetFromAddress().String(),
+				TokenId:  tokenId,
+				Domain:   uint32(domain),
+				Inbound:  inbound,
+				Outbound: outbound,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid owner address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseRateLimitConfig(maxCapacity, windowSeconds string) (types.RateLimitConfig, error) {
+	capacity, ok := math.NewIntFromString(maxCapacity)
+	if !ok {
+		return types.RateLimitConfig{}, errors.New("failed to convert `max-capacity` into math.Int")
+	}
+
+	window, err := strconv.ParseUint(windowSeconds, 10, 64)
+	if err != nil {
+		return types.RateLimitConfig{}, err
+	}
+
+	return types.RateLimitConfig{MaxCapacity: capacity, WindowSeconds: window}, nil
+}
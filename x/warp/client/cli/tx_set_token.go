@@ -60,12 +60,27 @@ func CmdSetToken() *cobra.Command {
 				ism = &parsed
 			}
 
+			var hook *util.HexAddress = nil
+			if hookId != "" {
+				parsed, err := util.DecodeHexAddress(hookId)
+				if err != nil {
+					return err
+				}
+				hook = &parsed
+			}
+
 			msg := types.MsgSetToken{
 				Owner:             clientCtx.GetFromAddress().String(),
 				TokenId:           tokenId,
 				NewOwner:          newOwner,
 				IsmId:             ism,
 				RenounceOwnership: renounceOwnership,
+				Metadata:          tokenMetadataFromFlags(),
+				NewGuardian:       newGuardian,
+				RemoveGuardian:    removeGuardian,
+				HookId:            hook,
+				HookMetadata:      hookMetadata,
+				RemoveHook:        removeHook,
 			}
 
 			_, err = sdk.AccAddressFromBech32(msg.Owner)
@@ -80,6 +95,12 @@ func CmdSetToken() *cobra.Command {
 	cmd.Flags().StringVar(&newOwner, "new-owner", "", "set updated owner")
 	cmd.Flags().StringVar(&ismId, "ism-id", "", "set updated ism")
 	cmd.Flags().BoolVar(&renounceOwnership, "renounce-ownership", false, "renounce ownership")
+	cmd.Flags().StringVar(&newGuardian, "new-guardian", "", "set guardian which is allowed to pause the token")
+	cmd.Flags().BoolVar(&removeGuardian, "remove-guardian", false, "remove guardian")
+	cmd.Flags().StringVar(&hookId, "hook-id", "", "set default post dispatch hook of remote transfers")
+	cmd.Flags().StringVar(&hookMetadata, "hook-metadata", "", "set default hex encoded hook metadata of remote transfers")
+	cmd.Flags().BoolVar(&removeHook, "remove-hook", false, "remove default hook and hook metadata")
+	addTokenMetadataFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
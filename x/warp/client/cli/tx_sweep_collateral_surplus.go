@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+)
+
+func CmdSweepCollateralSurplus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-collateral-surplus [denom] [recipient]",
+		Short: "Send the module balance above the tracked collateral of a denom to a recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSweepCollateralSurplus{
+				Signer:    clientCtx.GetFromAddress().String(),
+				Denom:     args[0],
+				Recipient: args[1],
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Signer)
+			if err != nil {
+				panic(fmt.Errorf("invalid signer address (%s)", msg.Signer))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+)
+
+func CmdUnpauseToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-token [token-id]",
+		Short: "Unpause the transfers of a Warp token",
+		Long:  "Unpause the given domains of a Warp token. If no domains are given, the token and all its domains are unpaused. Can only be executed by the owner of the token.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenId, err := util.DecodeHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			parsedDomains, err := domainsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnpauseToken{
+				Owner:   clientCtx.GetFromAddress().String(),
+				TokenId: tokenId,
+				Domains: parsedDomains,
+			}
+
+			_, err = sdk.AccAddressFromBech32(msg.Owner)
+			if err != nil {
+				panic(fmt.Errorf("invalid owner address (%s)", msg.Owner))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().UintSliceVar(&domains, "domains", []uint{}, "only unpause the transfers from and to these domains")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
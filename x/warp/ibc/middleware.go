@@ -0,0 +1,124 @@
+package ibc
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer module.
+// Incoming transfers with a warp memo are received by an intermediate account and sent on with a
+// warp transfer. After the transfer module processed an acknowledgement or a timeout, the result
+// is reported to the warp keeper, so that forwarded tokens can be released to the fallback
+// recipient of the forward. All other callbacks are passed through to the wrapped module.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer module.
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. If the memo of the transfer contains a warp
+// instruction, the receiver is replaced by an intermediate account which dispatches the warp
+// transfer. If the dispatch fails, an error acknowledgement is returned, so that the tokens are
+// refunded on the sending chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	transfer, err := types.ParseIbcWarpMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if transfer == nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	intermediate := types.IbcIntermediateAddress(packet.DestinationChannel, data.Sender)
+	data.Receiver = intermediate.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid ICS-20 amount %s", data.Amount))
+	}
+	received := sdk.NewCoin(receivedDenom(packet, data.Denom), amount)
+
+	if _, err := im.keeper.RemoteTransferFromIbc(ctx, intermediate, received, *transfer); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// receivedDenom returns the denom of the tokens which the transfer module credited on this chain.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens are returning to this chain, remove the prefix added by the sending chain
+		unprefixedDenom := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("cannot unmarshal ICS-20 transfer packet acknowledgement: %w", err)
+	}
+
+	return im.keeper.OnIbcForwardAcknowledgement(ctx, packet.SourceChannel, packet.Sequence, ack.Success())
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnIbcForwardTimeout(ctx, packet.SourceChannel, packet.Sequence)
+}
@@ -17,6 +17,9 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		if err := k.HypTokens.Set(ctx, token.Id.GetInternalId(), token); err != nil {
 			return err
 		}
+		if err := k.setDenomToken(ctx, token); err != nil {
+			return err
+		}
 	}
 
 	for _, r := range data.RemoteRouters {
@@ -25,6 +28,35 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 	}
 
+	for _, f := range data.PendingIbcForwards {
+		if err := k.IbcForwards.Set(ctx, collections.Join(f.SourceChannel, f.Sequence), f); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range data.RateLimits {
+		if err := k.RateLimits.Set(ctx, collections.Join(r.TokenId.GetInternalId(), r.Domain), r); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range data.CollateralRouters {
+		key := collections.Join3(r.TokenId, r.RemoteRouter.ReceiverDomain, r.RemoteRouter.ReceiverContract)
+		if err := k.CollateralRouters.Set(ctx, key, r.RemoteRouter); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range data.BridgeFees {
+		if err := k.BridgeFees.Set(ctx, collections.Join(f.TokenId.GetInternalId(), f.Domain), f); err != nil {
+			return err
+		}
+	}
+
+	if err := k.LocalSettlements.Set(ctx, data.LocalSettlements); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -56,9 +88,58 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	forwardIterator, err := k.IbcForwards.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	forwards, err := forwardIterator.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitIterator, err := k.RateLimits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	rateLimits, err := rateLimitIterator.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeFeeIterator, err := k.BridgeFees.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	bridgeFees, err := bridgeFeeIterator.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	var collateralRouters []types.GenesisRemoteRouterWrapper
+	err = k.CollateralRouters.Walk(ctx, nil, func(key collections.Triple[uint64, uint32, string], value types.RemoteRouter) (stop bool, err error) {
+		collateralRouters = append(collateralRouters, types.GenesisRemoteRouterWrapper{
+			TokenId:      key.K1(),
+			RemoteRouter: value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	localSettlements, err := k.LocalSettlements.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Tokens:        tokens,
-		Params:        params,
-		RemoteRouters: genesisRouters,
+		Tokens:             tokens,
+		Params:             params,
+		RemoteRouters:      genesisRouters,
+		PendingIbcForwards: forwards,
+		RateLimits:         rateLimits,
+		BridgeFees:         bridgeFees,
+		CollateralRouters:  collateralRouters,
+		LocalSettlements:   localSettlements,
 	}, nil
 }
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all warp module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "collateral-balance", CollateralBalanceInvariant(k))
+}
+
+// CollateralBalanceInvariant checks that the module account holds at least the tracked collateral
+// of every denom. A surplus, e.g. from tokens sent directly to the module account, does not break
+// the invariant.
+func CollateralBalanceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		discrepancies, err := k.CollateralDiscrepancies(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "collateral-balance", err.Error()), true
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+		for _, discrepancy := range discrepancies {
+			if discrepancy.Surplus.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\tdenom %s: tracked collateral %s, module balance %s\n",
+					discrepancy.Denom, discrepancy.TrackedCollateral, discrepancy.ModuleBalance)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "collateral-balance",
+			fmt.Sprintf("module account holds less than the tracked collateral:\n%s", msg)), broken
+	}
+}
@@ -1,20 +1,22 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"slices"
-	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type Keeper struct {
@@ -33,9 +35,23 @@ type Keeper struct {
 	HypTokens collections.Map[uint64, types.HypToken]
 	// <tokenId> <domain> -> Router
 	EnrolledRouters collections.Map[collections.Pair[uint64, uint32], types.RemoteRouter]
+	// <sourceChannel> <sequence> -> PendingIbcForward
+	IbcForwards collections.Map[collections.Pair[string, uint64], types.PendingIbcForward]
+	// <tokenId> <domain> -> RouteRateLimit
+	RateLimits collections.Map[collections.Pair[uint64, uint32], types.RouteRateLimit]
+	// <tokenId> <domain> -> RouteBridgeFee
+	BridgeFees collections.Map[collections.Pair[uint64, uint32], types.RouteBridgeFee]
+	// <tokenId> <domain> <receiverContract> -> RemoteRouter
+	CollateralRouters collections.Map[collections.Triple[uint64, uint32, string], types.RemoteRouter]
+	// nonce of the messages of local collateral settlements
+	LocalSettlements collections.Sequence
+	// <originDenom> <tokenId> -> tokenId
+	DenomTokens collections.Map[collections.Pair[string, uint64], uint64]
 
 	bankKeeper types.BankKeeper
 	coreKeeper types.CoreKeeper
+	// ibc is shared between all copies of the keeper, as IBC is wired after the app is built.
+	ibc *ibcKeepers
 }
 
 // NewKeeper creates a new Keeper instance
@@ -54,15 +70,22 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		cdc:             cdc,
-		addressCodec:    addressCodec,
-		authority:       authority,
-		enabledTokens:   enabledTokens,
-		HypTokens:       collections.NewMap(sb, types.HypTokenKey, "hyptokens", collections.Uint64Key, codec.CollValue[types.HypToken](cdc)),
-		EnrolledRouters: collections.NewMap(sb, types.EnrolledRoutersKey, "enrolled_routers", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.RemoteRouter](cdc)),
-		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		bankKeeper:      bankKeeper,
-		coreKeeper:      coreKeeper,
+		cdc:               cdc,
+		addressCodec:      addressCodec,
+		authority:         authority,
+		enabledTokens:     enabledTokens,
+		HypTokens:         collections.NewMap(sb, types.HypTokenKey, "hyptokens", collections.Uint64Key, codec.CollValue[types.HypToken](cdc)),
+		EnrolledRouters:   collections.NewMap(sb, types.EnrolledRoutersKey, "enrolled_routers", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.RemoteRouter](cdc)),
+		IbcForwards:       collections.NewMap(sb, types.IbcForwardsKey, "ibc_forwards", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.PendingIbcForward](cdc)),
+		RateLimits:        collections.NewMap(sb, types.RateLimitsKey, "rate_limits", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.RouteRateLimit](cdc)),
+		BridgeFees:        collections.NewMap(sb, types.BridgeFeesKey, "bridge_fees", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.RouteBridgeFee](cdc)),
+		CollateralRouters: collections.NewMap(sb, types.CollateralRoutersKey, "collateral_routers", collections.TripleKeyCodec(collections.Uint64Key, collections.Uint32Key, collections.StringKey), codec.CollValue[types.RemoteRouter](cdc)),
+		LocalSettlements:  collections.NewSequence(sb, types.LocalSettlementsKey, "local_settlements"),
+		DenomTokens:       collections.NewMap(sb, types.DenomTokensKey, "denom_tokens", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Uint64Value),
+		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		bankKeeper:        bankKeeper,
+		coreKeeper:        coreKeeper,
+		ibc:               &ibcKeepers{},
 	}
 
 	schema, err := sb.Build()
@@ -78,6 +101,11 @@ func NewKeeper(
 	return k
 }
 
+// setDenomToken indexes the token by its origin denom. The origin denom of a token never changes.
+func (k *Keeper) setDenomToken(ctx context.Context, token types.HypToken) error {
+	return k.DenomTokens.Set(ctx, collections.Join(token.OriginDenom, token.Id.GetInternalId()), token.Id.GetInternalId())
+}
+
 func (k *Keeper) Exists(ctx context.Context, tokenId util.HexAddress) (bool, error) {
 	return k.HypTokens.Has(ctx, tokenId.GetInternalId())
 }
@@ -110,17 +138,43 @@ func (k *Keeper) Handle(ctx context.Context, mailboxId util.HexAddress, message
 		return err
 	}
 
+	forward, err := payload.IbcForward()
+	if err != nil {
+		return err
+	}
+	if forward != nil && k.ibc.transferKeeper == nil {
+		return fmt.Errorf("ibc forwarding is not enabled")
+	}
+
 	if token.OriginMailbox != mailboxId {
 		return fmt.Errorf("invalid origin mailbox address")
 	}
 
-	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(message.Recipient.GetInternalId(), message.Origin))
+	// paused messages are rejected, so that they stay undelivered and can be retried after unpausing
+	if err := token.AssertNotPaused(message.Origin); err != nil {
+		return err
+	}
+
+	remoteRouter, err := k.enrolledRouter(ctx, message.Recipient, message.Origin, message.Sender)
 	if err != nil {
-		return fmt.Errorf("no enrolled router found for origin %d", message.Origin)
+		return err
 	}
 
-	if message.Sender.String() != strings.ToLower(remoteRouter.ReceiverContract) {
-		return fmt.Errorf("invalid receiver contract")
+	amount, dust, err := types.ToLocalAmount(math.NewIntFromBigInt(payload.Amount()), token.Decimals, remoteRouter.Decimals)
+	if err != nil {
+		return err
+	}
+	if dust.IsPositive() {
+		// the dust can't be represented in local units and stays locked or burned on the origin
+		_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.RemoteReceiveDust{
+			TokenId:      token.Id.String(),
+			OriginDomain: message.Origin,
+			Dust:         dust.String(),
+		})
+	}
+
+	if err := k.consumeRateLimit(ctx, token.Id, message.Origin, true, amount); err != nil {
+		return err
 	}
 
 	// Check token type
@@ -129,15 +183,59 @@ func (k *Keeper) Handle(ctx context.Context, mailboxId util.HexAddress, message
 		if !slices.Contains(k.enabledTokens, int32(types.HYP_TOKEN_TYPE_COLLATERAL)) {
 			return fmt.Errorf("module disabled collateral tokens")
 		}
-		err = k.RemoteReceiveCollateral(ctx, token, payload)
+		err = k.RemoteReceiveCollateral(ctx, token, payload, amount)
 	} else if token.TokenType == types.HYP_TOKEN_TYPE_SYNTHETIC {
 		if !slices.Contains(k.enabledTokens, int32(types.HYP_TOKEN_TYPE_SYNTHETIC)) {
 			return fmt.Errorf("module disabled synthetic tokens")
 		}
-		err = k.RemoteReceiveSynthetic(ctx, token, payload)
+		err = k.RemoteReceiveSynthetic(ctx, token, payload, amount)
 	} else {
 		panic("inconsistent store")
 	}
+	if err != nil {
+		return err
+	}
+
+	if forward != nil {
+		return k.ForwardIbcTransfer(ctx, token, payload, amount, *forward)
+	}
+
+	return nil
+}
+
+// receiverAccount returns the account which receives the tokens of the payload. 20-byte addresses
+// are zero-padded to 32 bytes, all other recipients are used with their full 32 bytes, e.g. for
+// interchain accounts or contracts. The account is validated with the address codec of the chain.
+func (k *Keeper) receiverAccount(payload types.WarpPayload) (sdk.AccAddress, error) {
+	account, err := k.cosmosAccount(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.HasIbcForward() {
+		// the tokens are forwarded from the forwarder account after they are received,
+		// the recipient of the payload only receives them if the forwarding fails
+		return types.IbcForwarderAddress, nil
+	}
+
+	return account, nil
+}
+
+// cosmosAccount returns the recipient of the payload after validating it with the address codec.
+func (k *Keeper) cosmosAccount(payload types.WarpPayload) (sdk.AccAddress, error) {
+	account := payload.GetCosmosAccount()
+	if bytes.Equal(account, make([]byte, len(account))) {
+		return nil, fmt.Errorf("invalid recipient: zero address")
+	}
+
+	// a round trip through the address codec applies the address verifier of the chain
+	recipient, err := k.addressCodec.BytesToString(account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+	if _, err = k.addressCodec.StringToBytes(recipient); err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
 
-	return err
+	return account, nil
 }
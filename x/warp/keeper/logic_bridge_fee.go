@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bridgeFeePolicy returns the fee policy of the token for the destination domain.
+// A domain specific policy takes precedence over the default policy of the token.
+func (k *Keeper) bridgeFeePolicy(ctx context.Context, token types.HypToken, destinationDomain uint32) (types.BridgeFeePolicy, error) {
+	routeFee, err := k.BridgeFees.Get(ctx, collections.Join(token.Id.GetInternalId(), destinationDomain))
+	if errors.Is(err, collections.ErrNotFound) {
+		return token.BridgeFee, nil
+	} else if err != nil {
+		return types.BridgeFeePolicy{}, err
+	}
+	return routeFee.Policy, nil
+}
+
+// bridgeFee splits the amount of a transfer to the remote router into the fee and the bridged amount.
+// If a fee is charged, the dust which can't be represented in the decimals of the remote router
+// is added to the fee, so that the bridged amount can be scaled exactly.
+func (k *Keeper) bridgeFee(ctx context.Context, token types.HypToken, remoteRouter types.RemoteRouter, amount math.Int) (fee math.Int, bridged math.Int, err error) {
+	policy, err := k.bridgeFeePolicy(ctx, token, remoteRouter.ReceiverDomain)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if !policy.Enabled() {
+		return math.ZeroInt(), amount, nil
+	}
+
+	fee = policy.Fee(amount)
+	bridged = amount.Sub(fee)
+
+	if remoteRouter.Decimals < token.Decimals {
+		dust := bridged.Mod(math.NewIntWithDecimal(1, int(token.Decimals-remoteRouter.Decimals)))
+		fee = fee.Add(dust)
+		bridged = bridged.Sub(dust)
+	}
+
+	if !bridged.IsPositive() {
+		return math.Int{}, math.Int{}, fmt.Errorf("amount %s does not cover the bridge fee %s", amount, fee)
+	}
+
+	return fee, bridged, nil
+}
+
+// chargeBridgeFee sends the fee from the sender to the fee recipient of the token.
+func (k *Keeper) chargeBridgeFee(ctx context.Context, token types.HypToken, sender sdk.AccAddress, fee math.Int) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(token.FeeRecipient)
+	if err != nil {
+		return fmt.Errorf("invalid fee recipient %s", token.FeeRecipient)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, fee)))
+}
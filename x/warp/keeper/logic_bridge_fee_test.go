@@ -0,0 +1,319 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_bridge_fee.go
+
+* MsgSetBridgeFee (invalid) non-owner address
+* MsgSetBridgeFee (invalid) missing fee recipient
+* MsgSetBridgeFee (invalid) non-enrolled remote router
+* MsgSetBridgeFee (invalid) invalid policy
+* MsgRemoteTransfer (valid) collateral with default bps fee
+* MsgRemoteTransfer (valid) synthetic with flat fee
+* MsgRemoteTransfer (valid) domain policy overrides default policy
+* MsgRemoteTransfer (valid) disabled domain policy overrides default policy
+* MsgRemoteTransfer (valid) dust is added to the fee
+* MsgRemoteTransfer (invalid) amount does not cover the fee
+* QueryQuoteRemoteTransfer (valid) reports bridge fee
+* MsgUnrollRemoteRouter (valid) removes domain policy
+
+*/
+
+var _ = Describe("logic_bridge_fee.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var feeRecipient i.TestValidatorAddress
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	maxFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	bpsPolicy := types.BridgeFeePolicy{FeeType: types.BRIDGE_FEE_TYPE_BPS, FlatAmount: math.ZeroInt(), Bps: 100}
+	flatPolicy := types.BridgeFeePolicy{FeeType: types.BRIDGE_FEE_TYPE_FLAT, FlatAmount: math.NewInt(25)}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		feeRecipient = i.GenerateTestValidatorAddress("FeeRecipient")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 10_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	setBridgeFee := func(tokenId util.HexAddress, policy types.BridgeFeePolicy, domains ...uint32) error {
+		_, err := s.RunTx(&types.MsgSetBridgeFee{
+			Owner:        owner.Address,
+			TokenId:      tokenId,
+			Domains:      domains,
+			Policy:       policy,
+			FeeRecipient: feeRecipient.Address,
+		})
+		return err
+	}
+
+	remoteTransfer := func(tokenId util.HexAddress, domain uint32, amount int64) error {
+		_, err := s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: domain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+		return err
+	}
+
+	collateralBalance := func(tokenId util.HexAddress) math.Int {
+		token, err := s.App().WarpKeeper.HypTokens.Get(s.Ctx(), tokenId.GetInternalId())
+		Expect(err).To(BeNil())
+		return token.CollateralBalance
+	}
+
+	feeBalance := func(tokenDenom string) math.Int {
+		return s.App().BankKeeper.GetBalance(s.Ctx(), feeRecipient.AccAddress, tokenDenom).Amount
+	}
+
+	It("MsgSetBridgeFee (invalid) non-owner address", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetBridgeFee{
+			Owner:        sender.Address,
+			TokenId:      tokenId,
+			Policy:       bpsPolicy,
+			FeeRecipient: sender.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(sender.Address + " does not own token with id " + tokenId.String()))
+	})
+
+	It("MsgSetBridgeFee (invalid) missing fee recipient", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetBridgeFee{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+			Policy:  bpsPolicy,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("fee recipient required"))
+	})
+
+	It("MsgSetBridgeFee (invalid) non-enrolled remote router", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		err := setBridgeFee(tokenId, bpsPolicy, 2)
+
+		// Assert
+		Expect(err.Error()).To(Equal("failed to find remote router for domain 2"))
+	})
+
+	It("MsgSetBridgeFee (invalid) invalid policy", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		err := setBridgeFee(tokenId, types.BridgeFeePolicy{FeeType: types.BRIDGE_FEE_TYPE_BPS, FlatAmount: math.ZeroInt(), Bps: 10_001})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid bridge fee: bridge fee bps must be between 1 and 10000"))
+	})
+
+	It("MsgRemoteTransfer (valid) collateral with default bps fee", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setBridgeFee(tokenId, bpsPolicy)).To(Succeed())
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount
+
+		// Act
+		err := remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 10_000)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(feeBalance(denom)).To(Equal(math.NewInt(100)))
+		Expect(collateralBalance(tokenId)).To(Equal(math.NewInt(9_900)))
+		// the sender pays the transferred amount and the igp fee
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount).To(Equal(senderBalance.SubRaw(10_000).Sub(maxFee.Amount)))
+
+		token, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).Token(s.Ctx(), &types.QueryTokenRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		Expect(token.Token.BridgeFee.FeeType).To(Equal(types.BRIDGE_FEE_TYPE_BPS))
+		Expect(token.Token.FeeRecipient).To(Equal(feeRecipient.Address))
+	})
+
+	It("MsgRemoteTransfer (valid) synthetic with flat fee", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		Expect(setBridgeFee(tokenId, flatPolicy)).To(Succeed())
+		syntheticDenom := "hyperlane/" + tokenId.String()
+		err := s.MintCoins(sender.Address, sdk.NewCoins(sdk.NewInt64Coin(syntheticDenom, 1_000)))
+		Expect(err).To(BeNil())
+
+		// Act
+		err = remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 1_000)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(feeBalance(syntheticDenom)).To(Equal(math.NewInt(25)))
+		Expect(s.App().BankKeeper.GetSupply(s.Ctx(), syntheticDenom).Amount).To(Equal(math.NewInt(25)))
+	})
+
+	It("MsgRemoteTransfer (valid) domain policy overrides default policy", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setBridgeFee(tokenId, bpsPolicy)).To(Succeed())
+		Expect(setBridgeFee(tokenId, flatPolicy, remoteRouter.ReceiverDomain)).To(Succeed())
+
+		// Act
+		err := remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 10_000)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(feeBalance(denom)).To(Equal(math.NewInt(25)))
+
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).BridgeFees(s.Ctx(), &types.QueryBridgeFeesRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		Expect(res.BridgeFees).To(HaveLen(1))
+		Expect(res.BridgeFees[0].Domain).To(Equal(remoteRouter.ReceiverDomain))
+		Expect(res.BridgeFees[0].Policy.FeeType).To(Equal(types.BRIDGE_FEE_TYPE_FLAT))
+
+	})
+
+	It("MsgRemoteTransfer (valid) disabled domain policy overrides default policy", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setBridgeFee(tokenId, bpsPolicy)).To(Succeed())
+		Expect(setBridgeFee(tokenId, types.BridgeFeePolicy{FlatAmount: math.ZeroInt()}, remoteRouter.ReceiverDomain)).To(Succeed())
+
+		// Act
+		err := remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 10_000)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(feeBalance(denom)).To(Equal(math.ZeroInt()))
+		Expect(collateralBalance(tokenId)).To(Equal(math.NewInt(10_000)))
+
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).BridgeFees(s.Ctx(), &types.QueryBridgeFeesRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		Expect(res.BridgeFees).To(HaveLen(1))
+		Expect(res.BridgeFees[0].Policy.FeeType).To(Equal(types.BRIDGE_FEE_TYPE_UNSPECIFIED))
+	})
+
+	It("MsgRemoteTransfer (valid) dust is added to the fee", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+		res, err := s.RunTx(&types.MsgCreateCollateralToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			OriginDenom:   denom,
+			Decimals:      3,
+		})
+		Expect(err).To(BeNil())
+		var response types.MsgCreateCollateralTokenResponse
+		Expect(proto.Unmarshal(res.MsgResponses[0].Value, &response)).To(Succeed())
+		tokenId := response.Id
+		router := remoteRouter
+		router.Decimals = 1
+		_, err = s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner.Address,
+			TokenId:      tokenId,
+			RemoteRouter: &router,
+		})
+		Expect(err).To(BeNil())
+		Expect(setBridgeFee(tokenId, bpsPolicy)).To(Succeed())
+
+		// Act
+		err = remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 10_000)
+
+		// Assert
+		// 1% of 10000 leaves 9900, which is representable with one remote decimal
+		Expect(err).To(BeNil())
+		Expect(feeBalance(denom)).To(Equal(math.NewInt(100)))
+
+		// 1% of 12345 is 123, the dust of the remaining 12222 is 22
+		err = remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 12_345)
+		Expect(err).To(BeNil())
+		Expect(feeBalance(denom)).To(Equal(math.NewInt(100 + 145)))
+		Expect(collateralBalance(tokenId)).To(Equal(math.NewInt(9_900 + 12_200)))
+	})
+
+	It("MsgRemoteTransfer (invalid) amount does not cover the fee", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setBridgeFee(tokenId, flatPolicy)).To(Succeed())
+
+		// Act
+		err := remoteTransfer(tokenId, remoteRouter.ReceiverDomain, 25)
+
+		// Assert
+		Expect(err.Error()).To(Equal("amount 25 does not cover the bridge fee 25"))
+		Expect(feeBalance(denom)).To(Equal(math.ZeroInt()))
+	})
+
+	It("QueryQuoteRemoteTransfer (valid) reports bridge fee", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setBridgeFee(tokenId, bpsPolicy)).To(Succeed())
+
+		// Act
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).QuoteRemoteTransfer(s.Ctx(), &types.QueryQuoteRemoteTransferRequest{
+			Id:                tokenId.String(),
+			DestinationDomain: "1",
+			Amount:            "10000",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.GasPayment).To(Equal(sdk.NewCoins(maxFee)))
+		Expect(res.BridgeFee).To(Equal(sdk.NewInt64Coin(denom, 100)))
+		Expect(res.Amount).To(Equal(sdk.NewInt64Coin(denom, 10_000)))
+		Expect(res.RemoteAmount.Amount).To(Equal(math.NewInt(9_900)))
+	})
+
+	It("MsgUnrollRemoteRouter (valid) removes domain policy", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setBridgeFee(tokenId, bpsPolicy, remoteRouter.ReceiverDomain)).To(Succeed())
+
+		// Act
+		_, err := s.RunTx(&types.MsgUnrollRemoteRouter{
+			Owner:          owner.Address,
+			TokenId:        tokenId,
+			ReceiverDomain: remoteRouter.ReceiverDomain,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).BridgeFees(s.Ctx(), &types.QueryBridgeFeesRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		Expect(res.BridgeFees).To(BeEmpty())
+	})
+})
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
@@ -14,26 +13,42 @@ import (
 
 // RemoteTransferCollateral handles the transfer of collateral token to a remote chain.
 // It withdraws the collateral from the sender, updates the token balance, and dispatches a message to the destination.
-func (k *Keeper) RemoteTransferCollateral(ctx sdk.Context, token types.HypToken, cosmosSender string, destinationDomain uint32, externalRecipient util.HexAddress, amount math.Int, customHookId *util.HexAddress, gasLimit math.Int, maxFee sdk.Coin, customHookMetadata []byte) (messageId util.HexAddress, err error) {
+// If a target router is given, the transfer is sent to this enrolled collateral router. Transfers to the local
+// domain are settled directly with the receiving collateral token.
+func (k *Keeper) RemoteTransferCollateral(ctx sdk.Context, token types.HypToken, cosmosSender string, destinationDomain uint32, targetRouter *util.HexAddress, externalRecipient util.HexAddress, amount math.Int, customHookId *util.HexAddress, gasLimit math.Int, maxFee sdk.Coin, customHookMetadata []byte) (messageId util.HexAddress, err error) {
+	if err := token.AssertNotPaused(destinationDomain); err != nil {
+		return util.HexAddress{}, err
+	}
+
 	senderAcc, err := sdk.AccAddressFromBech32(cosmosSender)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, amount)))
+	remoteRouter, err := k.targetRouter(ctx, token, destinationDomain, targetRouter)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
 
-	token.CollateralBalance = token.CollateralBalance.Add(amount)
+	// the bridge fee is deducted from the amount, only the remaining amount is bridged
+	fee, amount, err := k.bridgeFee(ctx, token, remoteRouter, amount)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
 
-	if err = k.HypTokens.Set(ctx, token.Id.GetInternalId(), token); err != nil {
+	if err = k.chargeBridgeFee(ctx, token, senderAcc, fee); err != nil {
 		return util.HexAddress{}, err
 	}
 
-	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(token.Id.GetInternalId(), destinationDomain))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, amount)))
 	if err != nil {
-		return util.HexAddress{}, fmt.Errorf("no enrolled router found for destination domain %d", destinationDomain)
+		return util.HexAddress{}, err
+	}
+
+	token.CollateralBalance = token.CollateralBalance.Add(amount)
+
+	if err = k.HypTokens.Set(ctx, token.Id.GetInternalId(), token); err != nil {
+		return util.HexAddress{}, err
 	}
 
 	receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
@@ -46,10 +61,35 @@ func (k *Keeper) RemoteTransferCollateral(ctx sdk.Context, token types.HypToken,
 		gas = gasLimit
 	}
 
-	warpPayload, err := types.NewWarpPayload(externalRecipient.Bytes(), *amount.BigInt())
+	if err := k.consumeRateLimit(ctx, token.Id, remoteRouter.ReceiverDomain, false, amount); err != nil {
+		return util.HexAddress{}, err
+	}
+
+	remoteAmount, err := types.ToRemoteAmount(amount, token.Decimals, remoteRouter.Decimals)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	warpPayload, err := types.NewWarpPayload(externalRecipient.Bytes(), *remoteAmount.BigInt())
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	mailbox, err := k.coreKeeper.GetMailbox(ctx, token.OriginMailbox)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
+	if remoteRouter.ReceiverDomain == mailbox.LocalDomain {
+		return k.settleLocalTransfer(ctx, token, mailbox.LocalDomain, receiverContract, warpPayload)
+	}
+
+	// the default hook of the token is used if the sender doesn't provide a custom hook
+	if customHookId == nil {
+		customHookId = token.HookId
+	}
+	if len(customHookMetadata) == 0 {
+		customHookMetadata = token.HookMetadata
+	}
 
 	// Token destinationDomain, recipientAddress
 	dispatchMsg, err := k.coreKeeper.DispatchMessage(
@@ -79,10 +119,12 @@ func (k *Keeper) RemoteTransferCollateral(ctx sdk.Context, token types.HypToken,
 
 // RemoteReceiveCollateral handles the receipt of collateral from a remote chain.
 // It updates the token balance, verifies there is enough collateral, and transfers the funds to the recipient's account.
-func (k *Keeper) RemoteReceiveCollateral(ctx context.Context, token types.HypToken, payload types.WarpPayload) error {
-	account := sdk.AccAddress(payload.Recipient()[12:32])
-
-	amount := math.NewIntFromBigInt(payload.Amount())
+// The amount is expected in local units.
+func (k *Keeper) RemoteReceiveCollateral(ctx context.Context, token types.HypToken, payload types.WarpPayload, amount math.Int) error {
+	account, err := k.receiverAccount(payload)
+	if err != nil {
+		return err
+	}
 
 	token.CollateralBalance = token.CollateralBalance.Sub(amount)
 	if token.CollateralBalance.IsNegative() {
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// trackedCollateral returns the summed collateral balance of all collateral tokens per denom.
+func (k *Keeper) trackedCollateral(ctx context.Context) (map[string]math.Int, error) {
+	tracked := make(map[string]math.Int)
+	err := k.HypTokens.Walk(ctx, nil, func(_ uint64, token types.HypToken) (stop bool, err error) {
+		if token.TokenType != types.HYP_TOKEN_TYPE_COLLATERAL {
+			return false, nil
+		}
+
+		if current, ok := tracked[token.OriginDenom]; ok {
+			tracked[token.OriginDenom] = current.Add(token.CollateralBalance)
+		} else {
+			tracked[token.OriginDenom] = token.CollateralBalance
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tracked, nil
+}
+
+// CollateralDiscrepancies compares the tracked collateral with the balance of the module account
+// and returns every denom for which they differ, sorted by denom. Denoms which are held by the module
+// account but not used by any collateral token are entirely surplus.
+func (k *Keeper) CollateralDiscrepancies(ctx context.Context) ([]types.CollateralDiscrepancy, error) {
+	tracked, err := k.trackedCollateral(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, types.ModuleAddress)
+
+	denoms := make([]string, 0, len(tracked)+len(balances))
+	for denom := range tracked {
+		denoms = append(denoms, denom)
+	}
+	for _, balance := range balances {
+		if _, ok := tracked[balance.Denom]; !ok {
+			denoms = append(denoms, balance.Denom)
+		}
+	}
+	sort.Strings(denoms)
+
+	discrepancies := make([]types.CollateralDiscrepancy, 0)
+	for _, denom := range denoms {
+		trackedCollateral, ok := tracked[denom]
+		if !ok {
+			trackedCollateral = math.ZeroInt()
+		}
+		moduleBalance := balances.AmountOf(denom)
+
+		if trackedCollateral.Equal(moduleBalance) {
+			continue
+		}
+
+		discrepancies = append(discrepancies, types.CollateralDiscrepancy{
+			Denom:             denom,
+			TrackedCollateral: trackedCollateral,
+			ModuleBalance:     moduleBalance,
+			Surplus:           moduleBalance.Sub(trackedCollateral),
+		})
+	}
+
+	return discrepancies, nil
+}
+
+// collateralSurplus returns the balance of the module account which exceeds the tracked collateral of the denom.
+func (k *Keeper) collateralSurplus(ctx context.Context, denom string) (math.Int, error) {
+	tracked, err := k.trackedCollateral(ctx)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	surplus := k.bankKeeper.GetBalance(ctx, types.ModuleAddress, denom).Amount
+	if trackedCollateral, ok := tracked[denom]; ok {
+		surplus = surplus.Sub(trackedCollateral)
+	}
+
+	if !surplus.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+	return surplus, nil
+}
+
+// SweepCollateralSurplus sends the whole surplus of the denom from the module account to the recipient.
+func (k *Keeper) SweepCollateralSurplus(ctx context.Context, denom string, recipient sdk.AccAddress) (sdk.Coin, error) {
+	surplus, err := k.collateralSurplus(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if surplus.IsZero() {
+		return sdk.Coin{}, fmt.Errorf("no surplus for denom %s", denom)
+	}
+
+	amount := sdk.NewCoin(denom, surplus)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return amount, nil
+}
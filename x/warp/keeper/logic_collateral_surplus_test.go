@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_collateral_surplus.go
+
+* QueryCollateralDiscrepancies (valid) no discrepancy after transfer
+* QueryCollateralDiscrepancies (valid) surplus from direct send to module account
+* CollateralBalanceInvariant (valid) surplus does not break invariant
+* CollateralBalanceInvariant (invalid) module balance below tracked collateral
+* MsgSweepCollateralSurplus (invalid) non-authority
+* MsgSweepCollateralSurplus (invalid) owner of another collateral token with the denom
+* MsgSweepCollateralSurplus (invalid) no surplus
+* MsgSweepCollateralSurplus (valid) by authority
+* MsgSweepCollateralSurplus (valid) untracked denom by authority
+
+*/
+
+var _ = Describe("logic_collateral_surplus", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var recipient i.TestValidatorAddress
+	var authority string
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	maxFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		recipient = i.GenerateTestValidatorAddress("Recipient")
+		authority = authtypes.NewModuleAddress("gov").String()
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 10_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	createCollateral := func(amount int64) util.HexAddress {
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		_, err := s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+		Expect(err).To(BeNil())
+		return tokenId
+	}
+
+	sendToModule := func(coin sdk.Coin) {
+		err := s.App().BankKeeper.SendCoins(s.Ctx(), sender.AccAddress, types.ModuleAddress, sdk.NewCoins(coin))
+		Expect(err).To(BeNil())
+	}
+
+	queryDiscrepancies := func() []types.CollateralDiscrepancy {
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).CollateralDiscrepancies(s.Ctx(), &types.QueryCollateralDiscrepanciesRequest{})
+		Expect(err).To(BeNil())
+		return res.Discrepancies
+	}
+
+	It("QueryCollateralDiscrepancies (valid) no discrepancy after transfer", func() {
+		// Arrange
+		createCollateral(100)
+
+		// Act
+		discrepancies := queryDiscrepancies()
+
+		// Assert
+		Expect(discrepancies).To(BeEmpty())
+	})
+
+	It("QueryCollateralDiscrepancies (valid) surplus from direct send to module account", func() {
+		// Arrange
+		createCollateral(100)
+
+		// Act
+		sendToModule(sdk.NewInt64Coin(denom, 40))
+
+		// Assert
+		Expect(queryDiscrepancies()).To(Equal([]types.CollateralDiscrepancy{{
+			Denom:             denom,
+			TrackedCollateral: math.NewInt(100),
+			ModuleBalance:     math.NewInt(140),
+			Surplus:           math.NewInt(40),
+		}}))
+	})
+
+	It("CollateralBalanceInvariant (valid) surplus does not break invariant", func() {
+		// Arrange
+		createCollateral(100)
+		sendToModule(sdk.NewInt64Coin(denom, 40))
+		k := s.App().WarpKeeper
+
+		// Act
+		_, broken := keeper.CollateralBalanceInvariant(&k)(s.Ctx())
+
+		// Assert
+		Expect(broken).To(BeFalse())
+	})
+
+	It("CollateralBalanceInvariant (invalid) module balance below tracked collateral", func() {
+		// Arrange
+		createCollateral(100)
+		err := s.App().BankKeeper.SendCoinsFromModuleToAccount(s.Ctx(), types.ModuleName, recipient.AccAddress, sdk.NewCoins(sdk.NewInt64Coin(denom, 30)))
+		Expect(err).To(BeNil())
+		k := s.App().WarpKeeper
+
+		// Act
+		msg, broken := keeper.CollateralBalanceInvariant(&k)(s.Ctx())
+
+		// Assert
+		Expect(broken).To(BeTrue())
+		Expect(msg).To(ContainSubstring("denom acoin: tracked collateral 100, module balance 70"))
+		Expect(queryDiscrepancies()[0].Surplus).To(Equal(math.NewInt(-30)))
+	})
+
+	It("MsgSweepCollateralSurplus (invalid) non-authority", func() {
+		// Arrange
+		createCollateral(100)
+		sendToModule(sdk.NewInt64Coin(denom, 40))
+
+		// Act
+		_, err := s.RunTx(&types.MsgSweepCollateralSurplus{
+			Signer:    owner.Address,
+			Denom:     denom,
+			Recipient: recipient.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid authority; expected " + authority + ", got " + owner.Address))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), types.ModuleAddress, denom).Amount).To(Equal(math.NewInt(140)))
+	})
+
+	It("MsgSweepCollateralSurplus (invalid) owner of another collateral token with the denom", func() {
+		// Arrange
+		tokenId := createCollateral(100)
+		token, err := s.App().WarpKeeper.HypTokens.Get(s.Ctx(), tokenId.GetInternalId())
+		Expect(err).To(BeNil())
+		otherOwner := i.GenerateTestValidatorAddress("Other Owner")
+		_, err = s.RunTx(&types.MsgCreateCollateralToken{
+			Owner:         otherOwner.Address,
+			OriginMailbox: token.OriginMailbox,
+			OriginDenom:   denom,
+		})
+		Expect(err).To(BeNil())
+		sendToModule(sdk.NewInt64Coin(denom, 40))
+
+		// Act
+		_, err = s.RunTx(&types.MsgSweepCollateralSurplus{
+			Signer:    otherOwner.Address,
+			Denom:     denom,
+			Recipient: otherOwner.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid authority; expected " + authority + ", got " + otherOwner.Address))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), types.ModuleAddress, denom).Amount).To(Equal(math.NewInt(140)))
+		Expect(queryDiscrepancies()[0].Surplus).To(Equal(math.NewInt(40)))
+	})
+
+	It("MsgSweepCollateralSurplus (invalid) no surplus", func() {
+		// Arrange
+		createCollateral(100)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSweepCollateralSurplus{
+			Signer:    authority,
+			Denom:     denom,
+			Recipient: recipient.Address,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("no surplus for denom acoin"))
+	})
+
+	It("MsgSweepCollateralSurplus (valid) by authority", func() {
+		// Arrange
+		tokenId := createCollateral(100)
+		sendToModule(sdk.NewInt64Coin(denom, 40))
+
+		// Act
+		res, err := s.RunTx(&types.MsgSweepCollateralSurplus{
+			Signer:    authority,
+			Denom:     denom,
+			Recipient: recipient.Address,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Events).To(ContainElement(HaveField("Type", "hyperlane.warp.v1.CollateralSurplusSwept")))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient.AccAddress, denom).Amount).To(Equal(math.NewInt(40)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), types.ModuleAddress, denom).Amount).To(Equal(math.NewInt(100)))
+
+		token, err := s.App().WarpKeeper.HypTokens.Get(s.Ctx(), tokenId.GetInternalId())
+		Expect(err).To(BeNil())
+		Expect(token.CollateralBalance).To(Equal(math.NewInt(100)))
+		Expect(queryDiscrepancies()).To(BeEmpty())
+	})
+
+	It("MsgSweepCollateralSurplus (valid) untracked denom by authority", func() {
+		// Arrange
+		createCollateral(100)
+		sendToModule(sdk.NewInt64Coin(i.B_DENOM, 25))
+
+		// Act
+		_, err := s.RunTx(&types.MsgSweepCollateralSurplus{
+			Signer:    authority,
+			Denom:     i.B_DENOM,
+			Recipient: recipient.Address,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient.AccAddress, i.B_DENOM).Amount).To(Equal(math.NewInt(25)))
+		Expect(queryDiscrepancies()).To(BeEmpty())
+	})
+})
@@ -11,6 +11,7 @@ import (
 	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -29,6 +30,8 @@ TEST CASES - logic_collateral.go
 * MsgRemoteTransfer (invalid) insufficient funds (Collateral)
 * MsgRemoteTransfer & MsgRemoteReceiveCollateral (invalid) not enough collateral (Collateral)
 * MsgRemoteTransfer && MsgRemoteReceiveCollateral (valid) (Collateral)
+* MsgRemoteReceiveCollateral (valid) 32-byte recipient (Collateral)
+* MsgRemoteReceiveCollateral (invalid) zero recipient (Collateral)
 
 */
 
@@ -366,4 +369,75 @@ var _ = Describe("logic_collateral.go", Ordered, func() {
 		Expect(err).To(BeNil())
 		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount).To(Equal(senderBalance.Amount.Add(amount)))
 	})
+
+	// receiveCollateral locks collateral with a remote transfer and processes a message which
+	// releases the amount to the given 32-byte recipient
+	receiveCollateral := func(recipient []byte, amount int64) error {
+		remoteRouter := types.RemoteRouter{
+			ReceiverDomain:   1,
+			ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+			Gas:              math.NewInt(50000),
+		}
+		receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		err := s.MintBaseCoins(sender.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		_, err = s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            sdk.NewCoin(denom, math.NewInt(250000)),
+		})
+		Expect(err).To(BeNil())
+
+		receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		warpPayload, err := types.NewWarpPayload(recipient, *big.NewInt(amount))
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      receiverContract,
+			Destination: 0,
+			Recipient:   tokenId,
+			Body:        warpPayload.Bytes(),
+		}
+
+		_, err = s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+		return err
+	}
+
+	It("MsgRemoteReceiveCollateral (valid) 32-byte recipient (Collateral)", func() {
+		// Arrange
+		recipient := sdk.AccAddress(address.Module("interchainaccounts", []byte("owner")))
+		Expect(recipient).To(HaveLen(32))
+
+		// Act
+		err := receiveCollateral(recipient, 100)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient, denom).Amount).To(Equal(math.NewInt(100)))
+	})
+
+	It("MsgRemoteReceiveCollateral (invalid) zero recipient (Collateral)", func() {
+		// Act
+		err := receiveCollateral(make([]byte, 32), 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid recipient: zero address"))
+	})
 })
@@ -0,0 +1,353 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - decimal scaling
+
+* MsgCreateCollateralToken (invalid) decimals too large
+* MsgEnrollRemoteRouter (invalid) decimals too large
+* MsgEnrollRemoteRouter (invalid) decimals not set for a token with decimals
+* MsgRemoteTransfer (valid) scale up to remote decimals (Collateral)
+* MsgRemoteTransfer (valid) scale down to remote decimals (Collateral)
+* MsgRemoteTransfer (invalid) amount truncates to zero (Collateral)
+* MsgRemoteTransfer (invalid) amount leaves dust (Collateral)
+* MsgProcessMessage (valid) scale down to local decimals (Synthetic)
+* MsgProcessMessage (valid) amount with dust is rounded down (Synthetic)
+* QueryQuoteRemoteTransfer reports local and remote amount
+* QueryBridgedSupply reports local and remote supply
+
+*/
+
+var _ = Describe("logic decimal scaling", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	maxFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	// createScaledToken creates a token with the given local decimals and a remote router with the given remote decimals
+	createScaledToken := func(tokenType types.HypTokenType, localDecimals, remoteDecimals uint32) (util.HexAddress, util.HexAddress, types.RemoteRouter) {
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+
+		var res *sdk.Result
+		var err error
+		if tokenType == types.HYP_TOKEN_TYPE_COLLATERAL {
+			res, err = s.RunTx(&types.MsgCreateCollateralToken{
+				Owner:         owner.Address,
+				OriginMailbox: mailboxId,
+				OriginDenom:   denom,
+				Decimals:      localDecimals,
+			})
+			Expect(err).To(BeNil())
+		} else {
+			res, err = s.RunTx(&types.MsgCreateSyntheticToken{
+				Owner:         owner.Address,
+				OriginMailbox: mailboxId,
+				Decimals:      localDecimals,
+			})
+			Expect(err).To(BeNil())
+		}
+
+		var response types.MsgCreateCollateralTokenResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+
+		remoteRouter := types.RemoteRouter{
+			ReceiverDomain:   1,
+			ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+			Gas:              math.NewInt(50000),
+			Decimals:         remoteDecimals,
+		}
+		_, err = s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner.Address,
+			TokenId:      response.Id,
+			RemoteRouter: &remoteRouter,
+		})
+		Expect(err).To(BeNil())
+
+		return response.Id, mailboxId, remoteRouter
+	}
+
+	// dispatchedAmount returns the amount of the warp payload in the dispatched message
+	dispatchedAmount := func(res *sdk.Result) *big.Int {
+		for _, event := range res.Events {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+			if dispatch, ok := typedEvent.(*coreTypes.Dispatch); ok {
+				raw, err := util.DecodeEthHex(dispatch.Message)
+				Expect(err).To(BeNil())
+				message, err := util.ParseHyperlaneMessage(raw)
+				Expect(err).To(BeNil())
+				payload, err := types.ParseWarpPayload(message.Body)
+				Expect(err).To(BeNil())
+				return payload.Amount()
+			}
+		}
+		Fail("no dispatch event found")
+		return nil
+	}
+
+	remoteTransfer := func(tokenId util.HexAddress, amount math.Int) (*sdk.Result, error) {
+		return s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: 1,
+			Recipient:         receiverAddress,
+			Amount:            amount,
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+	}
+
+	processMessage := func(tokenId, mailboxId util.HexAddress, remoteRouter types.RemoteRouter, amount *big.Int) (*sdk.Result, error) {
+		receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		warpPayload, err := types.NewWarpPayload(sender.AccAddress, *amount)
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      receiverContract,
+			Destination: 0,
+			Recipient:   tokenId,
+			Body:        warpPayload.Bytes(),
+		}
+
+		return s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+	}
+
+	It("MsgCreateCollateralToken (invalid) decimals too large", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateCollateralToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			OriginDenom:   denom,
+			Decimals:      37,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("decimals must not exceed 36"))
+	})
+
+	It("MsgEnrollRemoteRouter (invalid) decimals too large", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, nil, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+			RemoteRouter: &types.RemoteRouter{
+				ReceiverDomain:   1,
+				ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+				Gas:              math.NewInt(50000),
+				Decimals:         37,
+			},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("decimals must not exceed 36"))
+	})
+
+	It("MsgEnrollRemoteRouter (invalid) decimals not set for a token with decimals", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+		res, err := s.RunTx(&types.MsgCreateCollateralToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			OriginDenom:   denom,
+			Decimals:      6,
+		})
+		Expect(err).To(BeNil())
+		var response types.MsgCreateCollateralTokenResponse
+		Expect(proto.Unmarshal(res.MsgResponses[0].Value, &response)).To(Succeed())
+
+		// Act
+		_, err = s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:   owner.Address,
+			TokenId: response.Id,
+			RemoteRouter: &types.RemoteRouter{
+				ReceiverDomain:   1,
+				ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+				Gas:              math.NewInt(50000),
+			},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("remote router decimals must be set for a token with 6 decimals"))
+	})
+
+	It("MsgRemoteTransfer (valid) scale up to remote decimals (Collateral)", func() {
+		// Arrange
+		tokenId, _, _ := createScaledToken(types.HYP_TOKEN_TYPE_COLLATERAL, 6, 18)
+
+		// Act
+		res, err := remoteTransfer(tokenId, math.NewInt(100))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(dispatchedAmount(res).String()).To(Equal("100000000000000"))
+
+		token, err := s.App().WarpKeeper.HypTokens.Get(s.Ctx(), tokenId.GetInternalId())
+		Expect(err).To(BeNil())
+		Expect(token.CollateralBalance).To(Equal(math.NewInt(100)))
+	})
+
+	It("MsgRemoteTransfer (valid) scale down to remote decimals (Collateral)", func() {
+		// Arrange
+		tokenId, _, _ := createScaledToken(types.HYP_TOKEN_TYPE_COLLATERAL, 8, 6)
+
+		// Act
+		res, err := remoteTransfer(tokenId, math.NewInt(1200))
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(dispatchedAmount(res).String()).To(Equal("12"))
+	})
+
+	It("MsgRemoteTransfer (invalid) amount truncates to zero (Collateral)", func() {
+		// Arrange
+		tokenId, _, _ := createScaledToken(types.HYP_TOKEN_TYPE_COLLATERAL, 8, 6)
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)
+
+		// Act
+		_, err := remoteTransfer(tokenId, math.NewInt(99))
+
+		// Assert
+		Expect(err.Error()).To(Equal("amount 99 truncates to zero when scaled from 8 to 6 decimals"))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)).To(Equal(senderBalance))
+	})
+
+	It("MsgRemoteTransfer (invalid) amount leaves dust (Collateral)", func() {
+		// Arrange
+		tokenId, _, _ := createScaledToken(types.HYP_TOKEN_TYPE_COLLATERAL, 8, 6)
+
+		// Act
+		_, err := remoteTransfer(tokenId, math.NewInt(1201))
+
+		// Assert
+		Expect(err.Error()).To(Equal("amount 1201 leaves dust when scaled from 8 to 6 decimals"))
+	})
+
+	It("MsgProcessMessage (valid) scale down to local decimals (Synthetic)", func() {
+		// Arrange
+		tokenId, mailboxId, remoteRouter := createScaledToken(types.HYP_TOKEN_TYPE_SYNTHETIC, 6, 18)
+		remoteAmount, _ := new(big.Int).SetString("2500000000000000", 10)
+
+		// Act
+		_, err := processMessage(tokenId, mailboxId, remoteRouter, remoteAmount)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, fmt.Sprintf("hyperlane/%s", tokenId.String())).Amount).To(Equal(math.NewInt(2500)))
+	})
+
+	It("MsgProcessMessage (valid) amount with dust is rounded down (Synthetic)", func() {
+		// Arrange
+		tokenId, mailboxId, remoteRouter := createScaledToken(types.HYP_TOKEN_TYPE_SYNTHETIC, 6, 18)
+		remoteAmount, _ := new(big.Int).SetString("2500000000000001", 10)
+
+		// Act
+		res, err := processMessage(tokenId, mailboxId, remoteRouter, remoteAmount)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, fmt.Sprintf("hyperlane/%s", tokenId.String())).Amount).To(Equal(math.NewInt(2500)))
+
+		var dust *types.RemoteReceiveDust
+		for _, event := range res.Events {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+			if e, ok := typedEvent.(*types.RemoteReceiveDust); ok {
+				dust = e
+			}
+		}
+		Expect(dust).To(Equal(&types.RemoteReceiveDust{
+			TokenId:      tokenId.String(),
+			OriginDomain: remoteRouter.ReceiverDomain,
+			Dust:         "1",
+		}))
+	})
+
+	It("QueryQuoteRemoteTransfer reports local and remote amount", func() {
+		// Arrange
+		tokenId, _, _ := createScaledToken(types.HYP_TOKEN_TYPE_COLLATERAL, 6, 18)
+
+		// Act
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).QuoteRemoteTransfer(s.Ctx(), &types.QueryQuoteRemoteTransferRequest{
+			Id:                tokenId.String(),
+			DestinationDomain: "1",
+			Amount:            "5",
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Amount).To(Equal(sdk.NewCoin(denom, math.NewInt(5))))
+		Expect(res.RemoteAmount.ReceiverDomain).To(Equal(uint32(1)))
+		Expect(res.RemoteAmount.Decimals).To(Equal(uint32(18)))
+		Expect(res.RemoteAmount.Amount).To(Equal(math.NewInt(5_000_000_000_000)))
+	})
+
+	It("QueryBridgedSupply reports local and remote supply", func() {
+		// Arrange
+		tokenId, _, _ := createScaledToken(types.HYP_TOKEN_TYPE_COLLATERAL, 6, 18)
+		_, err := remoteTransfer(tokenId, math.NewInt(100))
+		Expect(err).To(BeNil())
+
+		// Act
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).BridgedSupply(s.Ctx(), &types.QueryBridgedSupplyRequest{
+			Id: tokenId.String(),
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.BridgedSupply).To(Equal(sdk.NewCoin(denom, math.NewInt(100))))
+		Expect(res.Decimals).To(Equal(uint32(6)))
+		Expect(res.RemoteBridgedSupplies).To(HaveLen(1))
+		Expect(res.RemoteBridgedSupplies[0].Decimals).To(Equal(uint32(18)))
+		Expect(res.RemoteBridgedSupplies[0].Amount).To(Equal(math.NewInt(100_000_000_000_000)))
+	})
+})
@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - default hook of warp tokens
+
+* MsgSetToken (invalid) non-existing hook
+* MsgSetToken (invalid) set and remove hook
+* MsgSetToken (invalid) hook metadata
+* MsgSetToken (valid) set hook and hook metadata
+* MsgRemoteTransfer (valid) uses default hook of token
+* MsgRemoteTransfer (valid) custom hook overrides default hook of token
+* QueryQuoteRemoteTransfer (valid) uses default hook of token
+* MsgSetToken (valid) remove hook
+
+*/
+
+var _ = Describe("logic_hook", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	igpFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 10_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	setHook := func(tokenId util.HexAddress, hookId util.HexAddress, hookMetadata string) error {
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:        owner.Address,
+			TokenId:      tokenId,
+			HookId:       &hookId,
+			HookMetadata: hookMetadata,
+		})
+		return err
+	}
+
+	queryToken := func(tokenId util.HexAddress) *types.WrappedHypToken {
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).Token(s.Ctx(), &types.QueryTokenRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		return res.Token
+	}
+
+	quote := func(tokenId util.HexAddress) sdk.Coins {
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).QuoteRemoteTransfer(s.Ctx(), &types.QueryQuoteRemoteTransferRequest{
+			Id:                tokenId.String(),
+			DestinationDomain: "1",
+		})
+		Expect(err).To(BeNil())
+		return res.GasPayment
+	}
+
+	remoteTransfer := func(tokenId util.HexAddress, customHookId *util.HexAddress, maxFee sdk.Coin) error {
+		_, err := s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(100),
+			CustomHookId:      customHookId,
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+		return err
+	}
+
+	It("MsgSetToken (invalid) non-existing hook", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		hookId, _ := util.DecodeHexAddress("0x726f757465725f706f73745f6469737061746368000000000000000000000099")
+
+		// Act
+		err := setHook(tokenId, hookId, "")
+
+		// Assert
+		Expect(err).NotTo(BeNil())
+		Expect(queryToken(tokenId).HookId).To(BeNil())
+	})
+
+	It("MsgSetToken (invalid) set and remove hook", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		hookId := createNoopHook(s, owner.Address)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:      owner.Address,
+			TokenId:    tokenId,
+			HookId:     &hookId,
+			RemoveHook: true,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("cannot set hook and remove hook at the same time"))
+	})
+
+	It("MsgSetToken (invalid) hook metadata", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		hookId := createNoopHook(s, owner.Address)
+
+		// Act
+		err := setHook(tokenId, hookId, "0xzz")
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid hook metadata"))
+	})
+
+	It("MsgSetToken (valid) set hook and hook metadata", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		hookId := createNoopHook(s, owner.Address)
+
+		// Act
+		err := setHook(tokenId, hookId, "0x0102")
+
+		// Assert
+		Expect(err).To(BeNil())
+		token := queryToken(tokenId)
+		Expect(*token.HookId).To(Equal(hookId))
+		Expect(token.HookMetadata).To(Equal("0x0102"))
+	})
+
+	It("MsgRemoteTransfer (valid) uses default hook of token", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(remoteTransfer(tokenId, nil, sdk.NewCoin(denom, math.ZeroInt()))).NotTo(Succeed())
+		Expect(setHook(tokenId, createNoopHook(s, owner.Address), "")).To(Succeed())
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)
+
+		// Act
+		err := remoteTransfer(tokenId, nil, sdk.NewCoin(denom, math.ZeroInt()))
+
+		// Assert
+		// the noop hook of the token replaces the igp, which is the default hook of the mailbox
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount).To(Equal(senderBalance.Amount.SubRaw(100)))
+	})
+
+	It("MsgRemoteTransfer (valid) custom hook overrides default hook of token", func() {
+		// Arrange
+		tokenId, _, igpId, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setHook(tokenId, createNoopHook(s, owner.Address), "")).To(Succeed())
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)
+
+		// Act
+		err := remoteTransfer(tokenId, &igpId, igpFee)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount).To(Equal(senderBalance.Amount.SubRaw(100).Sub(igpFee.Amount)))
+	})
+
+	It("QueryQuoteRemoteTransfer (valid) uses default hook of token", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(quote(tokenId)).To(Equal(sdk.NewCoins(igpFee)))
+
+		// Act
+		err := setHook(tokenId, createNoopHook(s, owner.Address), "")
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(quote(tokenId)).To(BeEmpty())
+	})
+
+	It("MsgSetToken (valid) remove hook", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(setHook(tokenId, createNoopHook(s, owner.Address), "0x0102")).To(Succeed())
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:      owner.Address,
+			TokenId:    tokenId,
+			RemoveHook: true,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		token := queryToken(tokenId)
+		Expect(token.HookId).To(BeNil())
+		Expect(token.HookMetadata).To(BeEmpty())
+		Expect(quote(tokenId)).To(Equal(sdk.NewCoins(igpFee)))
+	})
+})
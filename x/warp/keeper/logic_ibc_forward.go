@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+type ibcKeepers struct {
+	transferKeeper types.IbcTransferKeeper
+}
+
+// SetIbcTransferKeeper enables forwarding of received tokens over IBC. It must be called during
+// app wiring, before any message is processed.
+func (k Keeper) SetIbcTransferKeeper(transferKeeper types.IbcTransferKeeper) {
+	k.ibc.transferKeeper = transferKeeper
+}
+
+// ForwardIbcTransfer sends tokens, which were received by the forwarder account, with an ICS-20
+// transfer to the receiver of the IbcForward. If the transfer can't be sent, the tokens are
+// released to the recipient of the payload right away. The amount is expected in local units.
+func (k *Keeper) ForwardIbcTransfer(ctx context.Context, token types.HypToken, payload types.WarpPayload, received math.Int, forward types.IbcForward) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	amount := sdk.NewCoin(token.OriginDenom, received)
+
+	fallbackAccount, err := k.cosmosAccount(payload)
+	if err != nil {
+		return err
+	}
+
+	fallbackRecipient, err := k.addressCodec.BytesToString(fallbackAccount)
+	if err != nil {
+		return err
+	}
+
+	sender, err := k.addressCodec.BytesToString(types.IbcForwarderAddress)
+	if err != nil {
+		return err
+	}
+
+	timeoutSeconds := forward.TimeoutSeconds
+	if timeoutSeconds == 0 {
+		timeoutSeconds = types.DefaultIbcForwardTimeoutSeconds
+	}
+	timeout := sdkCtx.BlockTime().Add(time.Duration(timeoutSeconds) * time.Second)
+
+	pending := types.PendingIbcForward{
+		SourceChannel:     forward.SourceChannel,
+		TokenId:           token.Id,
+		FallbackRecipient: fallbackRecipient,
+		Amount:            amount,
+	}
+
+	// The transfer is executed in a cached context, so that a failed transfer does not leave
+	// partial state behind and the tokens can be released to the fallback recipient instead.
+	cacheCtx, write := sdkCtx.CacheContext()
+	res, err := k.ibc.transferKeeper.Transfer(cacheCtx, &ibctransfertypes.MsgTransfer{
+		SourcePort:       ibctransfertypes.PortID,
+		SourceChannel:    forward.SourceChannel,
+		Token:            amount,
+		Sender:           sender,
+		Receiver:         forward.Receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: uint64(timeout.UnixNano()),
+		Memo:             forward.Memo,
+	})
+	if err != nil {
+		return k.refundIbcForward(ctx, pending, err.Error())
+	}
+	write()
+
+	pending.Sequence = res.Sequence
+	if err := k.IbcForwards.Set(ctx, collections.Join(pending.SourceChannel, pending.Sequence), pending); err != nil {
+		return err
+	}
+
+	_ = sdkCtx.EventManager().EmitTypedEvent(&types.IbcForwardSent{
+		TokenId:       token.Id.String(),
+		SourceChannel: pending.SourceChannel,
+		Sequence:      pending.Sequence,
+		Receiver:      forward.Receiver,
+		Amount:        amount.String(),
+	})
+
+	return nil
+}
+
+// OnIbcForwardAcknowledgement must be called by the IBC stack after the transfer module processed
+// the acknowledgement of a packet. Packets which were not sent by the forwarder are ignored.
+// On an error acknowledgement, ICS-20 refunds the forwarder and the tokens are released to the
+// fallback recipient.
+func (k *Keeper) OnIbcForwardAcknowledgement(ctx context.Context, sourceChannel string, sequence uint64, success bool) error {
+	pending, found, err := k.popIbcForward(ctx, sourceChannel, sequence)
+	if err != nil || !found || success {
+		return err
+	}
+
+	return k.refundIbcForward(ctx, pending, "error acknowledgement")
+}
+
+// OnIbcForwardTimeout must be called by the IBC stack after the transfer module processed the
+// timeout of a packet. The refunded tokens are released to the fallback recipient.
+func (k *Keeper) OnIbcForwardTimeout(ctx context.Context, sourceChannel string, sequence uint64) error {
+	pending, found, err := k.popIbcForward(ctx, sourceChannel, sequence)
+	if err != nil || !found {
+		return err
+	}
+
+	return k.refundIbcForward(ctx, pending, "timeout")
+}
+
+func (k *Keeper) popIbcForward(ctx context.Context, sourceChannel string, sequence uint64) (types.PendingIbcForward, bool, error) {
+	key := collections.Join(sourceChannel, sequence)
+
+	pending, err := k.IbcForwards.Get(ctx, key)
+	if err != nil {
+		return types.PendingIbcForward{}, false, nil
+	}
+
+	if err := k.IbcForwards.Remove(ctx, key); err != nil {
+		return types.PendingIbcForward{}, false, err
+	}
+
+	return pending, true, nil
+}
+
+func (k *Keeper) refundIbcForward(ctx context.Context, pending types.PendingIbcForward, reason string) error {
+	recipient, err := k.addressCodec.StringToBytes(pending.FallbackRecipient)
+	if err != nil {
+		return fmt.Errorf("invalid fallback recipient %s", pending.FallbackRecipient)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.IbcForwarderAddress, recipient, sdk.NewCoins(pending.Amount)); err != nil {
+		return err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.IbcForwardRefunded{
+		TokenId:           pending.TokenId.String(),
+		SourceChannel:     pending.SourceChannel,
+		Sequence:          pending.Sequence,
+		FallbackRecipient: pending.FallbackRecipient,
+		Amount:            pending.Amount.String(),
+		Reason:            reason,
+	})
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_ibc_forward.go
+
+* MsgProcessMessage (invalid) ibc forwarding not enabled
+* MsgProcessMessage (invalid) invalid ibc forward channel
+* MsgProcessMessage (invalid) zero address fallback recipient
+* MsgProcessMessage (valid) forward received tokens (Synthetic)
+* MsgProcessMessage (valid) forward received tokens (Collateral)
+* MsgProcessMessage (valid) failing transfer releases tokens to fallback recipient
+* OnIbcForwardAcknowledgement (valid) success acknowledgement
+* OnIbcForwardAcknowledgement (valid) error acknowledgement releases tokens to fallback recipient
+* OnIbcForwardTimeout (valid) timeout releases tokens to fallback recipient
+* OnIbcForwardTimeout (valid) unknown packet is ignored
+
+*/
+
+var ibcEscrowAddress = sdk.AccAddress(address.Module("transfer", []byte("escrow")))
+
+// mockTransferKeeper moves the tokens into an escrow account, just like ICS-20 does for native tokens.
+type mockTransferKeeper struct {
+	s        *i.KeeperTestSuite
+	sequence uint64
+	fail     bool
+	msgs     []*ibctransfertypes.MsgTransfer
+}
+
+func (m *mockTransferKeeper) Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	if m.fail {
+		return nil, fmt.Errorf("channel not found")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.s.App().BankKeeper.SendCoins(ctx, sender, ibcEscrowAddress, sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	m.sequence++
+	m.msgs = append(m.msgs, msg)
+	return &ibctransfertypes.MsgTransferResponse{Sequence: m.sequence}, nil
+}
+
+// refund returns the escrowed tokens to the forwarder, like ICS-20 does before the callback is invoked.
+func (m *mockTransferKeeper) refund(amount sdk.Coin) {
+	err := m.s.App().BankKeeper.SendCoins(m.s.Ctx(), ibcEscrowAddress, types.IbcForwarderAddress, sdk.NewCoins(amount))
+	Expect(err).To(BeNil())
+}
+
+var _ = Describe("logic_ibc_forward.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var transferKeeper *mockTransferKeeper
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+
+	forward := types.IbcForward{
+		SourceChannel:  "channel-0",
+		Receiver:       "osmo1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+		TimeoutSeconds: 60,
+		Memo:           "memo",
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		transferKeeper = &mockTransferKeeper{s: s}
+	})
+
+	// fundCollateral locks collateral in the token by sending a remote transfer
+	fundCollateral := func(tokenId, igpId util.HexAddress, amount int64) {
+		err := s.MintBaseCoins(sender.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+		_, err = s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			CustomHookId:      &igpId,
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            sdk.NewCoin(denom, math.NewInt(250000)),
+		})
+		Expect(err).To(BeNil())
+	}
+
+	processMessageTo := func(tokenId, mailboxId util.HexAddress, recipient []byte, amount int64, forward types.IbcForward) error {
+		receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		warpPayload, err := types.NewWarpPayloadWithIbcForward(recipient, *big.NewInt(amount), forward)
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      receiverContract,
+			Destination: 0,
+			Recipient:   tokenId,
+			Body:        warpPayload.Bytes(),
+		}
+
+		_, err = s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+		return err
+	}
+
+	processMessage := func(tokenId, mailboxId util.HexAddress, amount int64, forward types.IbcForward) error {
+		return processMessageTo(tokenId, mailboxId, sender.AccAddress, amount, forward)
+	}
+
+	It("MsgProcessMessage (invalid) ibc forwarding not enabled", func() {
+		// Arrange
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+
+		// Act
+		err := processMessage(tokenId, mailboxId, 100, forward)
+
+		// Assert
+		Expect(err.Error()).To(Equal("ibc forwarding is not enabled"))
+	})
+
+	It("MsgProcessMessage (invalid) invalid ibc forward channel", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+
+		invalidForward := forward
+		invalidForward.SourceChannel = "#"
+
+		// Act
+		err := processMessage(tokenId, mailboxId, 100, invalidForward)
+
+		// Assert
+		Expect(err.Error()).To(ContainSubstring("invalid ibc forward channel"))
+		Expect(transferKeeper.msgs).To(BeEmpty())
+	})
+
+	It("MsgProcessMessage (invalid) zero address fallback recipient", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+
+		// Act
+		err := processMessageTo(tokenId, mailboxId, make([]byte, 32), 100, forward)
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid recipient: zero address"))
+		Expect(transferKeeper.msgs).To(BeEmpty())
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), types.IbcForwarderAddress)).To(BeEmpty())
+	})
+
+	It("MsgProcessMessage (valid) forward received tokens (Synthetic)", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", tokenId.String())
+
+		// Act
+		err := processMessage(tokenId, mailboxId, 100, forward)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(transferKeeper.msgs).To(HaveLen(1))
+		msg := transferKeeper.msgs[0]
+		Expect(msg.SourcePort).To(Equal(ibctransfertypes.PortID))
+		Expect(msg.SourceChannel).To(Equal(forward.SourceChannel))
+		Expect(msg.Receiver).To(Equal(forward.Receiver))
+		Expect(msg.Memo).To(Equal(forward.Memo))
+		Expect(msg.Token).To(Equal(sdk.NewCoin(syntheticDenom, math.NewInt(100))))
+		Expect(msg.TimeoutTimestamp).To(Equal(uint64(s.Ctx().BlockTime().UnixNano()) + 60_000_000_000))
+
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.ZeroInt()))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), ibcEscrowAddress, syntheticDenom).Amount).To(Equal(math.NewInt(100)))
+
+		pending, err := s.App().WarpKeeper.IbcForwards.Get(s.Ctx(), collections.Join(forward.SourceChannel, uint64(1)))
+		Expect(err).To(BeNil())
+		Expect(pending.FallbackRecipient).To(Equal(sender.Address))
+		Expect(pending.TokenId).To(Equal(tokenId))
+	})
+
+	It("MsgProcessMessage (valid) forward received tokens (Collateral)", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, igpId, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		fundCollateral(tokenId, igpId, 100)
+
+		// Act
+		err := processMessage(tokenId, mailboxId, 100, forward)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(transferKeeper.msgs).To(HaveLen(1))
+		Expect(transferKeeper.msgs[0].Token).To(Equal(sdk.NewCoin(denom, math.NewInt(100))))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), ibcEscrowAddress, denom).Amount).To(Equal(math.NewInt(100)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), types.IbcForwarderAddress, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("MsgProcessMessage (valid) failing transfer releases tokens to fallback recipient", func() {
+		// Arrange
+		transferKeeper.fail = true
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", tokenId.String())
+
+		// Act
+		err := processMessage(tokenId, mailboxId, 100, forward)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.NewInt(100)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), types.IbcForwarderAddress, syntheticDenom).Amount).To(Equal(math.ZeroInt()))
+
+		pending, err := s.App().WarpKeeper.IbcForwards.Iterate(s.Ctx(), nil)
+		Expect(err).To(BeNil())
+		values, err := pending.Values()
+		Expect(err).To(BeNil())
+		Expect(values).To(BeEmpty())
+	})
+
+	It("OnIbcForwardAcknowledgement (valid) success acknowledgement", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", tokenId.String())
+		err := processMessage(tokenId, mailboxId, 100, forward)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = s.App().WarpKeeper.OnIbcForwardAcknowledgement(s.Ctx(), forward.SourceChannel, 1, true)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.ZeroInt()))
+		has, err := s.App().WarpKeeper.IbcForwards.Has(s.Ctx(), collections.Join(forward.SourceChannel, uint64(1)))
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+	})
+
+	It("OnIbcForwardAcknowledgement (valid) error acknowledgement releases tokens to fallback recipient", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", tokenId.String())
+		err := processMessage(tokenId, mailboxId, 100, forward)
+		Expect(err).To(BeNil())
+		transferKeeper.refund(sdk.NewCoin(syntheticDenom, math.NewInt(100)))
+
+		// Act
+		err = s.App().WarpKeeper.OnIbcForwardAcknowledgement(s.Ctx(), forward.SourceChannel, 1, false)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.NewInt(100)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), types.IbcForwarderAddress, syntheticDenom).Amount).To(Equal(math.ZeroInt()))
+		has, err := s.App().WarpKeeper.IbcForwards.Has(s.Ctx(), collections.Join(forward.SourceChannel, uint64(1)))
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+	})
+
+	It("OnIbcForwardTimeout (valid) timeout releases tokens to fallback recipient", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", tokenId.String())
+		err := processMessage(tokenId, mailboxId, 100, forward)
+		Expect(err).To(BeNil())
+		transferKeeper.refund(sdk.NewCoin(syntheticDenom, math.NewInt(100)))
+
+		// Act
+		err = s.App().WarpKeeper.OnIbcForwardTimeout(s.Ctx(), forward.SourceChannel, 1)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.NewInt(100)))
+		has, err := s.App().WarpKeeper.IbcForwards.Has(s.Ctx(), collections.Join(forward.SourceChannel, uint64(1)))
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+	})
+
+	It("OnIbcForwardTimeout (valid) unknown packet is ignored", func() {
+		// Arrange
+		s.App().WarpKeeper.SetIbcTransferKeeper(transferKeeper)
+
+		// Act
+		err := s.App().WarpKeeper.OnIbcForwardTimeout(s.Ctx(), "channel-7", 42)
+
+		// Assert
+		Expect(err).To(BeNil())
+	})
+})
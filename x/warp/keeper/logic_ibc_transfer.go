@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RemoteTransferFromIbc sends tokens received over ICS-20 by the intermediate account on to the
+// destination of the warp memo. The max fee must be given in the received denom. Only the quoted
+// dispatch fee is deducted from the received amount, so that no tokens remain with the
+// intermediate account, which nobody can sign for.
+func (k *Keeper) RemoteTransferFromIbc(ctx sdk.Context, intermediate sdk.AccAddress, received sdk.Coin, transfer types.IbcWarpTransfer) (util.HexAddress, error) {
+	token, err := k.HypTokens.Get(ctx, transfer.TokenId.GetInternalId())
+	if err != nil {
+		return util.HexAddress{}, fmt.Errorf("failed to find token with id: %s", transfer.TokenId.String())
+	}
+
+	if token.OriginDenom != received.Denom {
+		return util.HexAddress{}, fmt.Errorf("received denom %s does not match token denom %s", received.Denom, token.OriginDenom)
+	}
+
+	if transfer.MaxFee.Denom != received.Denom {
+		return util.HexAddress{}, fmt.Errorf("max fee denom %s does not match received denom %s", transfer.MaxFee.Denom, received.Denom)
+	}
+
+	requiredPayment, err := k.quoteDispatch(ctx, token, transfer.DestinationDomain, intermediate, transfer.CustomHookId, transfer.GasLimit, transfer.CustomHookMetadata)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+	if !requiredPayment.IsAllLTE(sdk.NewCoins(transfer.MaxFee)) {
+		return util.HexAddress{}, fmt.Errorf("required payment exceeds max hyperlane fee: %s", requiredPayment.String())
+	}
+
+	fee := sdk.NewCoin(received.Denom, requiredPayment.AmountOf(received.Denom))
+	amount := received.Amount.Sub(fee.Amount)
+	if !amount.IsPositive() {
+		return util.HexAddress{}, fmt.Errorf("received amount %s does not cover fee %s", received.String(), fee.String())
+	}
+
+	sender, err := k.addressCodec.BytesToString(intermediate)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	switch token.TokenType {
+	case types.HYP_TOKEN_TYPE_COLLATERAL:
+		return k.RemoteTransferCollateral(ctx, token, sender, transfer.DestinationDomain, nil, transfer.Recipient, amount, transfer.CustomHookId, transfer.GasLimit, fee, transfer.CustomHookMetadata)
+	case types.HYP_TOKEN_TYPE_SYNTHETIC:
+		return k.RemoteTransferSynthetic(ctx, token, sender, transfer.DestinationDomain, transfer.Recipient, amount, transfer.CustomHookId, transfer.GasLimit, fee, transfer.CustomHookMetadata)
+	default:
+		return util.HexAddress{}, fmt.Errorf("invalid token type")
+	}
+}
+
+// quoteDispatch returns the fee which the mailbox charges for a remote transfer of the token to
+// the destination domain. The same defaults as for the transfer itself are applied. Transfers
+// which are settled on the local domain don't dispatch a message and are free.
+func (k *Keeper) quoteDispatch(ctx sdk.Context, token types.HypToken, destinationDomain uint32, payer sdk.AccAddress, customHookId *util.HexAddress, gasLimit math.Int, customHookMetadata []byte) (sdk.Coins, error) {
+	remoteRouter, err := k.targetRouter(ctx, token, destinationDomain, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	mailbox, err := k.coreKeeper.GetMailbox(ctx, token.OriginMailbox)
+	if err != nil {
+		return nil, err
+	}
+	if remoteRouter.ReceiverDomain == mailbox.LocalDomain {
+		return sdk.NewCoins(), nil
+	}
+
+	hookId := util.NewZeroAddress()
+	if customHookId != nil {
+		hookId = *customHookId
+	} else if token.HookId != nil {
+		hookId = *token.HookId
+	}
+	if len(customHookMetadata) == 0 {
+		customHookMetadata = token.HookMetadata
+	}
+
+	gas := remoteRouter.Gas
+	if !gasLimit.IsZero() {
+		gas = gasLimit
+	}
+
+	metadata := util.StandardHookMetadata{
+		GasLimit:           gas,
+		Address:            payer,
+		CustomHookMetadata: customHookMetadata,
+	}
+
+	return k.coreKeeper.QuoteDispatch(ctx, util.HexAddress(token.OriginMailbox), hookId, metadata, util.HyperlaneMessage{Destination: remoteRouter.ReceiverDomain})
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	warpibc "github.com/bcp-innovations/hyperlane-cosmos/x/warp/ibc"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_ibc_transfer.go
+
+* OnRecvPacket (valid) transfer without warp memo
+* OnRecvPacket (invalid) invalid warp memo
+* OnRecvPacket (invalid) non-existing token
+* OnRecvPacket (invalid) received denom does not match token
+* OnRecvPacket (invalid) max fee in other denom
+* OnRecvPacket (invalid) required payment exceeds max fee
+* OnRecvPacket (invalid) received amount does not cover fee
+* OnRecvPacket (valid) warp transfer (Collateral)
+
+*/
+
+// mockTransferModule credits the received tokens to the receiver, like ICS-20 does for returning tokens.
+type mockTransferModule struct {
+	porttypes.IBCModule
+
+	s        *i.KeeperTestSuite
+	received []transfertypes.FungibleTokenPacketData
+}
+
+func (m *mockTransferModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	Expect(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data)).To(Succeed())
+	m.received = append(m.received, data)
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	amount, _ := math.NewIntFromString(data.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	Expect(m.s.App().BankKeeper.MintCoins(ctx, banktypes.ModuleName, coins)).To(Succeed())
+	Expect(m.s.App().BankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.ModuleName, receiver, coins)).To(Succeed())
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+var _ = Describe("logic_ibc_transfer.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var transferModule *mockTransferModule
+	var middleware warpibc.IBCMiddleware
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	recipient := "0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647"
+	ibcSender := "osmo1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+
+		transferModule = &mockTransferModule{s: s}
+		middleware = warpibc.NewIBCMiddleware(transferModule, &s.App().WarpKeeper)
+	})
+
+	// recvPacket executes the middleware like core IBC, which discards all state changes on an error acknowledgement
+	recvPacket := func(amount int64, memo string) ibcexported.Acknowledgement {
+		data := transfertypes.NewFungibleTokenPacketData(
+			// the tokens are returning to this chain
+			fmt.Sprintf("transfer/channel-5/%s", denom), math.NewInt(amount).String(), ibcSender, sender.Address, memo,
+		)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-5", "transfer", "channel-0", clienttypes.ZeroHeight(), 0)
+
+		ctx, write := s.Ctx().CacheContext()
+		ack := middleware.OnRecvPacket(ctx, packet, sender.AccAddress)
+		if ack.Success() {
+			write()
+		}
+		return ack
+	}
+
+	warpMemo := func(tokenId string, maxFee string) string {
+		return fmt.Sprintf(`{"hyperlane_warp": {"token_id": "%s", "destination_domain": %d, "recipient": "%s", "max_fee": "%s"}}`,
+			tokenId, remoteRouter.ReceiverDomain, recipient, maxFee)
+	}
+
+	It("OnRecvPacket (valid) transfer without warp memo", func() {
+		// Act
+		ack := recvPacket(100, `{"wasm": {}}`)
+
+		// Assert
+		Expect(ack.Success()).To(BeTrue())
+		Expect(transferModule.received).To(HaveLen(1))
+		Expect(transferModule.received[0].Receiver).To(Equal(sender.Address))
+	})
+
+	It("OnRecvPacket (invalid) invalid warp memo", func() {
+		// Act
+		ack := recvPacket(100, `{"hyperlane_warp": {"token_id": "0x12"}}`)
+
+		// Assert
+		Expect(ack.Success()).To(BeFalse())
+		Expect(transferModule.received).To(BeEmpty())
+	})
+
+	It("OnRecvPacket (invalid) non-existing token", func() {
+		// Arrange
+		tokenId := "0x726f757465725f61707000000000000000000000000000010000000000000000"
+		intermediate := types.IbcIntermediateAddress("channel-0", ibcSender)
+
+		// Act
+		ack := recvPacket(2_000_000, warpMemo(tokenId, "1000000acoin"))
+
+		// Assert
+		Expect(ack.Success()).To(BeFalse())
+		Expect(transferModule.received).To(HaveLen(1))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), intermediate, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("OnRecvPacket (invalid) received denom does not match token", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+
+		// Act
+		ack := recvPacket(2_000_000, warpMemo(tokenId.String(), "1000000acoin"))
+
+		// Assert
+		Expect(ack.Success()).To(BeFalse())
+		Expect(string(ack.Acknowledgement())).To(ContainSubstring("error"))
+	})
+
+	It("OnRecvPacket (invalid) max fee in other denom", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		intermediate := types.IbcIntermediateAddress("channel-0", ibcSender)
+
+		// Act
+		ack := recvPacket(2_000_000, warpMemo(tokenId.String(), "1000000bcoin"))
+
+		// Assert
+		Expect(ack.Success()).To(BeFalse())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), intermediate, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("OnRecvPacket (invalid) required payment exceeds max fee", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		intermediate := types.IbcIntermediateAddress("channel-0", ibcSender)
+
+		// Act
+		ack := recvPacket(2_000_000, warpMemo(tokenId.String(), "249999acoin"))
+
+		// Assert
+		Expect(ack.Success()).To(BeFalse())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), intermediate, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("OnRecvPacket (invalid) received amount does not cover fee", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		intermediate := types.IbcIntermediateAddress("channel-0", ibcSender)
+
+		// Act
+		ack := recvPacket(250_000, warpMemo(tokenId.String(), "1000000acoin"))
+
+		// Assert
+		Expect(ack.Success()).To(BeFalse())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), intermediate, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("OnRecvPacket (valid) warp transfer (Collateral)", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		intermediate := types.IbcIntermediateAddress("channel-0", ibcSender)
+
+		// Act
+		ack := recvPacket(2_000_000, warpMemo(tokenId.String(), "1000000acoin"))
+
+		// Assert
+		Expect(ack.Success()).To(BeTrue())
+		Expect(transferModule.received).To(HaveLen(1))
+		Expect(transferModule.received[0].Receiver).To(Equal(intermediate.String()))
+
+		token, err := s.App().WarpKeeper.HypTokens.Get(s.Ctx(), tokenId.GetInternalId())
+		Expect(err).To(BeNil())
+		// only the quoted gas payment is deducted, the rest of the received amount is bridged
+		Expect(token.CollateralBalance).To(Equal(math.NewInt(1_750_000)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), intermediate, denom).Amount).To(Equal(math.ZeroInt()))
+	})
+})
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// enrolledRouter returns the router of the token for the domain with the given receiver contract.
+// The remote router of the domain is checked first, then the additional collateral routers.
+func (k *Keeper) enrolledRouter(ctx context.Context, tokenId util.HexAddress, domain uint32, receiverContract util.HexAddress) (types.RemoteRouter, error) {
+	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(tokenId.GetInternalId(), domain))
+	if err == nil && strings.ToLower(remoteRouter.ReceiverContract) == receiverContract.String() {
+		return remoteRouter, nil
+	}
+
+	collateralRouter, collateralErr := k.CollateralRouters.Get(ctx, collections.Join3(tokenId.GetInternalId(), domain, receiverContract.String()))
+	if collateralErr == nil {
+		return collateralRouter, nil
+	} else if !errors.Is(collateralErr, collections.ErrNotFound) {
+		return types.RemoteRouter{}, collateralErr
+	}
+
+	if err != nil {
+		return types.RemoteRouter{}, fmt.Errorf("no enrolled router found for origin %d", domain)
+	}
+	return types.RemoteRouter{}, fmt.Errorf("invalid receiver contract")
+}
+
+// targetRouter returns the router which receives a transfer to the destination domain. Without a
+// target router the remote router of the domain is used.
+func (k *Keeper) targetRouter(ctx context.Context, token types.HypToken, destinationDomain uint32, target *util.HexAddress) (types.RemoteRouter, error) {
+	if target == nil {
+		remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(token.Id.GetInternalId(), destinationDomain))
+		if err != nil {
+			return types.RemoteRouter{}, fmt.Errorf("no enrolled router found for destination domain %d", destinationDomain)
+		}
+		return remoteRouter, nil
+	}
+
+	remoteRouter, err := k.enrolledRouter(ctx, token.Id, destinationDomain, *target)
+	if err != nil {
+		return types.RemoteRouter{}, fmt.Errorf("router %s is not enrolled for destination domain %d", target.String(), destinationDomain)
+	}
+	return remoteRouter, nil
+}
+
+// settleLocalTransfer delivers a transfer to a collateral token on the local domain without a
+// Hyperlane message. The receiving token handles the message as if it was delivered by its mailbox,
+// so it must have enrolled the sending token as router for the local domain. Every settlement uses
+// its own nonce, so that the returned message IDs are unique.
+func (k *Keeper) settleLocalTransfer(ctx sdk.Context, token types.HypToken, localDomain uint32, receiverContract util.HexAddress, payload types.WarpPayload) (util.HexAddress, error) {
+	target, err := k.HypTokens.Get(ctx, receiverContract.GetInternalId())
+	if err != nil || target.Id != receiverContract {
+		return util.HexAddress{}, fmt.Errorf("token with id %s not found", receiverContract.String())
+	}
+
+	if target.TokenType != types.HYP_TOKEN_TYPE_COLLATERAL || target.OriginDenom != token.OriginDenom {
+		return util.HexAddress{}, fmt.Errorf("token %s is not a collateral token of %s", target.Id.String(), token.OriginDenom)
+	}
+
+	nonce, err := k.LocalSettlements.Next(ctx)
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	message := util.HyperlaneMessage{
+		Version:     coreTypes.MESSAGE_VERSION,
+		Nonce:       uint32(nonce),
+		Origin:      localDomain,
+		Sender:      token.Id,
+		Destination: localDomain,
+		Recipient:   target.Id,
+		Body:        payload.Bytes(),
+	}
+
+	if err := k.Handle(ctx, target.OriginMailbox, message); err != nil {
+		return util.HexAddress{}, err
+	}
+
+	return message.Id(), nil
+}
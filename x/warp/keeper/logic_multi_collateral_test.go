@@ -0,0 +1,398 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_multi_collateral.go
+
+* MsgEnrollCollateralRouter (invalid) non-owner address
+* MsgEnrollCollateralRouter (invalid) synthetic token
+* MsgEnrollCollateralRouter (invalid) invalid receiver contract
+* MsgRemoteTransfer (valid) settles locally with collateral token of the same denom
+* MsgRemoteTransfer (valid) local settlements have unique message ids
+* MsgRemoteTransfer (invalid) local collateral token did not enroll the sender
+* MsgRemoteTransfer (valid) to collateral router of remote domain
+* MsgRemoteTransfer (invalid) target router is not enrolled
+* MsgProcessMessage (valid) from collateral router of remote domain
+* MsgUnrollCollateralRouter (valid)
+* QueryRoutesByDenom (valid)
+* QueryRoutesByDenom (valid) paginates over the tokens of the denom
+
+*/
+
+var _ = Describe("logic_multi_collateral.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var mailboxId util.HexAddress
+	var tokenA util.HexAddress
+	var tokenB util.HexAddress
+
+	const localDomain = 0
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	collateralRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x00000000000000000000000073a6e2eb4fcb8d1e6c0f1bcbd2e8d2b5c2b66c6f",
+		Gas:              math.NewInt(50000),
+	}
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	maxFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	createCollateralToken := func() util.HexAddress {
+		res, err := s.RunTx(&types.MsgCreateCollateralToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			OriginDenom:   denom,
+		})
+		Expect(err).To(BeNil())
+
+		var response types.MsgCreateCollateralTokenResponse
+		Expect(proto.Unmarshal(res.MsgResponses[0].Value, &response)).To(Succeed())
+		return response.Id
+	}
+
+	enroll := func(tokenId util.HexAddress, router types.RemoteRouter) {
+		_, err := s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:        owner.Address,
+			TokenId:      tokenId,
+			RemoteRouter: &router,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	enrollCollateral := func(tokenId util.HexAddress, router types.RemoteRouter) error {
+		_, err := s.RunTx(&types.MsgEnrollCollateralRouter{
+			Owner:        owner.Address,
+			TokenId:      tokenId,
+			RemoteRouter: &router,
+		})
+		return err
+	}
+
+	localRouter := func(tokenId util.HexAddress) types.RemoteRouter {
+		return types.RemoteRouter{
+			ReceiverDomain:   localDomain,
+			ReceiverContract: tokenId.String(),
+			Gas:              math.ZeroInt(),
+		}
+	}
+
+	remoteTransfer := func(tokenId util.HexAddress, domain uint32, targetRouter *util.HexAddress, amount int64) (*sdk.Result, error) {
+		return s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: domain,
+			TargetRouter:      targetRouter,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+	}
+
+	collateralBalance := func(tokenId util.HexAddress) math.Int {
+		token, err := s.App().WarpKeeper.HypTokens.Get(s.Ctx(), tokenId.GetInternalId())
+		Expect(err).To(BeNil())
+		return token.CollateralBalance
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 10_000_000)
+		Expect(err).To(BeNil())
+
+		mailboxId, _, _ = createValidMailbox(s, owner.Address, "noop", 1)
+		tokenA = createCollateralToken()
+		tokenB = createCollateralToken()
+		enroll(tokenA, remoteRouter)
+		enroll(tokenB, remoteRouter)
+	})
+
+	It("MsgEnrollCollateralRouter (invalid) non-owner address", func() {
+		// Act
+		_, err := s.RunTx(&types.MsgEnrollCollateralRouter{
+			Owner:        sender.Address,
+			TokenId:      tokenA,
+			RemoteRouter: &collateralRouter,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(sender.Address + " does not own token with id " + tokenA.String()))
+	})
+
+	It("MsgEnrollCollateralRouter (invalid) synthetic token", func() {
+		// Arrange
+		res, err := s.RunTx(&types.MsgCreateSyntheticToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+		})
+		Expect(err).To(BeNil())
+		var response types.MsgCreateSyntheticTokenResponse
+		Expect(proto.Unmarshal(res.MsgResponses[0].Value, &response)).To(Succeed())
+
+		// Act
+		err = enrollCollateral(response.Id, collateralRouter)
+
+		// Assert
+		Expect(err.Error()).To(Equal("collateral routers can only be enrolled for collateral tokens"))
+	})
+
+	It("MsgEnrollCollateralRouter (invalid) invalid receiver contract", func() {
+		// Arrange
+		router := collateralRouter
+		router.ReceiverContract = "0x1234"
+
+		// Act
+		err := enrollCollateral(tokenA, router)
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid receiver contract"))
+	})
+
+	It("MsgRemoteTransfer (valid) settles locally with collateral token of the same denom", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, localRouter(tokenB))).To(Succeed())
+		enroll(tokenB, localRouter(tokenA))
+		// provide collateral for token B
+		_, err := remoteTransfer(tokenB, remoteRouter.ReceiverDomain, nil, 1_000)
+		Expect(err).To(BeNil())
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount
+		recipient := sdk.AccAddress(receiverAddress.Bytes())
+
+		// Act
+		res, err := remoteTransfer(tokenA, localDomain, &tokenB, 100)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(collateralBalance(tokenA)).To(Equal(math.NewInt(100)))
+		Expect(collateralBalance(tokenB)).To(Equal(math.NewInt(900)))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient, denom).Amount).To(Equal(math.NewInt(100)))
+		// no hyperlane message is dispatched, so no gas payment is charged
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom).Amount).To(Equal(senderBalance.SubRaw(100)))
+		for _, event := range res.Events {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+			_, isDispatch := typedEvent.(*coreTypes.Dispatch)
+			Expect(isDispatch).To(BeFalse())
+		}
+	})
+
+	It("MsgRemoteTransfer (valid) local settlements have unique message ids", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, localRouter(tokenB))).To(Succeed())
+		enroll(tokenB, localRouter(tokenA))
+		_, err := remoteTransfer(tokenB, remoteRouter.ReceiverDomain, nil, 1_000)
+		Expect(err).To(BeNil())
+
+		messageId := func(res *sdk.Result) util.HexAddress {
+			var response types.MsgRemoteTransferResponse
+			Expect(proto.Unmarshal(res.MsgResponses[0].Value, &response)).To(Succeed())
+			return response.MessageId
+		}
+
+		// Act
+		first, err := remoteTransfer(tokenA, localDomain, &tokenB, 100)
+		Expect(err).To(BeNil())
+		second, err := remoteTransfer(tokenA, localDomain, &tokenB, 100)
+		Expect(err).To(BeNil())
+
+		// Assert
+		Expect(messageId(first)).NotTo(Equal(messageId(second)))
+		Expect(collateralBalance(tokenB)).To(Equal(math.NewInt(800)))
+	})
+
+	It("MsgRemoteTransfer (invalid) local collateral token did not enroll the sender", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, localRouter(tokenB))).To(Succeed())
+		_, err := remoteTransfer(tokenB, remoteRouter.ReceiverDomain, nil, 1_000)
+		Expect(err).To(BeNil())
+
+		// Act
+		_, err = remoteTransfer(tokenA, localDomain, &tokenB, 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("no enrolled router found for origin 0"))
+		Expect(collateralBalance(tokenA)).To(Equal(math.ZeroInt()))
+		Expect(collateralBalance(tokenB)).To(Equal(math.NewInt(1_000)))
+	})
+
+	It("MsgRemoteTransfer (valid) to collateral router of remote domain", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, collateralRouter)).To(Succeed())
+		target, err := util.DecodeHexAddress(collateralRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		// Act
+		res, err := remoteTransfer(tokenA, collateralRouter.ReceiverDomain, &target, 100)
+
+		// Assert
+		Expect(err).To(BeNil())
+		var dispatched *util.HyperlaneMessage
+		for _, event := range res.Events {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				continue
+			}
+			if dispatch, ok := typedEvent.(*coreTypes.Dispatch); ok {
+				raw, err := util.DecodeEthHex(dispatch.Message)
+				Expect(err).To(BeNil())
+				message, err := util.ParseHyperlaneMessage(raw)
+				Expect(err).To(BeNil())
+				dispatched = &message
+			}
+		}
+		Expect(dispatched).NotTo(BeNil())
+		Expect(dispatched.Recipient).To(Equal(target))
+		Expect(collateralBalance(tokenA)).To(Equal(math.NewInt(100)))
+	})
+
+	It("MsgRemoteTransfer (invalid) target router is not enrolled", func() {
+		// Arrange
+		target, err := util.DecodeHexAddress(collateralRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		// Act
+		_, err = remoteTransfer(tokenA, collateralRouter.ReceiverDomain, &target, 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("router " + target.String() + " is not enrolled for destination domain 1"))
+	})
+
+	It("MsgProcessMessage (valid) from collateral router of remote domain", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, collateralRouter)).To(Succeed())
+		_, err := remoteTransfer(tokenA, remoteRouter.ReceiverDomain, nil, 1_000)
+		Expect(err).To(BeNil())
+
+		sender32, err := util.DecodeHexAddress(collateralRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+		warpPayload, err := types.NewWarpPayload(sender.AccAddress, *big.NewInt(100))
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      collateralRouter.ReceiverDomain,
+			Sender:      sender32,
+			Destination: localDomain,
+			Recipient:   tokenA,
+			Body:        warpPayload.Bytes(),
+		}
+
+		// Act
+		_, err = s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(collateralBalance(tokenA)).To(Equal(math.NewInt(900)))
+	})
+
+	It("MsgUnrollCollateralRouter (valid)", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, collateralRouter)).To(Succeed())
+
+		// Act
+		_, err := s.RunTx(&types.MsgUnrollCollateralRouter{
+			Owner:            owner.Address,
+			TokenId:          tokenA,
+			ReceiverDomain:   collateralRouter.ReceiverDomain,
+			ReceiverContract: collateralRouter.ReceiverContract,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		key := collections.Join3(tokenA.GetInternalId(), collateralRouter.ReceiverDomain, collateralRouter.ReceiverContract)
+		has, err := s.App().WarpKeeper.CollateralRouters.Has(s.Ctx(), key)
+		Expect(err).To(BeNil())
+		Expect(has).To(BeFalse())
+	})
+
+	It("QueryRoutesByDenom (valid)", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, collateralRouter)).To(Succeed())
+		Expect(enrollCollateral(tokenA, localRouter(tokenB))).To(Succeed())
+
+		// Act
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).RoutesByDenom(s.Ctx(), &types.QueryRoutesByDenomRequest{Denom: denom})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(res.Routes).To(HaveLen(4))
+
+		collateralRoutes := 0
+		for _, route := range res.Routes {
+			Expect(route.TokenType).To(Equal(types.HYP_TOKEN_TYPE_COLLATERAL))
+			if route.CollateralRouter {
+				collateralRoutes++
+				Expect(route.TokenId).To(Equal(tokenA))
+			}
+		}
+		Expect(collateralRoutes).To(Equal(2))
+
+		res, err = keeper.NewQueryServerImpl(s.App().WarpKeeper).RoutesByDenom(s.Ctx(), &types.QueryRoutesByDenomRequest{Denom: "other"})
+		Expect(err).To(BeNil())
+		Expect(res.Routes).To(BeEmpty())
+	})
+
+	It("QueryRoutesByDenom (valid) paginates over the tokens of the denom", func() {
+		// Arrange
+		Expect(enrollCollateral(tokenA, collateralRouter)).To(Succeed())
+		queryServer := keeper.NewQueryServerImpl(s.App().WarpKeeper)
+
+		// Act
+		first, err := queryServer.RoutesByDenom(s.Ctx(), &types.QueryRoutesByDenomRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		Expect(err).To(BeNil())
+		second, err := queryServer.RoutesByDenom(s.Ctx(), &types.QueryRoutesByDenomRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 1},
+		})
+		Expect(err).To(BeNil())
+
+		// Assert
+		Expect(first.Routes).To(HaveLen(2))
+		for _, route := range first.Routes {
+			Expect(route.TokenId).To(Equal(tokenA))
+		}
+		Expect(first.Pagination.NextKey).NotTo(BeNil())
+
+		Expect(second.Routes).To(HaveLen(1))
+		Expect(second.Routes[0].TokenId).To(Equal(tokenB))
+		Expect(second.Pagination.NextKey).To(BeNil())
+	})
+})
@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - pause and unpause of warp tokens
+
+* MsgSetToken (invalid) new guardian and remove guardian
+* MsgSetToken (valid) set and remove guardian
+* MsgPauseToken (invalid) non-owner and non-guardian address
+* MsgPauseToken (valid) by owner
+* MsgPauseToken (valid) by guardian
+* MsgUnpauseToken (invalid) by guardian
+* MsgRemoteTransfer (invalid) paused token
+* MsgRemoteTransfer (invalid) paused domain
+* MsgProcessMessage (invalid) paused token and retry after unpause
+
+*/
+
+var _ = Describe("logic_pause", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+	var guardian i.TestValidatorAddress
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	maxFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		guardian = i.GenerateTestValidatorAddress("Guardian")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 10_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	setGuardian := func(tokenId util.HexAddress) {
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:       owner.Address,
+			TokenId:     tokenId,
+			NewGuardian: guardian.Address,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	queryToken := func(tokenId util.HexAddress) *types.WrappedHypToken {
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).Token(s.Ctx(), &types.QueryTokenRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		return res.Token
+	}
+
+	remoteTransfer := func(tokenId util.HexAddress, amount int64) error {
+		_, err := s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+		return err
+	}
+
+	processMessage := func(tokenId, mailboxId util.HexAddress, amount int64) error {
+		receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		warpPayload, err := types.NewWarpPayload(sender.AccAddress, *big.NewInt(amount))
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      receiverContract,
+			Destination: 0,
+			Recipient:   tokenId,
+			Body:        warpPayload.Bytes(),
+		}
+
+		_, err = s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+		return err
+	}
+
+	It("MsgSetToken (invalid) new guardian and remove guardian", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:          owner.Address,
+			TokenId:        tokenId,
+			NewGuardian:    guardian.Address,
+			RemoveGuardian: true,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("cannot set new guardian and remove guardian at the same time"))
+		Expect(queryToken(tokenId).Guardian).To(BeEmpty())
+	})
+
+	It("MsgSetToken (valid) set and remove guardian", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		setGuardian(tokenId)
+		Expect(queryToken(tokenId).Guardian).To(Equal(guardian.Address))
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:          owner.Address,
+			TokenId:        tokenId,
+			RemoveGuardian: true,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(queryToken(tokenId).Guardian).To(BeEmpty())
+	})
+
+	It("MsgPauseToken (invalid) non-owner and non-guardian address", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		setGuardian(tokenId)
+
+		// Act
+		_, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  sender.Address,
+			TokenId: tokenId,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(sender.Address + " is neither owner nor guardian of token with id " + tokenId.String()))
+		Expect(queryToken(tokenId).Paused).To(BeFalse())
+	})
+
+	It("MsgPauseToken (valid) by owner", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		res, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  owner.Address,
+			TokenId: tokenId,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(queryToken(tokenId).Paused).To(BeTrue())
+
+		var event *types.TokenPaused
+		for _, e := range res.Events {
+			typedEvent, err := sdk.ParseTypedEvent(e)
+			if err != nil {
+				continue
+			}
+			if paused, ok := typedEvent.(*types.TokenPaused); ok {
+				event = paused
+			}
+		}
+		Expect(event).NotTo(BeNil())
+		Expect(event.TokenId).To(Equal(tokenId.String()))
+		Expect(event.Sender).To(Equal(owner.Address))
+	})
+
+	It("MsgPauseToken (valid) by guardian", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		setGuardian(tokenId)
+
+		// Act
+		_, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  guardian.Address,
+			TokenId: tokenId,
+			Domains: []uint32{2, 1, 2},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		token := queryToken(tokenId)
+		Expect(token.Paused).To(BeFalse())
+		Expect(token.PausedDomains).To(Equal([]uint32{1, 2}))
+	})
+
+	It("MsgUnpauseToken (invalid) by guardian", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		setGuardian(tokenId)
+		_, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  guardian.Address,
+			TokenId: tokenId,
+		})
+		Expect(err).To(BeNil())
+
+		// Act
+		_, err = s.RunTx(&types.MsgUnpauseToken{
+			Owner:   guardian.Address,
+			TokenId: tokenId,
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(guardian.Address + " does not own token with id " + tokenId.String()))
+		Expect(queryToken(tokenId).Paused).To(BeTrue())
+	})
+
+	It("MsgRemoteTransfer (invalid) paused token", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		_, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  owner.Address,
+			TokenId: tokenId,
+		})
+		Expect(err).To(BeNil())
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)
+
+		// Act
+		err = remoteTransfer(tokenId, 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("token " + tokenId.String() + " is paused for domain 1: token paused"))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)).To(Equal(senderBalance))
+
+		_, err = s.RunTx(&types.MsgUnpauseToken{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+		})
+		Expect(err).To(BeNil())
+		Expect(remoteTransfer(tokenId, 100)).To(Succeed())
+	})
+
+	It("MsgRemoteTransfer (invalid) paused domain", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		_, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  owner.Address,
+			TokenId: tokenId,
+			Domains: []uint32{remoteRouter.ReceiverDomain},
+		})
+		Expect(err).To(BeNil())
+
+		// Act
+		err = remoteTransfer(tokenId, 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("token " + tokenId.String() + " is paused for domain 1: token paused"))
+
+		_, err = s.RunTx(&types.MsgUnpauseToken{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+			Domains: []uint32{remoteRouter.ReceiverDomain},
+		})
+		Expect(err).To(BeNil())
+		Expect(queryToken(tokenId).PausedDomains).To(BeEmpty())
+		Expect(remoteTransfer(tokenId, 100)).To(Succeed())
+	})
+
+	It("MsgProcessMessage (invalid) paused token and retry after unpause", func() {
+		// Arrange
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		_, err := s.RunTx(&types.MsgPauseToken{
+			Sender:  owner.Address,
+			TokenId: tokenId,
+		})
+		Expect(err).To(BeNil())
+		syntheticDenom := "hyperlane/" + tokenId.String()
+
+		// Act
+		err = processMessage(tokenId, mailboxId, 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("token " + tokenId.String() + " is paused for domain 1: token paused"))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.ZeroInt()))
+
+		_, err = s.RunTx(&types.MsgUnpauseToken{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+		})
+		Expect(err).To(BeNil())
+		Expect(processMessage(tokenId, mailboxId, 100)).To(Succeed())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(math.NewInt(100)))
+	})
+})
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// consumeRateLimit subtracts the amount from the inbound or outbound capacity of the route.
+// Routes without a rate limit are not restricted.
+func (k *Keeper) consumeRateLimit(ctx context.Context, tokenId util.HexAddress, domain uint32, inbound bool, amount math.Int) error {
+	key := collections.Join(tokenId.GetInternalId(), domain)
+
+	rateLimit, err := k.RateLimits.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if inbound {
+		if !rateLimit.Inbound.Enabled() {
+			return nil
+		}
+		rateLimit.InboundState, err = rateLimit.Inbound.Consume(rateLimit.InboundState, amount, now)
+	} else {
+		if !rateLimit.Outbound.Enabled() {
+			return nil
+		}
+		rateLimit.OutboundState, err = rateLimit.Outbound.Consume(rateLimit.OutboundState, amount, now)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.RateLimits.Set(ctx, key, rateLimit)
+}
+
+// newRateLimitState returns the state of an updated rate limit. The capacity which is already
+// used in the current window is kept, a newly enabled rate limit starts with its full capacity.
+func newRateLimitState(previous types.RateLimitConfig, state types.RateLimitState, updated types.RateLimitConfig, now int64) types.RateLimitState {
+	if !updated.Enabled() {
+		return types.RateLimitState{Capacity: math.ZeroInt(), LastUpdated: now}
+	}
+
+	capacity := updated.MaxCapacity
+	if previous.Enabled() {
+		capacity = math.MinInt(previous.CurrentCapacity(state, now), updated.MaxCapacity)
+	}
+
+	return types.RateLimitState{Capacity: capacity, LastUpdated: now}
+}
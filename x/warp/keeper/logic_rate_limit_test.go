@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_rate_limit.go
+
+* MsgSetRateLimit (invalid) non-owner address
+* MsgSetRateLimit (invalid) non-enrolled remote router
+* MsgSetRateLimit (invalid) missing window
+* MsgSetRateLimit (valid)
+* MsgSetRateLimit (valid) remove rate limit
+* MsgRemoteTransfer (invalid) exceeds outbound rate limit
+* MsgRemoteTransfer (valid) outbound capacity refills over the window
+* MsgProcessMessage (invalid) exceeds inbound rate limit
+* MsgUnrollRemoteRouter (valid) removes rate limit
+
+*/
+
+var _ = Describe("logic_rate_limit.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+	var sender i.TestValidatorAddress
+
+	remoteRouter := types.RemoteRouter{
+		ReceiverDomain:   1,
+		ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+		Gas:              math.NewInt(50000),
+	}
+	receiverAddress, _ := util.DecodeHexAddress("0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647")
+	maxFee := sdk.NewCoin(denom, math.NewInt(250000))
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		sender = i.GenerateTestValidatorAddress("Sender")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+		err = s.MintBaseCoins(sender.Address, 10_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	setRateLimit := func(tokenId util.HexAddress, inbound, outbound int64, window uint64) error {
+		_, err := s.RunTx(&types.MsgSetRateLimit{
+			Owner:    owner.Address,
+			TokenId:  tokenId,
+			Domain:   remoteRouter.ReceiverDomain,
+			Inbound:  types.RateLimitConfig{MaxCapacity: math.NewInt(inbound), WindowSeconds: window},
+			Outbound: types.RateLimitConfig{MaxCapacity: math.NewInt(outbound), WindowSeconds: window},
+		})
+		return err
+	}
+
+	queryRateLimits := func(tokenId util.HexAddress) []types.RateLimitStatus {
+		res, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).RateLimits(s.Ctx(), &types.QueryRateLimitsRequest{Id: tokenId.String()})
+		Expect(err).To(BeNil())
+		return res.RateLimits
+	}
+
+	remoteTransfer := func(tokenId util.HexAddress, amount int64) error {
+		_, err := s.RunTx(&types.MsgRemoteTransfer{
+			Sender:            sender.Address,
+			TokenId:           tokenId,
+			DestinationDomain: remoteRouter.ReceiverDomain,
+			Recipient:         receiverAddress,
+			Amount:            math.NewInt(amount),
+			GasLimit:          math.ZeroInt(),
+			MaxFee:            maxFee,
+		})
+		return err
+	}
+
+	processMessage := func(tokenId, mailboxId util.HexAddress, amount int64) error {
+		receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		warpPayload, err := types.NewWarpPayload(sender.AccAddress, *big.NewInt(amount))
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      receiverContract,
+			Destination: 0,
+			Recipient:   tokenId,
+			Body:        warpPayload.Bytes(),
+		}
+
+		_, err = s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+		return err
+	}
+
+	It("MsgSetRateLimit (invalid) non-owner address", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetRateLimit{
+			Owner:    sender.Address,
+			TokenId:  tokenId,
+			Domain:   remoteRouter.ReceiverDomain,
+			Inbound:  types.RateLimitConfig{MaxCapacity: math.NewInt(100), WindowSeconds: 100},
+			Outbound: types.RateLimitConfig{MaxCapacity: math.NewInt(100), WindowSeconds: 100},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal(sender.Address + " does not own token with id " + tokenId.String()))
+		Expect(queryRateLimits(tokenId)).To(BeEmpty())
+	})
+
+	It("MsgSetRateLimit (invalid) non-enrolled remote router", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, nil, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		err := setRateLimit(tokenId, 100, 100, 100)
+
+		// Assert
+		Expect(err.Error()).To(Equal("failed to find remote router for domain 1"))
+	})
+
+	It("MsgSetRateLimit (invalid) missing window", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		err := setRateLimit(tokenId, 100, 0, 0)
+
+		// Assert
+		Expect(err.Error()).To(Equal("invalid inbound rate limit: rate limit window must be greater than zero"))
+	})
+
+	It("MsgSetRateLimit (valid)", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		err := setRateLimit(tokenId, 100, 200, 3600)
+
+		// Assert
+		Expect(err).To(BeNil())
+		rateLimits := queryRateLimits(tokenId)
+		Expect(rateLimits).To(HaveLen(1))
+		Expect(rateLimits[0].Domain).To(Equal(remoteRouter.ReceiverDomain))
+		Expect(rateLimits[0].Inbound.WindowSeconds).To(Equal(uint64(3600)))
+		Expect(rateLimits[0].InboundRemaining).To(Equal(math.NewInt(100)))
+		Expect(rateLimits[0].OutboundRemaining).To(Equal(math.NewInt(200)))
+	})
+
+	It("MsgSetRateLimit (valid) remove rate limit", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		err := setRateLimit(tokenId, 100, 100, 3600)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = setRateLimit(tokenId, 0, 0, 0)
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(queryRateLimits(tokenId)).To(BeEmpty())
+		Expect(remoteTransfer(tokenId, 1000)).To(Succeed())
+	})
+
+	It("MsgRemoteTransfer (invalid) exceeds outbound rate limit", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		err := setRateLimit(tokenId, 0, 100, 3600)
+		Expect(err).To(BeNil())
+		senderBalance := s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)
+
+		// Act
+		err = remoteTransfer(tokenId, 101)
+
+		// Assert
+		Expect(err.Error()).To(Equal("amount 101 exceeds remaining capacity 100: rate limit exceeded"))
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, denom)).To(Equal(senderBalance))
+	})
+
+	It("MsgRemoteTransfer (valid) outbound capacity refills over the window", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		err := setRateLimit(tokenId, 0, 100, 100)
+		Expect(err).To(BeNil())
+		Expect(remoteTransfer(tokenId, 100)).To(Succeed())
+		Expect(remoteTransfer(tokenId, 1)).NotTo(Succeed())
+
+		// Act
+		s.CommitAfterSeconds(50)
+
+		// Assert
+		Expect(queryRateLimits(tokenId)[0].OutboundRemaining).To(Equal(math.NewInt(50)))
+		Expect(remoteTransfer(tokenId, 51)).NotTo(Succeed())
+		Expect(remoteTransfer(tokenId, 50)).To(Succeed())
+		Expect(queryRateLimits(tokenId)[0].OutboundRemaining).To(Equal(math.ZeroInt()))
+
+		s.CommitAfterSeconds(1000)
+		Expect(queryRateLimits(tokenId)[0].OutboundRemaining).To(Equal(math.NewInt(100)))
+	})
+
+	It("MsgProcessMessage (invalid) exceeds inbound rate limit", func() {
+		// Arrange
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		Expect(remoteTransfer(tokenId, 100)).To(Succeed())
+		err := setRateLimit(tokenId, 50, 0, 3600)
+		Expect(err).To(BeNil())
+
+		// Act
+		err = processMessage(tokenId, mailboxId, 100)
+
+		// Assert
+		Expect(err.Error()).To(ContainSubstring("amount 100 exceeds remaining capacity 50: rate limit exceeded"))
+		Expect(processMessage(tokenId, mailboxId, 50)).To(Succeed())
+		Expect(queryRateLimits(tokenId)[0].InboundRemaining).To(Equal(math.ZeroInt()))
+	})
+
+	It("MsgUnrollRemoteRouter (valid) removes rate limit", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+		err := setRateLimit(tokenId, 100, 100, 3600)
+		Expect(err).To(BeNil())
+
+		// Act
+		_, err = s.RunTx(&types.MsgUnrollRemoteRouter{
+			Owner:          owner.Address,
+			TokenId:        tokenId,
+			ReceiverDomain: remoteRouter.ReceiverDomain,
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(queryRateLimits(tokenId)).To(BeEmpty())
+	})
+})
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -10,30 +11,45 @@ import (
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // RemoteTransferSynthetic handles the transfer of synthetic tokens to a remote chain.
 // It burns the specified amount of tokens, then dispatches a message to the destination
 // with the required gas limit, fee, and custom metadata.
 func (k *Keeper) RemoteTransferSynthetic(ctx sdk.Context, token types.HypToken, cosmosSender string, destinationDomain uint32, recipient util.HexAddress, amount math.Int, customHookId *util.HexAddress, gasLimit math.Int, maxFee sdk.Coin, customHookMetadata []byte) (messageId util.HexAddress, err error) {
+	if err := token.AssertNotPaused(destinationDomain); err != nil {
+		return util.HexAddress{}, err
+	}
+
 	senderAcc, err := sdk.AccAddressFromBech32(cosmosSender)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, amount)))
+	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(token.Id.GetInternalId(), destinationDomain))
+	if err != nil {
+		return util.HexAddress{}, fmt.Errorf("no enrolled router found for destination domain %d", destinationDomain)
+	}
+
+	// the bridge fee is deducted from the amount, only the remaining amount is bridged
+	fee, amount, err := k.bridgeFee(ctx, token, remoteRouter, amount)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
 
-	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, amount)))
+	if err = k.chargeBridgeFee(ctx, token, senderAcc, fee); err != nil {
+		return util.HexAddress{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, amount)))
 	if err != nil {
 		return util.HexAddress{}, err
 	}
 
-	remoteRouter, err := k.EnrolledRouters.Get(ctx, collections.Join(token.Id.GetInternalId(), destinationDomain))
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.OriginDenom, amount)))
 	if err != nil {
-		return util.HexAddress{}, fmt.Errorf("no enrolled router found for destination domain %d", destinationDomain)
+		return util.HexAddress{}, err
 	}
 
 	receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
@@ -46,11 +62,28 @@ func (k *Keeper) RemoteTransferSynthetic(ctx sdk.Context, token types.HypToken,
 		gas = gasLimit
 	}
 
-	warpPayload, err := types.NewWarpPayload(recipient.Bytes(), *amount.BigInt())
+	if err := k.consumeRateLimit(ctx, token.Id, remoteRouter.ReceiverDomain, false, amount); err != nil {
+		return util.HexAddress{}, err
+	}
+
+	remoteAmount, err := types.ToRemoteAmount(amount, token.Decimals, remoteRouter.Decimals)
 	if err != nil {
 		return util.HexAddress{}, err
 	}
 
+	warpPayload, err := types.NewWarpPayload(recipient.Bytes(), *remoteAmount.BigInt())
+	if err != nil {
+		return util.HexAddress{}, err
+	}
+
+	// the default hook of the token is used if the sender doesn't provide a custom hook
+	if customHookId == nil {
+		customHookId = token.HookId
+	}
+	if len(customHookMetadata) == 0 {
+		customHookMetadata = token.HookMetadata
+	}
+
 	// Token destinationDomain, recipientAddress
 	dispatchMsg, err := k.coreKeeper.DispatchMessage(
 		ctx,
@@ -78,13 +111,14 @@ func (k *Keeper) RemoteTransferSynthetic(ctx sdk.Context, token types.HypToken,
 
 // RemoteReceiveSynthetic handles the receipt of synthetic tokens from a remote chain.
 // It mints the synthetic token and transfers it to the recipient's account.
-func (k *Keeper) RemoteReceiveSynthetic(ctx context.Context, token types.HypToken, payload types.WarpPayload) error {
-	account := payload.GetCosmosAccount()
+// The amount is expected in local units.
+func (k *Keeper) RemoteReceiveSynthetic(ctx context.Context, token types.HypToken, payload types.WarpPayload, amount math.Int) error {
+	account, err := k.receiverAccount(payload)
+	if err != nil {
+		return err
+	}
 
-	shadowToken := sdk.NewCoin(
-		token.OriginDenom,
-		math.NewIntFromBigInt(payload.Amount()),
-	)
+	shadowToken := sdk.NewCoin(token.OriginDenom, amount)
 
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shadowToken)); err != nil {
 		return err
@@ -96,3 +130,34 @@ func (k *Keeper) RemoteReceiveSynthetic(ctx context.Context, token types.HypToke
 
 	return nil
 }
+
+// SetSyntheticTokenMetadata stores the display metadata of a synthetic token as bank denom metadata.
+// The display denom unit is derived from the symbol and the decimals of the token.
+func (k *Keeper) SetSyntheticTokenMetadata(ctx context.Context, token types.HypToken, metadata types.TokenMetadata) error {
+	if token.TokenType != types.HYP_TOKEN_TYPE_SYNTHETIC {
+		return fmt.Errorf("metadata can only be set for synthetic tokens")
+	}
+
+	denomUnits := []*banktypes.DenomUnit{{Denom: token.OriginDenom, Exponent: 0}}
+	display := token.OriginDenom
+	if token.Decimals > 0 {
+		display = strings.ToLower(metadata.Symbol)
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{Denom: display, Exponent: token.Decimals})
+	}
+
+	denomMetadata := banktypes.Metadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        token.OriginDenom,
+		Display:     display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		URI:         metadata.Uri,
+	}
+	if err := denomMetadata.Validate(); err != nil {
+		return fmt.Errorf("invalid token metadata: %w", err)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, denomMetadata)
+	return nil
+}
@@ -9,8 +9,12 @@ import (
 	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
 	"github.com/bcp-innovations/hyperlane-cosmos/util"
 	coreTypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
 	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -29,6 +33,11 @@ TEST CASES - logic_synthetic.go
 * MsgRemoteTransfer (invalid) insufficient funds (Synthetic)
 * MsgRemoteTransfer (valid) (Synthetic)
 * MsgRemoteTransfer && MsgRemoteReceiveSynthetic (valid) (Synthetic)
+* MsgRemoteReceiveSynthetic (valid) 32-byte recipient (Synthetic)
+* MsgCreateSyntheticToken (invalid) invalid metadata
+* MsgCreateSyntheticToken (valid) with metadata
+* MsgSetToken (invalid) metadata for collateral token
+* MsgSetToken (valid) update metadata
 
 */
 
@@ -351,4 +360,156 @@ var _ = Describe("logic_synthetic.go", Ordered, func() {
 		Expect(err).To(BeNil())
 		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sender.AccAddress, syntheticDenom).Amount).To(Equal(senderBalance.Amount.Add(amount)))
 	})
+
+	It("MsgRemoteReceiveSynthetic (valid) 32-byte recipient (Synthetic)", func() {
+		// Arrange
+		remoteRouter := types.RemoteRouter{
+			ReceiverDomain:   1,
+			ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+			Gas:              math.NewInt(50000),
+		}
+		amount := math.NewInt(100)
+
+		tokenId, mailboxId, _, _ := createToken(s, &remoteRouter, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := "hyperlane/" + tokenId.String()
+
+		recipient := sdk.AccAddress(address.Module("wasm", []byte("contract")))
+		Expect(recipient).To(HaveLen(32))
+
+		receiverContract, err := util.DecodeHexAddress(remoteRouter.ReceiverContract)
+		Expect(err).To(BeNil())
+
+		warpPayload, err := types.NewWarpPayload(recipient, *big.NewInt(amount.Int64()))
+		Expect(err).To(BeNil())
+
+		message := util.HyperlaneMessage{
+			Version:     3,
+			Nonce:       1,
+			Origin:      remoteRouter.ReceiverDomain,
+			Sender:      receiverContract,
+			Destination: 0,
+			Recipient:   tokenId,
+			Body:        warpPayload.Bytes(),
+		}
+
+		// Act
+		_, err = s.RunTx(&coreTypes.MsgProcessMessage{
+			MailboxId: mailboxId,
+			Relayer:   sender.Address,
+			Metadata:  "",
+			Message:   message.String(),
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), recipient, syntheticDenom).Amount).To(Equal(amount))
+		// the 32-byte recipient must not be truncated to its last 20 bytes
+		Expect(s.App().BankKeeper.GetBalance(s.Ctx(), sdk.AccAddress(recipient[12:32]), syntheticDenom).Amount).To(Equal(math.ZeroInt()))
+	})
+
+	It("MsgCreateSyntheticToken (invalid) invalid metadata", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+
+		// Act
+		_, err := s.RunTx(&types.MsgCreateSyntheticToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			Decimals:      18,
+			Metadata: &types.TokenMetadata{
+				Name: "Ether",
+			},
+		})
+
+		// Assert
+		Expect(err.Error()).To(ContainSubstring("invalid token metadata"))
+		tokens, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).Tokens(s.Ctx(), &types.QueryTokensRequest{})
+		Expect(err).To(BeNil())
+		Expect(tokens.Tokens).To(BeEmpty())
+	})
+
+	It("MsgCreateSyntheticToken (valid) with metadata", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+
+		// Act
+		res, err := s.RunTx(&types.MsgCreateSyntheticToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			Decimals:      18,
+			Metadata: &types.TokenMetadata{
+				Name:        "Ether",
+				Symbol:      "ETH",
+				Description: "Ether bridged from Ethereum",
+				Uri:         "https://ethereum.org",
+			},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		var response types.MsgCreateSyntheticTokenResponse
+		err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+		Expect(err).To(BeNil())
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", response.Id.String())
+
+		metadata, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).TokenMetadata(s.Ctx(), &types.QueryTokenMetadataRequest{Id: response.Id.String()})
+		Expect(err).To(BeNil())
+		Expect(metadata.Token.Id).To(Equal(response.Id.String()))
+		Expect(metadata.Metadata).To(Equal(banktypes.Metadata{
+			Description: "Ether bridged from Ethereum",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: syntheticDenom, Exponent: 0},
+				{Denom: "eth", Exponent: 18},
+			},
+			Base:    syntheticDenom,
+			Display: "eth",
+			Name:    "Ether",
+			Symbol:  "ETH",
+			URI:     "https://ethereum.org",
+		}))
+	})
+
+	It("MsgSetToken (invalid) metadata for collateral token", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, nil, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_COLLATERAL)
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+			Metadata: &types.TokenMetadata{
+				Name:   "Ether",
+				Symbol: "ETH",
+			},
+		})
+
+		// Assert
+		Expect(err.Error()).To(Equal("metadata can only be set for synthetic tokens"))
+	})
+
+	It("MsgSetToken (valid) update metadata", func() {
+		// Arrange
+		tokenId, _, _, _ := createToken(s, nil, owner.Address, sender.Address, types.HYP_TOKEN_TYPE_SYNTHETIC)
+		syntheticDenom := fmt.Sprintf("hyperlane/%s", tokenId.String())
+
+		// Act
+		_, err := s.RunTx(&types.MsgSetToken{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+			Metadata: &types.TokenMetadata{
+				Name:   "Wrapped Ether",
+				Symbol: "WETH",
+			},
+		})
+
+		// Assert
+		Expect(err).To(BeNil())
+		metadata, found := s.App().BankKeeper.GetDenomMetaData(s.Ctx(), syntheticDenom)
+		Expect(found).To(BeTrue())
+		Expect(metadata.Name).To(Equal("Wrapped Ether"))
+		Expect(metadata.Symbol).To(Equal("WETH"))
+		// the token has no decimals, so the base denom is displayed
+		Expect(metadata.Display).To(Equal(syntheticDenom))
+		Expect(metadata.DenomUnits).To(HaveLen(1))
+	})
 })
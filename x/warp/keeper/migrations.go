@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+// It indexes all existing tokens by their origin denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.HypTokens.Walk(ctx, nil, func(_ uint64, token types.HypToken) (bool, error) {
+		return false, m.keeper.setDenomToken(ctx, token)
+	})
+}
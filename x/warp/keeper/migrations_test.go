@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+
+	i "github.com/bcp-innovations/hyperlane-cosmos/tests/integration"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - migrations.go
+
+* Migrate1to2 (valid) indexes tokens by their origin denom
+
+*/
+
+var _ = Describe("migrations.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var owner i.TestValidatorAddress
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		owner = i.GenerateTestValidatorAddress("Owner")
+		err := s.MintBaseCoins(owner.Address, 1_000_000)
+		Expect(err).To(BeNil())
+	})
+
+	It("Migrate1to2 (valid) indexes tokens by their origin denom", func() {
+		// Arrange
+		mailboxId, _, _ := createValidMailbox(s, owner.Address, "noop", 1)
+		res, err := s.RunTx(&types.MsgCreateCollateralToken{
+			Owner:         owner.Address,
+			OriginMailbox: mailboxId,
+			OriginDenom:   denom,
+		})
+		Expect(err).To(BeNil())
+		var response types.MsgCreateCollateralTokenResponse
+		Expect(proto.Unmarshal(res.MsgResponses[0].Value, &response)).To(Succeed())
+		tokenId := response.Id
+
+		_, err = s.RunTx(&types.MsgEnrollRemoteRouter{
+			Owner:   owner.Address,
+			TokenId: tokenId,
+			RemoteRouter: &types.RemoteRouter{
+				ReceiverDomain:   1,
+				ReceiverContract: "0x934b867052ca9c65e33362112f35fb548f8732c2fe45f07b9c591958e865def0",
+				Gas:              math.NewInt(50000),
+			},
+		})
+		Expect(err).To(BeNil())
+
+		// tokens created before version 2 are not indexed
+		Expect(s.App().WarpKeeper.DenomTokens.Remove(s.Ctx(), collections.Join(denom, tokenId.GetInternalId()))).To(Succeed())
+
+		// Act
+		err = keeper.NewMigrator(&s.App().WarpKeeper).Migrate1to2(s.Ctx())
+
+		// Assert
+		Expect(err).To(BeNil())
+
+		routes, err := keeper.NewQueryServerImpl(s.App().WarpKeeper).RoutesByDenom(s.Ctx(), &types.QueryRoutesByDenomRequest{Denom: denom})
+		Expect(err).To(BeNil())
+		Expect(routes.Routes).To(HaveLen(1))
+		Expect(routes.Routes[0].TokenId).To(Equal(tokenId))
+	})
+})
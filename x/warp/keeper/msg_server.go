@@ -31,6 +31,10 @@ func (ms msgServer) CreateSyntheticToken(ctx context.Context, msg *types.MsgCrea
 		return nil, fmt.Errorf("failed to find mailbox with id: %s", msg.OriginMailbox.String())
 	}
 
+	if err := types.ValidateDecimals(msg.Decimals); err != nil {
+		return nil, err
+	}
+
 	tokenId, err := ms.k.coreKeeper.AppRouter().GetNextSequence(ctx, uint8(types.HYP_TOKEN_TYPE_SYNTHETIC))
 	if err != nil {
 		return nil, err
@@ -42,11 +46,21 @@ func (ms msgServer) CreateSyntheticToken(ctx context.Context, msg *types.MsgCrea
 		TokenType:     types.HYP_TOKEN_TYPE_SYNTHETIC,
 		OriginMailbox: msg.OriginMailbox,
 		OriginDenom:   fmt.Sprintf("hyperlane/%s", tokenId.String()),
+		Decimals:      msg.Decimals,
 	}
 
 	if err = ms.k.HypTokens.Set(ctx, tokenId.GetInternalId(), newToken); err != nil {
 		return nil, err
 	}
+	if err = ms.k.setDenomToken(ctx, newToken); err != nil {
+		return nil, err
+	}
+
+	if msg.Metadata != nil {
+		if err = ms.k.SetSyntheticTokenMetadata(ctx, newToken, *msg.Metadata); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgCreateSyntheticTokenResponse{Id: tokenId}, nil
 }
@@ -70,6 +84,10 @@ func (ms msgServer) CreateCollateralToken(ctx context.Context, msg *types.MsgCre
 		return nil, fmt.Errorf("failed to find mailbox with id: %s", msg.OriginMailbox.String())
 	}
 
+	if err := types.ValidateDecimals(msg.Decimals); err != nil {
+		return nil, err
+	}
+
 	tokenId, err := ms.k.coreKeeper.AppRouter().GetNextSequence(ctx, uint8(types.HYP_TOKEN_TYPE_COLLATERAL))
 	if err != nil {
 		return nil, err
@@ -81,18 +99,23 @@ func (ms msgServer) CreateCollateralToken(ctx context.Context, msg *types.MsgCre
 		TokenType:     types.HYP_TOKEN_TYPE_COLLATERAL,
 		OriginMailbox: msg.OriginMailbox,
 		OriginDenom:   msg.OriginDenom,
+		Decimals:      msg.Decimals,
 	}
 
 	if err = ms.k.HypTokens.Set(ctx, tokenId.GetInternalId(), newToken); err != nil {
 		return nil, err
 	}
+	if err = ms.k.setDenomToken(ctx, newToken); err != nil {
+		return nil, err
+	}
 	return &types.MsgCreateCollateralTokenResponse{Id: tokenId}, nil
 }
 
-// SetToken allows the owner of a token to change its ownership or update its ISM ID.
+// SetToken allows the owner of a token to change its ownership, update its ISM ID or the metadata of a synthetic token.
 func (ms msgServer) SetToken(ctx context.Context, msg *types.MsgSetToken) (*types.MsgSetTokenResponse, error) {
-	if msg.NewOwner == "" && msg.IsmId == nil && !msg.RenounceOwnership {
-		return nil, fmt.Errorf("new owner, renounce ownership or ism id required")
+	if msg.NewOwner == "" && msg.IsmId == nil && !msg.RenounceOwnership && msg.Metadata == nil && msg.NewGuardian == "" && !msg.RemoveGuardian &&
+		msg.HookId == nil && msg.HookMetadata == "" && !msg.RemoveHook {
+		return nil, fmt.Errorf("new owner, renounce ownership, ism id, metadata, guardian or hook required")
 	}
 
 	tokenId := msg.TokenId
@@ -121,6 +144,21 @@ func (ms msgServer) SetToken(ctx context.Context, msg *types.MsgSetToken) (*type
 		token.Owner = ""
 	}
 
+	if msg.RemoveGuardian && msg.NewGuardian != "" {
+		return nil, fmt.Errorf("cannot set new guardian and remove guardian at the same time")
+	}
+
+	if msg.NewGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewGuardian); err != nil {
+			return nil, fmt.Errorf("invalid new guardian")
+		}
+		token.Guardian = msg.NewGuardian
+	}
+
+	if msg.RemoveGuardian {
+		token.Guardian = ""
+	}
+
 	if msg.IsmId != nil {
 		if err := ms.k.coreKeeper.AssertIsmExists(ctx, *msg.IsmId); err != nil {
 			return nil, err
@@ -128,6 +166,36 @@ func (ms msgServer) SetToken(ctx context.Context, msg *types.MsgSetToken) (*type
 		token.IsmId = msg.IsmId
 	}
 
+	if msg.Metadata != nil {
+		if err := ms.k.SetSyntheticTokenMetadata(ctx, token, *msg.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.RemoveHook && (msg.HookId != nil || msg.HookMetadata != "") {
+		return nil, fmt.Errorf("cannot set hook and remove hook at the same time")
+	}
+
+	if msg.HookId != nil {
+		if err := ms.k.coreKeeper.AssertPostDispatchHookExists(ctx, *msg.HookId); err != nil {
+			return nil, err
+		}
+		token.HookId = msg.HookId
+	}
+
+	if msg.HookMetadata != "" {
+		hookMetadata, err := util.DecodeEthHex(msg.HookMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook metadata")
+		}
+		token.HookMetadata = hookMetadata
+	}
+
+	if msg.RemoveHook {
+		token.HookId = nil
+		token.HookMetadata = nil
+	}
+
 	err = ms.k.HypTokens.Set(ctx, tokenId.GetInternalId(), token)
 	if err != nil {
 		return nil, err
@@ -156,6 +224,10 @@ func (ms msgServer) EnrollRemoteRouter(ctx context.Context, msg *types.MsgEnroll
 		return nil, fmt.Errorf("invalid receiver contract")
 	}
 
+	if err := types.ValidateRemoteDecimals(token, *msg.RemoteRouter); err != nil {
+		return nil, err
+	}
+
 	if err = ms.k.EnrolledRouters.Set(ctx, collections.Join(tokenId.GetInternalId(), msg.RemoteRouter.ReceiverDomain), *msg.RemoteRouter); err != nil {
 		return nil, err
 	}
@@ -184,6 +256,14 @@ func (ms msgServer) UnrollRemoteRouter(ctx context.Context, msg *types.MsgUnroll
 		return nil, err
 	}
 
+	if err = ms.k.RateLimits.Remove(ctx, collections.Join(tokenId.GetInternalId(), msg.ReceiverDomain)); err != nil {
+		return nil, err
+	}
+
+	if err = ms.k.BridgeFees.Remove(ctx, collections.Join(tokenId.GetInternalId(), msg.ReceiverDomain)); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUnrollRemoteRouterResponse{}, nil
 }
 
@@ -203,12 +283,15 @@ func (ms msgServer) RemoteTransfer(ctx context.Context, msg *types.MsgRemoteTran
 
 	var messageResultId util.HexAddress
 	if token.TokenType == types.HYP_TOKEN_TYPE_COLLATERAL {
-		result, err := ms.k.RemoteTransferCollateral(goCtx, token, msg.Sender, msg.DestinationDomain, msg.Recipient, msg.Amount, msg.CustomHookId, msg.GasLimit, msg.MaxFee, customHookMetadata)
+		result, err := ms.k.RemoteTransferCollateral(goCtx, token, msg.Sender, msg.DestinationDomain, msg.TargetRouter, msg.Recipient, msg.Amount, msg.CustomHookId, msg.GasLimit, msg.MaxFee, customHookMetadata)
 		if err != nil {
 			return nil, err
 		}
 		messageResultId = result
 	} else if token.TokenType == types.HYP_TOKEN_TYPE_SYNTHETIC {
+		if msg.TargetRouter != nil {
+			return nil, fmt.Errorf("target router is only supported for collateral tokens")
+		}
 		result, err := ms.k.RemoteTransferSynthetic(goCtx, token, msg.Sender, msg.DestinationDomain, msg.Recipient, msg.Amount, msg.CustomHookId, msg.GasLimit, msg.MaxFee, customHookMetadata)
 		if err != nil {
 			return nil, err
@@ -223,6 +306,291 @@ func (ms msgServer) RemoteTransfer(ctx context.Context, msg *types.MsgRemoteTran
 	}, nil
 }
 
+// SetRateLimit sets the inbound and outbound rate limit of a token for an enrolled remote router.
+func (ms msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	tokenId := msg.TokenId
+	token, err := ms.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("token with id %s not found", tokenId.String())
+	}
+
+	if token.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own token with id %s", msg.Owner, tokenId.String())
+	}
+
+	key := collections.Join(tokenId.GetInternalId(), msg.Domain)
+
+	exists, err := ms.k.EnrolledRouters.Has(ctx, key)
+	if err != nil || !exists {
+		return nil, fmt.Errorf("failed to find remote router for domain %v", msg.Domain)
+	}
+
+	if err := msg.Inbound.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid inbound rate limit: %w", err)
+	}
+	if err := msg.Outbound.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid outbound rate limit: %w", err)
+	}
+
+	if !msg.Inbound.Enabled() && !msg.Outbound.Enabled() {
+		if err := ms.k.RateLimits.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		return &types.MsgSetRateLimitResponse{}, nil
+	}
+
+	previous, err := ms.k.RateLimits.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	rateLimit := types.RouteRateLimit{
+		TokenId:       tokenId,
+		Domain:        msg.Domain,
+		Inbound:       msg.Inbound,
+		InboundState:  newRateLimitState(previous.Inbound, previous.InboundState, msg.Inbound, now),
+		Outbound:      msg.Outbound,
+		OutboundState: newRateLimitState(previous.Outbound, previous.OutboundState, msg.Outbound, now),
+	}
+
+	if err = ms.k.RateLimits.Set(ctx, key, rateLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// PauseToken pauses a token or only the transfers from and to the given domains.
+// It can be called by the owner and by the guardian of the token.
+func (ms msgServer) PauseToken(ctx context.Context, msg *types.MsgPauseToken) (*types.MsgPauseTokenResponse, error) {
+	tokenId := msg.TokenId
+	token, err := ms.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("token with id %s not found", tokenId.String())
+	}
+
+	if msg.Sender == "" || (token.Owner != msg.Sender && token.Guardian != msg.Sender) {
+		return nil, fmt.Errorf("%s is neither owner nor guardian of token with id %s", msg.Sender, tokenId.String())
+	}
+
+	if len(msg.Domains) == 0 {
+		token.Paused = true
+	}
+	for _, domain := range msg.Domains {
+		if !slices.Contains(token.PausedDomains, domain) {
+			token.PausedDomains = append(token.PausedDomains, domain)
+		}
+	}
+	slices.Sort(token.PausedDomains)
+
+	if err = ms.k.HypTokens.Set(ctx, tokenId.GetInternalId(), token); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.TokenPaused{
+		TokenId: tokenId.String(),
+		Sender:  msg.Sender,
+		Domains: msg.Domains,
+	})
+
+	return &types.MsgPauseTokenResponse{}, nil
+}
+
+// UnpauseToken unpauses the given domains of a token. If no domains are given, the token and all
+// its domains are unpaused. Only the owner of the token can unpause it.
+func (ms msgServer) UnpauseToken(ctx context.Context, msg *types.MsgUnpauseToken) (*types.MsgUnpauseTokenResponse, error) {
+	tokenId := msg.TokenId
+	token, err := ms.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("token with id %s not found", tokenId.String())
+	}
+
+	if token.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own token with id %s", msg.Owner, tokenId.String())
+	}
+
+	if len(msg.Domains) == 0 {
+		token.Paused = false
+		token.PausedDomains = nil
+	} else {
+		token.PausedDomains = slices.DeleteFunc(token.PausedDomains, func(domain uint32) bool {
+			return slices.Contains(msg.Domains, domain)
+		})
+	}
+
+	if err = ms.k.HypTokens.Set(ctx, tokenId.GetInternalId(), token); err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.TokenUnpaused{
+		TokenId: tokenId.String(),
+		Sender:  msg.Owner,
+		Domains: msg.Domains,
+	})
+
+	return &types.MsgUnpauseTokenResponse{}, nil
+}
+
+// SetBridgeFee sets the default bridge fee policy of a token or the policy for the given domains.
+// Domain specific policies can only be set for enrolled remote routers.
+func (ms msgServer) SetBridgeFee(ctx context.Context, msg *types.MsgSetBridgeFee) (*types.MsgSetBridgeFeeResponse, error) {
+	tokenId := msg.TokenId
+	token, err := ms.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("token with id %s not found", tokenId.String())
+	}
+
+	if token.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own token with id %s", msg.Owner, tokenId.String())
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid bridge fee: %w", err)
+	}
+
+	if msg.FeeRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.FeeRecipient); err != nil {
+			return nil, fmt.Errorf("invalid fee recipient")
+		}
+		token.FeeRecipient = msg.FeeRecipient
+	}
+
+	if msg.Policy.Enabled() && token.FeeRecipient == "" {
+		return nil, fmt.Errorf("fee recipient required")
+	}
+
+	if len(msg.Domains) == 0 {
+		token.BridgeFee = msg.Policy
+	}
+
+	for _, domain := range msg.Domains {
+		key := collections.Join(tokenId.GetInternalId(), domain)
+
+		exists, err := ms.k.EnrolledRouters.Has(ctx, key)
+		if err != nil || !exists {
+			return nil, fmt.Errorf("failed to find remote router for domain %v", domain)
+		}
+
+		// a disabled policy is stored as well, so that the domain is free of charge
+		// even if the default policy of the token charges a fee
+		if err = ms.k.BridgeFees.Set(ctx, key, types.RouteBridgeFee{
+			TokenId: tokenId,
+			Domain:  domain,
+			Policy:  msg.Policy,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = ms.k.HypTokens.Set(ctx, tokenId.GetInternalId(), token); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBridgeFeeResponse{}, nil
+}
+
+// EnrollCollateralRouter enrolls an additional router of a collateral token for a domain.
+func (ms msgServer) EnrollCollateralRouter(ctx context.Context, msg *types.MsgEnrollCollateralRouter) (*types.MsgEnrollCollateralRouterResponse, error) {
+	tokenId := msg.TokenId
+	token, err := ms.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("token with id %s not found", tokenId.String())
+	}
+
+	if token.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own token with id %s", msg.Owner, tokenId.String())
+	}
+
+	if token.TokenType != types.HYP_TOKEN_TYPE_COLLATERAL {
+		return nil, fmt.Errorf("collateral routers can only be enrolled for collateral tokens")
+	}
+
+	if msg.RemoteRouter == nil {
+		return nil, fmt.Errorf("invalid remote router")
+	}
+
+	receiverContract, err := util.DecodeHexAddress(msg.RemoteRouter.ReceiverContract)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receiver contract")
+	}
+
+	if err := types.ValidateRemoteDecimals(token, *msg.RemoteRouter); err != nil {
+		return nil, err
+	}
+
+	remoteRouter := *msg.RemoteRouter
+	remoteRouter.ReceiverContract = receiverContract.String()
+
+	key := collections.Join3(tokenId.GetInternalId(), remoteRouter.ReceiverDomain, receiverContract.String())
+	if err = ms.k.CollateralRouters.Set(ctx, key, remoteRouter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnrollCollateralRouterResponse{}, nil
+}
+
+// UnrollCollateralRouter removes an additional router of a collateral token.
+func (ms msgServer) UnrollCollateralRouter(ctx context.Context, msg *types.MsgUnrollCollateralRouter) (*types.MsgUnrollCollateralRouterResponse, error) {
+	tokenId := msg.TokenId
+	token, err := ms.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, fmt.Errorf("token with id %s not found", tokenId.String())
+	}
+
+	if token.Owner != msg.Owner {
+		return nil, fmt.Errorf("%s does not own token with id %s", msg.Owner, tokenId.String())
+	}
+
+	receiverContract, err := util.DecodeHexAddress(msg.ReceiverContract)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receiver contract")
+	}
+
+	key := collections.Join3(tokenId.GetInternalId(), msg.ReceiverDomain, receiverContract.String())
+	exists, err := ms.k.CollateralRouters.Has(ctx, key)
+	if err != nil || !exists {
+		return nil, fmt.Errorf("failed to find collateral router %s for domain %v", receiverContract.String(), msg.ReceiverDomain)
+	}
+
+	if err = ms.k.CollateralRouters.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnrollCollateralRouterResponse{}, nil
+}
+
+// SweepCollateralSurplus sends the surplus of the module account above the tracked collateral of a
+// denom to the recipient. Only the authority is allowed to do so, as the surplus of a denom is shared
+// by all collateral tokens with that denom.
+func (ms msgServer) SweepCollateralSurplus(ctx context.Context, msg *types.MsgSweepCollateralSurplus) (*types.MsgSweepCollateralSurplusResponse, error) {
+	if ms.k.authority != msg.Signer {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", ms.k.authority, msg.Signer)
+	}
+
+	if msg.Denom == "" {
+		return nil, fmt.Errorf("denom required")
+	}
+
+	recipient, err := ms.k.addressCodec.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	amount, err := ms.k.SweepCollateralSurplus(ctx, msg.Denom, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.CollateralSurplusSwept{
+		Signer:    msg.Signer,
+		Recipient: msg.Recipient,
+		Amount:    amount.String(),
+	})
+
+	return &types.MsgSweepCollateralSurplusResponse{Amount: amount}, nil
+}
+
 var _ types.MsgServer = msgServer{}
 
 // NewMsgServerImpl returns an implementation of the module MsgServer interface.
@@ -763,7 +763,7 @@ var _ = Describe("msg_server.go", Ordered, func() {
 		})
 
 		// Assert
-		Expect(err.Error()).To(Equal("new owner, renounce ownership or ism id required"))
+		Expect(err.Error()).To(Equal("new owner, renounce ownership, ism id, metadata, guardian or hook required"))
 	})
 
 	It("MsgSetToken (invalid) non-existing ISM ID", func() {
@@ -1020,6 +1020,19 @@ func createIgp(s *i.KeeperTestSuite, creator string) util.HexAddress {
 	return response.Id
 }
 
+func createNoopHook(s *i.KeeperTestSuite, creator string) util.HexAddress {
+	res, err := s.RunTx(&pdTypes.MsgCreateNoopHook{
+		Owner: creator,
+	})
+	Expect(err).To(BeNil())
+
+	var response pdTypes.MsgCreateNoopHookResponse
+	err = proto.Unmarshal(res.MsgResponses[0].Value, &response)
+	Expect(err).To(BeNil())
+
+	return response.Id
+}
+
 func createMerkleHook(s *i.KeeperTestSuite, creator string, mailboxId util.HexAddress) util.HexAddress {
 	res, err := s.RunTx(&pdTypes.MsgCreateMerkleTreeHook{
 		Owner:     creator,
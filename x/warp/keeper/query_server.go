@@ -49,6 +49,123 @@ func (qs queryServer) RemoteRouters(ctx context.Context, request *types.QueryRem
 	}, nil
 }
 
+func (qs queryServer) RateLimits(ctx context.Context, request *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	tokenId, err := util.DecodeHexAddress(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimits, page, err := util.GetPaginatedPrefixFromMap(ctx, qs.k.RateLimits, request.Pagination, tokenId.GetInternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	statuses := make([]types.RateLimitStatus, len(rateLimits))
+	for i, r := range rateLimits {
+		statuses[i] = types.RateLimitStatus{
+			Domain:            r.Domain,
+			Inbound:           r.Inbound,
+			InboundRemaining:  r.Inbound.CurrentCapacity(r.InboundState, now),
+			Outbound:          r.Outbound,
+			OutboundRemaining: r.Outbound.CurrentCapacity(r.OutboundState, now),
+		}
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: statuses,
+		Pagination: page,
+	}, nil
+}
+
+func (qs queryServer) BridgeFees(ctx context.Context, request *types.QueryBridgeFeesRequest) (*types.QueryBridgeFeesResponse, error) {
+	tokenId, err := util.DecodeHexAddress(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bridgeFees, page, err := util.GetPaginatedPrefixFromMap(ctx, qs.k.BridgeFees, request.Pagination, tokenId.GetInternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBridgeFeesResponse{
+		BridgeFees: bridgeFees,
+		Pagination: page,
+	}, nil
+}
+
+func (qs queryServer) RoutesByDenom(ctx context.Context, request *types.QueryRoutesByDenomRequest) (*types.QueryRoutesByDenomResponse, error) {
+	if request.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom required")
+	}
+
+	tokenIds, page, err := util.GetPaginatedPrefixFromMap(ctx, qs.k.DenomTokens, request.Pagination, request.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]types.DenomRoute, 0)
+	for _, tokenId := range tokenIds {
+		token, err := qs.k.HypTokens.Get(ctx, tokenId)
+		if err != nil {
+			return nil, err
+		}
+
+		routerRange := collections.NewPrefixedPairRange[uint64, uint32](tokenId)
+		err = qs.k.EnrolledRouters.Walk(ctx, routerRange, func(_ collections.Pair[uint64, uint32], router types.RemoteRouter) (bool, error) {
+			routes = append(routes, types.DenomRoute{TokenId: token.Id, TokenType: token.TokenType, RemoteRouter: router})
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		collateralRange := collections.NewPrefixedTripleRange[uint64, uint32, string](tokenId)
+		err = qs.k.CollateralRouters.Walk(ctx, collateralRange, func(_ collections.Triple[uint64, uint32, string], router types.RemoteRouter) (bool, error) {
+			routes = append(routes, types.DenomRoute{TokenId: token.Id, TokenType: token.TokenType, RemoteRouter: router, CollateralRouter: true})
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryRoutesByDenomResponse{
+		Routes:     routes,
+		Pagination: page,
+	}, nil
+}
+
+func (qs queryServer) CollateralDiscrepancies(ctx context.Context, _ *types.QueryCollateralDiscrepanciesRequest) (*types.QueryCollateralDiscrepanciesResponse, error) {
+	discrepancies, err := qs.k.CollateralDiscrepancies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCollateralDiscrepanciesResponse{Discrepancies: discrepancies}, nil
+}
+
+func (qs queryServer) TokenMetadata(ctx context.Context, request *types.QueryTokenMetadataRequest) (*types.QueryTokenMetadataResponse, error) {
+	tokenId, err := util.DecodeHexAddress(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, err := qs.k.HypTokens.Get(ctx, tokenId.GetInternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, _ := qs.k.bankKeeper.GetDenomMetaData(ctx, token.OriginDenom)
+
+	return &types.QueryTokenMetadataResponse{
+		Token:    parseTokenResponse(token),
+		Metadata: metadata,
+	}, nil
+}
+
 func (qs queryServer) BridgedSupply(ctx context.Context, request *types.QueryBridgedSupplyRequest) (*types.QueryBridgedSupplyResponse, error) {
 	tokenId, err := util.DecodeHexAddress(request.Id)
 	if err != nil {
@@ -80,7 +197,30 @@ func (qs queryServer) BridgedSupply(ctx context.Context, request *types.QueryBri
 		return nil, err
 	}
 
-	return &types.QueryBridgedSupplyResponse{BridgedSupply: bridgedSupply}, nil
+	rng := collections.NewPrefixedPairRange[uint64, uint32](tokenId.GetInternalId())
+	iter, err := qs.k.EnrolledRouters.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	routers, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteSupplies := make([]types.RemoteAmount, 0, len(routers))
+	for _, router := range routers {
+		remoteSupplies = append(remoteSupplies, types.RemoteAmount{
+			ReceiverDomain: router.ReceiverDomain,
+			Decimals:       router.Decimals,
+			Amount:         types.ToRemoteAmountTruncated(amount, token.Decimals, router.Decimals),
+		})
+	}
+
+	return &types.QueryBridgedSupplyResponse{
+		BridgedSupply:         bridgedSupply,
+		Decimals:              token.Decimals,
+		RemoteBridgedSupplies: remoteSupplies,
+	}, nil
 }
 
 func (qs queryServer) QuoteRemoteTransfer(ctx context.Context, request *types.QueryQuoteRemoteTransferRequest) (*types.QueryQuoteRemoteTransferResponse, error) {
@@ -104,18 +244,60 @@ func (qs queryServer) QuoteRemoteTransfer(ctx context.Context, request *types.Qu
 		return nil, fmt.Errorf("failed to get remote router for destination domain %v", request.DestinationDomain)
 	}
 
+	customHookMetadata := []byte{}
+	if len(token.HookMetadata) > 0 {
+		customHookMetadata = token.HookMetadata
+	}
+
 	metadata := util.StandardHookMetadata{
 		GasLimit:           remoteRouter.Gas,
 		Address:            sdk.AccAddress{},
-		CustomHookMetadata: []byte{},
+		CustomHookMetadata: customHookMetadata,
 	}
 
-	requiredPayment, err := qs.k.coreKeeper.QuoteDispatch(ctx, util.HexAddress(token.OriginMailbox), util.NewZeroAddress(), metadata, util.HyperlaneMessage{Destination: uint32(destinationDomain)})
+	// the default hook of the token overwrites the default hook of the mailbox
+	hookId := util.NewZeroAddress()
+	if token.HookId != nil {
+		hookId = *token.HookId
+	}
+
+	requiredPayment, err := qs.k.coreKeeper.QuoteDispatch(ctx, util.HexAddress(token.OriginMailbox), hookId, metadata, util.HyperlaneMessage{Destination: uint32(destinationDomain)})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryQuoteRemoteTransferResponse{GasPayment: requiredPayment}, nil
+	amount := math.ZeroInt()
+	if request.Amount != "" {
+		var ok bool
+		amount, ok = math.NewIntFromString(request.Amount)
+		if !ok || amount.IsNegative() {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid amount %s", request.Amount))
+		}
+	}
+
+	fee, bridged := math.ZeroInt(), amount
+	if amount.IsPositive() {
+		fee, bridged, err = qs.k.bridgeFee(ctx, token, remoteRouter, amount)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	remoteAmount, err := types.ToRemoteAmount(bridged, token.Decimals, remoteRouter.Decimals)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryQuoteRemoteTransferResponse{
+		GasPayment: requiredPayment,
+		Amount:     sdk.NewCoin(token.OriginDenom, amount),
+		BridgeFee:  sdk.NewCoin(token.OriginDenom, fee),
+		RemoteAmount: types.RemoteAmount{
+			ReceiverDomain: remoteRouter.ReceiverDomain,
+			Decimals:       remoteRouter.Decimals,
+			Amount:         remoteAmount,
+		},
+	}, nil
 }
 
 func (qs queryServer) Tokens(ctx context.Context, req *types.QueryTokensRequest) (*types.QueryTokensResponse, error) {
@@ -152,7 +334,7 @@ func (qs queryServer) Token(ctx context.Context, request *types.QueryTokenReques
 }
 
 func parseTokenResponse(get types.HypToken) *types.WrappedHypToken {
-	return &types.WrappedHypToken{
+	response := &types.WrappedHypToken{
 		Id:        get.Id.String(),
 		Owner:     get.Owner,
 		TokenType: get.TokenType,
@@ -161,5 +343,20 @@ func parseTokenResponse(get types.HypToken) *types.WrappedHypToken {
 		OriginDenom:   get.OriginDenom,
 
 		IsmId: get.IsmId,
+
+		Decimals: get.Decimals,
+
+		Paused:        get.Paused,
+		PausedDomains: get.PausedDomains,
+		Guardian:      get.Guardian,
+
+		BridgeFee:    get.BridgeFee,
+		FeeRecipient: get.FeeRecipient,
+
+		HookId: get.HookId,
+	}
+	if len(get.HookMetadata) > 0 {
+		response.HookMetadata = util.EncodeEthHex(get.HookMetadata)
 	}
+	return response
 }
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxBridgeFeeBps is the maximum fee in basis points, which equals the full transferred amount.
+const MaxBridgeFeeBps uint32 = 10_000
+
+// Enabled returns true if the policy charges a fee.
+func (p BridgeFeePolicy) Enabled() bool {
+	return p.FeeType != BRIDGE_FEE_TYPE_UNSPECIFIED
+}
+
+// Validate checks that the policy only sets the fields of its fee type and that all values are in range.
+func (p BridgeFeePolicy) Validate() error {
+	hasFlat := !p.FlatAmount.IsNil() && !p.FlatAmount.IsZero()
+
+	switch p.FeeType {
+	case BRIDGE_FEE_TYPE_UNSPECIFIED:
+		if hasFlat || p.Bps != 0 || len(p.Tiers) != 0 {
+			return fmt.Errorf("bridge fee without fee type must not set any fee")
+		}
+	case BRIDGE_FEE_TYPE_FLAT:
+		if !hasFlat || p.FlatAmount.IsNegative() {
+			return fmt.Errorf("flat bridge fee must be positive")
+		}
+		if p.Bps != 0 || len(p.Tiers) != 0 {
+			return fmt.Errorf("flat bridge fee must not set bps or tiers")
+		}
+	case BRIDGE_FEE_TYPE_BPS:
+		if p.Bps == 0 || p.Bps > MaxBridgeFeeBps {
+			return fmt.Errorf("bridge fee bps must be between 1 and %d", MaxBridgeFeeBps)
+		}
+		if hasFlat || len(p.Tiers) != 0 {
+			return fmt.Errorf("bps bridge fee must not set flat amount or tiers")
+		}
+	case BRIDGE_FEE_TYPE_TIERED:
+		if len(p.Tiers) == 0 {
+			return fmt.Errorf("tiered bridge fee requires at least one tier")
+		}
+		if hasFlat || p.Bps != 0 {
+			return fmt.Errorf("tiered bridge fee must not set flat amount or bps")
+		}
+		for i, tier := range p.Tiers {
+			if tier.MinAmount.IsNil() || tier.MinAmount.IsNegative() {
+				return fmt.Errorf("min amount of tier %d must not be negative", i)
+			}
+			if tier.Bps > MaxBridgeFeeBps {
+				return fmt.Errorf("bps of tier %d must not exceed %d", i, MaxBridgeFeeBps)
+			}
+			if i > 0 && !tier.MinAmount.GT(p.Tiers[i-1].MinAmount) {
+				return fmt.Errorf("tiers must be sorted by strictly ascending min amount")
+			}
+		}
+	default:
+		return fmt.Errorf("invalid bridge fee type %d", p.FeeType)
+	}
+	return nil
+}
+
+// Fee returns the fee in local units for transferring the amount.
+func (p BridgeFeePolicy) Fee(amount math.Int) math.Int {
+	switch p.FeeType {
+	case BRIDGE_FEE_TYPE_FLAT:
+		return p.FlatAmount
+	case BRIDGE_FEE_TYPE_BPS:
+		return bpsOf(amount, p.Bps)
+	case BRIDGE_FEE_TYPE_TIERED:
+		fee := math.ZeroInt()
+		for _, tier := range p.Tiers {
+			if amount.LT(tier.MinAmount) {
+				break
+			}
+			fee = bpsOf(amount, tier.Bps)
+		}
+		return fee
+	default:
+		return math.ZeroInt()
+	}
+}
+
+func bpsOf(amount math.Int, bps uint32) math.Int {
+	return amount.Mul(math.NewIntFromUint64(uint64(bps))).Quo(math.NewIntFromUint64(uint64(MaxBridgeFeeBps)))
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+)
+
+func TestBridgeFeePolicyFee(t *testing.T) {
+	tiers := []BridgeFeeTier{
+		{MinAmount: math.NewInt(0), Bps: 100},
+		{MinAmount: math.NewInt(10_000), Bps: 50},
+		{MinAmount: math.NewInt(1_000_000), Bps: 10},
+	}
+
+	type testCase struct {
+		name     string
+		policy   BridgeFeePolicy
+		amount   int64
+		expected int64
+	}
+	for _, c := range []testCase{
+		{"no fee", BridgeFeePolicy{}, 1000, 0},
+		{"flat", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_FLAT, FlatAmount: math.NewInt(7)}, 1000, 7},
+		{"bps", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_BPS, Bps: 25}, 10_000, 25},
+		{"bps rounds down", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_BPS, Bps: 25}, 399, 0},
+		{"lowest tier", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED, Tiers: tiers}, 5_000, 50},
+		{"tier boundary", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED, Tiers: tiers}, 10_000, 50},
+		{"highest tier", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED, Tiers: tiers}, 2_000_000, 2_000},
+		{"below first tier", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED, Tiers: tiers[1:]}, 5_000, 0},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			fee := c.policy.Fee(math.NewInt(c.amount))
+			if !fee.Equal(math.NewInt(c.expected)) {
+				t.Fatalf("expected %d, got %s", c.expected, fee)
+			}
+		})
+	}
+}
+
+func TestBridgeFeePolicyValidate(t *testing.T) {
+	type testCase struct {
+		name   string
+		policy BridgeFeePolicy
+		valid  bool
+	}
+	for _, c := range []testCase{
+		{"no fee", BridgeFeePolicy{}, true},
+		{"no fee with bps", BridgeFeePolicy{Bps: 1}, false},
+		{"flat", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_FLAT, FlatAmount: math.NewInt(1)}, true},
+		{"flat without amount", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_FLAT}, false},
+		{"flat negative", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_FLAT, FlatAmount: math.NewInt(-1)}, false},
+		{"bps", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_BPS, Bps: 10_000}, true},
+		{"bps too high", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_BPS, Bps: 10_001}, false},
+		{"bps with tiers", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_BPS, Bps: 1, Tiers: []BridgeFeeTier{{MinAmount: math.ZeroInt()}}}, false},
+		{"tiered", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED, Tiers: []BridgeFeeTier{{MinAmount: math.ZeroInt(), Bps: 10}, {MinAmount: math.NewInt(5), Bps: 5}}}, true},
+		{"tiered without tiers", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED}, false},
+		{"tiered unsorted", BridgeFeePolicy{FeeType: BRIDGE_FEE_TYPE_TIERED, Tiers: []BridgeFeeTier{{MinAmount: math.NewInt(5), Bps: 5}, {MinAmount: math.NewInt(5), Bps: 10}}}, false},
+		{"invalid type", BridgeFeePolicy{FeeType: 42}, false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.policy.Validate()
+			if c.valid && err != nil {
+				t.Fatalf("expected valid policy, got %v", err)
+			}
+			if !c.valid && err == nil {
+				t.Fatal("expected invalid policy")
+			}
+		})
+	}
+}
@@ -15,6 +15,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEnrollRemoteRouter{},
 		&MsgUnrollRemoteRouter{},
 		&MsgRemoteTransfer{},
+		&MsgSetRateLimit{},
+		&MsgPauseToken{},
+		&MsgUnpauseToken{},
+		&MsgSetBridgeFee{},
+		&MsgEnrollCollateralRouter{},
+		&MsgUnrollCollateralRouter{},
+		&MsgSweepCollateralSurplus{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
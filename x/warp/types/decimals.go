@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxDecimals is the maximum number of decimals of a token or remote router.
+const MaxDecimals uint32 = 36
+
+// ValidateDecimals checks that the decimals are in the supported range.
+func ValidateDecimals(decimals uint32) error {
+	if decimals > MaxDecimals {
+		return fmt.Errorf("decimals must not exceed %d", MaxDecimals)
+	}
+	return nil
+}
+
+// ToRemoteAmount converts an amount in local units to the units of the remote router.
+// Amounts which can't be represented exactly in remote units are rejected.
+func ToRemoteAmount(amount math.Int, localDecimals, remoteDecimals uint32) (math.Int, error) {
+	return scaleAmount(amount, localDecimals, remoteDecimals)
+}
+
+// ValidateRemoteDecimals checks the decimals of a remote router of the token. Routers of a token
+// with decimals must set their decimals, as unset decimals would scale amounts by the full
+// decimals of the token.
+func ValidateRemoteDecimals(token HypToken, remoteRouter RemoteRouter) error {
+	if err := ValidateDecimals(remoteRouter.Decimals); err != nil {
+		return err
+	}
+	if token.Decimals > 0 && remoteRouter.Decimals == 0 {
+		return fmt.Errorf("remote router decimals must be set for a token with %d decimals", token.Decimals)
+	}
+	return nil
+}
+
+// ToLocalAmount converts an amount in remote units to local units.
+// The tokens are already locked or burned on the remote chain, hence the amount is not rejected
+// but rounded down. The remainder is returned as dust in remote units.
+func ToLocalAmount(amount math.Int, localDecimals, remoteDecimals uint32) (local, dust math.Int, err error) {
+	if remoteDecimals <= localDecimals {
+		local, err = scaleAmount(amount, remoteDecimals, localDecimals)
+		return local, math.ZeroInt(), err
+	}
+
+	factor := decimalsFactor(remoteDecimals - localDecimals)
+	return amount.Quo(factor), amount.Mod(factor), nil
+}
+
+// ToRemoteAmountTruncated converts an amount in local units to the units of the remote router and
+// rounds down. It is used for reporting only.
+func ToRemoteAmountTruncated(amount math.Int, localDecimals, remoteDecimals uint32) math.Int {
+	if remoteDecimals >= localDecimals {
+		scaled, err := scaleAmount(amount, localDecimals, remoteDecimals)
+		if err != nil {
+			return math.ZeroInt()
+		}
+		return scaled
+	}
+	return amount.Quo(decimalsFactor(localDecimals - remoteDecimals))
+}
+
+func scaleAmount(amount math.Int, fromDecimals, toDecimals uint32) (math.Int, error) {
+	if fromDecimals == toDecimals {
+		return amount, nil
+	}
+
+	if toDecimals > fromDecimals {
+		scaled, err := amount.SafeMul(decimalsFactor(toDecimals - fromDecimals))
+		if err != nil {
+			return math.Int{}, fmt.Errorf("amount %s exceeds the maximum amount after scaling", amount)
+		}
+		return scaled, nil
+	}
+
+	factor := decimalsFactor(fromDecimals - toDecimals)
+	scaled := amount.Quo(factor)
+	if scaled.IsZero() && !amount.IsZero() {
+		return math.Int{}, fmt.Errorf("amount %s truncates to zero when scaled from %d to %d decimals", amount, fromDecimals, toDecimals)
+	}
+	if !amount.Mod(factor).IsZero() {
+		return math.Int{}, fmt.Errorf("amount %s leaves dust when scaled from %d to %d decimals", amount, fromDecimals, toDecimals)
+	}
+	return scaled, nil
+}
+
+func decimalsFactor(decimals uint32) math.Int {
+	return math.NewIntWithDecimal(1, int(decimals))
+}
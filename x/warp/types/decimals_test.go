@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+)
+
+func TestScaleAmount(t *testing.T) {
+	type testCase struct {
+		name     string
+		amount   int64
+		local    uint32
+		remote   uint32
+		expected string
+		hasError bool
+	}
+	for _, c := range []testCase{
+		{"same decimals", 123, 6, 6, "123", false},
+		{"scale up", 123, 6, 18, "123000000000000", false},
+		{"scale down", 1200, 8, 6, "12", false},
+		{"zero", 0, 8, 6, "0", false},
+		{"truncates to zero", 99, 8, 6, "", true},
+		{"dust", 1201, 8, 6, "", true},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			scaled, err := ToRemoteAmount(math.NewInt(c.amount), c.local, c.remote)
+			if (err != nil) != c.hasError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil && scaled.String() != c.expected {
+				t.Fatalf("expected %s, got %s", c.expected, scaled.String())
+			}
+			if err == nil {
+				back, dust, err := ToLocalAmount(scaled, c.local, c.remote)
+				if err != nil || !back.Equal(math.NewInt(c.amount)) || !dust.IsZero() {
+					t.Fatalf("round trip failed: %v %v %v", back, dust, err)
+				}
+			}
+		})
+	}
+
+	if _, err := ToRemoteAmount(math.NewIntWithDecimal(1, 70), 0, 36); err == nil {
+		t.Fatal("expected overflow error")
+	}
+}
+
+func TestToLocalAmountRoundsDown(t *testing.T) {
+	type testCase struct {
+		name   string
+		amount int64
+		local  uint32
+		remote uint32
+		scaled int64
+		dust   int64
+	}
+	for _, c := range []testCase{
+		{"exact", 1200, 6, 8, 12, 0},
+		{"dust", 1201, 6, 8, 12, 1},
+		{"truncates to zero", 99, 6, 8, 0, 99},
+		{"scale up", 12, 8, 6, 1200, 0},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			scaled, dust, err := ToLocalAmount(math.NewInt(c.amount), c.local, c.remote)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !scaled.Equal(math.NewInt(c.scaled)) || !dust.Equal(math.NewInt(c.dust)) {
+				t.Fatalf("expected %d and dust %d, got %s and dust %s", c.scaled, c.dust, scaled, dust)
+			}
+		})
+	}
+}
+
+func TestValidateRemoteDecimals(t *testing.T) {
+	if err := ValidateRemoteDecimals(HypToken{}, RemoteRouter{}); err != nil {
+		t.Fatalf("token without decimals must accept routers without decimals: %v", err)
+	}
+	if err := ValidateRemoteDecimals(HypToken{Decimals: 6}, RemoteRouter{}); err == nil {
+		t.Fatal("expected missing remote decimals error")
+	}
+	if err := ValidateRemoteDecimals(HypToken{Decimals: 6}, RemoteRouter{Decimals: 18}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ValidateRemoteDecimals(HypToken{}, RemoteRouter{Decimals: MaxDecimals + 1}); err == nil {
+		t.Fatal("expected max decimals error")
+	}
+}
@@ -5,4 +5,6 @@ import "cosmossdk.io/errors"
 var (
 	ErrNotEnoughCollateral = errors.Register(ModuleName, 1, "not enough collateral")
 	ErrTokenNotFound       = errors.Register(ModuleName, 2, "token not found")
+	ErrRateLimitExceeded   = errors.Register(ModuleName, 3, "rate limit exceeded")
+	ErrTokenPaused         = errors.Register(ModuleName, 4, "token paused")
 )
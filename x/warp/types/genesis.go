@@ -6,6 +6,9 @@ func NewGenesisState() *GenesisState {
 		Params:        Params{},
 		Tokens:        []HypToken{},
 		RemoteRouters: []GenesisRemoteRouterWrapper{},
+
+		PendingIbcForwards: []PendingIbcForward{},
+		RateLimits:         []RouteRateLimit{},
 	}
 }
 
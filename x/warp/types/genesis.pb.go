@@ -25,9 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	Params        Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Tokens        []HypToken                   `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
-	RemoteRouters []GenesisRemoteRouterWrapper `protobuf:"bytes,3,rep,name=remote_routers,json=remoteRouters,proto3" json:"remote_routers"`
+	Params             Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Tokens             []HypToken                   `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+	RemoteRouters      []GenesisRemoteRouterWrapper `protobuf:"bytes,3,rep,name=remote_routers,json=remoteRouters,proto3" json:"remote_routers"`
+	PendingIbcForwards []PendingIbcForward          `protobuf:"bytes,4,rep,name=pending_ibc_forwards,json=pendingIbcForwards,proto3" json:"pending_ibc_forwards"`
+	RateLimits         []RouteRateLimit             `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	BridgeFees         []RouteBridgeFee             `protobuf:"bytes,6,rep,name=bridge_fees,json=bridgeFees,proto3" json:"bridge_fees"`
+	CollateralRouters  []GenesisRemoteRouterWrapper `protobuf:"bytes,7,rep,name=collateral_routers,json=collateralRouters,proto3" json:"collateral_routers"`
+	// local_settlements is the next nonce of local collateral settlements.
+	LocalSettlements uint64 `protobuf:"varint,8,opt,name=local_settlements,json=localSettlements,proto3" json:"local_settlements,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +90,41 @@ func (m *GenesisState) GetRemoteRouters() []GenesisRemoteRouterWrapper {
 	return nil
 }
 
+func (m *GenesisState) GetPendingIbcForwards() []PendingIbcForward {
+	if m != nil {
+		return m.PendingIbcForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RouteRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeFees() []RouteBridgeFee {
+	if m != nil {
+		return m.BridgeFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralRouters() []GenesisRemoteRouterWrapper {
+	if m != nil {
+		return m.CollateralRouters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLocalSettlements() uint64 {
+	if m != nil {
+		return m.LocalSettlements
+	}
+	return 0
+}
+
 // GenesisRemoteRouterWrapper ...
 type GenesisRemoteRouterWrapper struct {
 	TokenId      uint64       `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
@@ -145,28 +186,37 @@ func init() {
 func init() { proto.RegisterFile("hyperlane/warp/v1/genesis.proto", fileDescriptor_09202a973c73677a) }
 
 var fileDescriptor_09202a973c73677a = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4e, 0x32, 0x31,
-	0x14, 0xc5, 0xa7, 0x40, 0xf8, 0xbe, 0x14, 0x30, 0x71, 0xe2, 0x62, 0xc0, 0x38, 0x10, 0x56, 0x6c,
-	0x68, 0x03, 0x2e, 0xd4, 0x2d, 0x1b, 0xff, 0xac, 0xcc, 0x68, 0x62, 0xc2, 0x86, 0x14, 0x68, 0x86,
-	0x89, 0x4e, 0x6f, 0xd3, 0x16, 0x94, 0xb5, 0x2f, 0xe0, 0x63, 0xb1, 0x64, 0xe9, 0x8a, 0x18, 0x78,
-	0x11, 0x43, 0x67, 0x24, 0x18, 0xc6, 0xdd, 0x9d, 0xe9, 0xef, 0x9c, 0x7b, 0xda, 0x83, 0xeb, 0x93,
-	0xb9, 0xe4, 0xea, 0x85, 0x09, 0x4e, 0x5f, 0x99, 0x92, 0x74, 0xd6, 0xa1, 0x21, 0x17, 0x5c, 0x47,
-	0x9a, 0x48, 0x05, 0x06, 0xdc, 0xe3, 0x1d, 0x40, 0xb6, 0x00, 0x99, 0x75, 0x6a, 0x67, 0x87, 0x1a,
-	0x33, 0x97, 0x3c, 0x55, 0xd4, 0x4e, 0x42, 0x08, 0xc1, 0x8e, 0x74, 0x3b, 0x25, 0x7f, 0x9b, 0x2b,
-	0x84, 0xcb, 0xd7, 0x89, 0xf3, 0x83, 0x61, 0x86, 0xbb, 0x17, 0xb8, 0x28, 0x99, 0x62, 0xb1, 0xf6,
-	0x50, 0x03, 0xb5, 0x4a, 0xdd, 0x2a, 0x39, 0xd8, 0x44, 0xee, 0x2d, 0xd0, 0x2b, 0x2c, 0x56, 0x75,
-	0x27, 0x48, 0x71, 0xf7, 0x0a, 0x17, 0x0d, 0x3c, 0x73, 0xa1, 0xbd, 0x5c, 0x23, 0xdf, 0x2a, 0x75,
-	0x4f, 0x33, 0x84, 0x37, 0x73, 0xf9, 0xb8, 0x65, 0x7e, 0xa4, 0x89, 0xc0, 0xed, 0xe3, 0x23, 0xc5,
-	0x63, 0x30, 0x7c, 0xa0, 0x60, 0x6a, 0xb8, 0xd2, 0x5e, 0xde, 0x5a, 0xb4, 0x33, 0x2c, 0xd2, 0xb0,
-	0x81, 0xe5, 0x03, 0x8b, 0x3f, 0x29, 0x26, 0x25, 0x57, 0xa9, 0x69, 0x45, 0xed, 0x1d, 0xe9, 0xe6,
-	0x3b, 0xc2, 0xb5, 0xbf, 0x35, 0x6e, 0x15, 0xff, 0xb7, 0x21, 0x06, 0xd1, 0xd8, 0x5e, 0xb8, 0x10,
-	0xfc, 0xb3, 0xdf, 0xb7, 0x63, 0xf7, 0x0e, 0x57, 0x7e, 0xa5, 0xf2, 0x72, 0xf6, 0x41, 0xea, 0x19,
-	0xa1, 0xf6, 0x9d, 0xd3, 0x18, 0xe5, 0xfd, 0x18, 0xbd, 0x60, 0xb1, 0xf6, 0xd1, 0x72, 0xed, 0xa3,
-	0xaf, 0xb5, 0x8f, 0x3e, 0x36, 0xbe, 0xb3, 0xdc, 0xf8, 0xce, 0xe7, 0xc6, 0x77, 0xfa, 0x97, 0x61,
-	0x64, 0x26, 0xd3, 0x21, 0x19, 0x41, 0x4c, 0x87, 0x23, 0xd9, 0x8e, 0x84, 0x80, 0x19, 0x33, 0x11,
-	0x08, 0x4d, 0x77, 0x8b, 0xda, 0x23, 0xd0, 0x31, 0x68, 0xfa, 0x96, 0x34, 0x6b, 0x6b, 0x1d, 0x16,
-	0x6d, 0x83, 0xe7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x64, 0x51, 0x6d, 0x3f, 0x2c, 0x02, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x40, 0xe3, 0xd6, 0xa4, 0xd5, 0xa6, 0x45, 0x64, 0xd5, 0x83, 0x1b, 0x84, 0x13, 0x2a, 0x0e,
+	0x91, 0x50, 0x6c, 0xb5, 0x1c, 0x80, 0x6b, 0x0e, 0xa5, 0x45, 0x1c, 0x90, 0x8b, 0x84, 0x54, 0x21,
+	0x59, 0x6b, 0x7b, 0xea, 0xae, 0xb0, 0xbd, 0xab, 0xdd, 0x6d, 0x4a, 0xce, 0xfc, 0x00, 0x9f, 0xd5,
+	0x63, 0x8f, 0x9c, 0x10, 0x24, 0x3f, 0x82, 0x32, 0xb6, 0xd3, 0x40, 0x0c, 0x87, 0xde, 0xd6, 0xb3,
+	0x6f, 0xde, 0xac, 0x47, 0x33, 0xa4, 0x7f, 0x39, 0x95, 0xa0, 0x32, 0x56, 0x80, 0x7f, 0xcd, 0x94,
+	0xf4, 0x27, 0x87, 0x7e, 0x0a, 0x05, 0x68, 0xae, 0x3d, 0xa9, 0x84, 0x11, 0xb4, 0xbb, 0x04, 0xbc,
+	0x05, 0xe0, 0x4d, 0x0e, 0x7b, 0x4f, 0xd6, 0x73, 0xcc, 0x54, 0x42, 0x95, 0xd1, 0xdb, 0x4b, 0x45,
+	0x2a, 0xf0, 0xe8, 0x2f, 0x4e, 0x65, 0xf4, 0xe0, 0x97, 0x4d, 0x76, 0xde, 0x94, 0xe6, 0x33, 0xc3,
+	0x0c, 0xd0, 0x97, 0xa4, 0x2d, 0x99, 0x62, 0xb9, 0x76, 0xac, 0x81, 0x35, 0xec, 0x1c, 0xed, 0x7b,
+	0x6b, 0x95, 0xbc, 0xf7, 0x08, 0x8c, 0xed, 0x9b, 0x1f, 0xfd, 0x56, 0x50, 0xe1, 0xf4, 0x35, 0x69,
+	0x1b, 0xf1, 0x19, 0x0a, 0xed, 0x6c, 0x0c, 0x36, 0x87, 0x9d, 0xa3, 0xc7, 0x0d, 0x89, 0x27, 0x53,
+	0xf9, 0x61, 0xc1, 0xd4, 0xa9, 0x65, 0x02, 0x3d, 0x27, 0x0f, 0x15, 0xe4, 0xc2, 0x40, 0xa8, 0xc4,
+	0x95, 0x01, 0xa5, 0x9d, 0x4d, 0x54, 0x8c, 0x1a, 0x14, 0xd5, 0x63, 0x03, 0xe4, 0x03, 0xc4, 0x3f,
+	0x2a, 0x26, 0x25, 0xa8, 0x4a, 0xba, 0xab, 0x56, 0xae, 0x34, 0xfd, 0x44, 0xf6, 0x24, 0x14, 0x09,
+	0x2f, 0xd2, 0x90, 0x47, 0x71, 0x78, 0x21, 0xd4, 0x35, 0x53, 0x89, 0x76, 0x6c, 0xac, 0xf0, 0xac,
+	0xe9, 0xef, 0x4a, 0xfc, 0x34, 0x8a, 0x8f, 0x4b, 0xb8, 0x12, 0x53, 0xf9, 0xf7, 0x85, 0xa6, 0x27,
+	0xa4, 0xa3, 0x98, 0x81, 0x30, 0xe3, 0x39, 0x37, 0xda, 0x79, 0x80, 0xd2, 0xa7, 0x0d, 0x52, 0x7c,
+	0x4e, 0xc0, 0x0c, 0xbc, 0x5b, 0x90, 0x95, 0x91, 0xa8, 0x3a, 0x80, 0xa6, 0x48, 0xf1, 0x24, 0x85,
+	0xf0, 0x02, 0x40, 0x3b, 0xed, 0xff, 0x9b, 0xc6, 0x88, 0x1e, 0x03, 0xd4, 0xa6, 0xa8, 0x0e, 0x68,
+	0x1a, 0x11, 0x1a, 0x8b, 0x2c, 0x63, 0x06, 0x14, 0xcb, 0x96, 0x1d, 0xdd, 0xba, 0x7f, 0x47, 0xbb,
+	0x77, 0xba, 0xba, 0xab, 0xcf, 0x49, 0x37, 0x13, 0x31, 0xcb, 0x42, 0x0d, 0xc6, 0x64, 0x90, 0x43,
+	0x61, 0xb4, 0xb3, 0x3d, 0xb0, 0x86, 0x76, 0xf0, 0x08, 0x2f, 0xce, 0xee, 0xe2, 0x07, 0x5f, 0x2d,
+	0xd2, 0xfb, 0x77, 0x11, 0xba, 0x4f, 0xb6, 0x71, 0x0e, 0x42, 0x9e, 0xe0, 0xcc, 0xd9, 0xc1, 0x16,
+	0x7e, 0x9f, 0x26, 0xf4, 0x2d, 0xd9, 0xfd, 0x63, 0x30, 0x9c, 0x0d, 0x9c, 0xc9, 0x7e, 0x53, 0x5b,
+	0x56, 0xcc, 0xd5, 0xbb, 0x77, 0x56, 0x27, 0x61, 0x1c, 0xdc, 0xcc, 0x5c, 0xeb, 0x76, 0xe6, 0x5a,
+	0x3f, 0x67, 0xae, 0xf5, 0x6d, 0xee, 0xb6, 0x6e, 0xe7, 0x6e, 0xeb, 0xfb, 0xdc, 0x6d, 0x9d, 0xbf,
+	0x4a, 0xb9, 0xb9, 0xbc, 0x8a, 0xbc, 0x58, 0xe4, 0x7e, 0x14, 0xcb, 0x11, 0x2f, 0x0a, 0x31, 0x61,
+	0x86, 0x8b, 0x42, 0xfb, 0xcb, 0x42, 0xa3, 0x58, 0xe8, 0x5c, 0x68, 0xff, 0x4b, 0xb9, 0x5c, 0xb8,
+	0x59, 0x51, 0x1b, 0x97, 0xe8, 0xc5, 0xef, 0x01, 0x00, 0xf3, 0x51, 0x68, 0xed, 0xaf, 0x03, 0x00,
 	0x00,
 }
 
@@ -190,6 +240,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LocalSettlements != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LocalSettlements))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CollateralRouters) > 0 {
+		for iNdEx := len(m.CollateralRouters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralRouters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BridgeFees) > 0 {
+		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingIbcForwards) > 0 {
+		for iNdEx := len(m.PendingIbcForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingIbcForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.RemoteRouters) > 0 {
 		for iNdEx := len(m.RemoteRouters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -300,6 +411,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingIbcForwards) > 0 {
+		for _, e := range m.PendingIbcForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeFees) > 0 {
+		for _, e := range m.BridgeFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralRouters) > 0 {
+		for _, e := range m.CollateralRouters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LocalSettlements != 0 {
+		n += 1 + sovGenesis(uint64(m.LocalSettlements))
+	}
 	return n
 }
 
@@ -453,6 +591,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingIbcForwards = append(m.PendingIbcForwards, PendingIbcForward{})
+			if err := m.PendingIbcForwards[len(m.PendingIbcForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RouteRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFees = append(m.BridgeFees, RouteBridgeFee{})
+			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRouters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralRouters = append(m.CollateralRouters, GenesisRemoteRouterWrapper{})
+			if err := m.CollateralRouters[len(m.CollateralRouters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalSettlements", wireType)
+			}
+			m.LocalSettlements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalSettlements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/bcp-innovations/hyperlane-cosmos/util"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// IbcWarpMemoKey is the key of the warp instruction inside an ICS-20 memo, e.g.
+//
+//	{"hyperlane_warp": {"token_id": "0x...", "destination_domain": 1, "recipient": "0x...", "gas_limit": "50000", "max_fee": "1000acoin"}}
+const IbcWarpMemoKey = "hyperlane_warp"
+
+// IbcWarpMemo is the JSON representation of a warp instruction in an ICS-20 memo.
+type IbcWarpMemo struct {
+	TokenId            string `json:"token_id"`
+	DestinationDomain  uint32 `json:"destination_domain"`
+	Recipient          string `json:"recipient"`
+	GasLimit           string `json:"gas_limit,omitempty"`
+	MaxFee             string `json:"max_fee"`
+	CustomHookId       string `json:"custom_hook_id,omitempty"`
+	CustomHookMetadata string `json:"custom_hook_metadata,omitempty"`
+}
+
+// IbcWarpTransfer is a parsed and validated IbcWarpMemo.
+type IbcWarpTransfer struct {
+	TokenId            util.HexAddress
+	DestinationDomain  uint32
+	Recipient          util.HexAddress
+	GasLimit           math.Int
+	MaxFee             sdk.Coin
+	CustomHookId       *util.HexAddress
+	CustomHookMetadata []byte
+}
+
+// ParseIbcWarpMemo returns the warp transfer contained in an ICS-20 memo. It returns nil if the
+// memo does not contain a warp instruction, so that memos of other middlewares are left untouched.
+func ParseIbcWarpMemo(memo string) (*IbcWarpTransfer, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+
+	raw, ok := fields[IbcWarpMemoKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var m IbcWarpMemo
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("invalid warp memo: %w", err)
+	}
+
+	tokenId, err := util.DecodeHexAddress(m.TokenId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid warp memo token id: %w", err)
+	}
+
+	recipient, err := util.DecodeHexAddress(m.Recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid warp memo recipient: %w", err)
+	}
+
+	gasLimit := math.ZeroInt()
+	if m.GasLimit != "" {
+		var ok bool
+		gasLimit, ok = math.NewIntFromString(m.GasLimit)
+		if !ok || gasLimit.IsNegative() {
+			return nil, fmt.Errorf("invalid warp memo gas limit: %s", m.GasLimit)
+		}
+	}
+
+	maxFee, err := sdk.ParseCoinNormalized(m.MaxFee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid warp memo max fee: %w", err)
+	}
+
+	var customHookId *util.HexAddress
+	if m.CustomHookId != "" {
+		hookId, err := util.DecodeHexAddress(m.CustomHookId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid warp memo custom hook id: %w", err)
+		}
+		customHookId = &hookId
+	}
+
+	customHookMetadata, err := util.DecodeEthHex(m.CustomHookMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("invalid warp memo custom hook metadata")
+	}
+
+	return &IbcWarpTransfer{
+		TokenId:            tokenId,
+		DestinationDomain:  m.DestinationDomain,
+		Recipient:          recipient,
+		GasLimit:           gasLimit,
+		MaxFee:             maxFee,
+		CustomHookId:       customHookId,
+		CustomHookMetadata: customHookMetadata,
+	}, nil
+}
+
+// IbcIntermediateAddress derives the account which receives ICS-20 tokens on behalf of the
+// original sender before they are sent on with a warp transfer.
+func IbcIntermediateAddress(channel, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("ibc_intermediate"), []byte(channel), []byte(sender))
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestParseIbcWarpMemo(t *testing.T) {
+	tokenId := "0x726f757465725f61707000000000000000000000000000010000000000000000"
+	recipient := "0xd7194459d45619d04a5a0f9e78dc9594a0f37fd6da8382fe12ddda6f2f46d647"
+
+	type testCase struct {
+		name     string
+		memo     string
+		isWarp   bool
+		hasError bool
+	}
+	for _, c := range []testCase{
+		{"empty memo", "", false, false},
+		{"plain text memo", "hello", false, false},
+		{"other middleware", `{"wasm": {"contract": "osmo1"}}`, false, false},
+		{"valid", `{"hyperlane_warp": {"token_id": "` + tokenId + `", "destination_domain": 1, "recipient": "` + recipient + `", "gas_limit": "50000", "max_fee": "100acoin"}}`, true, false},
+		{"invalid token id", `{"hyperlane_warp": {"token_id": "0x12", "destination_domain": 1, "recipient": "` + recipient + `", "max_fee": "100acoin"}}`, false, true},
+		{"invalid recipient", `{"hyperlane_warp": {"token_id": "` + tokenId + `", "destination_domain": 1, "recipient": "", "max_fee": "100acoin"}}`, false, true},
+		{"invalid gas limit", `{"hyperlane_warp": {"token_id": "` + tokenId + `", "destination_domain": 1, "recipient": "` + recipient + `", "gas_limit": "-1", "max_fee": "100acoin"}}`, false, true},
+		{"missing max fee", `{"hyperlane_warp": {"token_id": "` + tokenId + `", "destination_domain": 1, "recipient": "` + recipient + `"}}`, false, true},
+		{"invalid custom hook", `{"hyperlane_warp": {"token_id": "` + tokenId + `", "destination_domain": 1, "recipient": "` + recipient + `", "max_fee": "100acoin", "custom_hook_id": "0x1"}}`, false, true},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			transfer, err := ParseIbcWarpMemo(c.memo)
+			if (err != nil) != c.hasError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (transfer != nil) != c.isWarp {
+				t.Fatalf("unexpected transfer: %v", transfer)
+			}
+		})
+	}
+}
@@ -1,11 +1,33 @@
 package types
 
-import "cosmossdk.io/collections"
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const ModuleName = "warp"
 
 var (
-	ParamsKey          = collections.NewPrefix(0)
-	HypTokenKey        = collections.NewPrefix(1)
-	EnrolledRoutersKey = collections.NewPrefix(2)
+	ParamsKey            = collections.NewPrefix(0)
+	HypTokenKey          = collections.NewPrefix(1)
+	EnrolledRoutersKey   = collections.NewPrefix(2)
+	IbcForwardsKey       = collections.NewPrefix(3)
+	RateLimitsKey        = collections.NewPrefix(4)
+	BridgeFeesKey        = collections.NewPrefix(5)
+	CollateralRoutersKey = collections.NewPrefix(6)
+	LocalSettlementsKey  = collections.NewPrefix(7)
+	DenomTokensKey       = collections.NewPrefix(8)
 )
+
+// ModuleAddress is the address of the warp module account, which holds the collateral of all
+// collateral tokens.
+var ModuleAddress = sdk.AccAddress(address.Module(ModuleName))
+
+// IbcForwarderAddress holds received tokens while they are forwarded over IBC. It is not a module
+// account, so that ICS-20 accepts it as sender and refunds failed transfers to it.
+var IbcForwarderAddress = sdk.AccAddress(address.Module(ModuleName, []byte("ibc_forwarder")))
+
+// DefaultIbcForwardTimeoutSeconds is used if an IbcForward does not specify a timeout.
+const DefaultIbcForwardTimeoutSeconds uint64 = 600
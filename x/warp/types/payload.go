@@ -3,15 +3,23 @@ package types
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math/big"
 	"slices"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// METADATA_TYPE_IBC_FORWARD is the first byte of the payload metadata which carries an IbcForward.
+const METADATA_TYPE_IBC_FORWARD byte = 1
+
 type WarpPayload struct {
 	recipient []byte
 	amount    big.Int
+	metadata  []byte
 }
 
 func NewWarpPayload(recipient []byte, amount big.Int) (WarpPayload, error) {
@@ -25,16 +33,34 @@ func NewWarpPayload(recipient []byte, amount big.Int) (WarpPayload, error) {
 	return WarpPayload{recipient: recipient, amount: amount}, nil
 }
 
+// NewWarpPayloadWithIbcForward creates a payload which instructs the receiving chain to forward
+// the tokens over IBC. The recipient receives the tokens if the forwarding fails.
+func NewWarpPayloadWithIbcForward(recipient []byte, amount big.Int, forward IbcForward) (WarpPayload, error) {
+	payload, err := NewWarpPayload(recipient, amount)
+	if err != nil {
+		return WarpPayload{}, err
+	}
+
+	bz, err := forward.Marshal()
+	if err != nil {
+		return WarpPayload{}, err
+	}
+
+	payload.metadata = append([]byte{METADATA_TYPE_IBC_FORWARD}, bz...)
+	return payload, nil
+}
+
 func ParseWarpPayload(payload []byte) (WarpPayload, error) {
-	if len(payload) != 64 {
+	if len(payload) < 64 {
 		return WarpPayload{}, errors.New("payload is invalid")
 	}
 
-	amount := big.NewInt(0).SetBytes(payload[32:])
+	amount := big.NewInt(0).SetBytes(payload[32:64])
 
 	return WarpPayload{
 		recipient: payload[0:32],
 		amount:    *amount,
+		metadata:  payload[64:],
 	}, nil
 }
 
@@ -61,6 +87,38 @@ func (p WarpPayload) Amount() *big.Int {
 	return newInt
 }
 
+func (p WarpPayload) Metadata() []byte {
+	return p.metadata
+}
+
+// HasIbcForward returns true if the metadata is tagged as an IBC forwarding instruction.
+func (p WarpPayload) HasIbcForward() bool {
+	return len(p.metadata) > 0 && p.metadata[0] == METADATA_TYPE_IBC_FORWARD
+}
+
+// IbcForward returns the IBC forwarding instruction of the payload metadata, or nil if the payload
+// does not carry one. Metadata of other types is ignored.
+func (p WarpPayload) IbcForward() (*IbcForward, error) {
+	if !p.HasIbcForward() {
+		return nil, nil
+	}
+
+	var forward IbcForward
+	if err := forward.Unmarshal(p.metadata[1:]); err != nil {
+		return nil, fmt.Errorf("invalid ibc forward metadata: %w", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(forward.SourceChannel); err != nil {
+		return nil, fmt.Errorf("invalid ibc forward channel: %w", err)
+	}
+
+	if strings.TrimSpace(forward.Receiver) == "" {
+		return nil, errors.New("invalid ibc forward receiver")
+	}
+
+	return &forward, nil
+}
+
 func (p WarpPayload) Bytes() []byte {
 	intBytes := p.amount.Bytes()
 	amountBytes := make([]byte, 32)
@@ -73,5 +131,6 @@ func (p WarpPayload) Bytes() []byte {
 	return slices.Concat(
 		receiverBytes,
 		amountBytes,
+		p.metadata,
 	)
 }
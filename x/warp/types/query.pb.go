@@ -5,13 +5,15 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -233,6 +235,15 @@ type WrappedHypToken struct {
 	OriginMailbox string                                                       `protobuf:"bytes,4,opt,name=origin_mailbox,json=originMailbox,proto3" json:"origin_mailbox,omitempty"`
 	OriginDenom   string                                                       `protobuf:"bytes,5,opt,name=origin_denom,json=originDenom,proto3" json:"origin_denom,omitempty"`
 	IsmId         *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,7,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id,omitempty"`
+	Decimals      uint32                                                       `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Paused        bool                                                         `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedDomains []uint32                                                     `protobuf:"varint,10,rep,packed,name=paused_domains,json=pausedDomains,proto3" json:"paused_domains,omitempty"`
+	Guardian      string                                                       `protobuf:"bytes,11,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	BridgeFee     BridgeFeePolicy                                              `protobuf:"bytes,12,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	FeeRecipient  string                                                       `protobuf:"bytes,13,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	HookId        *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,14,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id,omitempty"`
+	// hook_metadata is hex encoded.
+	HookMetadata string `protobuf:"bytes,15,opt,name=hook_metadata,json=hookMetadata,proto3" json:"hook_metadata,omitempty"`
 }
 
 func (m *WrappedHypToken) Reset()         { *m = WrappedHypToken{} }
@@ -303,6 +314,153 @@ func (m *WrappedHypToken) GetOriginDenom() string {
 	return ""
 }
 
+func (m *WrappedHypToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *WrappedHypToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *WrappedHypToken) GetPausedDomains() []uint32 {
+	if m != nil {
+		return m.PausedDomains
+	}
+	return nil
+}
+
+func (m *WrappedHypToken) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *WrappedHypToken) GetBridgeFee() BridgeFeePolicy {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return BridgeFeePolicy{}
+}
+
+func (m *WrappedHypToken) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *WrappedHypToken) GetHookMetadata() string {
+	if m != nil {
+		return m.HookMetadata
+	}
+	return ""
+}
+
+// QueryTokenMetadataRequest ...
+type QueryTokenMetadataRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTokenMetadataRequest) Reset()         { *m = QueryTokenMetadataRequest{} }
+func (m *QueryTokenMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenMetadataRequest) ProtoMessage()    {}
+func (*QueryTokenMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{5}
+}
+func (m *QueryTokenMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenMetadataRequest.Merge(m, src)
+}
+func (m *QueryTokenMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryTokenMetadataRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryTokenMetadataResponse ...
+type QueryTokenMetadataResponse struct {
+	Token    *WrappedHypToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Metadata types.Metadata   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *QueryTokenMetadataResponse) Reset()         { *m = QueryTokenMetadataResponse{} }
+func (m *QueryTokenMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenMetadataResponse) ProtoMessage()    {}
+func (*QueryTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{6}
+}
+func (m *QueryTokenMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenMetadataResponse.Merge(m, src)
+}
+func (m *QueryTokenMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryTokenMetadataResponse) GetToken() *WrappedHypToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *QueryTokenMetadataResponse) GetMetadata() types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types.Metadata{}
+}
+
 // QueryBridgedSupplyRequest ...
 type QueryBridgedSupplyRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -312,7 +470,7 @@ func (m *QueryBridgedSupplyRequest) Reset()         { *m = QueryBridgedSupplyReq
 func (m *QueryBridgedSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBridgedSupplyRequest) ProtoMessage()    {}
 func (*QueryBridgedSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3514340e89a94dcf, []int{5}
+	return fileDescriptor_3514340e89a94dcf, []int{7}
 }
 func (m *QueryBridgedSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,14 +508,19 @@ func (m *QueryBridgedSupplyRequest) GetId() string {
 
 // QueryBridgedSupplyResponse ...
 type QueryBridgedSupplyResponse struct {
-	BridgedSupply types.Coin `protobuf:"bytes,1,opt,name=bridged_supply,json=bridgedSupply,proto3" json:"bridged_supply"`
+	BridgedSupply types1.Coin `protobuf:"bytes,1,opt,name=bridged_supply,json=bridgedSupply,proto3" json:"bridged_supply"`
+	// decimals of the bridged supply.
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// remote_bridged_supplies is the bridged supply in the units of each
+	// enrolled remote router.
+	RemoteBridgedSupplies []RemoteAmount `protobuf:"bytes,3,rep,name=remote_bridged_supplies,json=remoteBridgedSupplies,proto3" json:"remote_bridged_supplies"`
 }
 
 func (m *QueryBridgedSupplyResponse) Reset()         { *m = QueryBridgedSupplyResponse{} }
 func (m *QueryBridgedSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBridgedSupplyResponse) ProtoMessage()    {}
 func (*QueryBridgedSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3514340e89a94dcf, []int{6}
+	return fileDescriptor_3514340e89a94dcf, []int{8}
 }
 func (m *QueryBridgedSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,11 +549,79 @@ func (m *QueryBridgedSupplyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBridgedSupplyResponse proto.InternalMessageInfo
 
-func (m *QueryBridgedSupplyResponse) GetBridgedSupply() types.Coin {
+func (m *QueryBridgedSupplyResponse) GetBridgedSupply() types1.Coin {
 	if m != nil {
 		return m.BridgedSupply
 	}
-	return types.Coin{}
+	return types1.Coin{}
+}
+
+func (m *QueryBridgedSupplyResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *QueryBridgedSupplyResponse) GetRemoteBridgedSupplies() []RemoteAmount {
+	if m != nil {
+		return m.RemoteBridgedSupplies
+	}
+	return nil
+}
+
+// RemoteAmount is an amount in the units of a remote router.
+type RemoteAmount struct {
+	ReceiverDomain uint32                `protobuf:"varint,1,opt,name=receiver_domain,json=receiverDomain,proto3" json:"receiver_domain,omitempty"`
+	Decimals       uint32                `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RemoteAmount) Reset()         { *m = RemoteAmount{} }
+func (m *RemoteAmount) String() string { return proto.CompactTextString(m) }
+func (*RemoteAmount) ProtoMessage()    {}
+func (*RemoteAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{9}
+}
+func (m *RemoteAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteAmount.Merge(m, src)
+}
+func (m *RemoteAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteAmount proto.InternalMessageInfo
+
+func (m *RemoteAmount) GetReceiverDomain() uint32 {
+	if m != nil {
+		return m.ReceiverDomain
+	}
+	return 0
+}
+
+func (m *RemoteAmount) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
 }
 
 // QueryRemoteRoutersRequest ...
@@ -403,7 +634,7 @@ func (m *QueryRemoteRoutersRequest) Reset()         { *m = QueryRemoteRoutersReq
 func (m *QueryRemoteRoutersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRemoteRoutersRequest) ProtoMessage()    {}
 func (*QueryRemoteRoutersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3514340e89a94dcf, []int{7}
+	return fileDescriptor_3514340e89a94dcf, []int{10}
 }
 func (m *QueryRemoteRoutersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +689,7 @@ func (m *QueryRemoteRoutersResponse) Reset()         { *m = QueryRemoteRoutersRe
 func (m *QueryRemoteRoutersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRemoteRoutersResponse) ProtoMessage()    {}
 func (*QueryRemoteRoutersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3514340e89a94dcf, []int{8}
+	return fileDescriptor_3514340e89a94dcf, []int{11}
 }
 func (m *QueryRemoteRoutersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,13 +736,15 @@ func (m *QueryRemoteRoutersResponse) GetPagination() *query.PageResponse {
 type QueryQuoteRemoteTransferRequest struct {
 	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	DestinationDomain string `protobuf:"bytes,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// amount is the optional amount to transfer in local units.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (m *QueryQuoteRemoteTransferRequest) Reset()         { *m = QueryQuoteRemoteTransferRequest{} }
 func (m *QueryQuoteRemoteTransferRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteRemoteTransferRequest) ProtoMessage()    {}
 func (*QueryQuoteRemoteTransferRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3514340e89a94dcf, []int{9}
+	return fileDescriptor_3514340e89a94dcf, []int{12}
 }
 func (m *QueryQuoteRemoteTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,16 +787,31 @@ func (m *QueryQuoteRemoteTransferRequest) GetDestinationDomain() string {
 	return ""
 }
 
+func (m *QueryQuoteRemoteTransferRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 // QueryQuoteRemoteTransferResponse ...
 type QueryQuoteRemoteTransferResponse struct {
 	GasPayment github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=gas_payment,json=gasPayment,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gas_payment"`
+	// amount is the amount to transfer in local units.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// remote_amount is the amount which is received in remote units after the
+	// bridge fee is deducted.
+	RemoteAmount RemoteAmount `protobuf:"bytes,3,opt,name=remote_amount,json=remoteAmount,proto3" json:"remote_amount"`
+	// bridge_fee is deducted from the amount and paid to the fee recipient of
+	// the token. It is charged in addition to the gas payment.
+	BridgeFee types1.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
 }
 
 func (m *QueryQuoteRemoteTransferResponse) Reset()         { *m = QueryQuoteRemoteTransferResponse{} }
 func (m *QueryQuoteRemoteTransferResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteRemoteTransferResponse) ProtoMessage()    {}
 func (*QueryQuoteRemoteTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3514340e89a94dcf, []int{10}
+	return fileDescriptor_3514340e89a94dcf, []int{13}
 }
 func (m *QueryQuoteRemoteTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,965 +847,4884 @@ func (m *QueryQuoteRemoteTransferResponse) GetGasPayment() github_com_cosmos_cos
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryTokensRequest)(nil), "hyperlane.warp.v1.QueryTokensRequest")
-	proto.RegisterType((*QueryTokensResponse)(nil), "hyperlane.warp.v1.QueryTokensResponse")
-	proto.RegisterType((*QueryTokenRequest)(nil), "hyperlane.warp.v1.QueryTokenRequest")
-	proto.RegisterType((*QueryTokenResponse)(nil), "hyperlane.warp.v1.QueryTokenResponse")
-	proto.RegisterType((*WrappedHypToken)(nil), "hyperlane.warp.v1.WrappedHypToken")
-	proto.RegisterType((*QueryBridgedSupplyRequest)(nil), "hyperlane.warp.v1.QueryBridgedSupplyRequest")
-	proto.RegisterType((*QueryBridgedSupplyResponse)(nil), "hyperlane.warp.v1.QueryBridgedSupplyResponse")
-	proto.RegisterType((*QueryRemoteRoutersRequest)(nil), "hyperlane.warp.v1.QueryRemoteRoutersRequest")
-	proto.RegisterType((*QueryRemoteRoutersResponse)(nil), "hyperlane.warp.v1.QueryRemoteRoutersResponse")
-	proto.RegisterType((*QueryQuoteRemoteTransferRequest)(nil), "hyperlane.warp.v1.QueryQuoteRemoteTransferRequest")
-	proto.RegisterType((*QueryQuoteRemoteTransferResponse)(nil), "hyperlane.warp.v1.QueryQuoteRemoteTransferResponse")
+func (m *QueryQuoteRemoteTransferResponse) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-func init() { proto.RegisterFile("hyperlane/warp/v1/query.proto", fileDescriptor_3514340e89a94dcf) }
-
-var fileDescriptor_3514340e89a94dcf = []byte{
-	// 931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6b, 0x24, 0x45,
-	0x14, 0x4e, 0xcf, 0x3a, 0x23, 0x53, 0x31, 0x23, 0x29, 0x83, 0x4c, 0xc6, 0x75, 0x26, 0xdb, 0x9a,
-	0x75, 0x88, 0x4e, 0xb7, 0x99, 0x45, 0x58, 0x10, 0x04, 0xb3, 0x31, 0xae, 0xf8, 0x83, 0xdd, 0xde,
-	0x80, 0xb0, 0x08, 0x6d, 0x75, 0xba, 0xec, 0x2d, 0x76, 0xba, 0xaa, 0x53, 0x55, 0x9d, 0x64, 0x58,
-	0xf6, 0xe2, 0xc9, 0xa3, 0x20, 0x5e, 0xbd, 0x09, 0x22, 0x08, 0x1e, 0x04, 0xff, 0x85, 0x1c, 0x3c,
-	0x2c, 0x7a, 0x11, 0x0f, 0x51, 0x12, 0xc1, 0x7f, 0x43, 0xba, 0xaa, 0x3a, 0xe9, 0x4e, 0xba, 0x37,
-	0x59, 0xf1, 0x92, 0x4c, 0xbf, 0x5f, 0xdf, 0xf7, 0x5e, 0xbd, 0xaf, 0xba, 0xc1, 0x8b, 0xf7, 0xa6,
-	0x09, 0xe6, 0x13, 0x44, 0xb1, 0xbb, 0x8b, 0x78, 0xe2, 0xee, 0xac, 0xba, 0xdb, 0x29, 0xe6, 0x53,
-	0x27, 0xe1, 0x4c, 0x32, 0x38, 0x7f, 0xec, 0x76, 0x32, 0xb7, 0xb3, 0xb3, 0xda, 0x5b, 0xd9, 0x62,
-	0x22, 0x66, 0xc2, 0x0d, 0x90, 0xc0, 0x3a, 0xd6, 0xdd, 0x59, 0x0d, 0xb0, 0x44, 0xab, 0x6e, 0x82,
-	0x22, 0x42, 0x91, 0x24, 0x8c, 0xea, 0xf4, 0x5e, 0xbf, 0x18, 0x9b, 0x47, 0x6d, 0x31, 0x92, 0xfb,
-	0x2b, 0xd0, 0xe5, 0x34, 0xc1, 0xc2, 0xb8, 0x2f, 0x47, 0x8c, 0x45, 0x13, 0xec, 0xa2, 0x84, 0xb8,
-	0x88, 0x52, 0x26, 0x55, 0xed, 0xdc, 0xbb, 0xa8, 0x8b, 0xfb, 0xea, 0xc9, 0xd5, 0x0f, 0xc6, 0xb5,
-	0x10, 0xb1, 0x88, 0x69, 0x7b, 0xf6, 0xcb, 0x58, 0xe7, 0x51, 0x4c, 0x28, 0x73, 0xd5, 0x5f, 0x6d,
-	0xb2, 0x3f, 0x01, 0xf0, 0x76, 0xd6, 0xc2, 0x26, 0xbb, 0x8f, 0xa9, 0xf0, 0xf0, 0x76, 0x8a, 0x85,
-	0x84, 0x1b, 0x00, 0x9c, 0xb4, 0xd2, 0xb5, 0x96, 0xac, 0xe1, 0xec, 0xf8, 0xaa, 0x63, 0x10, 0xb2,
-	0x5e, 0x1c, 0x3d, 0x23, 0xd3, 0x91, 0x73, 0x0b, 0x45, 0xd8, 0xe4, 0x7a, 0x85, 0x4c, 0xfb, 0x5b,
-	0x0b, 0x3c, 0x57, 0x2a, 0x2f, 0x12, 0x46, 0x05, 0x86, 0xef, 0x80, 0x96, 0x54, 0x96, 0xae, 0xb5,
-	0x74, 0x69, 0x38, 0x3b, 0xb6, 0x9d, 0x33, 0x63, 0x76, 0x3e, 0xe6, 0x28, 0x49, 0x70, 0x78, 0x73,
-	0x9a, 0xa8, 0xe4, 0xb5, 0xf6, 0xfe, 0xc1, 0x60, 0xe6, 0xbb, 0x7f, 0x7e, 0x5c, 0xb1, 0x3c, 0x93,
-	0x0c, 0xdf, 0x2d, 0xd1, 0x6c, 0x28, 0x9a, 0xaf, 0x9c, 0x4b, 0x53, 0x73, 0x28, 0xf1, 0x7c, 0x09,
-	0xcc, 0x9f, 0xd0, 0xcc, 0x87, 0xd0, 0x01, 0x0d, 0x12, 0xaa, 0xe6, 0xdb, 0x5e, 0x83, 0x84, 0xf6,
-	0x47, 0xc5, 0x51, 0x1d, 0xb7, 0x72, 0x1d, 0x34, 0x15, 0x1b, 0x33, 0xa5, 0x0b, 0x74, 0xe2, 0xe9,
-	0x04, 0xfb, 0xe7, 0x06, 0x78, 0xf6, 0x94, 0xeb, 0x34, 0x26, 0x74, 0x40, 0x93, 0xed, 0x52, 0xcc,
-	0x55, 0x73, 0xed, 0xb5, 0xee, 0xaf, 0x3f, 0x8d, 0x16, 0x4c, 0x7f, 0x6f, 0x87, 0x21, 0xc7, 0x42,
-	0xdc, 0x91, 0x9c, 0xd0, 0xc8, 0xd3, 0x61, 0xf0, 0x2d, 0x00, 0x54, 0x71, 0x3f, 0xdb, 0xa2, 0xee,
-	0xa5, 0x25, 0x6b, 0xd8, 0x19, 0x0f, 0x2a, 0x28, 0xe5, 0x80, 0x9b, 0xd3, 0x04, 0x7b, 0x6d, 0x99,
-	0xff, 0x84, 0xcb, 0xa0, 0xc3, 0x38, 0x89, 0x08, 0xf5, 0x63, 0x44, 0x26, 0x01, 0xdb, 0xeb, 0x3e,
-	0xa5, 0xb8, 0xcc, 0x69, 0xeb, 0x87, 0xda, 0x08, 0xaf, 0x80, 0x67, 0x4c, 0x58, 0x88, 0x29, 0x8b,
-	0xbb, 0x4d, 0x15, 0x34, 0xab, 0x6d, 0xeb, 0x99, 0x09, 0xde, 0x05, 0x2d, 0x22, 0x62, 0x9f, 0x84,
-	0xdd, 0xa7, 0x15, 0xf5, 0x1b, 0xfb, 0x07, 0x03, 0xeb, 0x8f, 0x83, 0xc1, 0x9b, 0x11, 0x91, 0xf7,
-	0xd2, 0xc0, 0xd9, 0x62, 0xb1, 0x1b, 0x6c, 0x25, 0x23, 0x42, 0x29, 0xdb, 0xd1, 0x6b, 0xed, 0x1e,
-	0xf3, 0x1c, 0x19, 0xd9, 0xa4, 0x92, 0x4c, 0x9c, 0x9b, 0x78, 0xcf, 0xf4, 0xea, 0x35, 0x89, 0x88,
-	0xdf, 0x0b, 0xed, 0x57, 0xc1, 0xa2, 0x3a, 0x89, 0x35, 0x4e, 0xc2, 0x08, 0x87, 0x77, 0xd2, 0x24,
-	0x99, 0x4c, 0xeb, 0x8e, 0x8d, 0x80, 0x5e, 0x55, 0xb0, 0x39, 0xbe, 0xf7, 0x41, 0x27, 0xd0, 0x0e,
-	0x5f, 0x28, 0x8f, 0x39, 0xc7, 0xc5, 0xd2, 0x1a, 0xe5, 0x0b, 0x74, 0x83, 0x91, 0xd2, 0x22, 0xce,
-	0x05, 0xc5, 0xa2, 0xb6, 0x30, 0xbc, 0x3c, 0x1c, 0x33, 0x89, 0x3d, 0x96, 0x4a, 0xcc, 0x45, 0x0d,
-	0xaf, 0x53, 0x1a, 0x6b, 0xfc, 0x67, 0x8d, 0xfd, 0x60, 0x99, 0x06, 0x4f, 0xa1, 0x9a, 0x06, 0x37,
-	0x40, 0x87, 0x2b, 0x87, 0xcf, 0xb5, 0xc7, 0x48, 0xae, 0x6a, 0x2b, 0x8a, 0x15, 0xbc, 0x39, 0x5e,
-	0xac, 0xf7, 0xff, 0x69, 0xed, 0x53, 0x30, 0x50, 0x74, 0x6f, 0xa7, 0x59, 0x75, 0x85, 0xb1, 0xc9,
-	0x11, 0x15, 0x9f, 0x61, 0x5e, 0x37, 0xaa, 0x11, 0x80, 0x21, 0x16, 0xd2, 0x54, 0xf0, 0x43, 0x16,
-	0x23, 0xa2, 0x39, 0xb4, 0xbd, 0xf9, 0x82, 0x67, 0x5d, 0x39, 0xec, 0xaf, 0x2d, 0xb0, 0x54, 0x0f,
-	0x61, 0xe6, 0xb2, 0x0d, 0x66, 0x23, 0x24, 0xfc, 0x04, 0x4d, 0x63, 0x4c, 0xa5, 0x19, 0xca, 0x63,
-	0x4e, 0xfd, 0x8d, 0xec, 0xd4, 0xbf, 0xff, 0x73, 0x30, 0x2c, 0xec, 0xaf, 0xd9, 0x52, 0xfd, 0x6f,
-	0x24, 0xc2, 0xfb, 0xe6, 0xf2, 0xce, 0x12, 0x84, 0xde, 0x10, 0x10, 0x21, 0x71, 0x4b, 0x63, 0x8c,
-	0xbf, 0x68, 0x81, 0xa6, 0xe2, 0x05, 0x53, 0xd0, 0xd2, 0x37, 0x22, 0x5c, 0xae, 0x38, 0x86, 0xb3,
-	0x17, 0x72, 0xef, 0xea, 0x79, 0x61, 0xba, 0x2b, 0xfb, 0xf2, 0xe7, 0xbf, 0xfd, 0xfd, 0x55, 0xe3,
-	0x79, 0xb8, 0x70, 0xa2, 0x25, 0xf5, 0x4e, 0xd1, 0x60, 0xbb, 0xa0, 0xa9, 0xaf, 0x99, 0x97, 0x1f,
-	0x5b, 0x2e, 0x07, 0x5d, 0x3e, 0x27, 0xca, 0x60, 0x5e, 0x51, 0x98, 0x2f, 0xc0, 0xc5, 0x2a, 0x4c,
-	0xf7, 0x01, 0x09, 0x1f, 0xc2, 0x6f, 0x2c, 0x30, 0x57, 0xd2, 0x1f, 0x7c, 0xad, 0xae, 0x76, 0x95,
-	0xa6, 0x7b, 0xa3, 0x0b, 0x46, 0x1b, 0x46, 0xaf, 0x2b, 0x46, 0x2b, 0x70, 0x58, 0xcb, 0xc8, 0x2d,
-	0x8b, 0x5e, 0x11, 0x2c, 0xe9, 0xa7, 0x9e, 0x60, 0x95, 0xb8, 0xeb, 0x09, 0x56, 0x8a, 0xf2, 0x22,
-	0x04, 0xcb, 0xa2, 0x85, 0xbf, 0xa8, 0x37, 0xe9, 0x99, 0x75, 0x86, 0xe3, 0x3a, 0xe0, 0x7a, 0x79,
-	0xf5, 0xae, 0x3d, 0x51, 0x8e, 0xa1, 0xfc, 0x81, 0xa2, 0xbc, 0x01, 0xd7, 0xeb, 0x29, 0x6f, 0xa7,
-	0x8a, 0xb1, 0x26, 0x2e, 0x4d, 0x01, 0xf7, 0xc1, 0x59, 0xe9, 0x3e, 0x5c, 0xf3, 0xf6, 0x0f, 0xfb,
-	0xd6, 0xa3, 0xc3, 0xbe, 0xf5, 0xd7, 0x61, 0xdf, 0xfa, 0xf2, 0xa8, 0x3f, 0xf3, 0xe8, 0xa8, 0x3f,
-	0xf3, 0xfb, 0x51, 0x7f, 0xe6, 0xee, 0xf5, 0x27, 0x79, 0x3f, 0xec, 0xe9, 0xaf, 0x26, 0xa5, 0xba,
-	0xa0, 0xa5, 0xbe, 0x68, 0xae, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x23, 0xfd, 0x3a, 0xd2,
-	0x09, 0x00, 0x00,
+func (m *QueryQuoteRemoteTransferResponse) GetRemoteAmount() RemoteAmount {
+	if m != nil {
+		return m.RemoteAmount
+	}
+	return RemoteAmount{}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Tokens ...
-	Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error)
-	// Token ...
-	Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error)
-	// BridgedSupply ...
-	BridgedSupply(ctx context.Context, in *QueryBridgedSupplyRequest, opts ...grpc.CallOption) (*QueryBridgedSupplyResponse, error)
-	// RemoteRouters ...
-	RemoteRouters(ctx context.Context, in *QueryRemoteRoutersRequest, opts ...grpc.CallOption) (*QueryRemoteRoutersResponse, error)
-	// QuoteRemoteTransfer ...
-	QuoteRemoteTransfer(ctx context.Context, in *QueryQuoteRemoteTransferRequest, opts ...grpc.CallOption) (*QueryQuoteRemoteTransferResponse, error)
+func (m *QueryQuoteRemoteTransferResponse) GetBridgeFee() types1.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return types1.Coin{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryBridgeFeesRequest ...
+type QueryBridgeFeesRequest struct {
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBridgeFeesRequest) Reset()         { *m = QueryBridgeFeesRequest{} }
+func (m *QueryBridgeFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeFeesRequest) ProtoMessage()    {}
+func (*QueryBridgeFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{14}
 }
-
-func (c *queryClient) Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error) {
-	out := new(QueryTokensResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/Tokens", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryBridgeFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error) {
-	out := new(QueryTokenResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/Token", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBridgeFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeFeesRequest.Merge(m, src)
+}
+func (m *QueryBridgeFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeFeesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BridgedSupply(ctx context.Context, in *QueryBridgedSupplyRequest, opts ...grpc.CallOption) (*QueryBridgedSupplyResponse, error) {
-	out := new(QueryBridgedSupplyResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/BridgedSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeFeesRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeFeesRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RemoteRouters(ctx context.Context, in *QueryRemoteRoutersRequest, opts ...grpc.CallOption) (*QueryRemoteRoutersResponse, error) {
-	out := new(QueryRemoteRoutersResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/RemoteRouters", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QuoteRemoteTransfer(ctx context.Context, in *QueryQuoteRemoteTransferRequest, opts ...grpc.CallOption) (*QueryQuoteRemoteTransferResponse, error) {
-	out := new(QueryQuoteRemoteTransferResponse)
-	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/QuoteRemoteTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBridgeFeesResponse ...
+type QueryBridgeFeesResponse struct {
+	BridgeFees []RouteBridgeFee `protobuf:"bytes,1,rep,name=bridge_fees,json=bridgeFees,proto3" json:"bridge_fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Tokens ...
-	Tokens(context.Context, *QueryTokensRequest) (*QueryTokensResponse, error)
-	// Token ...
-	Token(context.Context, *QueryTokenRequest) (*QueryTokenResponse, error)
-	// BridgedSupply ...
-	BridgedSupply(context.Context, *QueryBridgedSupplyRequest) (*QueryBridgedSupplyResponse, error)
-	// RemoteRouters ...
-	RemoteRouters(context.Context, *QueryRemoteRoutersRequest) (*QueryRemoteRoutersResponse, error)
-	// QuoteRemoteTransfer ...
-	QuoteRemoteTransfer(context.Context, *QueryQuoteRemoteTransferRequest) (*QueryQuoteRemoteTransferResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBridgeFeesResponse) Reset()         { *m = QueryBridgeFeesResponse{} }
+func (m *QueryBridgeFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeFeesResponse) ProtoMessage()    {}
+func (*QueryBridgeFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{15}
 }
-
-func (*UnimplementedQueryServer) Tokens(ctx context.Context, req *QueryTokensRequest) (*QueryTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tokens not implemented")
+func (m *QueryBridgeFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Token(ctx context.Context, req *QueryTokenRequest) (*QueryTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+func (m *QueryBridgeFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BridgedSupply(ctx context.Context, req *QueryBridgedSupplyRequest) (*QueryBridgedSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgedSupply not implemented")
+func (m *QueryBridgeFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeFeesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RemoteRouters(ctx context.Context, req *QueryRemoteRoutersRequest) (*QueryRemoteRoutersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoteRouters not implemented")
+func (m *QueryBridgeFeesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QuoteRemoteTransfer(ctx context.Context, req *QueryQuoteRemoteTransferRequest) (*QueryQuoteRemoteTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuoteRemoteTransfer not implemented")
+func (m *QueryBridgeFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeFeesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryBridgeFeesResponse proto.InternalMessageInfo
 
-func _Query_Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.warp.v1.Query/Tokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tokens(ctx, req.(*QueryTokensRequest))
+func (m *QueryBridgeFeesResponse) GetBridgeFees() []RouteBridgeFee {
+	if m != nil {
+		return m.BridgeFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Token(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.warp.v1.Query/Token",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Token(ctx, req.(*QueryTokenRequest))
+func (m *QueryBridgeFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BridgedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgedSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BridgedSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.warp.v1.Query/BridgedSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BridgedSupply(ctx, req.(*QueryBridgedSupplyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRoutesByDenomRequest ...
+type QueryRoutesByDenomRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_RemoteRouters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRemoteRoutersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RemoteRouters(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.warp.v1.Query/RemoteRouters",
+func (m *QueryRoutesByDenomRequest) Reset()         { *m = QueryRoutesByDenomRequest{} }
+func (m *QueryRoutesByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesByDenomRequest) ProtoMessage()    {}
+func (*QueryRoutesByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{16}
+}
+func (m *QueryRoutesByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutesByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutesByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RemoteRouters(ctx, req.(*QueryRemoteRoutersRequest))
+}
+func (m *QueryRoutesByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesByDenomRequest.Merge(m, src)
+}
+func (m *QueryRoutesByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutesByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryRoutesByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QuoteRemoteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQuoteRemoteTransferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryRoutesByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuoteRemoteTransfer(ctx, in)
+	return nil
+}
+
+// QueryRoutesByDenomResponse ...
+type QueryRoutesByDenomResponse struct {
+	Routes     []DenomRoute        `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoutesByDenomResponse) Reset()         { *m = QueryRoutesByDenomResponse{} }
+func (m *QueryRoutesByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesByDenomResponse) ProtoMessage()    {}
+func (*QueryRoutesByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{17}
+}
+func (m *QueryRoutesByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutesByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutesByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hyperlane.warp.v1.Query/QuoteRemoteTransfer",
+}
+func (m *QueryRoutesByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesByDenomResponse.Merge(m, src)
+}
+func (m *QueryRoutesByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutesByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesByDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryRoutesByDenomResponse) GetRoutes() []DenomRoute {
+	if m != nil {
+		return m.Routes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuoteRemoteTransfer(ctx, req.(*QueryQuoteRemoteTransferRequest))
+	return nil
+}
+
+func (m *QueryRoutesByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hyperlane.warp.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Tokens",
-			Handler:    _Query_Tokens_Handler,
-		},
-		{
-			MethodName: "Token",
-			Handler:    _Query_Token_Handler,
-		},
-		{
-			MethodName: "BridgedSupply",
-			Handler:    _Query_BridgedSupply_Handler,
-		},
-		{
-			MethodName: "RemoteRouters",
-			Handler:    _Query_RemoteRouters_Handler,
-		},
-		{
-			MethodName: "QuoteRemoteTransfer",
-			Handler:    _Query_QuoteRemoteTransfer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hyperlane/warp/v1/query.proto",
+// DenomRoute is a router of a token.
+type DenomRoute struct {
+	TokenId      github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	TokenType    HypTokenType                                                `protobuf:"varint,2,opt,name=token_type,json=tokenType,proto3,enum=hyperlane.warp.v1.HypTokenType" json:"token_type,omitempty"`
+	RemoteRouter RemoteRouter                                                `protobuf:"bytes,3,opt,name=remote_router,json=remoteRouter,proto3" json:"remote_router"`
+	// collateral_router is true for additional collateral routers, which are
+	// only used if they are selected as target router.
+	CollateralRouter bool `protobuf:"varint,4,opt,name=collateral_router,json=collateralRouter,proto3" json:"collateral_router,omitempty"`
 }
 
-func (m *QueryTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DenomRoute) Reset()         { *m = DenomRoute{} }
+func (m *DenomRoute) String() string { return proto.CompactTextString(m) }
+func (*DenomRoute) ProtoMessage()    {}
+func (*DenomRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{18}
+}
+func (m *DenomRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DenomRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRoute.Merge(m, src)
+}
+func (m *DenomRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRoute.DiscardUnknown(m)
 }
 
-func (m *QueryTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DenomRoute proto.InternalMessageInfo
+
+func (m *DenomRoute) GetTokenType() HypTokenType {
+	if m != nil {
+		return m.TokenType
+	}
+	return HYP_TOKEN_TYPE_UNSPECIFIED
 }
 
-func (m *QueryTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *DenomRoute) GetRemoteRouter() RemoteRouter {
+	if m != nil {
+		return m.RemoteRouter
 	}
-	return len(dAtA) - i, nil
+	return RemoteRouter{}
 }
 
-func (m *QueryTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DenomRoute) GetCollateralRouter() bool {
+	if m != nil {
+		return m.CollateralRouter
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCollateralDiscrepanciesRequest ...
+type QueryCollateralDiscrepanciesRequest struct {
 }
 
-func (m *QueryTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCollateralDiscrepanciesRequest) Reset()         { *m = QueryCollateralDiscrepanciesRequest{} }
+func (m *QueryCollateralDiscrepanciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralDiscrepanciesRequest) ProtoMessage()    {}
+func (*QueryCollateralDiscrepanciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{19}
+}
+func (m *QueryCollateralDiscrepanciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralDiscrepanciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralDiscrepanciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCollateralDiscrepanciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralDiscrepanciesRequest.Merge(m, src)
+}
+func (m *QueryCollateralDiscrepanciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralDiscrepanciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralDiscrepanciesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryCollateralDiscrepanciesRequest proto.InternalMessageInfo
+
+// QueryCollateralDiscrepanciesResponse ...
+type QueryCollateralDiscrepanciesResponse struct {
+	Discrepancies []CollateralDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies"`
 }
 
-func (m *QueryTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCollateralDiscrepanciesResponse) Reset()         { *m = QueryCollateralDiscrepanciesResponse{} }
+func (m *QueryCollateralDiscrepanciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralDiscrepanciesResponse) ProtoMessage()    {}
+func (*QueryCollateralDiscrepanciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{20}
+}
+func (m *QueryCollateralDiscrepanciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralDiscrepanciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralDiscrepanciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCollateralDiscrepanciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralDiscrepanciesResponse.Merge(m, src)
+}
+func (m *QueryCollateralDiscrepanciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralDiscrepanciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralDiscrepanciesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCollateralDiscrepanciesResponse proto.InternalMessageInfo
+
+func (m *QueryCollateralDiscrepanciesResponse) GetDiscrepancies() []CollateralDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CollateralDiscrepancy compares the tracked collateral of a denom with the
+// balance of the module account.
+type CollateralDiscrepancy struct {
+	Denom             string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TrackedCollateral cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=tracked_collateral,json=trackedCollateral,proto3,customtype=cosmossdk.io/math.Int" json:"tracked_collateral"`
+	ModuleBalance     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=module_balance,json=moduleBalance,proto3,customtype=cosmossdk.io/math.Int" json:"module_balance"`
+	// surplus is the module balance minus the tracked collateral. It is negative
+	// if the module account holds less than the tracked collateral.
+	Surplus cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=surplus,proto3,customtype=cosmossdk.io/math.Int" json:"surplus"`
 }
 
-func (m *QueryTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CollateralDiscrepancy) Reset()         { *m = CollateralDiscrepancy{} }
+func (m *CollateralDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*CollateralDiscrepancy) ProtoMessage()    {}
+func (*CollateralDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{21}
+}
+func (m *CollateralDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CollateralDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralDiscrepancy.Merge(m, src)
+}
+func (m *CollateralDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralDiscrepancy.DiscardUnknown(m)
 }
 
-func (m *WrappedHypToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CollateralDiscrepancy proto.InternalMessageInfo
+
+func (m *CollateralDiscrepancy) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *WrappedHypToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRateLimitsRequest ...
+type QueryRateLimitsRequest struct {
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *WrappedHypToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsmId != nil {
-		{
-			size := m.IsmId.Size()
-			i -= size
-			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{22}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OriginDenom) > 0 {
-		i -= len(m.OriginDenom)
-		copy(dAtA[i:], m.OriginDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OriginMailbox) > 0 {
-		i -= len(m.OriginMailbox)
-		copy(dAtA[i:], m.OriginMailbox)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginMailbox)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TokenType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TokenType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBridgedSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
 }
-
-func (m *QueryBridgedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBridgedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBridgedSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBridgedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRateLimitsResponse ...
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimitStatus `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBridgedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgedSupply.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{23}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRemoteRoutersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimitStatus {
+	if m != nil {
+		return m.RateLimits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRemoteRoutersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRemoteRoutersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// RateLimitStatus is the rate limit of a route with the currently remaining
+// capacity in each direction.
+type RateLimitStatus struct {
+	Domain            uint32                `protobuf:"varint,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Inbound           RateLimitConfig       `protobuf:"bytes,2,opt,name=inbound,proto3" json:"inbound"`
+	InboundRemaining  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=inbound_remaining,json=inboundRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_remaining"`
+	Outbound          RateLimitConfig       `protobuf:"bytes,4,opt,name=outbound,proto3" json:"outbound"`
+	OutboundRemaining cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=outbound_remaining,json=outboundRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_remaining"`
+}
+
+func (m *RateLimitStatus) Reset()         { *m = RateLimitStatus{} }
+func (m *RateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*RateLimitStatus) ProtoMessage()    {}
+func (*RateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3514340e89a94dcf, []int{24}
+}
+func (m *RateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitStatus.Merge(m, src)
+}
+func (m *RateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitStatus proto.InternalMessageInfo
+
+func (m *RateLimitStatus) GetDomain() uint32 {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryRemoteRoutersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitStatus) GetInbound() RateLimitConfig {
+	if m != nil {
+		return m.Inbound
+	}
+	return RateLimitConfig{}
+}
+
+func (m *RateLimitStatus) GetOutbound() RateLimitConfig {
+	if m != nil {
+		return m.Outbound
+	}
+	return RateLimitConfig{}
+}
+
+func init() {
+	proto.RegisterType((*QueryTokensRequest)(nil), "hyperlane.warp.v1.QueryTokensRequest")
+	proto.RegisterType((*QueryTokensResponse)(nil), "hyperlane.warp.v1.QueryTokensResponse")
+	proto.RegisterType((*QueryTokenRequest)(nil), "hyperlane.warp.v1.QueryTokenRequest")
+	proto.RegisterType((*QueryTokenResponse)(nil), "hyperlane.warp.v1.QueryTokenResponse")
+	proto.RegisterType((*WrappedHypToken)(nil), "hyperlane.warp.v1.WrappedHypToken")
+	proto.RegisterType((*QueryTokenMetadataRequest)(nil), "hyperlane.warp.v1.QueryTokenMetadataRequest")
+	proto.RegisterType((*QueryTokenMetadataResponse)(nil), "hyperlane.warp.v1.QueryTokenMetadataResponse")
+	proto.RegisterType((*QueryBridgedSupplyRequest)(nil), "hyperlane.warp.v1.QueryBridgedSupplyRequest")
+	proto.RegisterType((*QueryBridgedSupplyResponse)(nil), "hyperlane.warp.v1.QueryBridgedSupplyResponse")
+	proto.RegisterType((*RemoteAmount)(nil), "hyperlane.warp.v1.RemoteAmount")
+	proto.RegisterType((*QueryRemoteRoutersRequest)(nil), "hyperlane.warp.v1.QueryRemoteRoutersRequest")
+	proto.RegisterType((*QueryRemoteRoutersResponse)(nil), "hyperlane.warp.v1.QueryRemoteRoutersResponse")
+	proto.RegisterType((*QueryQuoteRemoteTransferRequest)(nil), "hyperlane.warp.v1.QueryQuoteRemoteTransferRequest")
+	proto.RegisterType((*QueryQuoteRemoteTransferResponse)(nil), "hyperlane.warp.v1.QueryQuoteRemoteTransferResponse")
+	proto.RegisterType((*QueryBridgeFeesRequest)(nil), "hyperlane.warp.v1.QueryBridgeFeesRequest")
+	proto.RegisterType((*QueryBridgeFeesResponse)(nil), "hyperlane.warp.v1.QueryBridgeFeesResponse")
+	proto.RegisterType((*QueryRoutesByDenomRequest)(nil), "hyperlane.warp.v1.QueryRoutesByDenomRequest")
+	proto.RegisterType((*QueryRoutesByDenomResponse)(nil), "hyperlane.warp.v1.QueryRoutesByDenomResponse")
+	proto.RegisterType((*DenomRoute)(nil), "hyperlane.warp.v1.DenomRoute")
+	proto.RegisterType((*QueryCollateralDiscrepanciesRequest)(nil), "hyperlane.warp.v1.QueryCollateralDiscrepanciesRequest")
+	proto.RegisterType((*QueryCollateralDiscrepanciesResponse)(nil), "hyperlane.warp.v1.QueryCollateralDiscrepanciesResponse")
+	proto.RegisterType((*CollateralDiscrepancy)(nil), "hyperlane.warp.v1.CollateralDiscrepancy")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "hyperlane.warp.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "hyperlane.warp.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*RateLimitStatus)(nil), "hyperlane.warp.v1.RateLimitStatus")
+}
+
+func init() { proto.RegisterFile("hyperlane/warp/v1/query.proto", fileDescriptor_3514340e89a94dcf) }
+
+var fileDescriptor_3514340e89a94dcf = []byte{
+	// 1831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x52, 0x22, 0x25, 0x3d, 0x91, 0x74, 0x38, 0xf5, 0x9f, 0x35, 0x1b, 0x4b, 0x32, 0x6d,
+	0xd9, 0xac, 0x5c, 0x72, 0x6b, 0xa5, 0x69, 0x03, 0xa4, 0x2d, 0x10, 0x4a, 0x51, 0xad, 0xd4, 0x69,
+	0x9d, 0xb5, 0x81, 0x02, 0x46, 0x50, 0x62, 0xc8, 0x1d, 0xd1, 0x03, 0x71, 0x77, 0xd6, 0x3b, 0xbb,
+	0xb2, 0x88, 0x34, 0x97, 0x1e, 0x73, 0x2a, 0xda, 0x43, 0x7b, 0x28, 0x7a, 0x28, 0x1a, 0xa0, 0x28,
+	0x5a, 0xa0, 0x87, 0x9c, 0xfa, 0x09, 0x7c, 0x68, 0x81, 0x20, 0xb9, 0x14, 0x39, 0xa8, 0x85, 0x5d,
+	0xa0, 0xc8, 0xb7, 0x28, 0x76, 0x66, 0x76, 0xb9, 0x4b, 0x72, 0x29, 0xb2, 0x51, 0x73, 0xb1, 0x39,
+	0x33, 0xef, 0xcf, 0xef, 0xbd, 0x37, 0xef, 0xcd, 0x7b, 0x2b, 0xb8, 0xfa, 0x78, 0xe0, 0x12, 0xaf,
+	0x8f, 0x1d, 0x62, 0x3c, 0xc5, 0x9e, 0x6b, 0x1c, 0xdd, 0x31, 0x9e, 0x04, 0xc4, 0x1b, 0x34, 0x5d,
+	0x8f, 0xf9, 0x0c, 0x55, 0xe2, 0xe3, 0x66, 0x78, 0xdc, 0x3c, 0xba, 0x53, 0xdd, 0xea, 0x32, 0x6e,
+	0x33, 0x6e, 0x74, 0x30, 0x27, 0x92, 0xd6, 0x38, 0xba, 0xd3, 0x21, 0x3e, 0xbe, 0x63, 0xb8, 0xb8,
+	0x47, 0x1d, 0xec, 0x53, 0xe6, 0x48, 0xf6, 0xea, 0x5a, 0x92, 0x36, 0xa2, 0xea, 0x32, 0x3a, 0x7e,
+	0xee, 0x1c, 0xc6, 0xe7, 0xe1, 0x42, 0x9d, 0x4f, 0x40, 0xe7, 0x0f, 0x5c, 0xc2, 0xd5, 0xf1, 0xcb,
+	0x3d, 0xc6, 0x7a, 0x7d, 0x62, 0x60, 0x97, 0x1a, 0xd8, 0x71, 0x98, 0x2f, 0x74, 0x47, 0xa7, 0x57,
+	0xa4, 0xf0, 0xb6, 0x58, 0x19, 0x72, 0xa1, 0x8e, 0x2e, 0xf4, 0x58, 0x8f, 0xc9, 0xfd, 0xf0, 0x97,
+	0xda, 0xad, 0x60, 0x9b, 0x3a, 0xcc, 0x10, 0xff, 0xca, 0xad, 0xda, 0xbb, 0x80, 0xde, 0x09, 0x4d,
+	0x7c, 0xc8, 0x0e, 0x89, 0xc3, 0x4d, 0xf2, 0x24, 0x20, 0xdc, 0x47, 0x7b, 0x00, 0x43, 0x53, 0x75,
+	0x6d, 0x43, 0xab, 0xaf, 0x6e, 0xdf, 0x6c, 0x2a, 0x0d, 0xa1, 0xad, 0x4d, 0xe9, 0x43, 0x65, 0x51,
+	0xf3, 0x3e, 0xee, 0x11, 0xc5, 0x6b, 0x26, 0x38, 0x6b, 0x1f, 0x6a, 0xf0, 0x95, 0x94, 0x78, 0xee,
+	0x32, 0x87, 0x13, 0xf4, 0x26, 0x14, 0x7c, 0xb1, 0xa3, 0x6b, 0x1b, 0x0b, 0xf5, 0xd5, 0xed, 0x5a,
+	0x73, 0x2c, 0x0c, 0xcd, 0x1f, 0x7b, 0xd8, 0x75, 0x89, 0x75, 0x77, 0xe0, 0x0a, 0xe6, 0xd6, 0xca,
+	0xb3, 0x93, 0xf5, 0x73, 0x7f, 0xf8, 0xcf, 0x5f, 0xb6, 0x34, 0x53, 0x31, 0xa3, 0xef, 0xa7, 0x60,
+	0xe6, 0x04, 0xcc, 0x5b, 0xa7, 0xc2, 0x94, 0x18, 0x52, 0x38, 0xaf, 0x43, 0x65, 0x08, 0x33, 0x72,
+	0x42, 0x19, 0x72, 0xd4, 0x12, 0xc6, 0xaf, 0x98, 0x39, 0x6a, 0xd5, 0x7e, 0x98, 0x74, 0x55, 0x6c,
+	0xca, 0x6b, 0x90, 0x17, 0x68, 0x94, 0x97, 0x66, 0xb0, 0xc4, 0x94, 0x0c, 0xb5, 0x4f, 0xf3, 0x70,
+	0x7e, 0xe4, 0x68, 0x54, 0x27, 0x6a, 0x42, 0x9e, 0x3d, 0x75, 0x88, 0x27, 0x8c, 0x5b, 0x69, 0xe9,
+	0x9f, 0x7c, 0xd4, 0xb8, 0xa0, 0xec, 0x7b, 0xc3, 0xb2, 0x3c, 0xc2, 0xf9, 0x03, 0xdf, 0xa3, 0x4e,
+	0xcf, 0x94, 0x64, 0xe8, 0x7b, 0x00, 0x42, 0x78, 0x3b, 0xbc, 0x45, 0xfa, 0xc2, 0x86, 0x56, 0x2f,
+	0x6f, 0xaf, 0x4f, 0x80, 0x14, 0x29, 0x7c, 0x38, 0x70, 0x89, 0xb9, 0xe2, 0x47, 0x3f, 0xd1, 0x26,
+	0x94, 0x99, 0x47, 0x7b, 0xd4, 0x69, 0xdb, 0x98, 0xf6, 0x3b, 0xec, 0x58, 0x5f, 0x14, 0x58, 0x4a,
+	0x72, 0xf7, 0x6d, 0xb9, 0x89, 0xae, 0x41, 0x51, 0x91, 0x59, 0xc4, 0x61, 0xb6, 0x9e, 0x17, 0x44,
+	0xab, 0x72, 0x6f, 0x37, 0xdc, 0x42, 0x8f, 0xa0, 0x40, 0xb9, 0xdd, 0xa6, 0x96, 0xbe, 0x24, 0xa0,
+	0xef, 0x3c, 0x3b, 0x59, 0xd7, 0x3e, 0x3b, 0x59, 0x7f, 0xbd, 0x47, 0xfd, 0xc7, 0x41, 0xa7, 0xd9,
+	0x65, 0xb6, 0xd1, 0xe9, 0xba, 0x0d, 0xea, 0x38, 0xec, 0x48, 0x5e, 0x6b, 0x23, 0xc6, 0xd9, 0x50,
+	0x69, 0x13, 0xf8, 0xb4, 0xdf, 0xbc, 0x4b, 0x8e, 0x95, 0xad, 0x66, 0x9e, 0x72, 0x7b, 0xdf, 0x42,
+	0x55, 0x58, 0xb6, 0x48, 0x97, 0xda, 0xb8, 0xcf, 0xf5, 0xe5, 0x0d, 0xad, 0x5e, 0x32, 0xe3, 0x35,
+	0xba, 0x04, 0x05, 0x17, 0x07, 0x9c, 0x58, 0xfa, 0xca, 0x86, 0x56, 0x5f, 0x36, 0xd5, 0x2a, 0xb4,
+	0x4c, 0xfe, 0x6a, 0x5b, 0xcc, 0xc6, 0xd4, 0xe1, 0x3a, 0x6c, 0x2c, 0xd4, 0x4b, 0x66, 0x49, 0xee,
+	0xee, 0xca, 0x4d, 0xf4, 0x4d, 0x58, 0xee, 0x05, 0xd8, 0xb3, 0x28, 0x76, 0xf4, 0xd5, 0x53, 0x7c,
+	0x1e, 0x53, 0x86, 0x17, 0xb1, 0xe3, 0x51, 0xab, 0x47, 0xda, 0x07, 0x84, 0xe8, 0xc5, 0xcc, 0x9b,
+	0xd0, 0x12, 0x44, 0x7b, 0x84, 0xdc, 0x67, 0x7d, 0xda, 0x1d, 0xb4, 0x16, 0xc3, 0x3b, 0x6d, 0xae,
+	0x74, 0xa2, 0x6d, 0xf4, 0x5d, 0x28, 0x1d, 0x10, 0xd2, 0xf6, 0x48, 0x97, 0xba, 0x94, 0x38, 0xbe,
+	0x5e, 0x3a, 0x05, 0x43, 0xf1, 0x80, 0x10, 0x33, 0xa2, 0x46, 0xef, 0xc2, 0xd2, 0x63, 0xc6, 0x0e,
+	0x43, 0xaf, 0x97, 0xcf, 0xce, 0xeb, 0x85, 0x50, 0xe6, 0xbe, 0x85, 0xae, 0x43, 0x49, 0x48, 0xb7,
+	0x89, 0x8f, 0x2d, 0xec, 0x63, 0xfd, 0xbc, 0x08, 0x7b, 0x31, 0xdc, 0x7c, 0x5b, 0xed, 0xd5, 0x6e,
+	0xc3, 0x95, 0x61, 0x96, 0x44, 0xbb, 0x59, 0x29, 0xf5, 0x1b, 0x0d, 0xaa, 0x93, 0xa8, 0xbf, 0x68,
+	0x6e, 0xa1, 0x5d, 0x58, 0x8e, 0x51, 0xca, 0xba, 0x70, 0x75, 0x58, 0x17, 0x9c, 0xc3, 0xb8, 0x22,
+	0x44, 0x2a, 0x93, 0xd5, 0x65, 0xd9, 0x1e, 0xb5, 0x45, 0x86, 0xcd, 0x7a, 0x10, 0xb8, 0x6e, 0x7f,
+	0x90, 0x65, 0xcb, 0xe7, 0x91, 0x2d, 0x23, 0xd4, 0xca, 0x96, 0x1f, 0x40, 0x59, 0x86, 0xd9, 0x6a,
+	0x73, 0x71, 0xa2, 0x8c, 0xba, 0x92, 0xaa, 0x57, 0x11, 0xae, 0x1d, 0x46, 0x53, 0x15, 0xaf, 0xd4,
+	0x49, 0x0a, 0x4d, 0x25, 0x40, 0x6e, 0x24, 0x01, 0x3a, 0x70, 0xd9, 0x23, 0x36, 0xf3, 0x49, 0x3b,
+	0xa5, 0x8f, 0x12, 0xae, 0x2f, 0x88, 0x62, 0x3b, 0xa9, 0x1e, 0x98, 0x82, 0xe3, 0x0d, 0x9b, 0x05,
+	0x8e, 0x9f, 0xd4, 0x7b, 0x51, 0x8a, 0x4a, 0x9a, 0x44, 0x09, 0xaf, 0x7d, 0xa0, 0x41, 0x31, 0xc9,
+	0x82, 0x6e, 0xc1, 0x79, 0x8f, 0x74, 0x09, 0x3d, 0x22, 0x9e, 0xca, 0x2f, 0x61, 0x5e, 0xc9, 0x2c,
+	0x47, 0xdb, 0x32, 0xc1, 0xa6, 0x22, 0x7f, 0x15, 0x0a, 0x58, 0x88, 0x13, 0x85, 0x6b, 0xa5, 0x75,
+	0x35, 0xc4, 0xf1, 0xd9, 0xc9, 0xfa, 0x45, 0xe9, 0x21, 0x6e, 0x1d, 0x36, 0x29, 0x33, 0x6c, 0xec,
+	0x3f, 0x6e, 0xee, 0x3b, 0xbe, 0xa9, 0x88, 0x6b, 0x5c, 0x45, 0x49, 0x02, 0x32, 0x59, 0xe0, 0x13,
+	0x8f, 0x67, 0x44, 0x69, 0xe4, 0x65, 0xcb, 0xfd, 0xcf, 0x2f, 0xdb, 0x9f, 0xa3, 0x68, 0x8f, 0x68,
+	0x55, 0xd1, 0xde, 0x83, 0xb2, 0x0a, 0x82, 0x27, 0x4f, 0x74, 0xed, 0x14, 0xdf, 0x4b, 0x09, 0x66,
+	0xc9, 0x4b, 0xca, 0x3b, 0xbb, 0x17, 0xee, 0x18, 0xd6, 0x05, 0xdc, 0x77, 0x82, 0x50, 0xba, 0xd0,
+	0xf1, 0xd0, 0xc3, 0x0e, 0x3f, 0x20, 0x5e, 0x96, 0xab, 0x1a, 0x80, 0x2c, 0xc2, 0x7d, 0x25, 0x21,
+	0x0a, 0xab, 0x78, 0x88, 0xcc, 0x4a, 0xe2, 0x44, 0x45, 0xf6, 0x52, 0x3a, 0x7a, 0x71, 0x78, 0x3e,
+	0xcf, 0xc1, 0x46, 0xb6, 0x6a, 0xe5, 0xaf, 0x27, 0xb0, 0xda, 0xc3, 0xbc, 0xed, 0xe2, 0x81, 0x1d,
+	0x56, 0x3d, 0xe9, 0xac, 0x29, 0xa9, 0xf1, 0x6a, 0x78, 0x35, 0xfe, 0xf8, 0xcf, 0xf5, 0x7a, 0xa2,
+	0xae, 0xa9, 0xea, 0x25, 0xff, 0x6b, 0x70, 0xeb, 0x50, 0xb5, 0x52, 0x21, 0x03, 0x97, 0xd7, 0x19,
+	0x7a, 0x98, 0xdf, 0x97, 0x3a, 0xd0, 0x77, 0x62, 0xbc, 0xb9, 0x39, 0x12, 0x51, 0xf1, 0xa0, 0x1f,
+	0x81, 0x8a, 0x54, 0x3b, 0x61, 0xf4, 0x7c, 0xb9, 0x55, 0xf4, 0x92, 0x19, 0xb4, 0x93, 0x7a, 0x42,
+	0x16, 0xe7, 0x80, 0x34, 0x7c, 0x3e, 0x6a, 0x2e, 0x5c, 0x4a, 0x94, 0xa0, 0x3d, 0x42, 0xfe, 0xef,
+	0x79, 0xf0, 0x27, 0x0d, 0x2e, 0x8f, 0xa9, 0x54, 0x41, 0xbd, 0x0b, 0xab, 0x43, 0x93, 0xa2, 0x0c,
+	0xb8, 0x36, 0xc9, 0x43, 0xe1, 0x6d, 0x8f, 0x05, 0xa8, 0x57, 0x11, 0x62, 0xb3, 0xce, 0x30, 0x0d,
+	0x06, 0x51, 0xad, 0x08, 0x35, 0xf2, 0xd6, 0x40, 0xf4, 0x2a, 0x91, 0x8f, 0x2e, 0x40, 0x5e, 0xb6,
+	0x33, 0xd2, 0x4d, 0x72, 0x71, 0x66, 0x9e, 0xfa, 0x5d, 0x5c, 0x31, 0xd2, 0xba, 0x95, 0xb3, 0x5e,
+	0x87, 0x82, 0x28, 0x15, 0x91, 0x9f, 0xae, 0x4e, 0xf0, 0x93, 0xe4, 0x10, 0xce, 0x92, 0x3e, 0x52,
+	0x2c, 0x67, 0xe7, 0x9f, 0x0f, 0x73, 0x00, 0x43, 0x2d, 0xe8, 0x27, 0xb0, 0x2c, 0xdb, 0xc9, 0xe8,
+	0xee, 0xb4, 0x76, 0x54, 0x4d, 0xfe, 0x42, 0x0d, 0xc5, 0x92, 0x10, 0xba, 0x6f, 0x8d, 0xb4, 0xab,
+	0xb9, 0xb9, 0xdb, 0xd5, 0xb7, 0xe2, 0x2c, 0x94, 0x65, 0xf6, 0xd4, 0x2c, 0x94, 0x75, 0x55, 0x79,
+	0xaf, 0x98, 0xac, 0xb5, 0xe8, 0x36, 0x54, 0xba, 0xac, 0xdf, 0xc7, 0x3e, 0xf1, 0x70, 0x3f, 0x92,
+	0xb7, 0x28, 0x7a, 0xc8, 0x97, 0x86, 0x07, 0x92, 0xb8, 0xb6, 0x09, 0xd7, 0x45, 0x2c, 0x77, 0xe2,
+	0x83, 0x5d, 0xca, 0xbb, 0x1e, 0x71, 0xb1, 0xd3, 0xa5, 0x71, 0xd6, 0xd5, 0x7e, 0x0a, 0x37, 0xa6,
+	0x93, 0xa9, 0xe0, 0x3f, 0x84, 0x92, 0x95, 0x3c, 0x50, 0x77, 0xa0, 0x3e, 0xc1, 0x8e, 0x49, 0xa2,
+	0xa2, 0x46, 0x32, 0x2d, 0xa4, 0xf6, 0xfb, 0x1c, 0x5c, 0x9c, 0x48, 0x9e, 0x71, 0xd3, 0x1f, 0x01,
+	0xf2, 0x3d, 0xdc, 0x3d, 0x24, 0x56, 0x7b, 0x68, 0xb0, 0x9a, 0x3c, 0x6e, 0x4f, 0x7d, 0x8b, 0x3f,
+	0xf9, 0xa8, 0x01, 0xf2, 0x20, 0x5c, 0x99, 0x15, 0x25, 0x66, 0xa8, 0x1c, 0x99, 0x50, 0xb6, 0x99,
+	0x15, 0xf4, 0x49, 0xbb, 0x83, 0xfb, 0xd8, 0xe9, 0x12, 0x7d, 0x61, 0x7e, 0xb9, 0x25, 0x29, 0xa2,
+	0x25, 0x25, 0xa0, 0x37, 0x61, 0x89, 0x07, 0x9e, 0xdb, 0x0f, 0xb8, 0xbe, 0x38, 0xbf, 0xb0, 0x88,
+	0x37, 0x2e, 0x9a, 0x26, 0xf6, 0xc9, 0x3d, 0x6a, 0x53, 0xff, 0xcb, 0x68, 0x1e, 0x2e, 0x8f, 0xa9,
+	0x54, 0x57, 0x61, 0x1f, 0x56, 0x3d, 0xec, 0x93, 0x76, 0x5f, 0x6c, 0x4f, 0x99, 0x8f, 0x63, 0xde,
+	0x07, 0x3e, 0xf6, 0x03, 0x1e, 0x55, 0x4d, 0x2f, 0x16, 0x79, 0x76, 0x55, 0xe1, 0xef, 0x39, 0x38,
+	0x3f, 0xa2, 0x2e, 0x7c, 0xee, 0x53, 0x8d, 0x9e, 0x5a, 0xa1, 0x16, 0x2c, 0x51, 0xa7, 0xc3, 0x02,
+	0xc7, 0x52, 0x1a, 0xa7, 0x62, 0xdf, 0x61, 0xce, 0x01, 0xed, 0x29, 0xec, 0x11, 0x23, 0x7a, 0x0b,
+	0x2a, 0xea, 0x67, 0xdb, 0x23, 0xa1, 0x54, 0xea, 0xf4, 0x66, 0xeb, 0x09, 0x5f, 0x52, 0x7c, 0x66,
+	0xc4, 0x16, 0x4e, 0x02, 0x2c, 0xf0, 0x25, 0xa0, 0xc5, 0x39, 0x01, 0xc5, 0x9c, 0xe8, 0x1e, 0xa0,
+	0xe8, 0x77, 0x02, 0x52, 0x7e, 0x16, 0x48, 0x95, 0x88, 0x31, 0xc6, 0xb4, 0xfd, 0xab, 0x22, 0xe4,
+	0x45, 0xfc, 0x51, 0x00, 0x05, 0xf9, 0x69, 0x04, 0x6d, 0x4e, 0x40, 0x35, 0xfe, 0x65, 0xa6, 0x7a,
+	0xf3, 0x34, 0x32, 0x19, 0xbe, 0xda, 0xcb, 0x3f, 0xfb, 0xf4, 0xdf, 0xbf, 0xcc, 0x5d, 0x42, 0x17,
+	0x86, 0xd5, 0x58, 0x7c, 0x5c, 0x92, 0xca, 0x9e, 0x42, 0x5e, 0xd0, 0xa3, 0x1b, 0x53, 0xc5, 0x45,
+	0x4a, 0x37, 0x4f, 0xa1, 0x52, 0x3a, 0xaf, 0x09, 0x9d, 0x5f, 0x45, 0x57, 0x26, 0xe9, 0x34, 0xde,
+	0xa3, 0xd6, 0xfb, 0xe8, 0xd7, 0x1a, 0x94, 0x52, 0xb3, 0x1e, 0xfa, 0xfa, 0x54, 0xd9, 0x23, 0x03,
+	0x64, 0xb5, 0x31, 0x23, 0xb5, 0x42, 0xb4, 0x25, 0x10, 0xdd, 0x40, 0xb5, 0x4c, 0x44, 0x46, 0x34,
+	0xec, 0xa1, 0xdf, 0x6a, 0x50, 0x4a, 0x8d, 0x6e, 0xd9, 0xd0, 0x26, 0xcd, 0x83, 0xd5, 0xc6, 0x8c,
+	0xd4, 0x0a, 0xda, 0x37, 0x04, 0xb4, 0x2d, 0x54, 0xcf, 0x86, 0x96, 0x9e, 0x17, 0x05, 0xc0, 0xd4,
+	0xb4, 0x91, 0x0d, 0x70, 0xd2, 0x28, 0x54, 0x6d, 0xcc, 0x48, 0x3d, 0x3b, 0xc0, 0xf4, 0x88, 0x83,
+	0x7e, 0xa1, 0x01, 0x0c, 0x2b, 0x1a, 0xfa, 0x5a, 0xa6, 0xbe, 0xd1, 0x42, 0x5b, 0xdd, 0x9a, 0x85,
+	0x54, 0xe1, 0x6a, 0x08, 0x5c, 0xb7, 0xd0, 0xe6, 0x14, 0x5c, 0xc3, 0x02, 0x2a, 0x40, 0x0d, 0x7b,
+	0xd3, 0x6c, 0x50, 0x63, 0x2d, 0x73, 0x75, 0x6b, 0x16, 0xd2, 0xd9, 0x41, 0x25, 0x5a, 0x61, 0xf4,
+	0x41, 0x18, 0xca, 0x64, 0x1b, 0x38, 0x25, 0x94, 0x13, 0x3a, 0xd5, 0x6a, 0x63, 0x46, 0xea, 0xe9,
+	0xc5, 0x40, 0x35, 0x8f, 0x7f, 0xd5, 0xe0, 0x72, 0x46, 0x83, 0x82, 0xbe, 0x95, 0xa5, 0x68, 0x7a,
+	0xe3, 0x53, 0xfd, 0xf6, 0xdc, 0x7c, 0x0a, 0x6a, 0x53, 0x40, 0xad, 0xa3, 0x9b, 0x69, 0xa8, 0x89,
+	0xce, 0x2c, 0xd5, 0xe3, 0xa0, 0xbf, 0x89, 0x2f, 0xcc, 0x63, 0x83, 0x25, 0xda, 0xce, 0x02, 0x90,
+	0x3d, 0x00, 0x57, 0x5f, 0x99, 0x8b, 0x47, 0x01, 0xbe, 0x27, 0x00, 0xef, 0xa1, 0xdd, 0xec, 0xc8,
+	0x3f, 0x09, 0x44, 0x96, 0xc8, 0x64, 0xf1, 0x95, 0x00, 0xe3, 0xbd, 0xf1, 0xe1, 0xfa, 0xfd, 0x96,
+	0xf9, 0xec, 0xf9, 0x9a, 0xf6, 0xf1, 0xf3, 0x35, 0xed, 0x5f, 0xcf, 0xd7, 0xb4, 0x9f, 0xbf, 0x58,
+	0x3b, 0xf7, 0xf1, 0x8b, 0xb5, 0x73, 0xff, 0x78, 0xb1, 0x76, 0xee, 0xd1, 0x6b, 0xf3, 0x34, 0xdc,
+	0xc7, 0xf2, 0xaf, 0x09, 0x62, 0xfe, 0xed, 0x14, 0xc4, 0x97, 0xfe, 0x57, 0xfe, 0x3b, 0x00, 0xbb,
+	0x6a, 0xa7, 0xe6, 0x0a, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Tokens ...
+	Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error)
+	// Token ...
+	Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error)
+	// TokenMetadata returns a token together with the bank metadata of its denom.
+	TokenMetadata(ctx context.Context, in *QueryTokenMetadataRequest, opts ...grpc.CallOption) (*QueryTokenMetadataResponse, error)
+	// BridgedSupply ...
+	BridgedSupply(ctx context.Context, in *QueryBridgedSupplyRequest, opts ...grpc.CallOption) (*QueryBridgedSupplyResponse, error)
+	// RemoteRouters ...
+	RemoteRouters(ctx context.Context, in *QueryRemoteRoutersRequest, opts ...grpc.CallOption) (*QueryRemoteRoutersResponse, error)
+	// RateLimits returns the rate limits of a token with the remaining capacity
+	// of each window.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// BridgeFees returns the domain specific bridge fee policies of a token.
+	BridgeFees(ctx context.Context, in *QueryBridgeFeesRequest, opts ...grpc.CallOption) (*QueryBridgeFeesResponse, error)
+	// RoutesByDenom returns the routers of all tokens with the given origin
+	// denom. The tokens are paginated, each page contains all routers of its
+	// tokens.
+	RoutesByDenom(ctx context.Context, in *QueryRoutesByDenomRequest, opts ...grpc.CallOption) (*QueryRoutesByDenomResponse, error)
+	// CollateralDiscrepancies returns every denom for which the balance of the
+	// module account differs from the tracked collateral of its tokens.
+	CollateralDiscrepancies(ctx context.Context, in *QueryCollateralDiscrepanciesRequest, opts ...grpc.CallOption) (*QueryCollateralDiscrepanciesResponse, error)
+	// QuoteRemoteTransfer ...
+	QuoteRemoteTransfer(ctx context.Context, in *QueryQuoteRemoteTransferRequest, opts ...grpc.CallOption) (*QueryQuoteRemoteTransferResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error) {
+	out := new(QueryTokensResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/Tokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRemoteRoutersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error) {
+	out := new(QueryTokenResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/Token", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRemoteRoutersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) TokenMetadata(ctx context.Context, in *QueryTokenMetadataRequest, opts ...grpc.CallOption) (*QueryTokenMetadataResponse, error) {
+	out := new(QueryTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/TokenMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RemoteRouters) > 0 {
-		for iNdEx := len(m.RemoteRouters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoteRouters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) BridgedSupply(ctx context.Context, in *QueryBridgedSupplyRequest, opts ...grpc.CallOption) (*QueryBridgedSupplyResponse, error) {
+	out := new(QueryBridgedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/BridgedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryQuoteRemoteTransferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RemoteRouters(ctx context.Context, in *QueryRemoteRoutersRequest, opts ...grpc.CallOption) (*QueryRemoteRoutersResponse, error) {
+	out := new(QueryRemoteRoutersResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/RemoteRouters", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryQuoteRemoteTransferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryQuoteRemoteTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestinationDomain) > 0 {
-		i -= len(m.DestinationDomain)
-		copy(dAtA[i:], m.DestinationDomain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationDomain)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) BridgeFees(ctx context.Context, in *QueryBridgeFeesRequest, opts ...grpc.CallOption) (*QueryBridgeFeesResponse, error) {
+	out := new(QueryBridgeFeesResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/BridgeFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) RoutesByDenom(ctx context.Context, in *QueryRoutesByDenomRequest, opts ...grpc.CallOption) (*QueryRoutesByDenomResponse, error) {
+	out := new(QueryRoutesByDenomResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/RoutesByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryQuoteRemoteTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CollateralDiscrepancies(ctx context.Context, in *QueryCollateralDiscrepanciesRequest, opts ...grpc.CallOption) (*QueryCollateralDiscrepanciesResponse, error) {
+	out := new(QueryCollateralDiscrepanciesResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/CollateralDiscrepancies", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryQuoteRemoteTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuoteRemoteTransfer(ctx context.Context, in *QueryQuoteRemoteTransferRequest, opts ...grpc.CallOption) (*QueryQuoteRemoteTransferResponse, error) {
+	out := new(QueryQuoteRemoteTransferResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Query/QuoteRemoteTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryQuoteRemoteTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GasPayment) > 0 {
-		for iNdEx := len(m.GasPayment) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GasPayment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Tokens ...
+	Tokens(context.Context, *QueryTokensRequest) (*QueryTokensResponse, error)
+	// Token ...
+	Token(context.Context, *QueryTokenRequest) (*QueryTokenResponse, error)
+	// TokenMetadata returns a token together with the bank metadata of its denom.
+	TokenMetadata(context.Context, *QueryTokenMetadataRequest) (*QueryTokenMetadataResponse, error)
+	// BridgedSupply ...
+	BridgedSupply(context.Context, *QueryBridgedSupplyRequest) (*QueryBridgedSupplyResponse, error)
+	// RemoteRouters ...
+	RemoteRouters(context.Context, *QueryRemoteRoutersRequest) (*QueryRemoteRoutersResponse, error)
+	// RateLimits returns the rate limits of a token with the remaining capacity
+	// of each window.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// BridgeFees returns the domain specific bridge fee policies of a token.
+	BridgeFees(context.Context, *QueryBridgeFeesRequest) (*QueryBridgeFeesResponse, error)
+	// RoutesByDenom returns the routers of all tokens with the given origin
+	// denom. The tokens are paginated, each page contains all routers of its
+	// tokens.
+	RoutesByDenom(context.Context, *QueryRoutesByDenomRequest) (*QueryRoutesByDenomResponse, error)
+	// CollateralDiscrepancies returns every denom for which the balance of the
+	// module account differs from the tracked collateral of its tokens.
+	CollateralDiscrepancies(context.Context, *QueryCollateralDiscrepanciesRequest) (*QueryCollateralDiscrepanciesResponse, error)
+	// QuoteRemoteTransfer ...
+	QuoteRemoteTransfer(context.Context, *QueryQuoteRemoteTransferRequest) (*QueryQuoteRemoteTransferResponse, error)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *QueryTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (*UnimplementedQueryServer) Tokens(ctx context.Context, req *QueryTokensRequest) (*QueryTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tokens not implemented")
+}
+func (*UnimplementedQueryServer) Token(ctx context.Context, req *QueryTokenRequest) (*QueryTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+}
+func (*UnimplementedQueryServer) TokenMetadata(ctx context.Context, req *QueryTokenMetadataRequest) (*QueryTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenMetadata not implemented")
+}
+func (*UnimplementedQueryServer) BridgedSupply(ctx context.Context, req *QueryBridgedSupplyRequest) (*QueryBridgedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgedSupply not implemented")
+}
+func (*UnimplementedQueryServer) RemoteRouters(ctx context.Context, req *QueryRemoteRoutersRequest) (*QueryRemoteRoutersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteRouters not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) BridgeFees(ctx context.Context, req *QueryBridgeFeesRequest) (*QueryBridgeFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeFees not implemented")
+}
+func (*UnimplementedQueryServer) RoutesByDenom(ctx context.Context, req *QueryRoutesByDenomRequest) (*QueryRoutesByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoutesByDenom not implemented")
+}
+func (*UnimplementedQueryServer) CollateralDiscrepancies(ctx context.Context, req *QueryCollateralDiscrepanciesRequest) (*QueryCollateralDiscrepanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralDiscrepancies not implemented")
+}
+func (*UnimplementedQueryServer) QuoteRemoteTransfer(ctx context.Context, req *QueryQuoteRemoteTransferRequest) (*QueryQuoteRemoteTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteRemoteTransfer not implemented")
 }
 
-func (m *QueryTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Tokens(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/Tokens",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tokens(ctx, req.(*QueryTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Token(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/Token",
 	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Token(ctx, req.(*QueryTokenRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WrappedHypToken) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TokenMetadata(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/TokenMetadata",
 	}
-	if m.TokenType != 0 {
-		n += 1 + sovQuery(uint64(m.TokenType))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenMetadata(ctx, req.(*QueryTokenMetadataRequest))
 	}
-	l = len(m.OriginMailbox)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.OriginDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BridgedSupply(ctx, in)
 	}
-	if m.IsmId != nil {
-		l = m.IsmId.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/BridgedSupply",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgedSupply(ctx, req.(*QueryBridgedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBridgedSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RemoteRouters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemoteRoutersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteRouters(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryBridgedSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/RemoteRouters",
 	}
-	var l int
-	_ = l
-	l = m.BridgedSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteRouters(ctx, req.(*QueryRemoteRoutersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRemoteRoutersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/RateLimits",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRemoteRoutersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BridgeFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RemoteRouters) > 0 {
-		for _, e := range m.RemoteRouters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeFees(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/BridgeFees",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeFees(ctx, req.(*QueryBridgeFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryQuoteRemoteTransferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RoutesByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutesByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoutesByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/RoutesByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoutesByDenom(ctx, req.(*QueryRoutesByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollateralDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralDiscrepanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/CollateralDiscrepancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralDiscrepancies(ctx, req.(*QueryCollateralDiscrepanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteRemoteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteRemoteTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteRemoteTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Query/QuoteRemoteTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteRemoteTransfer(ctx, req.(*QueryQuoteRemoteTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperlane.warp.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Tokens",
+			Handler:    _Query_Tokens_Handler,
+		},
+		{
+			MethodName: "Token",
+			Handler:    _Query_Token_Handler,
+		},
+		{
+			MethodName: "TokenMetadata",
+			Handler:    _Query_TokenMetadata_Handler,
+		},
+		{
+			MethodName: "BridgedSupply",
+			Handler:    _Query_BridgedSupply_Handler,
+		},
+		{
+			MethodName: "RemoteRouters",
+			Handler:    _Query_RemoteRouters_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "BridgeFees",
+			Handler:    _Query_BridgeFees_Handler,
+		},
+		{
+			MethodName: "RoutesByDenom",
+			Handler:    _Query_RoutesByDenom_Handler,
+		},
+		{
+			MethodName: "CollateralDiscrepancies",
+			Handler:    _Query_CollateralDiscrepancies_Handler,
+		},
+		{
+			MethodName: "QuoteRemoteTransfer",
+			Handler:    _Query_QuoteRemoteTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyperlane/warp/v1/query.proto",
+}
+
+func (m *QueryTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.DestinationDomain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WrappedHypToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WrappedHypToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WrappedHypToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookMetadata) > 0 {
+		i -= len(m.HookMetadata)
+		copy(dAtA[i:], m.HookMetadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HookMetadata)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.HookId != nil {
+		{
+			size := m.HookId.Size()
+			i -= size
+			if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PausedDomains) > 0 {
+		dAtA6 := make([]byte, len(m.PausedDomains)*10)
+		var j5 int
+		for _, num := range m.PausedDomains {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsmId != nil {
+		{
+			size := m.IsmId.Size()
+			i -= size
+			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OriginDenom) > 0 {
+		i -= len(m.OriginDenom)
+		copy(dAtA[i:], m.OriginDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OriginMailbox) > 0 {
+		i -= len(m.OriginMailbox)
+		copy(dAtA[i:], m.OriginMailbox)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginMailbox)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TokenType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteBridgedSupplies) > 0 {
+		for iNdEx := len(m.RemoteBridgedSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteBridgedSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.BridgedSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReceiverDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemoteRoutersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemoteRoutersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemoteRoutersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemoteRoutersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemoteRoutersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemoteRoutersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoteRouters) > 0 {
+		for iNdEx := len(m.RemoteRouters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteRouters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteRemoteTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteRemoteTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteRemoteTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationDomain) > 0 {
+		i -= len(m.DestinationDomain)
+		copy(dAtA[i:], m.DestinationDomain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationDomain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteRemoteTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteRemoteTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteRemoteTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RemoteAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GasPayment) > 0 {
+		for iNdEx := len(m.GasPayment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPayment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeFees) > 0 {
+		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoutesByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoutesByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoutesByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoutesByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoutesByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoutesByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CollateralRouter {
+		i--
+		if m.CollateralRouter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.RemoteRouter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TokenType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralDiscrepanciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralDiscrepanciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralDiscrepanciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralDiscrepanciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralDiscrepanciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralDiscrepanciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Surplus.Size()
+		i -= size
+		if _, err := m.Surplus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ModuleBalance.Size()
+		i -= size
+		if _, err := m.ModuleBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TrackedCollateral.Size()
+		i -= size
+		if _, err := m.TrackedCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OutboundRemaining.Size()
+		i -= size
+		if _, err := m.OutboundRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Outbound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InboundRemaining.Size()
+		i -= size
+		if _, err := m.InboundRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Inbound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Domain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Domain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedHypToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovQuery(uint64(m.TokenType))
+	}
+	l = len(m.OriginMailbox)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OriginDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsmId != nil {
+		l = m.IsmId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedDomains) > 0 {
+		l = 0
+		for _, e := range m.PausedDomains {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HookId != nil {
+		l = m.HookId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HookMetadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BridgedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if len(m.RemoteBridgedSupplies) > 0 {
+		for _, e := range m.RemoteBridgedSupplies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoteAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverDomain))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemoteRoutersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemoteRoutersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteRouters) > 0 {
+		for _, e := range m.RemoteRouters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteRemoteTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationDomain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteRemoteTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPayment) > 0 {
+		for _, e := range m.GasPayment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemoteAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BridgeFees) > 0 {
+		for _, e := range m.BridgeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoutesByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoutesByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TokenType != 0 {
+		n += 1 + sovQuery(uint64(m.TokenType))
+	}
+	l = m.RemoteRouter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CollateralRouter {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCollateralDiscrepanciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCollateralDiscrepanciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CollateralDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TrackedCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Surplus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Domain != 0 {
+		n += 1 + sovQuery(uint64(m.Domain))
+	}
+	l = m.Inbound.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InboundRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outbound.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutboundRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, WrappedHypToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &WrappedHypToken{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedHypToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedHypToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= HypTokenType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginMailbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.IsmId = &v
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedDomains = append(m.PausedDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedDomains) == 0 {
+					m.PausedDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedDomains = append(m.PausedDomains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDomains", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.HookId = &v
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &WrappedHypToken{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteBridgedSupplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteBridgedSupplies = append(m.RemoteBridgedSupplies, RemoteAmount{})
+			if err := m.RemoteBridgedSupplies[len(m.RemoteBridgedSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			}
+			m.ReceiverDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemoteRoutersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemoteRoutersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemoteRoutersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemoteRoutersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemoteRoutersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemoteRoutersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteRouters = append(m.RemoteRouters, &RemoteRouter{})
+			if err := m.RemoteRouters[len(m.RemoteRouters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteRemoteTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteRemoteTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteRemoteTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryQuoteRemoteTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.GasPayment) > 0 {
-		for _, e := range m.GasPayment {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryQuoteRemoteTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteRemoteTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteRemoteTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPayment = append(m.GasPayment, types1.Coin{})
+			if err := m.GasPayment[len(m.GasPayment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1580,13 +5747,45 @@ func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1643,7 +5842,7 @@ func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,15 +5865,15 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1701,8 +5900,8 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, WrappedHypToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeFees = append(m.BridgeFees, RouteBridgeFee{})
+			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1763,7 +5962,7 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoutesByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,15 +5985,15 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoutesByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoutesByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1822,7 +6021,43 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1845,7 +6080,7 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoutesByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1868,15 +6103,15 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoutesByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoutesByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1903,10 +6138,44 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &WrappedHypToken{}
+			m.Routes = append(m.Routes, DenomRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1931,7 +6200,7 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
+func (m *DenomRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,15 +6223,15 @@ func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedHypToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedHypToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1990,41 +6259,11 @@ func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
 			}
@@ -2043,11 +6282,11 @@ func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2057,29 +6296,30 @@ func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginMailbox = string(dAtA[iNdEx:postIndex])
+			if err := m.RemoteRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginDenom", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRouter", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2089,60 +6329,62 @@ func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.CollateralRouter = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralDiscrepanciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.IsmId = &v
-			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralDiscrepanciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralDiscrepanciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2164,7 +6406,7 @@ func (m *WrappedHypToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgedSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralDiscrepanciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,17 +6429,17 @@ func (m *QueryBridgedSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgedSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralDiscrepanciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralDiscrepanciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2207,23 +6449,25 @@ func (m *QueryBridgedSupplyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Discrepancies = append(m.Discrepancies, CollateralDiscrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2246,7 +6490,7 @@ func (m *QueryBridgedSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgedSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *CollateralDiscrepancy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2269,17 +6513,17 @@ func (m *QueryBridgedSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgedSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollateralDiscrepancy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollateralDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgedSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2289,22 +6533,123 @@ func (m *QueryBridgedSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedCollateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrackedCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Surplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2329,7 +6674,7 @@ func (m *QueryBridgedSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemoteRoutersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2352,10 +6697,10 @@ func (m *QueryRemoteRoutersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemoteRoutersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemoteRoutersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2447,7 +6792,7 @@ func (m *QueryRemoteRoutersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemoteRoutersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2470,15 +6815,15 @@ func (m *QueryRemoteRoutersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemoteRoutersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemoteRoutersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2505,8 +6850,8 @@ func (m *QueryRemoteRoutersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteRouters = append(m.RemoteRouters, &RemoteRouter{})
-			if err := m.RemoteRouters[len(m.RemoteRouters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, RateLimitStatus{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2567,7 +6912,7 @@ func (m *QueryRemoteRoutersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQuoteRemoteTransferRequest) Unmarshal(dAtA []byte) error {
+func (m *RateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2590,17 +6935,36 @@ func (m *QueryQuoteRemoteTransferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteRemoteTransferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteRemoteTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2610,27 +6974,28 @@ func (m *QueryQuoteRemoteTransferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRemaining", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2658,63 +7023,48 @@ func (m *QueryQuoteRemoteTransferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.InboundRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryQuoteRemoteTransferResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Outbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteRemoteTransferResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteRemoteTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRemaining", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2724,23 +7074,23 @@ func (m *QueryQuoteRemoteTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPayment = append(m.GasPayment, types.Coin{})
-			if err := m.GasPayment[len(m.GasPayment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutboundRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
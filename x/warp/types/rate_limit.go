@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Enabled returns true if the rate limit restricts transfers.
+func (c RateLimitConfig) Enabled() bool {
+	return !c.MaxCapacity.IsNil() && c.MaxCapacity.IsPositive()
+}
+
+// Validate checks that an enabled rate limit has a window.
+func (c RateLimitConfig) Validate() error {
+	if !c.MaxCapacity.IsNil() && c.MaxCapacity.IsNegative() {
+		return fmt.Errorf("rate limit max capacity must not be negative")
+	}
+	if c.Enabled() && c.WindowSeconds == 0 {
+		return fmt.Errorf("rate limit window must be greater than zero")
+	}
+	return nil
+}
+
+// CurrentCapacity returns the capacity at the given unix time. The capacity refills linearly with
+// max_capacity per window, starting from the capacity at the last update.
+func (c RateLimitConfig) CurrentCapacity(state RateLimitState, now int64) math.Int {
+	if !c.Enabled() {
+		return math.ZeroInt()
+	}
+
+	capacity := state.Capacity
+	if capacity.IsNil() {
+		capacity = math.ZeroInt()
+	}
+
+	elapsed := now - state.LastUpdated
+	if elapsed <= 0 {
+		return math.MinInt(capacity, c.MaxCapacity)
+	}
+	if uint64(elapsed) >= c.WindowSeconds {
+		return c.MaxCapacity
+	}
+
+	window := math.NewIntFromUint64(c.WindowSeconds)
+	refill, err := c.MaxCapacity.SafeMul(math.NewInt(elapsed))
+	if err == nil {
+		refill = refill.Quo(window)
+	} else {
+		// for large capacities, the refill is computed with a small rounding error instead of overflowing
+		refill = c.MaxCapacity.Quo(window).Mul(math.NewInt(elapsed))
+	}
+
+	capacity, err = capacity.SafeAdd(refill)
+	if err != nil {
+		return c.MaxCapacity
+	}
+
+	return math.MinInt(capacity, c.MaxCapacity)
+}
+
+// Consume subtracts the amount from the current capacity and returns the updated state.
+// Amounts exceeding the current capacity are rejected.
+func (c RateLimitConfig) Consume(state RateLimitState, amount math.Int, now int64) (RateLimitState, error) {
+	capacity := c.CurrentCapacity(state, now)
+	if amount.GT(capacity) {
+		return state, ErrRateLimitExceeded.Wrapf("amount %s exceeds remaining capacity %s", amount, capacity)
+	}
+
+	return RateLimitState{
+		Capacity:    capacity.Sub(amount),
+		LastUpdated: now,
+	}, nil
+}
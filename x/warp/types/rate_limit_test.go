@@ -0,0 +1,87 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+)
+
+func TestRateLimitCurrentCapacity(t *testing.T) {
+	config := RateLimitConfig{MaxCapacity: math.NewInt(1000), WindowSeconds: 100}
+
+	type testCase struct {
+		name     string
+		capacity int64
+		elapsed  int64
+		expected int64
+	}
+	for _, c := range []testCase{
+		{"no time elapsed", 300, 0, 300},
+		{"partial refill", 300, 10, 400},
+		{"refill is capped", 300, 100, 1000},
+		{"empty", 0, 1, 10},
+		{"clock moved backwards", 300, -10, 300},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			state := RateLimitState{Capacity: math.NewInt(c.capacity), LastUpdated: 1000}
+			capacity := config.CurrentCapacity(state, 1000+c.elapsed)
+			if !capacity.Equal(math.NewInt(c.expected)) {
+				t.Fatalf("expected %d, got %s", c.expected, capacity)
+			}
+		})
+	}
+}
+
+func TestRateLimitCurrentCapacityLargeMaxCapacity(t *testing.T) {
+	// 2^255 is close to the upper bound of math.Int
+	maxCapacity := math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 255))
+	config := RateLimitConfig{MaxCapacity: maxCapacity, WindowSeconds: 100}
+	state := RateLimitState{Capacity: math.ZeroInt(), LastUpdated: 0}
+
+	if capacity := config.CurrentCapacity(state, 1_000_000); !capacity.Equal(maxCapacity) {
+		t.Fatalf("expected %s, got %s", maxCapacity, capacity)
+	}
+
+	// the refill overflows before it is divided by the window
+	expected := maxCapacity.QuoRaw(100).MulRaw(50)
+	if capacity := config.CurrentCapacity(state, 50); !capacity.Equal(expected) {
+		t.Fatalf("expected %s, got %s", expected, capacity)
+	}
+
+	state.Capacity = maxCapacity.SubRaw(1)
+	if capacity := config.CurrentCapacity(state, 50); !capacity.Equal(maxCapacity) {
+		t.Fatalf("expected %s, got %s", maxCapacity, capacity)
+	}
+}
+
+func TestRateLimitConsume(t *testing.T) {
+	config := RateLimitConfig{MaxCapacity: math.NewInt(1000), WindowSeconds: 100}
+	state := RateLimitState{Capacity: math.NewInt(1000), LastUpdated: 0}
+
+	state, err := config.Consume(state, math.NewInt(1000), 0)
+	if err != nil || !state.Capacity.IsZero() {
+		t.Fatalf("unexpected consume result: %v %v", state, err)
+	}
+
+	if _, err := config.Consume(state, math.NewInt(1), 0); err == nil {
+		t.Fatal("expected rate limit error")
+	}
+
+	state, err = config.Consume(state, math.NewInt(500), 50)
+	if err != nil || !state.Capacity.IsZero() || state.LastUpdated != 50 {
+		t.Fatalf("unexpected consume result: %v %v", state, err)
+	}
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	if err := (RateLimitConfig{}).Validate(); err != nil {
+		t.Fatalf("disabled rate limit must be valid: %v", err)
+	}
+	if err := (RateLimitConfig{MaxCapacity: math.NewInt(1)}).Validate(); err == nil {
+		t.Fatal("expected missing window error")
+	}
+	if err := (RateLimitConfig{MaxCapacity: math.NewInt(-1), WindowSeconds: 1}).Validate(); err == nil {
+		t.Fatal("expected negative capacity error")
+	}
+}
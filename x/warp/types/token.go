@@ -0,0 +1,18 @@
+package types
+
+import (
+	"slices"
+)
+
+// IsPaused returns true if the transfers of the token from or to the domain are paused.
+func (t HypToken) IsPaused(domain uint32) bool {
+	return t.Paused || slices.Contains(t.PausedDomains, domain)
+}
+
+// AssertNotPaused returns an error if the transfers of the token from or to the domain are paused.
+func (t HypToken) AssertNotPaused(domain uint32) error {
+	if t.IsPaused(domain) {
+		return ErrTokenPaused.Wrapf("token %s is paused for domain %d", t.Id.String(), domain)
+	}
+	return nil
+}
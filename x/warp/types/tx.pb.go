@@ -40,6 +40,8 @@ type MsgCreateCollateralToken struct {
 	Owner         string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	OriginMailbox github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=origin_mailbox,json=originMailbox,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"origin_mailbox"`
 	OriginDenom   string                                                      `protobuf:"bytes,3,opt,name=origin_denom,json=originDenom,proto3" json:"origin_denom,omitempty"`
+	// decimals of the origin denom.
+	Decimals uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
 func (m *MsgCreateCollateralToken) Reset()         { *m = MsgCreateCollateralToken{} }
@@ -89,6 +91,13 @@ func (m *MsgCreateCollateralToken) GetOriginDenom() string {
 	return ""
 }
 
+func (m *MsgCreateCollateralToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 // MsgCreateCollateralTokenResponse ...
 type MsgCreateCollateralTokenResponse struct {
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
@@ -132,6 +141,10 @@ type MsgCreateSyntheticToken struct {
 	// owner is the message sender.
 	Owner         string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	OriginMailbox github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=origin_mailbox,json=originMailbox,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"origin_mailbox"`
+	// decimals of the synthetic denom.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// metadata is the optional display metadata of the synthetic denom.
+	Metadata *TokenMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *MsgCreateSyntheticToken) Reset()         { *m = MsgCreateSyntheticToken{} }
@@ -174,6 +187,20 @@ func (m *MsgCreateSyntheticToken) GetOwner() string {
 	return ""
 }
 
+func (m *MsgCreateSyntheticToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *MsgCreateSyntheticToken) GetMetadata() *TokenMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // MsgCreateSyntheticTokenResponse ...
 type MsgCreateSyntheticTokenResponse struct {
 	Id github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"id"`
@@ -220,6 +247,18 @@ type MsgSetToken struct {
 	NewOwner          string                                                       `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 	IsmId             *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,4,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id,omitempty"`
 	RenounceOwnership bool                                                         `protobuf:"varint,7,opt,name=renounce_ownership,json=renounceOwnership,proto3" json:"renounce_ownership,omitempty"`
+	// metadata updates the display metadata of a synthetic token.
+	Metadata *TokenMetadata `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// new_guardian sets the address which is allowed to pause the token.
+	NewGuardian string `protobuf:"bytes,9,opt,name=new_guardian,json=newGuardian,proto3" json:"new_guardian,omitempty"`
+	// remove_guardian removes the guardian of the token.
+	RemoveGuardian bool `protobuf:"varint,10,opt,name=remove_guardian,json=removeGuardian,proto3" json:"remove_guardian,omitempty"`
+	// hook_id sets the default post dispatch hook of the token.
+	HookId *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,11,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id,omitempty"`
+	// hook_metadata sets the hex encoded default hook metadata of the token.
+	HookMetadata string `protobuf:"bytes,12,opt,name=hook_metadata,json=hookMetadata,proto3" json:"hook_metadata,omitempty"`
+	// remove_hook removes the default hook and hook metadata of the token.
+	RemoveHook bool `protobuf:"varint,13,opt,name=remove_hook,json=removeHook,proto3" json:"remove_hook,omitempty"`
 }
 
 func (m *MsgSetToken) Reset()         { *m = MsgSetToken{} }
@@ -276,6 +315,41 @@ func (m *MsgSetToken) GetRenounceOwnership() bool {
 	return false
 }
 
+func (m *MsgSetToken) GetMetadata() *TokenMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MsgSetToken) GetNewGuardian() string {
+	if m != nil {
+		return m.NewGuardian
+	}
+	return ""
+}
+
+func (m *MsgSetToken) GetRemoveGuardian() bool {
+	if m != nil {
+		return m.RemoveGuardian
+	}
+	return false
+}
+
+func (m *MsgSetToken) GetHookMetadata() string {
+	if m != nil {
+		return m.HookMetadata
+	}
+	return ""
+}
+
+func (m *MsgSetToken) GetRemoveHook() bool {
+	if m != nil {
+		return m.RemoveHook
+	}
+	return false
+}
+
 // MsgSetTokenResponse ...
 type MsgSetTokenResponse struct {
 }
@@ -497,6 +571,301 @@ func (m *MsgUnrollRemoteRouterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnrollRemoteRouterResponse proto.InternalMessageInfo
 
+// MsgSetRateLimit sets the inbound and outbound rate limit of a token for a
+// remote domain. The rate limit is removed if both max capacities are zero.
+type MsgSetRateLimit struct {
+	// owner is the message sender.
+	Owner    string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TokenId  github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	Domain   uint32                                                      `protobuf:"varint,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Inbound  RateLimitConfig                                             `protobuf:"bytes,4,opt,name=inbound,proto3" json:"inbound"`
+	Outbound RateLimitConfig                                             `protobuf:"bytes,5,opt,name=outbound,proto3" json:"outbound"`
+}
+
+func (m *MsgSetRateLimit) Reset()         { *m = MsgSetRateLimit{} }
+func (m *MsgSetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimit) ProtoMessage()    {}
+func (*MsgSetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{10}
+}
+func (m *MsgSetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimit.Merge(m, src)
+}
+func (m *MsgSetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetRateLimit) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSetRateLimit) GetDomain() uint32 {
+	if m != nil {
+		return m.Domain
+	}
+	return 0
+}
+
+func (m *MsgSetRateLimit) GetInbound() RateLimitConfig {
+	if m != nil {
+		return m.Inbound
+	}
+	return RateLimitConfig{}
+}
+
+func (m *MsgSetRateLimit) GetOutbound() RateLimitConfig {
+	if m != nil {
+		return m.Outbound
+	}
+	return RateLimitConfig{}
+}
+
+// MsgSetRateLimitResponse ...
+type MsgSetRateLimitResponse struct {
+}
+
+func (m *MsgSetRateLimitResponse) Reset()         { *m = MsgSetRateLimitResponse{} }
+func (m *MsgSetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{11}
+}
+func (m *MsgSetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimitResponse proto.InternalMessageInfo
+
+// MsgPauseToken pauses a token. If domains are given, only the transfers from
+// and to these domains are paused.
+type MsgPauseToken struct {
+	// sender is the owner or the guardian of the token.
+	Sender  string                                                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TokenId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	Domains []uint32                                                    `protobuf:"varint,3,rep,packed,name=domains,proto3" json:"domains,omitempty"`
+}
+
+func (m *MsgPauseToken) Reset()         { *m = MsgPauseToken{} }
+func (m *MsgPauseToken) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseToken) ProtoMessage()    {}
+func (*MsgPauseToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{12}
+}
+func (m *MsgPauseToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseToken.Merge(m, src)
+}
+func (m *MsgPauseToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseToken proto.InternalMessageInfo
+
+func (m *MsgPauseToken) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPauseToken) GetDomains() []uint32 {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+// MsgPauseTokenResponse ...
+type MsgPauseTokenResponse struct {
+}
+
+func (m *MsgPauseTokenResponse) Reset()         { *m = MsgPauseTokenResponse{} }
+func (m *MsgPauseTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseTokenResponse) ProtoMessage()    {}
+func (*MsgPauseTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{13}
+}
+func (m *MsgPauseTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseTokenResponse.Merge(m, src)
+}
+func (m *MsgPauseTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseTokenResponse proto.InternalMessageInfo
+
+// MsgUnpauseToken unpauses a token. If domains are given, only these domains
+// are unpaused.
+type MsgUnpauseToken struct {
+	// owner is the message sender.
+	Owner   string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TokenId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	Domains []uint32                                                    `protobuf:"varint,3,rep,packed,name=domains,proto3" json:"domains,omitempty"`
+}
+
+func (m *MsgUnpauseToken) Reset()         { *m = MsgUnpauseToken{} }
+func (m *MsgUnpauseToken) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseToken) ProtoMessage()    {}
+func (*MsgUnpauseToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{14}
+}
+func (m *MsgUnpauseToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseToken.Merge(m, src)
+}
+func (m *MsgUnpauseToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseToken proto.InternalMessageInfo
+
+func (m *MsgUnpauseToken) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUnpauseToken) GetDomains() []uint32 {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+// MsgUnpauseTokenResponse ...
+type MsgUnpauseTokenResponse struct {
+}
+
+func (m *MsgUnpauseTokenResponse) Reset()         { *m = MsgUnpauseTokenResponse{} }
+func (m *MsgUnpauseTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseTokenResponse) ProtoMessage()    {}
+func (*MsgUnpauseTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{15}
+}
+func (m *MsgUnpauseTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseTokenResponse.Merge(m, src)
+}
+func (m *MsgUnpauseTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseTokenResponse proto.InternalMessageInfo
+
 // MsgRemoteTransfer ...
 type MsgRemoteTransfer struct {
 	Sender            string                                                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -509,13 +878,16 @@ type MsgRemoteTransfer struct {
 	GasLimit           cosmossdk_io_math.Int                                        `protobuf:"bytes,7,opt,name=gas_limit,json=gasLimit,proto3,customtype=cosmossdk.io/math.Int" json:"gas_limit"`
 	MaxFee             types.Coin                                                   `protobuf:"bytes,8,opt,name=max_fee,json=maxFee,proto3" json:"max_fee"`
 	CustomHookMetadata string                                                       `protobuf:"bytes,9,opt,name=custom_hook_metadata,json=customHookMetadata,proto3" json:"custom_hook_metadata,omitempty"`
+	// target_router selects an enrolled collateral router of the destination
+	// domain. If unset, the remote router of the destination domain is used.
+	TargetRouter *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,10,opt,name=target_router,json=targetRouter,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"target_router,omitempty"`
 }
 
 func (m *MsgRemoteTransfer) Reset()         { *m = MsgRemoteTransfer{} }
 func (m *MsgRemoteTransfer) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoteTransfer) ProtoMessage()    {}
 func (*MsgRemoteTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d1a3f4c9d53a091, []int{10}
+	return fileDescriptor_9d1a3f4c9d53a091, []int{16}
 }
 func (m *MsgRemoteTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +953,7 @@ func (m *MsgRemoteTransferResponse) Reset()         { *m = MsgRemoteTransferResp
 func (m *MsgRemoteTransferResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoteTransferResponse) ProtoMessage()    {}
 func (*MsgRemoteTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d1a3f4c9d53a091, []int{11}
+	return fileDescriptor_9d1a3f4c9d53a091, []int{17}
 }
 func (m *MsgRemoteTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,97 +982,566 @@ func (m *MsgRemoteTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoteTransferResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateCollateralToken)(nil), "hyperlane.warp.v1.MsgCreateCollateralToken")
-	proto.RegisterType((*MsgCreateCollateralTokenResponse)(nil), "hyperlane.warp.v1.MsgCreateCollateralTokenResponse")
-	proto.RegisterType((*MsgCreateSyntheticToken)(nil), "hyperlane.warp.v1.MsgCreateSyntheticToken")
-	proto.RegisterType((*MsgCreateSyntheticTokenResponse)(nil), "hyperlane.warp.v1.MsgCreateSyntheticTokenResponse")
-	proto.RegisterType((*MsgSetToken)(nil), "hyperlane.warp.v1.MsgSetToken")
-	proto.RegisterType((*MsgSetTokenResponse)(nil), "hyperlane.warp.v1.MsgSetTokenResponse")
-	proto.RegisterType((*MsgEnrollRemoteRouter)(nil), "hyperlane.warp.v1.MsgEnrollRemoteRouter")
-	proto.RegisterType((*MsgEnrollRemoteRouterResponse)(nil), "hyperlane.warp.v1.MsgEnrollRemoteRouterResponse")
-	proto.RegisterType((*MsgUnrollRemoteRouter)(nil), "hyperlane.warp.v1.MsgUnrollRemoteRouter")
-	proto.RegisterType((*MsgUnrollRemoteRouterResponse)(nil), "hyperlane.warp.v1.MsgUnrollRemoteRouterResponse")
-	proto.RegisterType((*MsgRemoteTransfer)(nil), "hyperlane.warp.v1.MsgRemoteTransfer")
-	proto.RegisterType((*MsgRemoteTransferResponse)(nil), "hyperlane.warp.v1.MsgRemoteTransferResponse")
+// MsgSetBridgeFee sets the bridge fee policy of a token. If no domains are
+// given, the default policy of the token is set, otherwise the policy is set
+// for each of the given domains. A policy of BRIDGE_FEE_TYPE_UNSPECIFIED
+// removes the fee. For domains, it is stored as an explicit zero fee which
+// takes precedence over the default policy.
+type MsgSetBridgeFee struct {
+	// owner is the message sender.
+	Owner   string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TokenId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	Domains []uint32                                                    `protobuf:"varint,3,rep,packed,name=domains,proto3" json:"domains,omitempty"`
+	Policy  BridgeFeePolicy                                             `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy"`
+	// fee_recipient updates the recipient of the bridge fees. It is required if
+	// the token has no fee recipient yet.
+	FeeRecipient string `protobuf:"bytes,5,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+func (m *MsgSetBridgeFee) Reset()         { *m = MsgSetBridgeFee{} }
+func (m *MsgSetBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeFee) ProtoMessage()    {}
+func (*MsgSetBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{18}
+}
+func (m *MsgSetBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeFee.Merge(m, src)
+}
+func (m *MsgSetBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeFee.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("hyperlane/warp/v1/tx.proto", fileDescriptor_9d1a3f4c9d53a091) }
+var xxx_messageInfo_MsgSetBridgeFee proto.InternalMessageInfo
 
-var fileDescriptor_9d1a3f4c9d53a091 = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0x8f, 0x5d, 0x9c, 0xd8, 0x93, 0x0f, 0x94, 0x21, 0x51, 0x1d, 0xa3, 0x38, 0xc1, 0x20, 0x1a,
-	0x4c, 0xbd, 0x9b, 0x0f, 0x09, 0x15, 0x23, 0x0e, 0x24, 0x01, 0xc5, 0x12, 0x56, 0xa5, 0x4d, 0x7d,
-	0xe9, 0x81, 0xd5, 0xd8, 0xfb, 0xba, 0x1e, 0xe2, 0x9d, 0xb1, 0x66, 0xc6, 0x1f, 0x39, 0x81, 0x38,
-	0x21, 0x4e, 0x1c, 0xb8, 0x72, 0x44, 0xe2, 0x98, 0x43, 0xff, 0x88, 0x9e, 0x50, 0xd5, 0x13, 0xe2,
-	0x50, 0xa1, 0xe4, 0x90, 0x23, 0x07, 0xfe, 0x01, 0xb4, 0xbb, 0xe3, 0xad, 0x8d, 0xd7, 0x91, 0xdb,
-	0xa6, 0x52, 0x2e, 0x96, 0xf7, 0x7d, 0xfc, 0x7e, 0xef, 0xfd, 0x66, 0xe6, 0xed, 0x2c, 0xca, 0x35,
-	0x4f, 0xdb, 0x20, 0x5a, 0x84, 0x81, 0xd9, 0x23, 0xa2, 0x6d, 0x76, 0x77, 0x4c, 0xd5, 0x37, 0xda,
-	0x82, 0x2b, 0x8e, 0x97, 0x23, 0x9f, 0xe1, 0xfb, 0x8c, 0xee, 0x4e, 0xee, 0x76, 0x83, 0x4b, 0x8f,
-	0x4b, 0xd3, 0x93, 0xae, 0x1f, 0xea, 0x49, 0x37, 0x8c, 0xcd, 0xad, 0xb8, 0xdc, 0xe5, 0xc1, 0x5f,
-	0xd3, 0xff, 0xa7, 0xad, 0xcb, 0xc4, 0xa3, 0x8c, 0x9b, 0xc1, 0xaf, 0x36, 0xad, 0x85, 0x08, 0x76,
-	0x18, 0x1b, 0x3e, 0x68, 0x57, 0x5e, 0x83, 0xd7, 0x89, 0x04, 0xb3, 0xbb, 0x53, 0x07, 0x45, 0x76,
-	0xcc, 0x06, 0xa7, 0x4c, 0xfb, 0xd7, 0x63, 0x6a, 0x3d, 0x6d, 0x83, 0x4e, 0x2f, 0xfc, 0x92, 0x44,
-	0xd9, 0xaa, 0x74, 0x0f, 0x04, 0x10, 0x05, 0x07, 0xbc, 0xd5, 0x22, 0x0a, 0x04, 0x69, 0x3d, 0xe0,
-	0x27, 0xc0, 0xb0, 0x81, 0x52, 0xbc, 0xc7, 0x40, 0x64, 0x13, 0x9b, 0x89, 0xad, 0xcc, 0x7e, 0xf6,
-	0xd9, 0xe3, 0xd2, 0x8a, 0x26, 0xff, 0xc2, 0x71, 0x04, 0x48, 0x79, 0xac, 0x04, 0x65, 0xae, 0x15,
-	0x86, 0xe1, 0x6f, 0xd1, 0x12, 0x17, 0xd4, 0xa5, 0xcc, 0xf6, 0x08, 0x6d, 0xd5, 0x79, 0x3f, 0x9b,
-	0x0c, 0x12, 0x0f, 0x9e, 0x3c, 0xdf, 0x98, 0xf9, 0xeb, 0xf9, 0xc6, 0x67, 0x2e, 0x55, 0xcd, 0x4e,
-	0xdd, 0x68, 0x70, 0xcf, 0xac, 0x37, 0xda, 0x25, 0xca, 0x18, 0xef, 0x12, 0x45, 0x39, 0x93, 0x66,
-	0x54, 0x66, 0x49, 0x37, 0xd4, 0x51, 0xb4, 0x65, 0x1c, 0x41, 0x5f, 0x33, 0x59, 0x8b, 0x21, 0x74,
-	0x35, 0x44, 0xc6, 0xef, 0xa1, 0x05, 0xcd, 0xe5, 0x00, 0xe3, 0x5e, 0xf6, 0x96, 0xcf, 0x64, 0xcd,
-	0x87, 0xb6, 0x43, 0xdf, 0x54, 0xfe, 0xf4, 0x87, 0xcb, 0xb3, 0x62, 0x58, 0xda, 0x4f, 0x97, 0x67,
-	0xc5, 0xe2, 0xb8, 0x12, 0x93, 0x3a, 0x2f, 0xf4, 0xd0, 0xe6, 0x24, 0x9f, 0x05, 0xb2, 0xcd, 0x99,
-	0x04, 0x7c, 0x8c, 0x92, 0xd4, 0xd1, 0xd2, 0x5c, 0x4b, 0x87, 0x49, 0xea, 0x14, 0xfe, 0x49, 0xa0,
-	0xdb, 0x11, 0xf3, 0xf1, 0x29, 0x53, 0x4d, 0x50, 0xb4, 0x71, 0xe3, 0x97, 0xa3, 0x7c, 0x6f, 0x54,
-	0xeb, 0x8f, 0xae, 0xd0, 0x7a, 0xb4, 0xab, 0x42, 0x17, 0x6d, 0x4c, 0x70, 0xbd, 0x59, 0xa5, 0xff,
-	0x4d, 0xa2, 0xf9, 0xaa, 0x74, 0x8f, 0x41, 0xbd, 0x9a, 0xba, 0xdf, 0xa0, 0xb4, 0xf2, 0x13, 0x6d,
-	0xea, 0x5c, 0xa7, 0xae, 0x73, 0x01, 0x68, 0xc5, 0xc1, 0xef, 0xa2, 0x0c, 0x83, 0x9e, 0x1d, 0xd6,
-	0x14, 0xee, 0xee, 0x34, 0x83, 0xde, 0xfd, 0x80, 0xfc, 0x21, 0x9a, 0xa5, 0xd2, 0xf3, 0xa9, 0xdf,
-	0x8a, 0xa8, 0x13, 0xaf, 0x4b, 0x9d, 0xa2, 0xd2, 0xab, 0x38, 0xb8, 0x84, 0xb0, 0x00, 0xc6, 0x3b,
-	0xac, 0x01, 0x21, 0xbb, 0x6c, 0xd2, 0x76, 0x76, 0x6e, 0x33, 0xb1, 0x95, 0xb6, 0x96, 0x07, 0x9e,
-	0xfb, 0x03, 0x47, 0xf9, 0xee, 0xe8, 0xca, 0xaf, 0xc7, 0xae, 0xfc, 0x40, 0xe5, 0xc2, 0x2a, 0x7a,
-	0x67, 0xe8, 0x71, 0xb0, 0xc2, 0x85, 0xdf, 0x92, 0x68, 0xb5, 0x2a, 0xdd, 0x2f, 0x99, 0xe0, 0xad,
-	0x96, 0x05, 0x1e, 0x57, 0x60, 0xf1, 0x8e, 0x02, 0x71, 0xe3, 0x96, 0xe5, 0x10, 0x2d, 0x8a, 0xa0,
-	0x3e, 0x5b, 0x04, 0x05, 0x06, 0x4b, 0x33, 0xbf, 0xbb, 0x61, 0x8c, 0xcd, 0x7d, 0x63, 0xb8, 0x0f,
-	0x6b, 0x41, 0x0c, 0x3d, 0x95, 0x3f, 0x19, 0x15, 0xed, 0x4e, 0xac, 0x68, 0xe3, 0x6a, 0x14, 0x36,
-	0xd0, 0x7a, 0xac, 0x23, 0x12, 0xf2, 0xc7, 0x50, 0xc8, 0xda, 0xcd, 0x17, 0xf2, 0x0e, 0x7a, 0x5b,
-	0x40, 0x03, 0x68, 0x17, 0x84, 0xed, 0x70, 0x8f, 0x50, 0x16, 0x48, 0xb9, 0x68, 0x2d, 0x0d, 0xcc,
-	0x87, 0x81, 0x75, 0x3a, 0xad, 0x6a, 0x93, 0xb4, 0xaa, 0x4d, 0xd6, 0xea, 0x8f, 0x14, 0x5a, 0xae,
-	0x4a, 0x37, 0xf4, 0x3d, 0x10, 0x84, 0xc9, 0x47, 0x20, 0xf0, 0x36, 0x9a, 0x95, 0xc0, 0x9c, 0x29,
-	0x84, 0xd2, 0x71, 0x6f, 0x5c, 0xa9, 0x12, 0xc2, 0x0e, 0x48, 0x45, 0x59, 0x90, 0x39, 0x2a, 0xd6,
-	0xf2, 0x90, 0x27, 0xd4, 0x0b, 0x13, 0x94, 0x11, 0xd0, 0xa0, 0x6d, 0x0a, 0x4c, 0x0d, 0x8d, 0x87,
-	0xd7, 0xae, 0xe7, 0x05, 0x2a, 0x3e, 0x42, 0xb3, 0xc4, 0xe3, 0x1d, 0xa6, 0xb2, 0xa9, 0x00, 0x7f,
-	0x5b, 0xe3, 0xaf, 0x86, 0xa9, 0xd2, 0x39, 0x31, 0x28, 0x37, 0x3d, 0xa2, 0x9a, 0x46, 0x85, 0xa9,
-	0x67, 0x8f, 0x4b, 0x48, 0x0b, 0x58, 0x61, 0xea, 0xf7, 0xcb, 0xb3, 0x62, 0xc2, 0xd2, 0xf9, 0x98,
-	0xa2, 0xa5, 0x46, 0x47, 0x2a, 0xee, 0xd9, 0x4d, 0xce, 0x4f, 0x7c, 0x05, 0x67, 0xaf, 0x6f, 0xa0,
-	0x2d, 0x84, 0xd0, 0x47, 0x9c, 0x9f, 0x54, 0x1c, 0x5c, 0x46, 0x19, 0x97, 0x48, 0xbb, 0x45, 0x3d,
-	0xaa, 0x82, 0x71, 0x96, 0xd9, 0x5f, 0xbf, 0xb2, 0x6e, 0x2b, 0xed, 0x12, 0xf9, 0xb5, 0x1f, 0x8e,
-	0x3f, 0x47, 0x73, 0x1e, 0xe9, 0xdb, 0x8f, 0x00, 0xb2, 0xe9, 0xe0, 0xbc, 0xaf, 0x19, 0xba, 0x23,
-	0xff, 0xde, 0x65, 0xe8, 0x7b, 0x97, 0x71, 0xc0, 0x29, 0xdb, 0xcf, 0xf8, 0xa0, 0xba, 0x4b, 0x8f,
-	0xf4, 0xbf, 0x02, 0xc0, 0xdb, 0x68, 0x65, 0xb8, 0x4b, 0x0f, 0x14, 0x71, 0x88, 0x22, 0xd9, 0x4c,
-	0x30, 0xd6, 0xf1, 0x8b, 0x32, 0xab, 0xda, 0x53, 0xde, 0xf3, 0x37, 0xbd, 0xde, 0x60, 0xfe, 0xae,
-	0x7f, 0x3f, 0x76, 0xd7, 0x8f, 0x6e, 0xdd, 0xc2, 0x77, 0x68, 0x6d, 0xcc, 0x18, 0xbd, 0x44, 0xeb,
-	0x08, 0x79, 0x20, 0x25, 0x71, 0xc1, 0xbe, 0xde, 0x97, 0x69, 0x46, 0xc3, 0x56, 0x9c, 0xdd, 0x5f,
-	0x53, 0xe8, 0x56, 0x55, 0xba, 0xf8, 0x14, 0xad, 0xc6, 0xdf, 0x28, 0x3f, 0x8e, 0x19, 0x93, 0x93,
-	0x2e, 0x5a, 0xb9, 0xbd, 0x97, 0x08, 0x8e, 0xda, 0xec, 0xa2, 0x95, 0xd8, 0xcb, 0x53, 0xf1, 0x2a,
-	0xb0, 0xd1, 0xd8, 0xdc, 0xee, 0xf4, 0xb1, 0x11, 0xaf, 0x85, 0xd2, 0xd1, 0x55, 0x22, 0x1f, 0x9f,
-	0x3f, 0xf0, 0xe7, 0x3e, 0xbc, 0xda, 0x1f, 0x61, 0xb6, 0x11, 0x8e, 0x79, 0x23, 0x6e, 0xc5, 0x67,
-	0x8f, 0x47, 0xe6, 0xb6, 0xa7, 0x8d, 0x1c, 0x66, 0xac, 0x4d, 0xcd, 0x58, 0x9b, 0x9a, 0x71, 0xf2,
-	0x10, 0xc6, 0x0e, 0x5a, 0xfa, 0xdf, 0x00, 0xfe, 0x20, 0x1e, 0x63, 0x34, 0x2a, 0x77, 0x77, 0x9a,
-	0xa8, 0x01, 0x4b, 0x2e, 0xf5, 0xbd, 0x7f, 0x1e, 0xf7, 0xad, 0x27, 0xe7, 0xf9, 0xc4, 0xd3, 0xf3,
-	0x7c, 0xe2, 0xef, 0xf3, 0x7c, 0xe2, 0xe7, 0x8b, 0xfc, 0xcc, 0xd3, 0x8b, 0xfc, 0xcc, 0x9f, 0x17,
-	0xf9, 0x99, 0x87, 0xf7, 0x5e, 0xe6, 0x04, 0xf4, 0xc3, 0x33, 0x18, 0x7c, 0x47, 0xd5, 0x67, 0x83,
-	0x0f, 0xa9, 0xbd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x8d, 0xe1, 0x4c, 0x15, 0x0e, 0x00,
-	0x00,
+func (m *MsgSetBridgeFee) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetBridgeFee) GetDomains() []uint32 {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
+func (m *MsgSetBridgeFee) GetPolicy() BridgeFeePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return BridgeFeePolicy{}
+}
+
+func (m *MsgSetBridgeFee) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+// MsgSetBridgeFeeResponse ...
+type MsgSetBridgeFeeResponse struct {
+}
+
+func (m *MsgSetBridgeFeeResponse) Reset()         { *m = MsgSetBridgeFeeResponse{} }
+func (m *MsgSetBridgeFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeFeeResponse) ProtoMessage()    {}
+func (*MsgSetBridgeFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{19}
+}
+func (m *MsgSetBridgeFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgeFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgeFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBridgeFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeFeeResponse.Merge(m, src)
+}
+func (m *MsgSetBridgeFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgeFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBridgeFeeResponse proto.InternalMessageInfo
+
+// MsgEnrollCollateralRouter enrolls an additional router of a collateral token
+// for a domain. Transfers to the local domain are settled directly with the
+// receiving collateral token.
+type MsgEnrollCollateralRouter struct {
+	// owner is the message sender.
+	Owner        string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TokenId      github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	RemoteRouter *RemoteRouter                                               `protobuf:"bytes,3,opt,name=remote_router,json=remoteRouter,proto3" json:"remote_router,omitempty"`
+}
+
+func (m *MsgEnrollCollateralRouter) Reset()         { *m = MsgEnrollCollateralRouter{} }
+func (m *MsgEnrollCollateralRouter) String() string { return proto.CompactTextString(m) }
+func (*MsgEnrollCollateralRouter) ProtoMessage()    {}
+func (*MsgEnrollCollateralRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{20}
+}
+func (m *MsgEnrollCollateralRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnrollCollateralRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnrollCollateralRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnrollCollateralRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnrollCollateralRouter.Merge(m, src)
+}
+func (m *MsgEnrollCollateralRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnrollCollateralRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnrollCollateralRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnrollCollateralRouter proto.InternalMessageInfo
+
+func (m *MsgEnrollCollateralRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgEnrollCollateralRouter) GetRemoteRouter() *RemoteRouter {
+	if m != nil {
+		return m.RemoteRouter
+	}
+	return nil
+}
+
+// MsgEnrollCollateralRouterResponse ...
+type MsgEnrollCollateralRouterResponse struct {
+}
+
+func (m *MsgEnrollCollateralRouterResponse) Reset()         { *m = MsgEnrollCollateralRouterResponse{} }
+func (m *MsgEnrollCollateralRouterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnrollCollateralRouterResponse) ProtoMessage()    {}
+func (*MsgEnrollCollateralRouterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{21}
+}
+func (m *MsgEnrollCollateralRouterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnrollCollateralRouterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnrollCollateralRouterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnrollCollateralRouterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnrollCollateralRouterResponse.Merge(m, src)
+}
+func (m *MsgEnrollCollateralRouterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnrollCollateralRouterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnrollCollateralRouterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnrollCollateralRouterResponse proto.InternalMessageInfo
+
+// MsgUnrollCollateralRouter removes an additional router of a collateral
+// token.
+type MsgUnrollCollateralRouter struct {
+	// owner is the message sender.
+	Owner            string                                                      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	TokenId          github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	ReceiverDomain   uint32                                                      `protobuf:"varint,3,opt,name=receiver_domain,json=receiverDomain,proto3" json:"receiver_domain,omitempty"`
+	ReceiverContract string                                                      `protobuf:"bytes,4,opt,name=receiver_contract,json=receiverContract,proto3" json:"receiver_contract,omitempty"`
+}
+
+func (m *MsgUnrollCollateralRouter) Reset()         { *m = MsgUnrollCollateralRouter{} }
+func (m *MsgUnrollCollateralRouter) String() string { return proto.CompactTextString(m) }
+func (*MsgUnrollCollateralRouter) ProtoMessage()    {}
+func (*MsgUnrollCollateralRouter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{22}
+}
+func (m *MsgUnrollCollateralRouter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnrollCollateralRouter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnrollCollateralRouter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnrollCollateralRouter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnrollCollateralRouter.Merge(m, src)
+}
+func (m *MsgUnrollCollateralRouter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnrollCollateralRouter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnrollCollateralRouter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnrollCollateralRouter proto.InternalMessageInfo
+
+func (m *MsgUnrollCollateralRouter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUnrollCollateralRouter) GetReceiverDomain() uint32 {
+	if m != nil {
+		return m.ReceiverDomain
+	}
+	return 0
+}
+
+func (m *MsgUnrollCollateralRouter) GetReceiverContract() string {
+	if m != nil {
+		return m.ReceiverContract
+	}
+	return ""
+}
+
+// MsgUnrollCollateralRouterResponse ...
+type MsgUnrollCollateralRouterResponse struct {
+}
+
+func (m *MsgUnrollCollateralRouterResponse) Reset()         { *m = MsgUnrollCollateralRouterResponse{} }
+func (m *MsgUnrollCollateralRouterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnrollCollateralRouterResponse) ProtoMessage()    {}
+func (*MsgUnrollCollateralRouterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{23}
+}
+func (m *MsgUnrollCollateralRouterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnrollCollateralRouterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnrollCollateralRouterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnrollCollateralRouterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnrollCollateralRouterResponse.Merge(m, src)
+}
+func (m *MsgUnrollCollateralRouterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnrollCollateralRouterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnrollCollateralRouterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnrollCollateralRouterResponse proto.InternalMessageInfo
+
+// MsgSweepCollateralSurplus sends the balance of the module account that
+// exceeds the tracked collateral of all collateral tokens of a denom to a
+// recipient. It can only be signed by the module authority.
+type MsgSweepCollateralSurplus struct {
+	// signer is the module authority.
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgSweepCollateralSurplus) Reset()         { *m = MsgSweepCollateralSurplus{} }
+func (m *MsgSweepCollateralSurplus) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepCollateralSurplus) ProtoMessage()    {}
+func (*MsgSweepCollateralSurplus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{24}
+}
+func (m *MsgSweepCollateralSurplus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepCollateralSurplus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepCollateralSurplus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepCollateralSurplus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepCollateralSurplus.Merge(m, src)
+}
+func (m *MsgSweepCollateralSurplus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepCollateralSurplus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepCollateralSurplus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepCollateralSurplus proto.InternalMessageInfo
+
+func (m *MsgSweepCollateralSurplus) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSweepCollateralSurplus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSweepCollateralSurplus) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgSweepCollateralSurplusResponse ...
+type MsgSweepCollateralSurplusResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgSweepCollateralSurplusResponse) Reset()         { *m = MsgSweepCollateralSurplusResponse{} }
+func (m *MsgSweepCollateralSurplusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepCollateralSurplusResponse) ProtoMessage()    {}
+func (*MsgSweepCollateralSurplusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d1a3f4c9d53a091, []int{25}
+}
+func (m *MsgSweepCollateralSurplusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepCollateralSurplusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepCollateralSurplusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepCollateralSurplusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepCollateralSurplusResponse.Merge(m, src)
+}
+func (m *MsgSweepCollateralSurplusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepCollateralSurplusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepCollateralSurplusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepCollateralSurplusResponse proto.InternalMessageInfo
+
+func (m *MsgSweepCollateralSurplusResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateCollateralToken)(nil), "hyperlane.warp.v1.MsgCreateCollateralToken")
+	proto.RegisterType((*MsgCreateCollateralTokenResponse)(nil), "hyperlane.warp.v1.MsgCreateCollateralTokenResponse")
+	proto.RegisterType((*MsgCreateSyntheticToken)(nil), "hyperlane.warp.v1.MsgCreateSyntheticToken")
+	proto.RegisterType((*MsgCreateSyntheticTokenResponse)(nil), "hyperlane.warp.v1.MsgCreateSyntheticTokenResponse")
+	proto.RegisterType((*MsgSetToken)(nil), "hyperlane.warp.v1.MsgSetToken")
+	proto.RegisterType((*MsgSetTokenResponse)(nil), "hyperlane.warp.v1.MsgSetTokenResponse")
+	proto.RegisterType((*MsgEnrollRemoteRouter)(nil), "hyperlane.warp.v1.MsgEnrollRemoteRouter")
+	proto.RegisterType((*MsgEnrollRemoteRouterResponse)(nil), "hyperlane.warp.v1.MsgEnrollRemoteRouterResponse")
+	proto.RegisterType((*MsgUnrollRemoteRouter)(nil), "hyperlane.warp.v1.MsgUnrollRemoteRouter")
+	proto.RegisterType((*MsgUnrollRemoteRouterResponse)(nil), "hyperlane.warp.v1.MsgUnrollRemoteRouterResponse")
+	proto.RegisterType((*MsgSetRateLimit)(nil), "hyperlane.warp.v1.MsgSetRateLimit")
+	proto.RegisterType((*MsgSetRateLimitResponse)(nil), "hyperlane.warp.v1.MsgSetRateLimitResponse")
+	proto.RegisterType((*MsgPauseToken)(nil), "hyperlane.warp.v1.MsgPauseToken")
+	proto.RegisterType((*MsgPauseTokenResponse)(nil), "hyperlane.warp.v1.MsgPauseTokenResponse")
+	proto.RegisterType((*MsgUnpauseToken)(nil), "hyperlane.warp.v1.MsgUnpauseToken")
+	proto.RegisterType((*MsgUnpauseTokenResponse)(nil), "hyperlane.warp.v1.MsgUnpauseTokenResponse")
+	proto.RegisterType((*MsgRemoteTransfer)(nil), "hyperlane.warp.v1.MsgRemoteTransfer")
+	proto.RegisterType((*MsgRemoteTransferResponse)(nil), "hyperlane.warp.v1.MsgRemoteTransferResponse")
+	proto.RegisterType((*MsgSetBridgeFee)(nil), "hyperlane.warp.v1.MsgSetBridgeFee")
+	proto.RegisterType((*MsgSetBridgeFeeResponse)(nil), "hyperlane.warp.v1.MsgSetBridgeFeeResponse")
+	proto.RegisterType((*MsgEnrollCollateralRouter)(nil), "hyperlane.warp.v1.MsgEnrollCollateralRouter")
+	proto.RegisterType((*MsgEnrollCollateralRouterResponse)(nil), "hyperlane.warp.v1.MsgEnrollCollateralRouterResponse")
+	proto.RegisterType((*MsgUnrollCollateralRouter)(nil), "hyperlane.warp.v1.MsgUnrollCollateralRouter")
+	proto.RegisterType((*MsgUnrollCollateralRouterResponse)(nil), "hyperlane.warp.v1.MsgUnrollCollateralRouterResponse")
+	proto.RegisterType((*MsgSweepCollateralSurplus)(nil), "hyperlane.warp.v1.MsgSweepCollateralSurplus")
+	proto.RegisterType((*MsgSweepCollateralSurplusResponse)(nil), "hyperlane.warp.v1.MsgSweepCollateralSurplusResponse")
+}
+
+func init() { proto.RegisterFile("hyperlane/warp/v1/tx.proto", fileDescriptor_9d1a3f4c9d53a091) }
+
+var fileDescriptor_9d1a3f4c9d53a091 = []byte{
+	// 1632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x4f, 0x1b, 0xdb,
+	0x15, 0xc7, 0x06, 0x8c, 0x7d, 0xc0, 0xa4, 0x4c, 0x21, 0x98, 0xa9, 0x30, 0x30, 0xa9, 0x1a, 0xea,
+	0x04, 0x1b, 0x48, 0x14, 0xa5, 0x4e, 0x22, 0xb5, 0x86, 0xb6, 0x20, 0xd5, 0x4a, 0x34, 0xc4, 0x52,
+	0x15, 0x55, 0xb1, 0xc6, 0x9e, 0xcb, 0x70, 0x8b, 0xe7, 0x5e, 0x6b, 0xee, 0xd8, 0x80, 0x54, 0xa9,
+	0x55, 0x57, 0x55, 0x57, 0xed, 0x9f, 0xd0, 0x45, 0xa5, 0x6e, 0x2a, 0xb1, 0xc8, 0xa2, 0x9b, 0xee,
+	0xf3, 0x76, 0x51, 0x56, 0xd1, 0x5b, 0x44, 0x4f, 0xc9, 0x22, 0x9b, 0xf7, 0xde, 0xee, 0xed, 0x9f,
+	0x66, 0xee, 0xf5, 0xb5, 0x07, 0x66, 0x8c, 0xfd, 0x1e, 0x91, 0xc8, 0xdb, 0x20, 0xe6, 0x9c, 0xdf,
+	0x3d, 0x1f, 0xbf, 0x73, 0x3f, 0xce, 0xbd, 0x06, 0xf5, 0xe0, 0xa4, 0x89, 0x9c, 0x86, 0x41, 0x50,
+	0xe1, 0xc8, 0x70, 0x9a, 0x85, 0xf6, 0x46, 0xc1, 0x3d, 0xce, 0x37, 0x1d, 0xea, 0x52, 0x65, 0x46,
+	0xea, 0xf2, 0x9e, 0x2e, 0xdf, 0xde, 0x50, 0xe7, 0xeb, 0x94, 0xd9, 0x94, 0x15, 0x6c, 0x66, 0x79,
+	0x50, 0x9b, 0x59, 0x1c, 0xab, 0xce, 0x5a, 0xd4, 0xa2, 0xfe, 0xbf, 0x05, 0xef, 0x3f, 0x21, 0x9d,
+	0x31, 0x6c, 0x4c, 0x68, 0xc1, 0xff, 0x2b, 0x44, 0x0b, 0xdc, 0x42, 0x95, 0x63, 0xf9, 0x87, 0x50,
+	0x65, 0x85, 0xf1, 0x9a, 0xc1, 0x50, 0xa1, 0xbd, 0x51, 0x43, 0xae, 0xb1, 0x51, 0xa8, 0x53, 0x4c,
+	0x84, 0x7e, 0x31, 0x24, 0xd6, 0x93, 0x26, 0x12, 0xc3, 0xb5, 0xd3, 0x38, 0x64, 0xca, 0xcc, 0xda,
+	0x72, 0x90, 0xe1, 0xa2, 0x2d, 0xda, 0x68, 0x18, 0x2e, 0x72, 0x8c, 0xc6, 0x53, 0x7a, 0x88, 0x88,
+	0x92, 0x87, 0x71, 0x7a, 0x44, 0x90, 0x93, 0x89, 0x2d, 0xc7, 0x56, 0x53, 0xa5, 0xcc, 0xeb, 0x17,
+	0x6b, 0xb3, 0xc2, 0xf9, 0xaf, 0x4c, 0xd3, 0x41, 0x8c, 0xed, 0xb9, 0x0e, 0x26, 0x96, 0xce, 0x61,
+	0xca, 0x1f, 0x61, 0x9a, 0x3a, 0xd8, 0xc2, 0xa4, 0x6a, 0x1b, 0xb8, 0x51, 0xa3, 0xc7, 0x99, 0xb8,
+	0x3f, 0x70, 0xeb, 0xe5, 0xdb, 0xa5, 0x91, 0xcf, 0xdf, 0x2e, 0x3d, 0xb0, 0xb0, 0x7b, 0xd0, 0xaa,
+	0xe5, 0xeb, 0xd4, 0x2e, 0xd4, 0xea, 0xcd, 0x35, 0x4c, 0x08, 0x6d, 0x1b, 0x2e, 0xa6, 0x84, 0x15,
+	0x64, 0x98, 0x6b, 0x22, 0xa1, 0x96, 0x8b, 0x1b, 0xf9, 0x1d, 0x74, 0x2c, 0x3c, 0xe9, 0x69, 0x6e,
+	0xba, 0xcc, 0x2d, 0x2b, 0x2b, 0x30, 0x25, 0x7c, 0x99, 0x88, 0x50, 0x3b, 0x33, 0xea, 0x79, 0xd2,
+	0x27, 0xb9, 0x6c, 0xdb, 0x13, 0x29, 0x2a, 0x24, 0x4d, 0x54, 0xc7, 0xb6, 0xd1, 0x60, 0x99, 0xb1,
+	0xe5, 0xd8, 0x6a, 0x5a, 0x97, 0xdf, 0xc5, 0x5f, 0xfc, 0xf5, 0xc3, 0x69, 0x8e, 0x87, 0xfd, 0xf7,
+	0x0f, 0xa7, 0xb9, 0xdc, 0x79, 0x96, 0xa2, 0x58, 0xd1, 0x8e, 0x60, 0x39, 0x4a, 0xa7, 0x23, 0xd6,
+	0xa4, 0x84, 0x21, 0x65, 0x0f, 0xe2, 0xd8, 0xcc, 0xc4, 0x2e, 0x2f, 0xfb, 0x38, 0x36, 0xb5, 0xcf,
+	0xe2, 0x30, 0x2f, 0x3d, 0xef, 0x9d, 0x10, 0xf7, 0x00, 0xb9, 0xb8, 0x7e, 0xf5, 0x4b, 0xd5, 0x5b,
+	0x87, 0xd1, 0x60, 0x1d, 0x94, 0x87, 0x90, 0xb4, 0x91, 0x6b, 0x98, 0x86, 0x6b, 0xf8, 0x35, 0x9a,
+	0xdc, 0x5c, 0xce, 0x9f, 0x5b, 0x41, 0x79, 0x3f, 0xc7, 0xb2, 0xc0, 0xe9, 0x72, 0x44, 0xf1, 0x7e,
+	0xb0, 0x8a, 0x3f, 0xef, 0x53, 0xc5, 0x20, 0x5f, 0x5a, 0x1b, 0x96, 0x22, 0x54, 0x1f, 0xb7, 0x86,
+	0xff, 0x1a, 0x87, 0xc9, 0x32, 0xb3, 0xf6, 0x90, 0xfb, 0xdd, 0xea, 0xf6, 0x1c, 0x92, 0xae, 0x37,
+	0xb0, 0x8a, 0xcd, 0xcb, 0xac, 0xd8, 0x84, 0x6f, 0x74, 0xd7, 0x54, 0x7e, 0x02, 0x29, 0x82, 0x8e,
+	0xaa, 0x3c, 0x26, 0xbe, 0xa6, 0x92, 0x04, 0x1d, 0x3d, 0xf6, 0x9d, 0x3f, 0x83, 0x04, 0x66, 0xb6,
+	0xe7, 0x7a, 0x4c, 0xba, 0x8e, 0x7d, 0x5f, 0xd7, 0xe3, 0x98, 0xd9, 0xbb, 0xa6, 0xb2, 0x06, 0x8a,
+	0x83, 0x08, 0x6d, 0x91, 0x3a, 0xe2, 0xde, 0xd9, 0x01, 0x6e, 0x66, 0x26, 0x96, 0x63, 0xab, 0x49,
+	0x7d, 0xa6, 0xa3, 0x79, 0xdc, 0x51, 0x04, 0xe6, 0x4d, 0x72, 0xd8, 0x79, 0xe3, 0x6d, 0x1e, 0x5e,
+	0x96, 0x56, 0xcb, 0x70, 0x4c, 0x6c, 0x90, 0x4c, 0x8a, 0x6f, 0x1e, 0x04, 0x1d, 0xfd, 0x56, 0x88,
+	0x94, 0x9b, 0x70, 0xcd, 0x41, 0x36, 0x6d, 0xa3, 0x2e, 0x0a, 0xfc, 0x60, 0xa6, 0xb9, 0x58, 0x02,
+	0xff, 0x00, 0x13, 0x07, 0x94, 0x1e, 0x7a, 0xac, 0x4c, 0x5e, 0x1e, 0x2b, 0x09, 0xcf, 0xe6, 0xae,
+	0xa9, 0xdc, 0x80, 0xb4, 0x6f, 0x5d, 0x26, 0x3b, 0xe5, 0x87, 0x3a, 0xe5, 0x09, 0x3b, 0x89, 0x29,
+	0x4b, 0x30, 0x29, 0x62, 0xf5, 0xc4, 0x99, 0xb4, 0x1f, 0x27, 0x70, 0xd1, 0x0e, 0xa5, 0x87, 0xc5,
+	0xdb, 0xc1, 0x75, 0xb2, 0x18, 0xba, 0x4e, 0x3a, 0x73, 0x52, 0x9b, 0x83, 0x1f, 0xf7, 0x7c, 0x76,
+	0xd6, 0x83, 0xf6, 0xef, 0x38, 0xcc, 0x95, 0x99, 0xf5, 0x6b, 0xe2, 0xd0, 0x46, 0x43, 0x47, 0x36,
+	0x75, 0x91, 0x4e, 0x5b, 0x2e, 0x72, 0xae, 0xdc, 0x24, 0xde, 0x86, 0xb4, 0xe3, 0xc7, 0x57, 0x75,
+	0xfc, 0x00, 0xfd, 0x89, 0x3c, 0xb9, 0xb9, 0x14, 0x32, 0x43, 0x7a, 0xf3, 0xd0, 0xa7, 0x9c, 0x9e,
+	0xaf, 0xe2, 0xbd, 0x20, 0x69, 0x37, 0x43, 0x49, 0x3b, 0xcf, 0x86, 0xb6, 0x04, 0x8b, 0xa1, 0x0a,
+	0x49, 0xe4, 0xdf, 0x38, 0x91, 0x95, 0xab, 0x4f, 0xa4, 0xbf, 0x08, 0xea, 0x08, 0xb7, 0x91, 0x53,
+	0x35, 0xa9, 0x6d, 0x60, 0x22, 0x36, 0xf0, 0xe9, 0x8e, 0x78, 0xdb, 0x97, 0x0e, 0xc6, 0x55, 0x25,
+	0x8a, 0xab, 0x4a, 0x34, 0x57, 0xdf, 0xc4, 0xe1, 0x1a, 0x9f, 0x8c, 0xba, 0xe1, 0xa2, 0xdf, 0x61,
+	0x1b, 0xbb, 0x57, 0x8e, 0xa5, 0xeb, 0x90, 0x08, 0x90, 0x23, 0xbe, 0x94, 0x12, 0x4c, 0x60, 0x52,
+	0xa3, 0x2d, 0x62, 0x8a, 0xa3, 0x4d, 0x0b, 0x9b, 0x80, 0x9d, 0xb4, 0xb6, 0x28, 0xd9, 0xc7, 0x56,
+	0x69, 0xcc, 0x0b, 0x4d, 0xef, 0x0c, 0x54, 0xb6, 0x21, 0x49, 0x5b, 0x2e, 0x37, 0x32, 0x3e, 0xa4,
+	0x11, 0x39, 0xb2, 0xb8, 0x1e, 0x2c, 0xcf, 0x4a, 0xd4, 0xfa, 0x97, 0x76, 0xb4, 0x05, 0x98, 0x3f,
+	0x23, 0x92, 0x25, 0xf9, 0x32, 0x06, 0xe9, 0x32, 0xb3, 0x9e, 0x18, 0x2d, 0x86, 0xf8, 0x21, 0xb6,
+	0x0e, 0x09, 0x86, 0x88, 0x39, 0x40, 0x45, 0x04, 0xee, 0xa3, 0x97, 0x24, 0x03, 0x13, 0xbc, 0x08,
+	0x5e, 0xc7, 0x31, 0xba, 0x9a, 0xd6, 0x3b, 0x9f, 0xc5, 0x82, 0x47, 0x85, 0x08, 0xc3, 0xe3, 0x62,
+	0x29, 0x94, 0x8b, 0x6e, 0x72, 0xda, 0x3c, 0xcc, 0x05, 0x04, 0x92, 0x87, 0xaf, 0x62, 0xfe, 0xd4,
+	0xac, 0x90, 0x66, 0x97, 0x89, 0xab, 0x36, 0x35, 0xa3, 0x79, 0x18, 0x68, 0x4a, 0xf4, 0xe6, 0x26,
+	0xa6, 0x44, 0xaf, 0x48, 0x52, 0xf1, 0xbf, 0x04, 0xcc, 0x94, 0x99, 0xc5, 0x57, 0xf0, 0x53, 0xc7,
+	0x20, 0x6c, 0x1f, 0x39, 0x57, 0x70, 0x5a, 0xac, 0x81, 0x62, 0x22, 0xe6, 0x62, 0xe2, 0x8f, 0x0c,
+	0x6e, 0x69, 0x33, 0x3d, 0x1a, 0xbe, 0xab, 0x29, 0x06, 0xa4, 0x1c, 0x54, 0xc7, 0x4d, 0x8c, 0x88,
+	0x9b, 0x19, 0xbb, 0xbc, 0x78, 0xba, 0x56, 0x95, 0x1d, 0x48, 0x18, 0x36, 0x6d, 0x11, 0xd7, 0x5f,
+	0xdd, 0xa9, 0xd2, 0xba, 0xb0, 0x3f, 0xc7, 0x87, 0x32, 0xf3, 0x30, 0x8f, 0x69, 0xc1, 0x36, 0xdc,
+	0x83, 0xfc, 0x2e, 0x71, 0x5f, 0xbf, 0x58, 0x03, 0x41, 0xe0, 0x2e, 0x71, 0xff, 0xf3, 0xe1, 0x34,
+	0x17, 0xd3, 0xc5, 0x78, 0x05, 0xc3, 0x74, 0xbd, 0xc5, 0x5c, 0x6a, 0x57, 0x3b, 0xed, 0x48, 0xe2,
+	0xf2, 0xda, 0x91, 0x29, 0x6e, 0x7a, 0x87, 0x37, 0x25, 0x45, 0x48, 0x59, 0x06, 0xab, 0x36, 0xbc,
+	0x6d, 0xc1, 0x6f, 0xd1, 0x52, 0xa5, 0xc5, 0xbe, 0x71, 0xeb, 0x49, 0xcb, 0x60, 0x7c, 0xf3, 0x7e,
+	0x04, 0x13, 0xb6, 0x71, 0x5c, 0xdd, 0x47, 0x48, 0xf4, 0x6d, 0x0b, 0x79, 0x91, 0x91, 0x77, 0x83,
+	0xcd, 0x8b, 0x1b, 0x6c, 0x7e, 0x8b, 0x62, 0x52, 0x4a, 0x79, 0x46, 0x45, 0x96, 0xb6, 0x71, 0xfc,
+	0x1b, 0x84, 0x94, 0x75, 0x98, 0xed, 0xcd, 0x52, 0xb6, 0x45, 0xbc, 0x83, 0x53, 0xba, 0x61, 0xca,
+	0xe6, 0xe8, 0x00, 0xd2, 0xae, 0xe1, 0x58, 0xc8, 0xed, 0x34, 0x03, 0x70, 0x89, 0xb4, 0x70, 0xcb,
+	0xa2, 0x61, 0xb8, 0x73, 0x66, 0x6b, 0xb9, 0x11, 0xba, 0xa6, 0x82, 0x8b, 0x44, 0xfb, 0x33, 0x2c,
+	0x9c, 0x13, 0xca, 0x2b, 0x48, 0x0d, 0xc0, 0x46, 0x8c, 0x19, 0x16, 0xaa, 0x5e, 0xee, 0x55, 0x24,
+	0x25, 0xcc, 0xee, 0x9a, 0xda, 0xd7, 0xf2, 0x84, 0x2d, 0x39, 0xd8, 0xb4, 0x90, 0xc7, 0xf2, 0x27,
+	0xb3, 0x8d, 0x29, 0xbf, 0x84, 0x44, 0x93, 0x36, 0x70, 0xfd, 0xa4, 0xcf, 0x11, 0x2b, 0xf3, 0x7a,
+	0xe2, 0x23, 0xc5, 0xe9, 0x28, 0xc6, 0x29, 0x8f, 0x20, 0xbd, 0x8f, 0x50, 0xb5, 0xbb, 0xd0, 0xc7,
+	0x2f, 0xc8, 0x79, 0x6a, 0x1f, 0x21, 0xbd, 0x83, 0x1e, 0xf8, 0x68, 0x95, 0x41, 0x74, 0x8f, 0x56,
+	0x29, 0x92, 0xfb, 0xe8, 0x7f, 0xe3, 0xb0, 0x20, 0x7b, 0xc7, 0xee, 0xdb, 0xc2, 0x0f, 0xba, 0xcd,
+	0x2e, 0x06, 0x09, 0xbc, 0xd5, 0xa7, 0xcd, 0x3e, 0xcb, 0x88, 0x76, 0x03, 0x56, 0x22, 0x95, 0x92,
+	0xd4, 0xff, 0x73, 0x52, 0x2b, 0x9f, 0x06, 0xa9, 0x83, 0xb6, 0xdc, 0xca, 0x2d, 0x98, 0x91, 0xc0,
+	0x3a, 0x25, 0xae, 0x63, 0xd4, 0xc5, 0x21, 0xa5, 0xff, 0xa8, 0xa3, 0xd8, 0x12, 0xf2, 0xc1, 0x48,
+	0xae, 0xf4, 0x23, 0xb9, 0xd2, 0x9f, 0xe4, 0x37, 0x31, 0x9f, 0xe4, 0xbd, 0x23, 0x84, 0x9a, 0x5d,
+	0xd0, 0x5e, 0xcb, 0x69, 0x36, 0x5a, 0xcc, 0xef, 0x04, 0xb0, 0x45, 0x06, 0xea, 0x04, 0x7c, 0x9c,
+	0x32, 0x0b, 0xe3, 0xfc, 0x5d, 0xcf, 0xe7, 0x58, 0xe7, 0x1f, 0xca, 0xbd, 0xde, 0x03, 0x79, 0xf4,
+	0x02, 0x53, 0x5d, 0x68, 0xf1, 0x01, 0xdf, 0x99, 0xb1, 0xd5, 0x37, 0xff, 0xf0, 0xe0, 0x35, 0x03,
+	0x56, 0x22, 0x95, 0x72, 0xa7, 0x7e, 0x28, 0xcf, 0xf1, 0xd8, 0x30, 0xa7, 0x1a, 0x1f, 0xb3, 0xf9,
+	0xcf, 0x49, 0x18, 0x2d, 0x33, 0x4b, 0x39, 0x81, 0xb9, 0xf0, 0x97, 0xd8, 0x5b, 0x21, 0x6b, 0x2a,
+	0xea, 0x11, 0x52, 0xbd, 0x33, 0x04, 0x58, 0x26, 0xd0, 0x86, 0xd9, 0xd0, 0x87, 0xc5, 0x5c, 0x3f,
+	0x63, 0x41, 0xac, 0xba, 0x39, 0x38, 0x56, 0xfa, 0xd5, 0x21, 0x29, 0x1f, 0xc3, 0xb2, 0xe1, 0xe3,
+	0x3b, 0x7a, 0xf5, 0x67, 0xfd, 0xf5, 0xd2, 0x66, 0x13, 0x94, 0x90, 0x57, 0x8a, 0xd5, 0xf0, 0xd1,
+	0xe7, 0x91, 0xea, 0xfa, 0xa0, 0xc8, 0x5e, 0x8f, 0x95, 0x81, 0x3d, 0x56, 0x06, 0xf6, 0x18, 0x7d,
+	0x31, 0x56, 0x4c, 0x98, 0x3e, 0xd3, 0x6e, 0xff, 0x34, 0xdc, 0x46, 0x10, 0xa5, 0xde, 0x1e, 0x04,
+	0x25, 0xbd, 0x3c, 0x87, 0xa9, 0xc0, 0xd5, 0x5b, 0x8b, 0xac, 0x80, 0xc4, 0xa8, 0xb9, 0x8b, 0x31,
+	0xd2, 0xfe, 0xef, 0x01, 0x7a, 0xee, 0x91, 0xcb, 0xe1, 0x23, 0xbb, 0x08, 0x75, 0xf5, 0x22, 0x44,
+	0x6f, 0xe4, 0x81, 0x9b, 0x99, 0x16, 0xc5, 0x70, 0x17, 0xa3, 0xe6, 0x2e, 0xc6, 0x9c, 0x61, 0xa6,
+	0xdb, 0x32, 0x45, 0x33, 0x23, 0x31, 0x6a, 0xee, 0x62, 0x8c, 0xb4, 0xff, 0x27, 0xb8, 0x1e, 0xd1,
+	0x06, 0xdc, 0xee, 0x37, 0x3b, 0xcf, 0xa2, 0xd5, 0xbb, 0xc3, 0xa0, 0x7b, 0xbd, 0x57, 0x86, 0xf2,
+	0x5e, 0x19, 0xca, 0x7b, 0xe5, 0x42, 0xef, 0x11, 0x07, 0x49, 0x84, 0xf7, 0x70, 0xb4, 0x7a, 0x77,
+	0x18, 0x74, 0xc7, 0xbb, 0x3a, 0xfe, 0x17, 0x6f, 0x6f, 0x2e, 0xe9, 0x2f, 0xdf, 0x65, 0x63, 0xaf,
+	0xde, 0x65, 0x63, 0x5f, 0xbc, 0xcb, 0xc6, 0xfe, 0xf1, 0x3e, 0x3b, 0xf2, 0xea, 0x7d, 0x76, 0xe4,
+	0xcd, 0xfb, 0xec, 0xc8, 0xb3, 0xfb, 0xc3, 0x1c, 0xf4, 0xc7, 0xfc, 0x84, 0xf1, 0x7f, 0x73, 0xab,
+	0x25, 0xfc, 0x1f, 0xdd, 0xee, 0x7c, 0x3b, 0x00, 0x4b, 0xcf, 0x07, 0x24, 0x41, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
@@ -720,6 +1561,20 @@ type MsgClient interface {
 	UnrollRemoteRouter(ctx context.Context, in *MsgUnrollRemoteRouter, opts ...grpc.CallOption) (*MsgUnrollRemoteRouterResponse, error)
 	// RemoteTransfer ...
 	RemoteTransfer(ctx context.Context, in *MsgRemoteTransfer, opts ...grpc.CallOption) (*MsgRemoteTransferResponse, error)
+	// SetRateLimit ...
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	// PauseToken ...
+	PauseToken(ctx context.Context, in *MsgPauseToken, opts ...grpc.CallOption) (*MsgPauseTokenResponse, error)
+	// UnpauseToken ...
+	UnpauseToken(ctx context.Context, in *MsgUnpauseToken, opts ...grpc.CallOption) (*MsgUnpauseTokenResponse, error)
+	// SetBridgeFee ...
+	SetBridgeFee(ctx context.Context, in *MsgSetBridgeFee, opts ...grpc.CallOption) (*MsgSetBridgeFeeResponse, error)
+	// EnrollCollateralRouter ...
+	EnrollCollateralRouter(ctx context.Context, in *MsgEnrollCollateralRouter, opts ...grpc.CallOption) (*MsgEnrollCollateralRouterResponse, error)
+	// UnrollCollateralRouter ...
+	UnrollCollateralRouter(ctx context.Context, in *MsgUnrollCollateralRouter, opts ...grpc.CallOption) (*MsgUnrollCollateralRouterResponse, error)
+	// SweepCollateralSurplus ...
+	SweepCollateralSurplus(ctx context.Context, in *MsgSweepCollateralSurplus, opts ...grpc.CallOption) (*MsgSweepCollateralSurplusResponse, error)
 }
 
 type msgClient struct {
@@ -784,25 +1639,102 @@ func (c *msgClient) RemoteTransfer(ctx context.Context, in *MsgRemoteTransfer, o
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateCollateralToken ...
-	CreateCollateralToken(context.Context, *MsgCreateCollateralToken) (*MsgCreateCollateralTokenResponse, error)
-	// CreateSyntheticToken ...
-	CreateSyntheticToken(context.Context, *MsgCreateSyntheticToken) (*MsgCreateSyntheticTokenResponse, error)
-	// SetToken ...
-	SetToken(context.Context, *MsgSetToken) (*MsgSetTokenResponse, error)
-	// EnrollRemoteRouter ...
-	EnrollRemoteRouter(context.Context, *MsgEnrollRemoteRouter) (*MsgEnrollRemoteRouterResponse, error)
-	// UnrollRemoteRouter ...
-	UnrollRemoteRouter(context.Context, *MsgUnrollRemoteRouter) (*MsgUnrollRemoteRouterResponse, error)
-	// RemoteTransfer ...
-	RemoteTransfer(context.Context, *MsgRemoteTransfer) (*MsgRemoteTransferResponse, error)
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
+func (c *msgClient) PauseToken(ctx context.Context, in *MsgPauseToken, opts ...grpc.CallOption) (*MsgPauseTokenResponse, error) {
+	out := new(MsgPauseTokenResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/PauseToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseToken(ctx context.Context, in *MsgUnpauseToken, opts ...grpc.CallOption) (*MsgUnpauseTokenResponse, error) {
+	out := new(MsgUnpauseTokenResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/UnpauseToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBridgeFee(ctx context.Context, in *MsgSetBridgeFee, opts ...grpc.CallOption) (*MsgSetBridgeFeeResponse, error) {
+	out := new(MsgSetBridgeFeeResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/SetBridgeFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnrollCollateralRouter(ctx context.Context, in *MsgEnrollCollateralRouter, opts ...grpc.CallOption) (*MsgEnrollCollateralRouterResponse, error) {
+	out := new(MsgEnrollCollateralRouterResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/EnrollCollateralRouter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnrollCollateralRouter(ctx context.Context, in *MsgUnrollCollateralRouter, opts ...grpc.CallOption) (*MsgUnrollCollateralRouterResponse, error) {
+	out := new(MsgUnrollCollateralRouterResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/UnrollCollateralRouter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepCollateralSurplus(ctx context.Context, in *MsgSweepCollateralSurplus, opts ...grpc.CallOption) (*MsgSweepCollateralSurplusResponse, error) {
+	out := new(MsgSweepCollateralSurplusResponse)
+	err := c.cc.Invoke(ctx, "/hyperlane.warp.v1.Msg/SweepCollateralSurplus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateCollateralToken ...
+	CreateCollateralToken(context.Context, *MsgCreateCollateralToken) (*MsgCreateCollateralTokenResponse, error)
+	// CreateSyntheticToken ...
+	CreateSyntheticToken(context.Context, *MsgCreateSyntheticToken) (*MsgCreateSyntheticTokenResponse, error)
+	// SetToken ...
+	SetToken(context.Context, *MsgSetToken) (*MsgSetTokenResponse, error)
+	// EnrollRemoteRouter ...
+	EnrollRemoteRouter(context.Context, *MsgEnrollRemoteRouter) (*MsgEnrollRemoteRouterResponse, error)
+	// UnrollRemoteRouter ...
+	UnrollRemoteRouter(context.Context, *MsgUnrollRemoteRouter) (*MsgUnrollRemoteRouterResponse, error)
+	// RemoteTransfer ...
+	RemoteTransfer(context.Context, *MsgRemoteTransfer) (*MsgRemoteTransferResponse, error)
+	// SetRateLimit ...
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	// PauseToken ...
+	PauseToken(context.Context, *MsgPauseToken) (*MsgPauseTokenResponse, error)
+	// UnpauseToken ...
+	UnpauseToken(context.Context, *MsgUnpauseToken) (*MsgUnpauseTokenResponse, error)
+	// SetBridgeFee ...
+	SetBridgeFee(context.Context, *MsgSetBridgeFee) (*MsgSetBridgeFeeResponse, error)
+	// EnrollCollateralRouter ...
+	EnrollCollateralRouter(context.Context, *MsgEnrollCollateralRouter) (*MsgEnrollCollateralRouterResponse, error)
+	// UnrollCollateralRouter ...
+	UnrollCollateralRouter(context.Context, *MsgUnrollCollateralRouter) (*MsgUnrollCollateralRouterResponse, error)
+	// SweepCollateralSurplus ...
+	SweepCollateralSurplus(context.Context, *MsgSweepCollateralSurplus) (*MsgSweepCollateralSurplusResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
 
 func (*UnimplementedMsgServer) CreateCollateralToken(ctx context.Context, req *MsgCreateCollateralToken) (*MsgCreateCollateralTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCollateralToken not implemented")
@@ -822,6 +1754,27 @@ func (*UnimplementedMsgServer) UnrollRemoteRouter(ctx context.Context, req *MsgU
 func (*UnimplementedMsgServer) RemoteTransfer(ctx context.Context, req *MsgRemoteTransfer) (*MsgRemoteTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoteTransfer not implemented")
 }
+func (*UnimplementedMsgServer) SetRateLimit(ctx context.Context, req *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) PauseToken(ctx context.Context, req *MsgPauseToken) (*MsgPauseTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseToken not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseToken(ctx context.Context, req *MsgUnpauseToken) (*MsgUnpauseTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseToken not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgeFee(ctx context.Context, req *MsgSetBridgeFee) (*MsgSetBridgeFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeFee not implemented")
+}
+func (*UnimplementedMsgServer) EnrollCollateralRouter(ctx context.Context, req *MsgEnrollCollateralRouter) (*MsgEnrollCollateralRouterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollCollateralRouter not implemented")
+}
+func (*UnimplementedMsgServer) UnrollCollateralRouter(ctx context.Context, req *MsgUnrollCollateralRouter) (*MsgUnrollCollateralRouterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnrollCollateralRouter not implemented")
+}
+func (*UnimplementedMsgServer) SweepCollateralSurplus(ctx context.Context, req *MsgSweepCollateralSurplus) (*MsgSweepCollateralSurplusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepCollateralSurplus not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -935,6 +1888,132 @@ func _Msg_RemoteTransfer_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/PauseToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseToken(ctx, req.(*MsgPauseToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/UnpauseToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseToken(ctx, req.(*MsgUnpauseToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBridgeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/SetBridgeFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeFee(ctx, req.(*MsgSetBridgeFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnrollCollateralRouter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnrollCollateralRouter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnrollCollateralRouter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/EnrollCollateralRouter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnrollCollateralRouter(ctx, req.(*MsgEnrollCollateralRouter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnrollCollateralRouter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnrollCollateralRouter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnrollCollateralRouter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/UnrollCollateralRouter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnrollCollateralRouter(ctx, req.(*MsgUnrollCollateralRouter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepCollateralSurplus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepCollateralSurplus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepCollateralSurplus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hyperlane.warp.v1.Msg/SweepCollateralSurplus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepCollateralSurplus(ctx, req.(*MsgSweepCollateralSurplus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hyperlane.warp.v1.Msg",
@@ -964,6 +2043,34 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoteTransfer",
 			Handler:    _Msg_RemoteTransfer_Handler,
 		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "PauseToken",
+			Handler:    _Msg_PauseToken_Handler,
+		},
+		{
+			MethodName: "UnpauseToken",
+			Handler:    _Msg_UnpauseToken_Handler,
+		},
+		{
+			MethodName: "SetBridgeFee",
+			Handler:    _Msg_SetBridgeFee_Handler,
+		},
+		{
+			MethodName: "EnrollCollateralRouter",
+			Handler:    _Msg_EnrollCollateralRouter_Handler,
+		},
+		{
+			MethodName: "UnrollCollateralRouter",
+			Handler:    _Msg_UnrollCollateralRouter_Handler,
+		},
+		{
+			MethodName: "SweepCollateralSurplus",
+			Handler:    _Msg_SweepCollateralSurplus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hyperlane/warp/v1/tx.proto",
@@ -989,6 +2096,11 @@ func (m *MsgCreateCollateralToken) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.OriginDenom) > 0 {
 		i -= len(m.OriginDenom)
 		copy(dAtA[i:], m.OriginDenom)
@@ -1069,6 +2181,23 @@ func (m *MsgCreateSyntheticToken) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.OriginMailbox.Size()
 		i -= size
@@ -1142,6 +2271,64 @@ func (m *MsgSetToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RemoveHook {
+		i--
+		if m.RemoveHook {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.HookMetadata) > 0 {
+		i -= len(m.HookMetadata)
+		copy(dAtA[i:], m.HookMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HookMetadata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.HookId != nil {
+		{
+			size := m.HookId.Size()
+			i -= size
+			if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RemoveGuardian {
+		i--
+		if m.RemoveGuardian {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.NewGuardian) > 0 {
+		i -= len(m.NewGuardian)
+		copy(dAtA[i:], m.NewGuardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewGuardian)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.RenounceOwnership {
 		i--
 		if m.RenounceOwnership {
@@ -1357,7 +2544,7 @@ func (m *MsgUnrollRemoteRouterResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoteTransfer) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1367,25 +2554,18 @@ func (m *MsgRemoteTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoteTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoteTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CustomHookMetadata) > 0 {
-		i -= len(m.CustomHookMetadata)
-		copy(dAtA[i:], m.CustomHookMetadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomHookMetadata)))
-		i--
-		dAtA[i] = 0x4a
-	}
 	{
-		size, err := m.MaxFee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Outbound.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1393,53 +2573,102 @@ func (m *MsgRemoteTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x2a
 	{
-		size := m.GasLimit.Size()
-		i -= size
-		if _, err := m.GasLimit.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Inbound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if m.CustomHookId != nil {
-		{
-			size := m.CustomHookId.Size()
-			i -= size
-			if _, err := m.CustomHookId.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	dAtA[i] = 0x22
+	if m.Domain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Domain))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x18
 	}
 	{
-		size := m.Amount.Size()
+		size := m.TokenId.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Recipient.Size()
-		i -= size
-		if _, err := m.Recipient.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.DestinationDomain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		dAtA7 := make([]byte, len(m.Domains)*10)
+		var j6 int
+		for _, num := range m.Domains {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	{
 		size := m.TokenId.Size()
@@ -1461,7 +2690,7 @@ func (m *MsgRemoteTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoteTransferResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPauseTokenResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1471,238 +2700,2856 @@ func (m *MsgRemoteTransferResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoteTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPauseTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoteTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPauseTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MessageId.Size()
-		i -= size
-		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateCollateralToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.OriginMailbox.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.OriginDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnpauseToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateCollateralTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUnpauseToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateSyntheticToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUnpauseToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Domains) > 0 {
+		dAtA9 := make([]byte, len(m.Domains)*10)
+		var j8 int
+		for _, num := range m.Domains {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.OriginMailbox.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgCreateSyntheticTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetToken) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TokenId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IsmId != nil {
-		l = m.IsmId.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RenounceOwnership {
-		n += 2
+func (m *MsgUnpauseTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUnpauseTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEnrollRemoteRouter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUnpauseTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TokenId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.RemoteRouter != nil {
-		l = m.RemoteRouter.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnrollRemoteRouterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoteTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUnrollRemoteRouter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TokenId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.ReceiverDomain != 0 {
-		n += 1 + sovTx(uint64(m.ReceiverDomain))
-	}
-	return n
+func (m *MsgRemoteTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnrollRemoteRouterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoteTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgRemoteTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TargetRouter != nil {
+		{
+			size := m.TargetRouter.Size()
+			i -= size
+			if _, err := m.TargetRouter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CustomHookMetadata) > 0 {
+		i -= len(m.CustomHookMetadata)
+		copy(dAtA[i:], m.CustomHookMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomHookMetadata)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.MaxFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GasLimit.Size()
+		i -= size
+		if _, err := m.GasLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.CustomHookId != nil {
+		{
+			size := m.CustomHookId.Size()
+			i -= size
+			if _, err := m.CustomHookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Recipient.Size()
+		i -= size
+		if _, err := m.Recipient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoteTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoteTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoteTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MessageId.Size()
+		i -= size
+		if _, err := m.MessageId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.TokenId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DestinationDomain != 0 {
-		n += 1 + sovTx(uint64(m.DestinationDomain))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Recipient.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.CustomHookId != nil {
-		l = m.CustomHookId.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.GasLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CustomHookMetadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Domains) > 0 {
+		dAtA13 := make([]byte, len(m.Domains)*10)
+		var j12 int
+		for _, num := range m.Domains {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnrollCollateralRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnrollCollateralRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnrollCollateralRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoteRouter != nil {
+		{
+			size, err := m.RemoteRouter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnrollCollateralRouterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnrollCollateralRouterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnrollCollateralRouterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnrollCollateralRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnrollCollateralRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnrollCollateralRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiverContract) > 0 {
+		i -= len(m.ReceiverContract)
+		copy(dAtA[i:], m.ReceiverContract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ReceiverDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiverDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnrollCollateralRouterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnrollCollateralRouterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnrollCollateralRouterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepCollateralSurplus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepCollateralSurplus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepCollateralSurplus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepCollateralSurplusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepCollateralSurplusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepCollateralSurplusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCollateralToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OriginMailbox.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.OriginDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *MsgCreateCollateralTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSyntheticToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OriginMailbox.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSyntheticTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsmId != nil {
+		l = m.IsmId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceOwnership {
+		n += 2
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewGuardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RemoveGuardian {
+		n += 2
+	}
+	if m.HookId != nil {
+		l = m.HookId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HookMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RemoveHook {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnrollRemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemoteRouter != nil {
+		l = m.RemoteRouter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnrollRemoteRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnrollRemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovTx(uint64(m.ReceiverDomain))
+	}
+	return n
+}
+
+func (m *MsgUnrollRemoteRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Domain != 0 {
+		n += 1 + sovTx(uint64(m.Domain))
+	}
+	l = m.Inbound.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Outbound.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Domains) > 0 {
+		l = 0
+		for _, e := range m.Domains {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgPauseTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Domains) > 0 {
+		l = 0
+		for _, e := range m.Domains {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUnpauseTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoteTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = m.Recipient.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CustomHookId != nil {
+		l = m.CustomHookId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CustomHookMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TargetRouter != nil {
+		l = m.TargetRouter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoteTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MessageId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBridgeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Domains) > 0 {
+		l = 0
+		for _, e := range m.Domains {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgeFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnrollCollateralRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RemoteRouter != nil {
+		l = m.RemoteRouter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnrollCollateralRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnrollCollateralRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovTx(uint64(m.ReceiverDomain))
+	}
+	l = len(m.ReceiverContract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnrollCollateralRouterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepCollateralSurplus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSweepCollateralSurplusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateCollateralToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCollateralToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCollateralToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCollateralTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCollateralTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCollateralTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSyntheticToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSyntheticToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSyntheticToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &TokenMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSyntheticTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSyntheticTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSyntheticTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.IsmId = &v
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenounceOwnership = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &TokenMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveGuardian", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveGuardian = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.HookId = &v
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveHook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveHook = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteRouter == nil {
+				m.RemoteRouter = &RemoteRouter{}
+			}
+			if err := m.RemoteRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnrollRemoteRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			}
+			m.ReceiverDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnrollRemoteRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Domains = append(m.Domains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Domains) == 0 {
+					m.Domains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Domains = append(m.Domains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoteTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MessageId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
+func (m *MsgPauseTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateCollateralToken) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,10 +5572,10 @@ func (m *MsgCreateCollateralToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCollateralToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCollateralToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1765,7 +5612,7 @@ func (m *MsgCreateCollateralToken) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1793,42 +5640,86 @@ func (m *MsgCreateCollateralToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Domains = append(m.Domains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Domains) == 0 {
+					m.Domains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Domains = append(m.Domains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1850,7 +5741,7 @@ func (m *MsgCreateCollateralToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCollateralTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,46 +5764,12 @@ func (m *MsgCreateCollateralTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCollateralTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCollateralTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1934,7 +5791,7 @@ func (m *MsgCreateCollateralTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSyntheticToken) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1957,15 +5814,100 @@ func (m *MsgCreateSyntheticToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSyntheticToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoteTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSyntheticToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoteTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1993,11 +5935,13 @@ func (m *MsgCreateSyntheticToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2025,63 +5969,13 @@ func (m *MsgCreateSyntheticToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateSyntheticTokenResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSyntheticTokenResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSyntheticTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2109,63 +6003,15 @@ func (m *MsgCreateSyntheticTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.CustomHookId = &v
+			if err := m.CustomHookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetToken: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetToken: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2193,13 +6039,15 @@ func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.GasLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2209,29 +6057,28 @@ func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookMetadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2259,11 +6106,11 @@ func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.CustomHookMetadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRouter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2292,31 +6139,11 @@ func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.IsmId = &v
-			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetRouter = &v
+			if err := m.TargetRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenounceOwnership", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RenounceOwnership = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2338,7 +6165,7 @@ func (m *MsgSetToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoteTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2361,12 +6188,46 @@ func (m *MsgSetTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoteTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoteTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2388,7 +6249,7 @@ func (m *MsgSetTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgeFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2411,10 +6272,10 @@ func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnrollRemoteRouter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgeFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnrollRemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2463,31 +6324,140 @@ func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Domains = append(m.Domains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Domains) == 0 {
+					m.Domains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Domains = append(m.Domains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2497,27 +6467,23 @@ func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemoteRouter == nil {
-				m.RemoteRouter = &RemoteRouter{}
-			}
-			if err := m.RemoteRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2540,7 +6506,7 @@ func (m *MsgEnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgeFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2563,10 +6529,10 @@ func (m *MsgEnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnrollRemoteRouterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgeFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnrollRemoteRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgeFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2590,7 +6556,7 @@ func (m *MsgEnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
+func (m *MsgEnrollCollateralRouter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2613,10 +6579,10 @@ func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnrollRemoteRouter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnrollCollateralRouter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnrollRemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnrollCollateralRouter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2686,10 +6652,10 @@ func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRouter", wireType)
 			}
-			m.ReceiverDomain = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2699,11 +6665,28 @@ func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteRouter == nil {
+				m.RemoteRouter = &RemoteRouter{}
+			}
+			if err := m.RemoteRouter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2725,7 +6708,7 @@ func (m *MsgUnrollRemoteRouter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnrollCollateralRouterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2748,10 +6731,10 @@ func (m *MsgUnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnrollRemoteRouterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnrollCollateralRouterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnrollRemoteRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnrollCollateralRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2775,7 +6758,7 @@ func (m *MsgUnrollRemoteRouterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgUnrollCollateralRouter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2798,15 +6781,15 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoteTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnrollCollateralRouter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoteTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnrollCollateralRouter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2834,7 +6817,7 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2872,9 +6855,9 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
 			}
-			m.DestinationDomain = 0
+			m.ReceiverDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2884,14 +6867,14 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2919,83 +6902,111 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReceiverContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnrollCollateralRouterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnrollCollateralRouterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnrollCollateralRouterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.CustomHookId = &v
-			if err := m.CustomHookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepCollateralSurplus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepCollateralSurplus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepCollateralSurplus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3023,15 +7034,13 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3041,28 +7050,27 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomHookMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3090,7 +7098,7 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomHookMetadata = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3113,7 +7121,7 @@ func (m *MsgRemoteTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoteTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepCollateralSurplusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,17 +7144,17 @@ func (m *MsgRemoteTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoteTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepCollateralSurplusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoteTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepCollateralSurplusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3156,23 +7164,22 @@ func (m *MsgRemoteTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_bcp_innovations_hyperlane_cosmos_util "github.com/bcp-innovations/hyperlane-cosmos/util"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +28,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BridgeFeeType ...
+type BridgeFeeType int32
+
+const (
+	// BRIDGE_FEE_TYPE_UNSPECIFIED charges no fee.
+	BRIDGE_FEE_TYPE_UNSPECIFIED BridgeFeeType = 0
+	// BRIDGE_FEE_TYPE_FLAT charges a fixed amount per transfer.
+	BRIDGE_FEE_TYPE_FLAT BridgeFeeType = 1
+	// BRIDGE_FEE_TYPE_BPS charges basis points of the transferred amount.
+	BRIDGE_FEE_TYPE_BPS BridgeFeeType = 2
+	// BRIDGE_FEE_TYPE_TIERED charges the basis points of the highest tier
+	// whose min_amount does not exceed the transferred amount.
+	BRIDGE_FEE_TYPE_TIERED BridgeFeeType = 3
+)
+
+var BridgeFeeType_name = map[int32]string{
+	0: "BRIDGE_FEE_TYPE_UNSPECIFIED",
+	1: "BRIDGE_FEE_TYPE_FLAT",
+	2: "BRIDGE_FEE_TYPE_BPS",
+	3: "BRIDGE_FEE_TYPE_TIERED",
+}
+
+var BridgeFeeType_value = map[string]int32{
+	"BRIDGE_FEE_TYPE_UNSPECIFIED": 0,
+	"BRIDGE_FEE_TYPE_FLAT":        1,
+	"BRIDGE_FEE_TYPE_BPS":         2,
+	"BRIDGE_FEE_TYPE_TIERED":      3,
+}
+
+func (x BridgeFeeType) String() string {
+	return proto.EnumName(BridgeFeeType_name, int32(x))
+}
+
+func (BridgeFeeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{0}
+}
+
 // HypTokenType ...
 type HypTokenType int32
 
@@ -56,7 +94,7 @@ func (x HypTokenType) String() string {
 }
 
 func (HypTokenType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7372986c61417e18, []int{0}
+	return fileDescriptor_7372986c61417e18, []int{1}
 }
 
 // Params
@@ -105,6 +143,26 @@ type HypToken struct {
 	OriginDenom       string                                                       `protobuf:"bytes,5,opt,name=origin_denom,json=originDenom,proto3" json:"origin_denom,omitempty"`
 	CollateralBalance cosmossdk_io_math.Int                                        `protobuf:"bytes,6,opt,name=collateral_balance,json=collateralBalance,proto3,customtype=cosmossdk.io/math.Int" json:"collateral_balance"`
 	IsmId             *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,7,opt,name=ism_id,json=ismId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"ism_id,omitempty"`
+	// decimals of the origin denom. Amounts are scaled between the decimals of
+	// the token and the decimals of the remote router.
+	Decimals uint32 `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// paused blocks all transfers of the token.
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_domains blocks the transfers from and to the given domains.
+	PausedDomains []uint32 `protobuf:"varint,10,rep,packed,name=paused_domains,json=pausedDomains,proto3" json:"paused_domains,omitempty"`
+	// guardian is allowed to pause the token in addition to the owner.
+	Guardian string `protobuf:"bytes,11,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// bridge_fee is the default fee policy for transfers to remote domains
+	// without a domain specific fee policy.
+	BridgeFee BridgeFeePolicy `protobuf:"bytes,12,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	// fee_recipient receives the bridge fees.
+	FeeRecipient string `protobuf:"bytes,13,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// hook_id is the post dispatch hook of remote transfers without a custom
+	// hook. If unset, the default hook of the mailbox is used.
+	HookId *github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,14,opt,name=hook_id,json=hookId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"hook_id,omitempty"`
+	// hook_metadata is the metadata of remote transfers without custom hook
+	// metadata.
+	HookMetadata []byte `protobuf:"bytes,15,opt,name=hook_metadata,json=hookMetadata,proto3" json:"hook_metadata,omitempty"`
 }
 
 func (m *HypToken) Reset()         { *m = HypToken{} }
@@ -161,11 +219,63 @@ func (m *HypToken) GetOriginDenom() string {
 	return ""
 }
 
+func (m *HypToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *HypToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *HypToken) GetPausedDomains() []uint32 {
+	if m != nil {
+		return m.PausedDomains
+	}
+	return nil
+}
+
+func (m *HypToken) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *HypToken) GetBridgeFee() BridgeFeePolicy {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return BridgeFeePolicy{}
+}
+
+func (m *HypToken) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *HypToken) GetHookMetadata() []byte {
+	if m != nil {
+		return m.HookMetadata
+	}
+	return nil
+}
+
 // RemoteRouter ...
 type RemoteRouter struct {
 	ReceiverDomain   uint32                `protobuf:"varint,1,opt,name=receiver_domain,json=receiverDomain,proto3" json:"receiver_domain,omitempty"`
 	ReceiverContract string                `protobuf:"bytes,2,opt,name=receiver_contract,json=receiverContract,proto3" json:"receiver_contract,omitempty"`
 	Gas              cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=gas,proto3,customtype=cosmossdk.io/math.Int" json:"gas"`
+	// decimals of the token on the remote chain. They must be set if the token
+	// has decimals.
+	Decimals uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
 func (m *RemoteRouter) Reset()         { *m = RemoteRouter{} }
@@ -215,311 +325,3168 @@ func (m *RemoteRouter) GetReceiverContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("hyperlane.warp.v1.HypTokenType", HypTokenType_name, HypTokenType_value)
-	proto.RegisterType((*Params)(nil), "hyperlane.warp.v1.Params")
-	proto.RegisterType((*HypToken)(nil), "hyperlane.warp.v1.HypToken")
-	proto.RegisterType((*RemoteRouter)(nil), "hyperlane.warp.v1.RemoteRouter")
+func (m *RemoteRouter) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("hyperlane/warp/v1/types.proto", fileDescriptor_7372986c61417e18) }
+// TokenMetadata is the display metadata of a synthetic token. It is stored as
+// bank denom metadata, the decimals are taken from the token.
+type TokenMetadata struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Uri         string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+}
 
-var fileDescriptor_7372986c61417e18 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0xd3, 0x36, 0xd0, 0xa5, 0x2d, 0xc9, 0xaa, 0x95, 0xdc, 0x88, 0xba, 0xa5, 0x42, 0xa2,
-	0x2a, 0xaa, 0xad, 0xc2, 0x05, 0x81, 0x84, 0xd4, 0xa6, 0x46, 0x89, 0x08, 0x6d, 0xe4, 0x98, 0x43,
-	0x7a, 0xb1, 0x36, 0xf6, 0xca, 0x59, 0x6a, 0xef, 0x9a, 0xf5, 0x26, 0x4d, 0xfe, 0xa0, 0xe2, 0xc4,
-	0x85, 0x2f, 0xe0, 0x07, 0x38, 0xf0, 0x11, 0x3d, 0x56, 0x9c, 0x10, 0x87, 0x0a, 0x25, 0x07, 0x7e,
-	0x03, 0xd9, 0xeb, 0x04, 0x14, 0x0e, 0x08, 0xc1, 0xc5, 0x5a, 0xbf, 0xf7, 0x76, 0xe6, 0xcd, 0xcc,
-	0x0e, 0xd8, 0xe8, 0x0e, 0x63, 0xcc, 0x43, 0x44, 0xb1, 0x79, 0x8e, 0x78, 0x6c, 0xf6, 0xf7, 0x4d,
-	0x31, 0x8c, 0x71, 0x62, 0xc4, 0x9c, 0x09, 0x06, 0xcb, 0x53, 0xda, 0x48, 0x69, 0xa3, 0xbf, 0x5f,
-	0x59, 0xf7, 0x58, 0x12, 0xb1, 0xc4, 0xcd, 0x04, 0xa6, 0xfc, 0x91, 0xea, 0xca, 0x6a, 0xc0, 0x02,
-	0x26, 0xf1, 0xf4, 0x94, 0xa3, 0x65, 0x14, 0x11, 0xca, 0xcc, 0xec, 0x2b, 0xa1, 0xed, 0x7b, 0xa0,
-	0xd8, 0x44, 0x1c, 0x45, 0xc9, 0x93, 0xca, 0xdb, 0xef, 0x1f, 0x77, 0xd7, 0x66, 0x4c, 0x48, 0x6e,
-	0xfb, 0x62, 0x1e, 0xdc, 0xac, 0x0d, 0x63, 0x87, 0x9d, 0x61, 0x0a, 0x5b, 0xa0, 0x40, 0x7c, 0x4d,
-	0xdd, 0x52, 0x77, 0x16, 0x0f, 0xab, 0x97, 0xd7, 0x9b, 0xca, 0xd7, 0xeb, 0xcd, 0xa7, 0x01, 0x11,
-	0xdd, 0x5e, 0xc7, 0xf0, 0x58, 0x64, 0x76, 0xbc, 0x78, 0x8f, 0x50, 0xca, 0xfa, 0x48, 0x10, 0x46,
-	0x13, 0x73, 0x1a, 0x72, 0x4f, 0x5a, 0x34, 0x7b, 0x82, 0x84, 0x46, 0x0d, 0x0f, 0x0e, 0x7c, 0x9f,
-	0xe3, 0x24, 0xb1, 0x0b, 0xc4, 0x87, 0x06, 0x58, 0x60, 0xe7, 0x14, 0x73, 0xad, 0x90, 0xc5, 0xd5,
-	0x3e, 0x7f, 0xda, 0x5b, 0xcd, 0x2b, 0xca, 0x65, 0x2d, 0xc1, 0x09, 0x0d, 0x6c, 0x29, 0x83, 0xcf,
-	0x00, 0x10, 0xa9, 0x1b, 0x37, 0xed, 0x91, 0x36, 0xb7, 0xa5, 0xee, 0xac, 0x3c, 0xdc, 0x34, 0x7e,
-	0xeb, 0x91, 0x31, 0x71, 0xed, 0x0c, 0x63, 0x6c, 0x2f, 0x8a, 0xc9, 0x11, 0xbe, 0x06, 0x2b, 0x8c,
-	0x93, 0x80, 0x50, 0x37, 0x42, 0x24, 0xec, 0xb0, 0x81, 0x36, 0xff, 0xff, 0x0a, 0x5a, 0x96, 0xa1,
-	0x5f, 0xca, 0xc8, 0xf0, 0x2e, 0x58, 0xca, 0x73, 0xf9, 0x98, 0xb2, 0x48, 0x5b, 0x48, 0x33, 0xd9,
-	0xb7, 0x24, 0x76, 0x94, 0x42, 0xb0, 0x01, 0xa0, 0xc7, 0xc2, 0x10, 0x09, 0xcc, 0x51, 0xe8, 0x76,
-	0x50, 0x88, 0xa8, 0x87, 0xb5, 0x62, 0x66, 0x69, 0x23, 0xb7, 0xb4, 0x26, 0xb3, 0x25, 0xfe, 0x99,
-	0x41, 0x98, 0x19, 0x21, 0xd1, 0x35, 0xea, 0x54, 0xd8, 0xe5, 0x9f, 0x17, 0x0f, 0xe5, 0x3d, 0x78,
-	0x0a, 0x8a, 0x24, 0x89, 0x5c, 0xe2, 0x6b, 0x37, 0xa6, 0x45, 0xa9, 0xff, 0x5a, 0xd4, 0x02, 0x49,
-	0xa2, 0xba, 0xbf, 0xfd, 0x5e, 0x05, 0x4b, 0x36, 0x8e, 0x98, 0xc0, 0x36, 0xeb, 0x09, 0xcc, 0xe1,
-	0x7d, 0x70, 0x9b, 0x63, 0x0f, 0x93, 0x3e, 0xe6, 0xae, 0xcf, 0x22, 0x44, 0x68, 0xf6, 0x36, 0x96,
-	0xed, 0x95, 0x09, 0x7c, 0x94, 0xa1, 0xf0, 0x01, 0x28, 0x4f, 0x85, 0x1e, 0xa3, 0x82, 0x23, 0x4f,
-	0xc8, 0x71, 0xdb, 0xa5, 0x09, 0x51, 0xcd, 0x71, 0x68, 0x82, 0xb9, 0x00, 0x25, 0xd9, 0x60, 0xff,
-	0xd8, 0x81, 0x54, 0xb9, 0xfb, 0x06, 0x2c, 0xfd, 0x3a, 0x6b, 0xa8, 0x83, 0x4a, 0xad, 0xdd, 0x74,
-	0x9d, 0x93, 0x17, 0xd6, 0xb1, 0xeb, 0xb4, 0x9b, 0x96, 0xfb, 0xea, 0xb8, 0xd5, 0xb4, 0xaa, 0xf5,
-	0xe7, 0x75, 0xeb, 0xa8, 0xa4, 0xc0, 0x0d, 0xb0, 0x3e, 0xc3, 0x57, 0x4f, 0x1a, 0x8d, 0x03, 0xc7,
-	0xb2, 0x0f, 0x1a, 0x25, 0x15, 0xde, 0x01, 0xda, 0x0c, 0xdd, 0x6a, 0x1f, 0x3b, 0x35, 0xcb, 0xa9,
-	0x57, 0x4b, 0x85, 0xca, 0xfc, 0xc5, 0x07, 0x5d, 0x39, 0xb4, 0x2f, 0x47, 0xba, 0x7a, 0x35, 0xd2,
-	0xd5, 0x6f, 0x23, 0x5d, 0x7d, 0x37, 0xd6, 0x95, 0xab, 0xb1, 0xae, 0x7c, 0x19, 0xeb, 0xca, 0xe9,
-	0xe3, 0xbf, 0x69, 0xf4, 0x40, 0xae, 0x5a, 0xb6, 0xec, 0x9d, 0x62, 0xb6, 0x96, 0x8f, 0x7e, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xff, 0xca, 0x37, 0xa7, 0x0e, 0x04, 0x00, 0x00,
+func (m *TokenMetadata) Reset()         { *m = TokenMetadata{} }
+func (m *TokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenMetadata) ProtoMessage()    {}
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{3}
+}
+func (m *TokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMetadata.Merge(m, src)
+}
+func (m *TokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMetadata.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenMetadata proto.InternalMessageInfo
+
+func (m *TokenMetadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TokenMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *TokenMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *HypToken) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TokenMetadata) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HypToken) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateLimitConfig configures a rolling window rate limit. The capacity refills
+// linearly over the window until max_capacity is reached.
+type RateLimitConfig struct {
+	// max_capacity is the maximum amount in local units which can be transferred
+	// within one window. Zero disables the rate limit.
+	MaxCapacity   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_capacity,json=maxCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"max_capacity"`
+	WindowSeconds uint64                `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
 }
 
-func (m *HypToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsmId != nil {
-		{
-			size := m.IsmId.Size()
-			i -= size
-			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *RateLimitConfig) Reset()         { *m = RateLimitConfig{} }
+func (m *RateLimitConfig) String() string { return proto.CompactTextString(m) }
+func (*RateLimitConfig) ProtoMessage()    {}
+func (*RateLimitConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{4}
+}
+func (m *RateLimitConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	{
-		size := m.CollateralBalance.Size()
-		i -= size
-		if _, err := m.CollateralBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *RateLimitConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitConfig.Merge(m, src)
+}
+func (m *RateLimitConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitConfig proto.InternalMessageInfo
+
+func (m *RateLimitConfig) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// RateLimitState is the capacity of a rate limit at the time of its last
+// update.
+type RateLimitState struct {
+	Capacity cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=capacity,proto3,customtype=cosmossdk.io/math.Int" json:"capacity"`
+	// last_updated is the unix time in seconds of the last update.
+	LastUpdated int64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (m *RateLimitState) Reset()         { *m = RateLimitState{} }
+func (m *RateLimitState) String() string { return proto.CompactTextString(m) }
+func (*RateLimitState) ProtoMessage()    {}
+func (*RateLimitState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{5}
+}
+func (m *RateLimitState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.OriginDenom) > 0 {
-		i -= len(m.OriginDenom)
-		copy(dAtA[i:], m.OriginDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OriginDenom)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *RateLimitState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitState.Merge(m, src)
+}
+func (m *RateLimitState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitState proto.InternalMessageInfo
+
+func (m *RateLimitState) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
 	}
-	{
-		size := m.OriginMailbox.Size()
-		i -= size
-		if _, err := m.OriginMailbox.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// RouteRateLimit holds the inbound and outbound rate limit of a token for a
+// remote domain.
+type RouteRateLimit struct {
+	TokenId       github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	Domain        uint32                                                      `protobuf:"varint,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Inbound       RateLimitConfig                                             `protobuf:"bytes,3,opt,name=inbound,proto3" json:"inbound"`
+	InboundState  RateLimitState                                              `protobuf:"bytes,4,opt,name=inbound_state,json=inboundState,proto3" json:"inbound_state"`
+	Outbound      RateLimitConfig                                             `protobuf:"bytes,5,opt,name=outbound,proto3" json:"outbound"`
+	OutboundState RateLimitState                                              `protobuf:"bytes,6,opt,name=outbound_state,json=outboundState,proto3" json:"outbound_state"`
+}
+
+func (m *RouteRateLimit) Reset()         { *m = RouteRateLimit{} }
+func (m *RouteRateLimit) String() string { return proto.CompactTextString(m) }
+func (*RouteRateLimit) ProtoMessage()    {}
+func (*RouteRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{6}
+}
+func (m *RouteRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.TokenType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TokenType))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *RouteRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteRateLimit.Merge(m, src)
+}
+func (m *RouteRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteRateLimit proto.InternalMessageInfo
+
+func (m *RouteRateLimit) GetDomain() uint32 {
+	if m != nil {
+		return m.Domain
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *RouteRateLimit) GetInbound() RateLimitConfig {
+	if m != nil {
+		return m.Inbound
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return RateLimitConfig{}
+}
+
+func (m *RouteRateLimit) GetInboundState() RateLimitState {
+	if m != nil {
+		return m.InboundState
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return RateLimitState{}
 }
 
-func (m *RemoteRouter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RouteRateLimit) GetOutbound() RateLimitConfig {
+	if m != nil {
+		return m.Outbound
 	}
-	return dAtA[:n], nil
+	return RateLimitConfig{}
 }
 
-func (m *RemoteRouter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RouteRateLimit) GetOutboundState() RateLimitState {
+	if m != nil {
+		return m.OutboundState
+	}
+	return RateLimitState{}
 }
 
-func (m *RemoteRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Gas.Size()
-		i -= size
-		if _, err := m.Gas.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// BridgeFeePolicy configures the fee which is deducted from the transferred
+// amount of a remote transfer.
+type BridgeFeePolicy struct {
+	FeeType BridgeFeeType `protobuf:"varint,1,opt,name=fee_type,json=feeType,proto3,enum=hyperlane.warp.v1.BridgeFeeType" json:"fee_type,omitempty"`
+	// flat_amount is the fee in local units for BRIDGE_FEE_TYPE_FLAT.
+	FlatAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=flat_amount,json=flatAmount,proto3,customtype=cosmossdk.io/math.Int" json:"flat_amount"`
+	// bps is the fee in basis points for BRIDGE_FEE_TYPE_BPS.
+	Bps uint32 `protobuf:"varint,3,opt,name=bps,proto3" json:"bps,omitempty"`
+	// tiers for BRIDGE_FEE_TYPE_TIERED, sorted by ascending min_amount.
+	Tiers []BridgeFeeTier `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *BridgeFeePolicy) Reset()         { *m = BridgeFeePolicy{} }
+func (m *BridgeFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeePolicy) ProtoMessage()    {}
+func (*BridgeFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{7}
+}
+func (m *BridgeFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ReceiverContract) > 0 {
-		i -= len(m.ReceiverContract)
-		copy(dAtA[i:], m.ReceiverContract)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReceiverContract)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BridgeFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeePolicy.Merge(m, src)
+}
+func (m *BridgeFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeFeePolicy proto.InternalMessageInfo
+
+func (m *BridgeFeePolicy) GetFeeType() BridgeFeeType {
+	if m != nil {
+		return m.FeeType
 	}
-	if m.ReceiverDomain != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ReceiverDomain))
-		i--
-		dAtA[i] = 0x8
+	return BRIDGE_FEE_TYPE_UNSPECIFIED
+}
+
+func (m *BridgeFeePolicy) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BridgeFeePolicy) GetTiers() []BridgeFeeTier {
+	if m != nil {
+		return m.Tiers
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// BridgeFeeTier charges bps for transfers of at least min_amount.
+type BridgeFeeTier struct {
+	MinAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	Bps       uint32                `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
+}
+
+func (m *BridgeFeeTier) Reset()         { *m = BridgeFeeTier{} }
+func (m *BridgeFeeTier) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeeTier) ProtoMessage()    {}
+func (*BridgeFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{8}
+}
+func (m *BridgeFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *BridgeFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeeTier.Merge(m, src)
+}
+func (m *BridgeFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeeTier.DiscardUnknown(m)
 }
 
-func (m *HypToken) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BridgeFeeTier proto.InternalMessageInfo
+
+func (m *BridgeFeeTier) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
 	}
-	var l int
-	_ = l
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// RouteBridgeFee is the fee policy of a token for a remote domain.
+type RouteBridgeFee struct {
+	TokenId github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	Domain  uint32                                                      `protobuf:"varint,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Policy  BridgeFeePolicy                                             `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *RouteBridgeFee) Reset()         { *m = RouteBridgeFee{} }
+func (m *RouteBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*RouteBridgeFee) ProtoMessage()    {}
+func (*RouteBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{9}
+}
+func (m *RouteBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TokenType != 0 {
-		n += 1 + sovTypes(uint64(m.TokenType))
+}
+func (m *RouteBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteBridgeFee.Merge(m, src)
+}
+func (m *RouteBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteBridgeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteBridgeFee proto.InternalMessageInfo
+
+func (m *RouteBridgeFee) GetDomain() uint32 {
+	if m != nil {
+		return m.Domain
 	}
-	l = m.OriginMailbox.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.OriginDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *RouteBridgeFee) GetPolicy() BridgeFeePolicy {
+	if m != nil {
+		return m.Policy
 	}
-	l = m.CollateralBalance.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.IsmId != nil {
-		l = m.IsmId.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return BridgeFeePolicy{}
+}
+
+// IbcForward is the instruction in the metadata of a warp payload to forward
+// the received tokens with an ICS-20 transfer to another IBC chain.
+type IbcForward struct {
+	// source_channel is the channel on this chain the transfer is sent over.
+	SourceChannel string `protobuf:"bytes,1,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// receiver is the recipient on the counterparty chain.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout_seconds is the timeout of the transfer relative to the block time
+	// of the receive. If zero, a default timeout is used.
+	TimeoutSeconds uint64 `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// memo is passed on to the ICS-20 transfer.
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *IbcForward) Reset()         { *m = IbcForward{} }
+func (m *IbcForward) String() string { return proto.CompactTextString(m) }
+func (*IbcForward) ProtoMessage()    {}
+func (*IbcForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{10}
+}
+func (m *IbcForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *IbcForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcForward.Merge(m, src)
+}
+func (m *IbcForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcForward.DiscardUnknown(m)
 }
 
-func (m *RemoteRouter) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_IbcForward proto.InternalMessageInfo
+
+func (m *IbcForward) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
 	}
-	var l int
-	_ = l
-	if m.ReceiverDomain != 0 {
-		n += 1 + sovTypes(uint64(m.ReceiverDomain))
+	return ""
+}
+
+func (m *IbcForward) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	l = len(m.ReceiverContract)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *IbcForward) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
 	}
-	l = m.Gas.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return 0
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *IbcForward) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// PendingIbcForward is an ICS-20 transfer of received tokens which is waiting
+// for its acknowledgement or timeout.
+type PendingIbcForward struct {
+	SourceChannel string                                                      `protobuf:"bytes,1,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	Sequence      uint64                                                      `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TokenId       github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3,customtype=github.com/bcp-innovations/hyperlane-cosmos/util.HexAddress" json:"token_id"`
+	// fallback_recipient receives the tokens if the transfer fails.
+	FallbackRecipient string     `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	Amount            types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
+
+func (m *PendingIbcForward) Reset()         { *m = PendingIbcForward{} }
+func (m *PendingIbcForward) String() string { return proto.CompactTextString(m) }
+func (*PendingIbcForward) ProtoMessage()    {}
+func (*PendingIbcForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7372986c61417e18, []int{11}
+}
+func (m *PendingIbcForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIbcForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIbcForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingIbcForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIbcForward.Merge(m, src)
+}
+func (m *PendingIbcForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIbcForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIbcForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIbcForward proto.InternalMessageInfo
+
+func (m *PendingIbcForward) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *PendingIbcForward) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingIbcForward) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
+	}
+	return ""
+}
+
+func (m *PendingIbcForward) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("hyperlane.warp.v1.BridgeFeeType", BridgeFeeType_name, BridgeFeeType_value)
+	proto.RegisterEnum("hyperlane.warp.v1.HypTokenType", HypTokenType_name, HypTokenType_value)
+	proto.RegisterType((*Params)(nil), "hyperlane.warp.v1.Params")
+	proto.RegisterType((*HypToken)(nil), "hyperlane.warp.v1.HypToken")
+	proto.RegisterType((*RemoteRouter)(nil), "hyperlane.warp.v1.RemoteRouter")
+	proto.RegisterType((*TokenMetadata)(nil), "hyperlane.warp.v1.TokenMetadata")
+	proto.RegisterType((*RateLimitConfig)(nil), "hyperlane.warp.v1.RateLimitConfig")
+	proto.RegisterType((*RateLimitState)(nil), "hyperlane.warp.v1.RateLimitState")
+	proto.RegisterType((*RouteRateLimit)(nil), "hyperlane.warp.v1.RouteRateLimit")
+	proto.RegisterType((*BridgeFeePolicy)(nil), "hyperlane.warp.v1.BridgeFeePolicy")
+	proto.RegisterType((*BridgeFeeTier)(nil), "hyperlane.warp.v1.BridgeFeeTier")
+	proto.RegisterType((*RouteBridgeFee)(nil), "hyperlane.warp.v1.RouteBridgeFee")
+	proto.RegisterType((*IbcForward)(nil), "hyperlane.warp.v1.IbcForward")
+	proto.RegisterType((*PendingIbcForward)(nil), "hyperlane.warp.v1.PendingIbcForward")
+}
+
+func init() { proto.RegisterFile("hyperlane/warp/v1/types.proto", fileDescriptor_7372986c61417e18) }
+
+var fileDescriptor_7372986c61417e18 = []byte{
+	// 1365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x89, 0xe3, 0x3c, 0x7b, 0x1d, 0x67, 0xbe, 0x6d, 0xbf, 0xdb, 0x40, 0x1d, 0xd7,
+	0x80, 0xb0, 0x82, 0x6a, 0x2b, 0x85, 0x03, 0xd0, 0x52, 0x35, 0x76, 0x9c, 0xc6, 0x22, 0x4d, 0xa3,
+	0x8d, 0x7b, 0x68, 0x85, 0x58, 0x8d, 0x77, 0x27, 0xce, 0x90, 0xdd, 0x99, 0xed, 0xee, 0x38, 0x3f,
+	0xb8, 0x23, 0x21, 0xc4, 0x81, 0xff, 0x01, 0x0e, 0x1c, 0x8b, 0xc4, 0x95, 0x7b, 0xc5, 0xa9, 0xe2,
+	0x84, 0x38, 0x54, 0xa8, 0x3d, 0xf4, 0xdf, 0x40, 0xb3, 0x33, 0xde, 0x26, 0xa6, 0x34, 0x0d, 0x14,
+	0x71, 0xb1, 0xde, 0x7c, 0xde, 0x7b, 0x33, 0x9f, 0x79, 0xf3, 0x7e, 0xac, 0xe1, 0xc2, 0xce, 0x61,
+	0x48, 0x22, 0x1f, 0x33, 0xd2, 0xdc, 0xc7, 0x51, 0xd8, 0xdc, 0x5b, 0x6a, 0x8a, 0xc3, 0x90, 0xc4,
+	0x8d, 0x30, 0xe2, 0x82, 0xa3, 0xb9, 0x54, 0xdd, 0x90, 0xea, 0xc6, 0xde, 0xd2, 0xfc, 0x79, 0x97,
+	0xc7, 0x01, 0x8f, 0x9d, 0xc4, 0xa0, 0xa9, 0x16, 0xca, 0x7a, 0xfe, 0xcc, 0x80, 0x0f, 0xb8, 0xc2,
+	0xa5, 0xa4, 0xd1, 0x39, 0x1c, 0x50, 0xc6, 0x9b, 0xc9, 0xaf, 0x86, 0x2a, 0xca, 0xad, 0xd9, 0xc7,
+	0x31, 0x69, 0xee, 0x2d, 0xf5, 0x89, 0xc0, 0x4b, 0x4d, 0x97, 0x53, 0xa6, 0xf4, 0xb5, 0x37, 0x21,
+	0xb7, 0x89, 0x23, 0x1c, 0xc4, 0x1f, 0xce, 0x7f, 0xf5, 0xf4, 0xfe, 0xe2, 0xd9, 0x31, 0x92, 0x4a,
+	0x57, 0xfb, 0x6e, 0x1a, 0xf2, 0x6b, 0x87, 0x61, 0x8f, 0xef, 0x12, 0x86, 0xb6, 0x20, 0x43, 0x3d,
+	0xcb, 0xa8, 0x1a, 0xf5, 0x99, 0x56, 0xfb, 0xc1, 0xa3, 0x85, 0x89, 0xdf, 0x1e, 0x2d, 0x5c, 0x19,
+	0x50, 0xb1, 0x33, 0xec, 0x37, 0x5c, 0x1e, 0x34, 0xfb, 0x6e, 0x78, 0x89, 0x32, 0xc6, 0xf7, 0xb0,
+	0xa0, 0x9c, 0xc5, 0xcd, 0x74, 0xcb, 0x4b, 0x9a, 0xcb, 0x50, 0x50, 0xbf, 0xb1, 0x46, 0x0e, 0x96,
+	0x3d, 0x2f, 0x22, 0x71, 0x6c, 0x67, 0xa8, 0x87, 0x1a, 0x30, 0xc5, 0xf7, 0x19, 0x89, 0xac, 0x4c,
+	0xb2, 0xaf, 0xf5, 0xcb, 0x8f, 0x97, 0xce, 0xe8, 0x1b, 0x6b, 0xb3, 0x2d, 0x11, 0x51, 0x36, 0xb0,
+	0x95, 0x19, 0xba, 0x06, 0x20, 0x24, 0x1b, 0x47, 0xc6, 0xd0, 0xca, 0x56, 0x8d, 0x7a, 0xe9, 0xf2,
+	0x42, 0xe3, 0x4f, 0x31, 0x6c, 0x8c, 0x58, 0xf7, 0x0e, 0x43, 0x62, 0xcf, 0x88, 0x91, 0x88, 0x3e,
+	0x83, 0x12, 0x8f, 0xe8, 0x80, 0x32, 0x27, 0xc0, 0xd4, 0xef, 0xf3, 0x03, 0x6b, 0xf2, 0xd5, 0x5d,
+	0xc8, 0x54, 0x5b, 0xdf, 0x54, 0x3b, 0xa3, 0x8b, 0x50, 0xd4, 0x67, 0x79, 0x84, 0xf1, 0xc0, 0x9a,
+	0x92, 0x27, 0xd9, 0x05, 0x85, 0xad, 0x48, 0x08, 0xad, 0x03, 0x72, 0xb9, 0xef, 0x63, 0x41, 0x22,
+	0xec, 0x3b, 0x7d, 0xec, 0x63, 0xe6, 0x12, 0x2b, 0x97, 0x50, 0xba, 0xa0, 0x29, 0x9d, 0x55, 0xa7,
+	0xc5, 0xde, 0x6e, 0x83, 0xf2, 0x66, 0x80, 0xc5, 0x4e, 0xa3, 0xcb, 0x84, 0x3d, 0xf7, 0xcc, 0xb1,
+	0xa5, 0xfc, 0xd0, 0x5d, 0xc8, 0xd1, 0x38, 0x70, 0xa8, 0x67, 0x4d, 0xa7, 0x97, 0x32, 0xfe, 0xe9,
+	0xa5, 0xa6, 0x68, 0x1c, 0x74, 0x3d, 0x34, 0x0f, 0x79, 0x8f, 0xb8, 0x34, 0xc0, 0x7e, 0x6c, 0xe5,
+	0xab, 0x46, 0xdd, 0xb4, 0xd3, 0x35, 0x3a, 0x07, 0xb9, 0x10, 0x0f, 0x63, 0xe2, 0x59, 0x33, 0x55,
+	0xa3, 0x9e, 0xb7, 0xf5, 0x0a, 0xbd, 0x05, 0x25, 0x25, 0x39, 0x1e, 0x0f, 0x30, 0x65, 0xb1, 0x05,
+	0xd5, 0x6c, 0xdd, 0xb4, 0x4d, 0x85, 0xae, 0x28, 0x10, 0xbd, 0x07, 0xf9, 0xc1, 0x10, 0x47, 0x1e,
+	0xc5, 0xcc, 0x2a, 0x9c, 0x90, 0x06, 0xa9, 0x25, 0xba, 0x01, 0xd0, 0x8f, 0xa8, 0x37, 0x20, 0xce,
+	0x36, 0x21, 0x56, 0xb1, 0x6a, 0xd4, 0x0b, 0x97, 0x6b, 0xcf, 0xc9, 0x84, 0x56, 0x62, 0xb4, 0x4a,
+	0xc8, 0x26, 0xf7, 0xa9, 0x7b, 0xd8, 0x9a, 0x94, 0x61, 0xb5, 0x67, 0xfa, 0x23, 0x18, 0x7d, 0x04,
+	0xe6, 0x36, 0x21, 0x4e, 0x44, 0x5c, 0x1a, 0x52, 0xc2, 0x84, 0x65, 0x9e, 0xc0, 0xa1, 0xb8, 0x4d,
+	0x88, 0x3d, 0xb2, 0x46, 0x9f, 0xc0, 0xf4, 0x0e, 0xe7, 0xbb, 0x32, 0xea, 0xa5, 0x57, 0x17, 0xf5,
+	0x9c, 0xdc, 0xb3, 0xeb, 0xa1, 0x37, 0xc0, 0x4c, 0x76, 0x0f, 0x88, 0xc0, 0x1e, 0x16, 0xd8, 0x9a,
+	0xad, 0x1a, 0xf5, 0xa2, 0x5d, 0x94, 0xe0, 0x4d, 0x8d, 0xd5, 0x7e, 0x30, 0xa0, 0x68, 0x93, 0x80,
+	0x0b, 0x62, 0xf3, 0xa1, 0x20, 0x11, 0x7a, 0x1b, 0x66, 0x23, 0xe2, 0x12, 0xba, 0x47, 0x22, 0x1d,
+	0xfa, 0xa4, 0x6e, 0x4d, 0xbb, 0x34, 0x82, 0x55, 0xec, 0xd1, 0x3b, 0x30, 0x97, 0x1a, 0xba, 0x9c,
+	0x89, 0x08, 0xbb, 0x42, 0x95, 0xa2, 0x5d, 0x1e, 0x29, 0xda, 0x1a, 0x47, 0x4d, 0xc8, 0x0e, 0x70,
+	0x6c, 0x65, 0x5f, 0x26, 0x3b, 0xa5, 0xe5, 0xb1, 0x9c, 0x99, 0x3c, 0x9e, 0x33, 0x35, 0x0e, 0x66,
+	0x52, 0xa0, 0xa3, 0x4b, 0x20, 0x04, 0x93, 0x0c, 0x07, 0x44, 0x35, 0x18, 0x3b, 0x91, 0x65, 0x62,
+	0xc5, 0x87, 0x41, 0x9f, 0xfb, 0x9a, 0x93, 0x5e, 0xa1, 0x2a, 0x14, 0x3c, 0x12, 0xbb, 0x11, 0x0d,
+	0x65, 0x38, 0x15, 0x23, 0xfb, 0x28, 0x84, 0xca, 0x90, 0x1d, 0x46, 0x54, 0x15, 0xb7, 0x2d, 0xc5,
+	0xda, 0xe7, 0x30, 0x6b, 0x63, 0x41, 0xd6, 0x69, 0x40, 0x45, 0x9b, 0xb3, 0x6d, 0x3a, 0x40, 0xd7,
+	0xa1, 0x18, 0xe0, 0x03, 0xc7, 0xc5, 0x21, 0x76, 0xa9, 0x38, 0xb4, 0x8c, 0x97, 0xb9, 0x59, 0x21,
+	0xc0, 0x07, 0x6d, 0xed, 0x21, 0x33, 0x7c, 0x9f, 0x32, 0x8f, 0xef, 0x3b, 0x31, 0x71, 0x39, 0xf3,
+	0xe2, 0x84, 0xe8, 0xa4, 0x6d, 0x2a, 0x74, 0x4b, 0x81, 0x35, 0x06, 0xa5, 0xf4, 0xec, 0x2d, 0x81,
+	0x05, 0x41, 0x1f, 0x40, 0xfe, 0x74, 0xc7, 0xa6, 0xe6, 0xb2, 0xad, 0xf8, 0x38, 0x16, 0xce, 0x30,
+	0xf4, 0xb0, 0x20, 0x5e, 0x72, 0x62, 0xd6, 0x2e, 0x48, 0xec, 0xb6, 0x82, 0x6a, 0xf7, 0xb3, 0x50,
+	0x4a, 0x52, 0x21, 0x3d, 0x15, 0x7d, 0x0a, 0x79, 0xd5, 0x38, 0x5f, 0x6d, 0x0f, 0x9f, 0x4e, 0x36,
+	0xed, 0x7a, 0xf2, 0xa9, 0x74, 0xa6, 0x65, 0x92, 0x97, 0xd6, 0x2b, 0xd4, 0x82, 0x69, 0xca, 0xfa,
+	0x7c, 0xc8, 0x3c, 0x2b, 0xfb, 0x97, 0x35, 0x3a, 0xf6, 0x30, 0xba, 0x46, 0x47, 0x8e, 0x68, 0x1d,
+	0x4c, 0x2d, 0x3a, 0xb1, 0x8c, 0x5e, 0xf2, 0xac, 0x85, 0xcb, 0x17, 0x5f, 0xb4, 0x53, 0x12, 0x66,
+	0xbd, 0x51, 0x51, 0x7b, 0xab, 0xd0, 0xaf, 0x40, 0x9e, 0x0f, 0x85, 0xa2, 0x34, 0x75, 0x4a, 0x4a,
+	0xa9, 0x27, 0xda, 0x80, 0xd2, 0x48, 0xd6, 0xa4, 0x72, 0xa7, 0x23, 0x65, 0x8e, 0xdc, 0x13, 0xb0,
+	0xf6, 0xc8, 0x80, 0xd9, 0xb1, 0x56, 0x85, 0xae, 0x40, 0x5e, 0x76, 0xa6, 0x64, 0xd4, 0x19, 0xc9,
+	0xa8, 0xab, 0xbe, 0xa8, 0xc1, 0x25, 0xb3, 0x6e, 0x7a, 0x5b, 0x09, 0xe8, 0x1a, 0x14, 0xb6, 0x7d,
+	0x2c, 0x1c, 0x1c, 0xf0, 0x21, 0xd3, 0x45, 0x7d, 0x52, 0x92, 0x81, 0xf4, 0x58, 0x4e, 0x1c, 0x64,
+	0x05, 0xf5, 0x43, 0x55, 0xed, 0xa6, 0x2d, 0x45, 0x74, 0x15, 0xa6, 0x04, 0x25, 0x91, 0xac, 0xe5,
+	0x6c, 0xbd, 0x70, 0x02, 0x17, 0x4a, 0x22, 0x7d, 0x51, 0xe5, 0x54, 0x73, 0xc0, 0x3c, 0xa6, 0x45,
+	0x57, 0x01, 0x02, 0xca, 0x46, 0xfc, 0x5e, 0xaa, 0x08, 0x66, 0x02, 0xca, 0x8e, 0xd3, 0xcb, 0xa4,
+	0xf4, 0x6a, 0x3f, 0x1b, 0x3a, 0xe9, 0xd3, 0x63, 0xfe, 0xb3, 0xa4, 0xbf, 0x0e, 0xb9, 0x30, 0x79,
+	0xc2, 0x17, 0xe4, 0xfc, 0xf3, 0xe7, 0x92, 0xf6, 0xab, 0x7d, 0x6d, 0x00, 0x74, 0xfb, 0xee, 0x2a,
+	0x8f, 0xf6, 0x71, 0x94, 0x4c, 0xd2, 0x98, 0x0f, 0x23, 0x97, 0x38, 0xee, 0x0e, 0x66, 0x8c, 0xf8,
+	0xba, 0x4d, 0x9a, 0x0a, 0x6d, 0x2b, 0x50, 0x36, 0xdc, 0x51, 0xd7, 0xd6, 0x1d, 0x33, 0x5d, 0xcb,
+	0x99, 0x20, 0x68, 0x40, 0xf8, 0x50, 0xa4, 0xbd, 0x2a, 0x9b, 0xf4, 0xaa, 0x92, 0x86, 0x75, 0xb3,
+	0x92, 0x8d, 0x38, 0x20, 0x01, 0xd7, 0xbd, 0x33, 0x91, 0x6b, 0x3f, 0x65, 0x60, 0x6e, 0x93, 0x30,
+	0x8f, 0xb2, 0xc1, 0xdf, 0x62, 0x15, 0x93, 0x7b, 0x43, 0x22, 0x3f, 0x6d, 0x54, 0x7b, 0x4c, 0xd7,
+	0xc7, 0x5e, 0x28, 0xfb, 0x2f, 0xbc, 0xd0, 0x0d, 0x40, 0xdb, 0xd8, 0xf7, 0xfb, 0xd8, 0xdd, 0x3d,
+	0x32, 0xe1, 0x27, 0x4f, 0x98, 0xf0, 0x73, 0x23, 0x9f, 0x67, 0x63, 0xfe, 0x2a, 0xe4, 0x74, 0xa6,
+	0xaa, 0x9e, 0x71, 0xbe, 0xa1, 0x3d, 0xe5, 0x17, 0x76, 0x43, 0x7f, 0x61, 0x37, 0xda, 0x9c, 0xb2,
+	0xd6, 0x8c, 0xbc, 0xc1, 0xf7, 0x4f, 0xef, 0x2f, 0x1a, 0xb6, 0xf6, 0x59, 0xfc, 0xc2, 0x38, 0x9a,
+	0xfd, 0xb2, 0x3c, 0x17, 0xe0, 0xb5, 0x96, 0xdd, 0x5d, 0xb9, 0xd1, 0x71, 0x56, 0x3b, 0x1d, 0xa7,
+	0x77, 0x67, 0xb3, 0xe3, 0xdc, 0xde, 0xd8, 0xda, 0xec, 0xb4, 0xbb, 0xab, 0xdd, 0xce, 0x4a, 0x79,
+	0x02, 0x59, 0x70, 0x66, 0xdc, 0x60, 0x75, 0x7d, 0xb9, 0x57, 0x36, 0xd0, 0xff, 0xe1, 0x7f, 0xe3,
+	0x9a, 0xd6, 0xe6, 0x56, 0x39, 0x83, 0xe6, 0xe1, 0xdc, 0xb8, 0xa2, 0xd7, 0xed, 0xd8, 0x9d, 0x95,
+	0x72, 0x76, 0x7e, 0xf2, 0xcb, 0x6f, 0x2b, 0x13, 0x8b, 0xf7, 0xa0, 0x78, 0xf4, 0xcb, 0x18, 0x55,
+	0x60, 0x7e, 0xed, 0xce, 0xa6, 0xd3, 0xbb, 0xf5, 0x71, 0x67, 0xe3, 0x79, 0x24, 0x2e, 0xc0, 0xf9,
+	0x31, 0x7d, 0xfb, 0xd6, 0xfa, 0xfa, 0x72, 0xaf, 0x63, 0x2f, 0xaf, 0x97, 0x0d, 0xf4, 0x3a, 0x58,
+	0x63, 0xea, 0xad, 0x3b, 0x1b, 0xbd, 0xb5, 0x4e, 0xaf, 0xdb, 0x2e, 0x67, 0xd4, 0x91, 0x2d, 0xfb,
+	0xc1, 0xe3, 0x8a, 0xf1, 0xf0, 0x71, 0xc5, 0xf8, 0xfd, 0x71, 0xc5, 0xf8, 0xe6, 0x49, 0x65, 0xe2,
+	0xe1, 0x93, 0xca, 0xc4, 0xaf, 0x4f, 0x2a, 0x13, 0x77, 0xdf, 0x3f, 0xcd, 0x0b, 0x1f, 0xa8, 0x3f,
+	0x26, 0xc9, 0x5f, 0xa7, 0x7e, 0x2e, 0xf9, 0x13, 0xf3, 0xee, 0x1f, 0x03, 0x00, 0x8c, 0x8c, 0xbb,
+	0x8a, 0x5c, 0x0d, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *HypToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HypToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HypToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookMetadata) > 0 {
+		i -= len(m.HookMetadata)
+		copy(dAtA[i:], m.HookMetadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.HookMetadata)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.HookId != nil {
+		{
+			size := m.HookId.Size()
+			i -= size
+			if _, err := m.HookId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PausedDomains) > 0 {
+		dAtA3 := make([]byte, len(m.PausedDomains)*10)
+		var j2 int
+		for _, num := range m.PausedDomains {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsmId != nil {
+		{
+			size := m.IsmId.Size()
+			i -= size
+			if _, err := m.IsmId.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.CollateralBalance.Size()
+		i -= size
+		if _, err := m.CollateralBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.OriginDenom) > 0 {
+		i -= len(m.OriginDenom)
+		copy(dAtA[i:], m.OriginDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OriginDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.OriginMailbox.Size()
+		i -= size
+		if _, err := m.OriginMailbox.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TokenType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TokenType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteRouter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteRouter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteRouter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Gas.Size()
+		i -= size
+		if _, err := m.Gas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReceiverContract) > 0 {
+		i -= len(m.ReceiverContract)
+		copy(dAtA[i:], m.ReceiverContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReceiverContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReceiverDomain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReceiverDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxCapacity.Size()
+		i -= size
+		if _, err := m.MaxCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Capacity.Size()
+		i -= size
+		if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OutboundState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Outbound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.InboundState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Inbound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Domain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Domain))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Bps != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.FlatAmount.Size()
+		i -= size
+		if _, err := m.FlatAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.FeeType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FeeType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bps != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Domain != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Domain))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingIbcForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingIbcForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingIbcForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HypToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TokenType != 0 {
+		n += 1 + sovTypes(uint64(m.TokenType))
+	}
+	l = m.OriginMailbox.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.OriginDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.CollateralBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.IsmId != nil {
+		l = m.IsmId.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedDomains) > 0 {
+		l = 0
+		for _, e := range m.PausedDomains {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.HookId != nil {
+		l = m.HookId.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.HookMetadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteRouter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiverDomain != 0 {
+		n += 1 + sovTypes(uint64(m.ReceiverDomain))
+	}
+	l = len(m.ReceiverContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Gas.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *TokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxCapacity.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *RateLimitState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capacity.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LastUpdated != 0 {
+		n += 1 + sovTypes(uint64(m.LastUpdated))
+	}
+	return n
+}
+
+func (m *RouteRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Domain != 0 {
+		n += 1 + sovTypes(uint64(m.Domain))
+	}
+	l = m.Inbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.InboundState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Outbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.OutboundState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *BridgeFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeType != 0 {
+		n += 1 + sovTypes(uint64(m.FeeType))
+	}
+	l = m.FlatAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Bps != 0 {
+		n += 1 + sovTypes(uint64(m.Bps))
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Bps != 0 {
+		n += 1 + sovTypes(uint64(m.Bps))
+	}
+	return n
+}
+
+func (m *RouteBridgeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Domain != 0 {
+		n += 1 + sovTypes(uint64(m.Domain))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *IbcForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutSeconds))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingIbcForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = m.TokenId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HypToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HypToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HypToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			m.TokenType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenType |= HypTokenType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.IsmId = &v
+			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedDomains = append(m.PausedDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedDomains) == 0 {
+					m.PausedDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedDomains = append(m.PausedDomains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDomains", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
+			m.HookId = &v
+			if err := m.HookId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookMetadata = append(m.HookMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.HookMetadata == nil {
+				m.HookMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteRouter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			}
+			m.ReceiverDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeType", wireType)
+			}
+			m.FeeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeType |= BridgeFeeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Tiers = append(m.Tiers, BridgeFeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -541,7 +3508,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HypToken) Unmarshal(dAtA []byte) error {
+func (m *BridgeFeeTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -564,15 +3531,15 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HypToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeFeeTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HypToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -600,13 +3567,82 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteBridgeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteBridgeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -634,13 +3670,15 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			m.TokenType = 0
+			m.Domain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -650,16 +3688,16 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokenType |= HypTokenType(b&0x7F) << shift
+				m.Domain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginMailbox", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -669,29 +3707,78 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OriginMailbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -719,11 +3806,11 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginDenom = string(dAtA[iNdEx:postIndex])
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -751,13 +3838,30 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -785,11 +3889,7 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_bcp_innovations_hyperlane_cosmos_util.HexAddress
-			m.IsmId = &v
-			if err := m.IsmId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -812,7 +3912,7 @@ func (m *HypToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
+func (m *PendingIbcForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -835,17 +3935,17 @@ func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoteRouter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingIbcForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoteRouter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingIbcForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverDomain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
-			m.ReceiverDomain = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -855,14 +3955,46 @@ func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReceiverDomain |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -890,11 +4022,13 @@ func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverContract = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -922,7 +4056,38 @@ func (m *RemoteRouter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex